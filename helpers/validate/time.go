@@ -63,337 +63,337 @@ func ISO8601DateTime(i interface{}, k string) (warnings []string, errors []error
 }
 
 func AzureTimeZoneString() func(interface{}, string) ([]string, []error) {
-	// List collected from https://support.microsoft.com/en-gb/help/973627/microsoft-time-zone-index-values
-	// TODO look into programatic retrieval https://docs.microsoft.com/en-us/rest/api/maps/timezone/gettimezoneenumwindows
-	validTimeZones := []string{
-		"Africa/Abidjan",
-		"Africa/Accra",
-		"Africa/Addis_Ababa",
-		"Africa/Algiers",
-		"Africa/Asmara",
-		"Africa/Bamako",
-		"Africa/Bangui",
-		"Africa/Banjul",
-		"Africa/Bissau",
-		"Africa/Blantyre",
-		"Africa/Brazzaville",
-		"Africa/Bujumbura",
-		"Africa/Cairo",
-		"Africa/Casablanca",
-		"Africa/Ceuta",
-		"Africa/Conakry",
-		"Africa/Dakar",
-		"Africa/Dar_es_Salaam",
-		"Africa/Djibouti",
-		"Africa/Douala",
-		"Africa/El_Aaiun",
-		"Africa/Freetown",
-		"Africa/Gaborone",
-		"Africa/Harare",
-		"Africa/Johannesburg",
-		"Africa/Juba",
-		"Africa/Kampala",
-		"Africa/Khartoum",
-		"Africa/Kigali",
-		"Africa/Kinshasa",
-		"Africa/Lagos",
-		"Africa/Libreville",
-		"Africa/Lome",
-		"Africa/Luanda",
-		"Africa/Lubumbashi",
-		"Africa/Lusaka",
-		"Africa/Malabo",
-		"Africa/Maputo",
-		"Africa/Maseru",
-		"Africa/Mbabane",
-		"Africa/Mogadishu",
-		"Africa/Monrovia",
-		"Africa/Nairobi",
-		"Africa/Ndjamena",
-		"Africa/Niamey",
-		"Africa/Nouakchott",
-		"Africa/Ouagadougou",
-		"Africa/Porto-Novo",
-		"Africa/Sao_Tome",
-		"Africa/Tripoli",
-		"Africa/Tunis",
-		"Africa/Windhoek",
-		"America/Adak",
-		"America/Anchorage",
-		"America/Anguilla",
-		"America/Antigua",
-		"America/Argentina/Buenos_Aires",
-		"America/Aruba",
-		"America/Asuncion",
-		"America/Atikokan",
-		"America/Barbados",
-		"America/Belize",
-		"America/Blanc-Sablon",
-		"America/Bogota",
-		"America/Cancun",
-		"America/Caracas",
-		"America/Cayenne",
-		"America/Cayman",
-		"America/Chicago",
-		"America/Chihuahua",
-		"America/Costa_Rica",
-		"America/Cuiaba",
-		"America/Curacao",
-		"America/Danmarkshavn",
-		"America/Dawson_Creek",
-		"America/Denver",
-		"America/Dominica",
-		"America/Edmonton",
-		"America/El_Salvador",
-		"America/Fortaleza",
-		"America/Godthab",
-		"America/Grand_Turk",
-		"America/Grenada",
-		"America/Guadeloupe",
-		"America/Guatemala",
-		"America/Guayaquil",
-		"America/Guyana",
-		"America/Halifax",
-		"America/Havana",
-		"America/Hermosillo",
-		"America/Jamaica",
-		"America/Kralendijk",
-		"America/La_Paz",
-		"America/Lima",
-		"America/Los_Angeles",
-		"America/Lower_Princes",
-		"America/Managua",
-		"America/Manaus",
-		"America/Marigot",
-		"America/Martinique",
-		"America/Matamoros",
-		"America/Mexico_City",
-		"America/Miquelon",
-		"America/Montevideo",
-		"America/Montserrat",
-		"America/Nassau",
-		"America/New_York",
-		"America/Noronha",
-		"America/Ojinaga",
-		"America/Panama",
-		"America/Paramaribo",
-		"America/Phoenix",
-		"America/Port-au-Prince",
-		"America/Port_of_Spain",
-		"America/Puerto_Rico",
-		"America/Punta_Arenas",
-		"America/Regina",
-		"America/Rio_Branco",
-		"America/Santiago",
-		"America/Santo_Domingo",
-		"America/Sao_Paulo",
-		"America/Scoresbysund",
-		"America/St_Barthelemy",
-		"America/St_Johns",
-		"America/St_Kitts",
-		"America/St_Lucia",
-		"America/St_Thomas",
-		"America/St_Vincent",
-		"America/Tegucigalpa",
-		"America/Thule",
-		"America/Tijuana",
-		"America/Toronto",
-		"America/Tortola",
-		"America/Vancouver",
-		"America/Winnipeg",
-		"Antarctica/Casey",
-		"Antarctica/Davis",
-		"Antarctica/DumontDUrville",
-		"Antarctica/Macquarie",
-		"Antarctica/Mawson",
-		"Antarctica/Palmer",
-		"Antarctica/Syowa",
-		"Antarctica/Troll",
-		"Antarctica/Vostok",
-		"Arctic/Longyearbyen",
-		"Asia/Aden",
-		"Asia/Almaty",
-		"Asia/Amman",
-		"Asia/Aqtobe",
-		"Asia/Ashgabat",
-		"Asia/Baghdad",
-		"Asia/Bahrain",
-		"Asia/Baku",
-		"Asia/Bangkok",
-		"Asia/Beirut",
-		"Asia/Bishkek",
-		"Asia/Brunei",
-		"Asia/Chita",
-		"Asia/Colombo",
-		"Asia/Damascus",
-		"Asia/Dhaka",
-		"Asia/Dili",
-		"Asia/Dubai",
-		"Asia/Dushanbe",
-		"Asia/Famagusta",
-		"Asia/Hebron",
-		"Asia/Ho_Chi_Minh",
-		"Asia/Hong_Kong",
-		"Asia/Hovd",
-		"Asia/Irkutsk",
-		"Asia/Jakarta",
-		"Asia/Jayapura",
-		"Asia/Jerusalem",
-		"Asia/Kabul",
-		"Asia/Kamchatka",
-		"Asia/Karachi",
-		"Asia/Kathmandu",
-		"Asia/Kolkata",
-		"Asia/Kuala_Lumpur",
-		"Asia/Kuwait",
-		"Asia/Macau",
-		"Asia/Makassar",
-		"Asia/Manila",
-		"Asia/Muscat",
-		"Asia/Nicosia",
-		"Asia/Novosibirsk",
-		"Asia/Omsk",
-		"Asia/Phnom_Penh",
-		"Asia/Pyongyang",
-		"Asia/Qatar",
-		"Asia/Riyadh",
-		"Asia/Sakhalin",
-		"Asia/Seoul",
-		"Asia/Shanghai",
-		"Asia/Singapore",
-		"Asia/Taipei",
-		"Asia/Tashkent",
-		"Asia/Tbilisi",
-		"Asia/Tehran",
-		"Asia/Thimphu",
-		"Asia/Tokyo",
-		"Asia/Ulaanbaatar",
-		"Asia/Vientiane",
-		"Asia/Vladivostok",
-		"Asia/Yangon",
-		"Asia/Yekaterinburg",
-		"Asia/Yerevan",
-		"Atlantic/Azores",
-		"Atlantic/Bermuda",
-		"Atlantic/Canary",
-		"Atlantic/Canary",
-		"Atlantic/Cape_Verde",
-		"Atlantic/Faroe",
-		"Atlantic/Reykjavik",
-		"Atlantic/South_Georgia",
-		"Atlantic/St_Helena",
-		"Atlantic/St_Helena",
-		"Atlantic/St_Helena",
-		"Atlantic/Stanley",
-		"Australia/Adelaide",
-		"Australia/Brisbane",
-		"Australia/Darwin",
-		"Australia/Eucla",
-		"Australia/Lord_Howe",
-		"Australia/Perth",
-		"Australia/Sydney",
-		"Europe/Amsterdam",
-		"Europe/Andorra",
-		"Europe/Athens",
-		"Europe/Belgrade",
-		"Europe/Belgrade",
-		"Europe/Berlin",
-		"Europe/Bratislava",
-		"Europe/Brussels",
-		"Europe/Bucharest",
-		"Europe/Budapest",
-		"Europe/Chisinau",
-		"Europe/Copenhagen",
-		"Europe/Dublin",
-		"Europe/Gibraltar",
-		"Europe/Guernsey",
-		"Europe/Helsinki",
-		"Europe/Isle_of_Man",
-		"Europe/Istanbul",
-		"Europe/Jersey",
-		"Europe/Kaliningrad",
-		"Europe/Kiev",
-		"Europe/Lisbon",
-		"Europe/Ljubljana",
-		"Europe/London",
-		"Europe/Luxembourg",
-		"Europe/Madrid",
-		"Europe/Malta",
-		"Europe/Mariehamn",
-		"Europe/Minsk",
-		"Europe/Monaco",
-		"Europe/Moscow",
-		"Europe/Oslo",
-		"Europe/Paris",
-		"Europe/Podgorica",
-		"Europe/Prague",
-		"Europe/Riga",
-		"Europe/Rome",
-		"Europe/Samara",
-		"Europe/San_Marino",
-		"Europe/Sarajevo",
-		"Europe/Skopje",
-		"Europe/Sofia",
-		"Europe/Stockholm",
-		"Europe/Tallinn",
-		"Europe/Tirane",
-		"Europe/Vaduz",
-		"Europe/Vatican",
-		"Europe/Vienna",
-		"Europe/Vilnius",
-		"Europe/Volgograd",
-		"Europe/Warsaw",
-		"Europe/Zagreb",
-		"Europe/Zurich",
-		"Indian/Antananarivo",
-		"Indian/Chagos",
-		"Indian/Chagos",
-		"Indian/Christmas",
-		"Indian/Cocos",
-		"Indian/Comoro",
-		"Indian/Kerguelen",
-		"Indian/Mahe",
-		"Indian/Maldives",
-		"Indian/Mauritius",
-		"Indian/Mayotte",
-		"Indian/Reunion",
-		"Pacific/Apia",
-		"Pacific/Auckland",
-		"Pacific/Bougainville",
-		"Pacific/Chatham",
-		"Pacific/Chuuk",
-		"Pacific/Easter",
-		"Pacific/Efate",
-		"Pacific/Enderbury",
-		"Pacific/Fakaofo",
-		"Pacific/Fiji",
-		"Pacific/Funafuti",
-		"Pacific/Galapagos",
-		"Pacific/Gambier",
-		"Pacific/Guadalcanal",
-		"Pacific/Guam",
-		"Pacific/Honolulu",
-		"Pacific/Kiritimati",
-		"Pacific/Majuro",
-		"Pacific/Marquesas",
-		"Pacific/Nauru",
-		"Pacific/Niue",
-		"Pacific/Norfolk",
-		"Pacific/Noumea",
-		"Pacific/Pago_Pago",
-		"Pacific/Palau",
-		"Pacific/Pitcairn",
-		"Pacific/Pohnpei",
-		"Pacific/Port_Moresby",
-		"Pacific/Rarotonga",
-		"Pacific/Saipan",
-		"Pacific/Tahiti",
-		"Pacific/Tarawa",
-		"Pacific/Tongatapu",
-		"Pacific/Wake",
-		"Pacific/Wallis",
-		"UTC",
-	}
+	return validation.StringInSlice(azureTimeZones, false)
+}
 
-	return validation.StringInSlice(validTimeZones, false)
+// List collected from https://support.microsoft.com/en-gb/help/973627/microsoft-time-zone-index-values
+// TODO look into programatic retrieval https://docs.microsoft.com/en-us/rest/api/maps/timezone/gettimezoneenumwindows
+var azureTimeZones = []string{
+	"Africa/Abidjan",
+	"Africa/Accra",
+	"Africa/Addis_Ababa",
+	"Africa/Algiers",
+	"Africa/Asmara",
+	"Africa/Bamako",
+	"Africa/Bangui",
+	"Africa/Banjul",
+	"Africa/Bissau",
+	"Africa/Blantyre",
+	"Africa/Brazzaville",
+	"Africa/Bujumbura",
+	"Africa/Cairo",
+	"Africa/Casablanca",
+	"Africa/Ceuta",
+	"Africa/Conakry",
+	"Africa/Dakar",
+	"Africa/Dar_es_Salaam",
+	"Africa/Djibouti",
+	"Africa/Douala",
+	"Africa/El_Aaiun",
+	"Africa/Freetown",
+	"Africa/Gaborone",
+	"Africa/Harare",
+	"Africa/Johannesburg",
+	"Africa/Juba",
+	"Africa/Kampala",
+	"Africa/Khartoum",
+	"Africa/Kigali",
+	"Africa/Kinshasa",
+	"Africa/Lagos",
+	"Africa/Libreville",
+	"Africa/Lome",
+	"Africa/Luanda",
+	"Africa/Lubumbashi",
+	"Africa/Lusaka",
+	"Africa/Malabo",
+	"Africa/Maputo",
+	"Africa/Maseru",
+	"Africa/Mbabane",
+	"Africa/Mogadishu",
+	"Africa/Monrovia",
+	"Africa/Nairobi",
+	"Africa/Ndjamena",
+	"Africa/Niamey",
+	"Africa/Nouakchott",
+	"Africa/Ouagadougou",
+	"Africa/Porto-Novo",
+	"Africa/Sao_Tome",
+	"Africa/Tripoli",
+	"Africa/Tunis",
+	"Africa/Windhoek",
+	"America/Adak",
+	"America/Anchorage",
+	"America/Anguilla",
+	"America/Antigua",
+	"America/Argentina/Buenos_Aires",
+	"America/Aruba",
+	"America/Asuncion",
+	"America/Atikokan",
+	"America/Barbados",
+	"America/Belize",
+	"America/Blanc-Sablon",
+	"America/Bogota",
+	"America/Cancun",
+	"America/Caracas",
+	"America/Cayenne",
+	"America/Cayman",
+	"America/Chicago",
+	"America/Chihuahua",
+	"America/Costa_Rica",
+	"America/Cuiaba",
+	"America/Curacao",
+	"America/Danmarkshavn",
+	"America/Dawson_Creek",
+	"America/Denver",
+	"America/Dominica",
+	"America/Edmonton",
+	"America/El_Salvador",
+	"America/Fortaleza",
+	"America/Godthab",
+	"America/Grand_Turk",
+	"America/Grenada",
+	"America/Guadeloupe",
+	"America/Guatemala",
+	"America/Guayaquil",
+	"America/Guyana",
+	"America/Halifax",
+	"America/Havana",
+	"America/Hermosillo",
+	"America/Jamaica",
+	"America/Kralendijk",
+	"America/La_Paz",
+	"America/Lima",
+	"America/Los_Angeles",
+	"America/Lower_Princes",
+	"America/Managua",
+	"America/Manaus",
+	"America/Marigot",
+	"America/Martinique",
+	"America/Matamoros",
+	"America/Mexico_City",
+	"America/Miquelon",
+	"America/Montevideo",
+	"America/Montserrat",
+	"America/Nassau",
+	"America/New_York",
+	"America/Noronha",
+	"America/Ojinaga",
+	"America/Panama",
+	"America/Paramaribo",
+	"America/Phoenix",
+	"America/Port-au-Prince",
+	"America/Port_of_Spain",
+	"America/Puerto_Rico",
+	"America/Punta_Arenas",
+	"America/Regina",
+	"America/Rio_Branco",
+	"America/Santiago",
+	"America/Santo_Domingo",
+	"America/Sao_Paulo",
+	"America/Scoresbysund",
+	"America/St_Barthelemy",
+	"America/St_Johns",
+	"America/St_Kitts",
+	"America/St_Lucia",
+	"America/St_Thomas",
+	"America/St_Vincent",
+	"America/Tegucigalpa",
+	"America/Thule",
+	"America/Tijuana",
+	"America/Toronto",
+	"America/Tortola",
+	"America/Vancouver",
+	"America/Winnipeg",
+	"Antarctica/Casey",
+	"Antarctica/Davis",
+	"Antarctica/DumontDUrville",
+	"Antarctica/Macquarie",
+	"Antarctica/Mawson",
+	"Antarctica/Palmer",
+	"Antarctica/Syowa",
+	"Antarctica/Troll",
+	"Antarctica/Vostok",
+	"Arctic/Longyearbyen",
+	"Asia/Aden",
+	"Asia/Almaty",
+	"Asia/Amman",
+	"Asia/Aqtobe",
+	"Asia/Ashgabat",
+	"Asia/Baghdad",
+	"Asia/Bahrain",
+	"Asia/Baku",
+	"Asia/Bangkok",
+	"Asia/Beirut",
+	"Asia/Bishkek",
+	"Asia/Brunei",
+	"Asia/Chita",
+	"Asia/Colombo",
+	"Asia/Damascus",
+	"Asia/Dhaka",
+	"Asia/Dili",
+	"Asia/Dubai",
+	"Asia/Dushanbe",
+	"Asia/Famagusta",
+	"Asia/Hebron",
+	"Asia/Ho_Chi_Minh",
+	"Asia/Hong_Kong",
+	"Asia/Hovd",
+	"Asia/Irkutsk",
+	"Asia/Jakarta",
+	"Asia/Jayapura",
+	"Asia/Jerusalem",
+	"Asia/Kabul",
+	"Asia/Kamchatka",
+	"Asia/Karachi",
+	"Asia/Kathmandu",
+	"Asia/Kolkata",
+	"Asia/Kuala_Lumpur",
+	"Asia/Kuwait",
+	"Asia/Macau",
+	"Asia/Makassar",
+	"Asia/Manila",
+	"Asia/Muscat",
+	"Asia/Nicosia",
+	"Asia/Novosibirsk",
+	"Asia/Omsk",
+	"Asia/Phnom_Penh",
+	"Asia/Pyongyang",
+	"Asia/Qatar",
+	"Asia/Riyadh",
+	"Asia/Sakhalin",
+	"Asia/Seoul",
+	"Asia/Shanghai",
+	"Asia/Singapore",
+	"Asia/Taipei",
+	"Asia/Tashkent",
+	"Asia/Tbilisi",
+	"Asia/Tehran",
+	"Asia/Thimphu",
+	"Asia/Tokyo",
+	"Asia/Ulaanbaatar",
+	"Asia/Vientiane",
+	"Asia/Vladivostok",
+	"Asia/Yangon",
+	"Asia/Yekaterinburg",
+	"Asia/Yerevan",
+	"Atlantic/Azores",
+	"Atlantic/Bermuda",
+	"Atlantic/Canary",
+	"Atlantic/Canary",
+	"Atlantic/Cape_Verde",
+	"Atlantic/Faroe",
+	"Atlantic/Reykjavik",
+	"Atlantic/South_Georgia",
+	"Atlantic/St_Helena",
+	"Atlantic/St_Helena",
+	"Atlantic/St_Helena",
+	"Atlantic/Stanley",
+	"Australia/Adelaide",
+	"Australia/Brisbane",
+	"Australia/Darwin",
+	"Australia/Eucla",
+	"Australia/Lord_Howe",
+	"Australia/Perth",
+	"Australia/Sydney",
+	"Europe/Amsterdam",
+	"Europe/Andorra",
+	"Europe/Athens",
+	"Europe/Belgrade",
+	"Europe/Belgrade",
+	"Europe/Berlin",
+	"Europe/Bratislava",
+	"Europe/Brussels",
+	"Europe/Bucharest",
+	"Europe/Budapest",
+	"Europe/Chisinau",
+	"Europe/Copenhagen",
+	"Europe/Dublin",
+	"Europe/Gibraltar",
+	"Europe/Guernsey",
+	"Europe/Helsinki",
+	"Europe/Isle_of_Man",
+	"Europe/Istanbul",
+	"Europe/Jersey",
+	"Europe/Kaliningrad",
+	"Europe/Kiev",
+	"Europe/Lisbon",
+	"Europe/Ljubljana",
+	"Europe/London",
+	"Europe/Luxembourg",
+	"Europe/Madrid",
+	"Europe/Malta",
+	"Europe/Mariehamn",
+	"Europe/Minsk",
+	"Europe/Monaco",
+	"Europe/Moscow",
+	"Europe/Oslo",
+	"Europe/Paris",
+	"Europe/Podgorica",
+	"Europe/Prague",
+	"Europe/Riga",
+	"Europe/Rome",
+	"Europe/Samara",
+	"Europe/San_Marino",
+	"Europe/Sarajevo",
+	"Europe/Skopje",
+	"Europe/Sofia",
+	"Europe/Stockholm",
+	"Europe/Tallinn",
+	"Europe/Tirane",
+	"Europe/Vaduz",
+	"Europe/Vatican",
+	"Europe/Vienna",
+	"Europe/Vilnius",
+	"Europe/Volgograd",
+	"Europe/Warsaw",
+	"Europe/Zagreb",
+	"Europe/Zurich",
+	"Indian/Antananarivo",
+	"Indian/Chagos",
+	"Indian/Chagos",
+	"Indian/Christmas",
+	"Indian/Cocos",
+	"Indian/Comoro",
+	"Indian/Kerguelen",
+	"Indian/Mahe",
+	"Indian/Maldives",
+	"Indian/Mauritius",
+	"Indian/Mayotte",
+	"Indian/Reunion",
+	"Pacific/Apia",
+	"Pacific/Auckland",
+	"Pacific/Bougainville",
+	"Pacific/Chatham",
+	"Pacific/Chuuk",
+	"Pacific/Easter",
+	"Pacific/Efate",
+	"Pacific/Enderbury",
+	"Pacific/Fakaofo",
+	"Pacific/Fiji",
+	"Pacific/Funafuti",
+	"Pacific/Galapagos",
+	"Pacific/Gambier",
+	"Pacific/Guadalcanal",
+	"Pacific/Guam",
+	"Pacific/Honolulu",
+	"Pacific/Kiritimati",
+	"Pacific/Majuro",
+	"Pacific/Marquesas",
+	"Pacific/Nauru",
+	"Pacific/Niue",
+	"Pacific/Norfolk",
+	"Pacific/Noumea",
+	"Pacific/Pago_Pago",
+	"Pacific/Palau",
+	"Pacific/Pitcairn",
+	"Pacific/Pohnpei",
+	"Pacific/Port_Moresby",
+	"Pacific/Rarotonga",
+	"Pacific/Saipan",
+	"Pacific/Tahiti",
+	"Pacific/Tarawa",
+	"Pacific/Tongatapu",
+	"Pacific/Wake",
+	"Pacific/Wallis",
+	"UTC",
 }
@@ -0,0 +1,132 @@
+package validate
+
+import (
+	"strings"
+)
+
+// azureTimeZoneAliases maps the alternate representations of a Time Zone which can be returned by
+// Azure APIs (such as the `Etc/` prefixed names or the Windows Time Zone IDs) to the canonical IANA
+// name - the keys are lower-cased to allow for a case-insensitive lookup.
+// Windows mappings taken from https://github.com/unicode-org/cldr/blob/main/common/supplemental/windowsZones.xml
+var azureTimeZoneAliases = map[string]string{
+	// UTC
+	"coordinated universal time": "UTC",
+	"etc/gmt":                    "UTC",
+	"etc/uct":                    "UTC",
+	"etc/universal":              "UTC",
+	"etc/utc":                    "UTC",
+	"etc/zulu":                   "UTC",
+	"uct":                        "UTC",
+	"universal":                  "UTC",
+	"utc standard time":          "UTC",
+	"zulu":                       "UTC",
+
+	// Windows Time Zone IDs
+	"afghanistan standard time":       "Asia/Kabul",
+	"alaskan standard time":           "America/Anchorage",
+	"arab standard time":              "Asia/Riyadh",
+	"arabian standard time":           "Asia/Dubai",
+	"arabic standard time":            "Asia/Baghdad",
+	"argentina standard time":         "America/Argentina/Buenos_Aires",
+	"atlantic standard time":          "America/Halifax",
+	"aus central standard time":       "Australia/Darwin",
+	"aus eastern standard time":       "Australia/Sydney",
+	"azerbaijan standard time":        "Asia/Baku",
+	"azores standard time":            "Atlantic/Azores",
+	"bangladesh standard time":        "Asia/Dhaka",
+	"belarus standard time":           "Europe/Minsk",
+	"canada central standard time":    "America/Regina",
+	"cape verde standard time":        "Atlantic/Cape_Verde",
+	"caucasus standard time":          "Asia/Yerevan",
+	"cen. australia standard time":    "Australia/Adelaide",
+	"central america standard time":   "America/Guatemala",
+	"central asia standard time":      "Asia/Almaty",
+	"central brazilian standard time": "America/Cuiaba",
+	"central europe standard time":    "Europe/Budapest",
+	"central european standard time":  "Europe/Warsaw",
+	"central pacific standard time":   "Pacific/Guadalcanal",
+	"central standard time":           "America/Chicago",
+	"central standard time (mexico)":  "America/Mexico_City",
+	"china standard time":             "Asia/Shanghai",
+	"e. africa standard time":         "Africa/Nairobi",
+	"e. australia standard time":      "Australia/Brisbane",
+	"e. europe standard time":         "Europe/Chisinau",
+	"e. south america standard time":  "America/Sao_Paulo",
+	"eastern standard time":           "America/New_York",
+	"egypt standard time":             "Africa/Cairo",
+	"ekaterinburg standard time":      "Asia/Yekaterinburg",
+	"fiji standard time":              "Pacific/Fiji",
+	"fle standard time":               "Europe/Kiev",
+	"georgian standard time":          "Asia/Tbilisi",
+	"gmt standard time":               "Europe/London",
+	"greenland standard time":         "America/Godthab",
+	"greenwich standard time":         "Atlantic/Reykjavik",
+	"gtb standard time":               "Europe/Bucharest",
+	"hawaiian standard time":          "Pacific/Honolulu",
+	"india standard time":             "Asia/Kolkata",
+	"iran standard time":              "Asia/Tehran",
+	"israel standard time":            "Asia/Jerusalem",
+	"jordan standard time":            "Asia/Amman",
+	"kaliningrad standard time":       "Europe/Kaliningrad",
+	"korea standard time":             "Asia/Seoul",
+	"libya standard time":             "Africa/Tripoli",
+	"line islands standard time":      "Pacific/Kiritimati",
+	"middle east standard time":       "Asia/Beirut",
+	"montevideo standard time":        "America/Montevideo",
+	"morocco standard time":           "Africa/Casablanca",
+	"mountain standard time":          "America/Denver",
+	"myanmar standard time":           "Asia/Yangon",
+	"n. central asia standard time":   "Asia/Novosibirsk",
+	"namibia standard time":           "Africa/Windhoek",
+	"nepal standard time":             "Asia/Kathmandu",
+	"new zealand standard time":       "Pacific/Auckland",
+	"newfoundland standard time":      "America/St_Johns",
+	"north asia east standard time":   "Asia/Irkutsk",
+	"omsk standard time":              "Asia/Omsk",
+	"pacific sa standard time":        "America/Santiago",
+	"pacific standard time":           "America/Los_Angeles",
+	"pakistan standard time":          "Asia/Karachi",
+	"paraguay standard time":          "America/Asuncion",
+	"romance standard time":           "Europe/Paris",
+	"russian standard time":           "Europe/Moscow",
+	"sa eastern standard time":        "America/Cayenne",
+	"sa pacific standard time":        "America/Bogota",
+	"sa western standard time":        "America/La_Paz",
+	"samoa standard time":             "Pacific/Apia",
+	"se asia standard time":           "Asia/Bangkok",
+	"singapore standard time":         "Asia/Singapore",
+	"south africa standard time":      "Africa/Johannesburg",
+	"sri lanka standard time":         "Asia/Colombo",
+	"syria standard time":             "Asia/Damascus",
+	"taipei standard time":            "Asia/Taipei",
+	"tokyo standard time":             "Asia/Tokyo",
+	"tonga standard time":             "Pacific/Tongatapu",
+	"turkey standard time":            "Europe/Istanbul",
+	"ulaanbaatar standard time":       "Asia/Ulaanbaatar",
+	"us mountain standard time":       "America/Phoenix",
+	"venezuela standard time":         "America/Caracas",
+	"vladivostok standard time":       "Asia/Vladivostok",
+	"w. australia standard time":      "Australia/Perth",
+	"w. central africa standard time": "Africa/Lagos",
+	"w. europe standard time":         "Europe/Berlin",
+	"west asia standard time":         "Asia/Tashkent",
+	"west pacific standard time":      "Pacific/Port_Moresby",
+}
+
+// CanonicalAzureTimeZone returns the canonical IANA name for the specified Time Zone, such that the
+// differing representations returned by Azure APIs (e.g. `UTC`, `Etc/UTC` and `Coordinated Universal Time`)
+// can be compared - unknown values are returned as-is.
+func CanonicalAzureTimeZone(input string) string {
+	key := strings.ToLower(strings.TrimSpace(input))
+	if v, ok := azureTimeZoneAliases[key]; ok {
+		return v
+	}
+
+	for _, v := range azureTimeZones {
+		if strings.EqualFold(v, key) {
+			return v
+		}
+	}
+
+	return input
+}
@@ -0,0 +1,66 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestCanonicalAzureTimeZone(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Input:    "UTC",
+			Expected: "UTC",
+		},
+		{
+			Input:    "Etc/UTC",
+			Expected: "UTC",
+		},
+		{
+			Input:    "etc/utc",
+			Expected: "UTC",
+		},
+		{
+			Input:    "Coordinated Universal Time",
+			Expected: "UTC",
+		},
+		{
+			Input:    "Pacific Standard Time",
+			Expected: "America/Los_Angeles",
+		},
+		{
+			Input:    "america/los_angeles",
+			Expected: "America/Los_Angeles",
+		},
+		{
+			Input:    "Europe/London",
+			Expected: "Europe/London",
+		},
+		{
+			Input:    "Not/A_Time_Zone",
+			Expected: "Not/A_Time_Zone",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			if actual := CanonicalAzureTimeZone(tc.Input); actual != tc.Expected {
+				t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestAzureTimeZoneAliasesAreValid(t *testing.T) {
+	validate := AzureTimeZoneString()
+	for alias, canonical := range azureTimeZoneAliases {
+		if _, errors := validate(canonical, "timezone"); len(errors) > 0 {
+			t.Fatalf("Expected the canonical Time Zone %q for %q to be valid but got: %+v", canonical, alias, errors)
+		}
+	}
+}
@@ -3,9 +3,16 @@ package features
 func Default() UserFeatures {
 	return UserFeatures{
 		// NOTE: ensure all nested objects are fully populated
+		AutomationVariable: AutomationVariableFeatures{
+			EscrowEncryptedValuesToKeyVault: false,
+		},
 		CognitiveAccount: CognitiveAccountFeatures{
 			PurgeSoftDeleteOnDestroy: true,
 		},
+		DataFactory: DataFactoryFeatures{
+			ValidateLinkedCustomServiceTypeProperties: false,
+			MaxConcurrentRequests:                     0,
+		},
 		KeyVault: KeyVaultFeatures{
 			PurgeSoftDeleteOnDestroy:    true,
 			RecoverSoftDeletedKeyVaults: true,
@@ -13,20 +20,31 @@ func Default() UserFeatures {
 		LogAnalyticsWorkspace: LogAnalyticsWorkspaceFeatures{
 			PermanentlyDeleteOnDestroy: false,
 		},
+		MonitorActionGroup: MonitorActionGroupFeatures{
+			DetectKeyVaultSecretRotation: false,
+		},
 		Network: NetworkFeatures{
 			RelaxedLocking: false,
 		},
+		RecoveryServicesVault: RecoveryServicesVaultFeatures{
+			PurgeSoftDeletedBackupItemsOnDestroy: false,
+		},
+		StreamAnalyticsJob: StreamAnalyticsJobFeatures{
+			ValidateTransformationQueryReferences: false,
+		},
 		TemplateDeployment: TemplateDeploymentFeatures{
 			DeleteNestedItemsDuringDeletion: true,
 		},
 		VirtualMachine: VirtualMachineFeatures{
-			DeleteOSDiskOnDeletion:     true,
-			GracefulShutdown:           false,
-			SkipShutdownAndForceDelete: false,
+			DeleteOSDiskOnDeletion:         true,
+			GracefulShutdown:               false,
+			ReimageEphemeralOSDiskOnResize: false,
+			SkipShutdownAndForceDelete:     false,
 		},
 		VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
-			ForceDelete:               false,
-			RollInstancesWhenRequired: true,
+			ForceDelete:                    false,
+			ReimageEphemeralOSDiskOnResize: false,
+			RollInstancesWhenRequired:      true,
 		},
 	}
 }
@@ -1,28 +1,44 @@
 package features
 
 type UserFeatures struct {
+	AutomationVariable     AutomationVariableFeatures
 	CognitiveAccount       CognitiveAccountFeatures
+	DataFactory            DataFactoryFeatures
 	VirtualMachine         VirtualMachineFeatures
 	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
 	KeyVault               KeyVaultFeatures
 	Network                NetworkFeatures
 	TemplateDeployment     TemplateDeploymentFeatures
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
+	MonitorActionGroup     MonitorActionGroupFeatures
+	RecoveryServicesVault  RecoveryServicesVaultFeatures
+	StreamAnalyticsJob     StreamAnalyticsJobFeatures
+}
+
+type AutomationVariableFeatures struct {
+	EscrowEncryptedValuesToKeyVault bool
 }
 
 type CognitiveAccountFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 }
 
+type DataFactoryFeatures struct {
+	ValidateLinkedCustomServiceTypeProperties bool
+	MaxConcurrentRequests                     int
+}
+
 type VirtualMachineFeatures struct {
-	DeleteOSDiskOnDeletion     bool
-	GracefulShutdown           bool
-	SkipShutdownAndForceDelete bool
+	DeleteOSDiskOnDeletion         bool
+	GracefulShutdown               bool
+	ReimageEphemeralOSDiskOnResize bool
+	SkipShutdownAndForceDelete     bool
 }
 
 type VirtualMachineScaleSetFeatures struct {
-	ForceDelete               bool
-	RollInstancesWhenRequired bool
+	ForceDelete                    bool
+	ReimageEphemeralOSDiskOnResize bool
+	RollInstancesWhenRequired      bool
 }
 
 type KeyVaultFeatures struct {
@@ -41,3 +57,15 @@ type TemplateDeploymentFeatures struct {
 type LogAnalyticsWorkspaceFeatures struct {
 	PermanentlyDeleteOnDestroy bool
 }
+
+type MonitorActionGroupFeatures struct {
+	DetectKeyVaultSecretRotation bool
+}
+
+type RecoveryServicesVaultFeatures struct {
+	PurgeSoftDeletedBackupItemsOnDestroy bool
+}
+
+type StreamAnalyticsJobFeatures struct {
+	ValidateTransformationQueryReferences bool
+}
@@ -4,12 +4,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 	// NOTE: if there's only one nested field these want to be Required (since there's no point
 	//       specifying the block otherwise) - however for 2+ they should be optional
 	features := map[string]*pluginsdk.Schema{
+		"automation_variable": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"escrow_encrypted_values_to_key_vault": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
 		// lintignore:XS003
 		"cognitive_account": {
 			Type:     pluginsdk.TypeList,
@@ -26,6 +41,28 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"data_factory": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validate_linked_custom_service_type_properties": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					// 0 means that the number of concurrent requests to the Data Factory API isn't limited
+					"max_concurrent_requests": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+
 		"key_vault": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -57,6 +94,20 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"monitor_action_group": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"detect_key_vault_secret_rotation": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
 		"network": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -71,6 +122,34 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"recovery_services_vault": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"purge_soft_deleted_backup_items_on_destroy": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"stream_analytics_job": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validate_transformation_query_references": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
 		"template_deployment": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -100,6 +179,10 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Type:     pluginsdk.TypeBool,
 						Optional: true,
 					},
+					"reimage_ephemeral_os_disk_on_resize": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
 					"skip_shutdown_and_force_delete": {
 						Type:     schema.TypeBool,
 						Optional: true,
@@ -118,6 +201,10 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Type:     pluginsdk.TypeBool,
 						Optional: true,
 					},
+					"reimage_ephemeral_os_disk_on_resize": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
 					"roll_instances_when_required": {
 						Type:     pluginsdk.TypeBool,
 						Required: true,
@@ -160,6 +247,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 
 	val := input[0].(map[string]interface{})
 
+	if raw, ok := val["automation_variable"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			automationVariableRaw := items[0].(map[string]interface{})
+			if v, ok := automationVariableRaw["escrow_encrypted_values_to_key_vault"]; ok {
+				features.AutomationVariable.EscrowEncryptedValuesToKeyVault = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["cognitive_account"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 && items[0] != nil {
@@ -170,6 +267,19 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["data_factory"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			dataFactoryRaw := items[0].(map[string]interface{})
+			if v, ok := dataFactoryRaw["validate_linked_custom_service_type_properties"]; ok {
+				features.DataFactory.ValidateLinkedCustomServiceTypeProperties = v.(bool)
+			}
+			if v, ok := dataFactoryRaw["max_concurrent_requests"]; ok {
+				features.DataFactory.MaxConcurrentRequests = v.(int)
+			}
+		}
+	}
+
 	if raw, ok := val["key_vault"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 && items[0] != nil {
@@ -193,6 +303,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["monitor_action_group"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			monitorActionGroupRaw := items[0].(map[string]interface{})
+			if v, ok := monitorActionGroupRaw["detect_key_vault_secret_rotation"]; ok {
+				features.MonitorActionGroup.DetectKeyVaultSecretRotation = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["network"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -203,6 +323,26 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["recovery_services_vault"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			recoveryServicesVaultRaw := items[0].(map[string]interface{})
+			if v, ok := recoveryServicesVaultRaw["purge_soft_deleted_backup_items_on_destroy"]; ok {
+				features.RecoveryServicesVault.PurgeSoftDeletedBackupItemsOnDestroy = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["stream_analytics_job"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			streamAnalyticsJobRaw := items[0].(map[string]interface{})
+			if v, ok := streamAnalyticsJobRaw["validate_transformation_query_references"]; ok {
+				features.StreamAnalyticsJob.ValidateTransformationQueryReferences = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["template_deployment"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -223,6 +363,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := virtualMachinesRaw["graceful_shutdown"]; ok {
 				features.VirtualMachine.GracefulShutdown = v.(bool)
 			}
+			if v, ok := virtualMachinesRaw["reimage_ephemeral_os_disk_on_resize"]; ok {
+				features.VirtualMachine.ReimageEphemeralOSDiskOnResize = v.(bool)
+			}
 			if v, ok := virtualMachinesRaw["skip_shutdown_and_force_delete"]; ok {
 				features.VirtualMachine.SkipShutdownAndForceDelete = v.(bool)
 			}
@@ -239,6 +382,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := scaleSetRaw["force_delete"]; ok {
 				features.VirtualMachineScaleSet.ForceDelete = v.(bool)
 			}
+			if v, ok := scaleSetRaw["reimage_ephemeral_os_disk_on_resize"]; ok {
+				features.VirtualMachineScaleSet.ReimageEphemeralOSDiskOnResize = v.(bool)
+			}
 		}
 	}
 
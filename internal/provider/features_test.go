@@ -18,9 +18,16 @@ func TestExpandFeatures(t *testing.T) {
 			Name:  "Empty Block",
 			Input: []interface{}{},
 			Expected: features.UserFeatures{
+				AutomationVariable: features.AutomationVariableFeatures{
+					EscrowEncryptedValuesToKeyVault: false,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
+				DataFactory: features.DataFactoryFeatures{
+					ValidateLinkedCustomServiceTypeProperties: false,
+					MaxConcurrentRequests:                     0,
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeleteOnDestroy:    true,
 					RecoverSoftDeletedKeyVaults: true,
@@ -28,20 +35,31 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
 				},
+				MonitorActionGroup: features.MonitorActionGroupFeatures{
+					DetectKeyVaultSecretRotation: false,
+				},
 				Network: features.NetworkFeatures{
 					RelaxedLocking: false,
 				},
+				RecoveryServicesVault: features.RecoveryServicesVaultFeatures{
+					PurgeSoftDeletedBackupItemsOnDestroy: false,
+				},
+				StreamAnalyticsJob: features.StreamAnalyticsJobFeatures{
+					ValidateTransformationQueryReferences: false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
 				VirtualMachine: features.VirtualMachineFeatures{
-					DeleteOSDiskOnDeletion:     true,
-					GracefulShutdown:           false,
-					SkipShutdownAndForceDelete: false,
+					DeleteOSDiskOnDeletion:         true,
+					GracefulShutdown:               false,
+					ReimageEphemeralOSDiskOnResize: false,
+					SkipShutdownAndForceDelete:     false,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
-					ForceDelete:               false,
-					RollInstancesWhenRequired: true,
+					ForceDelete:                    false,
+					ReimageEphemeralOSDiskOnResize: false,
+					RollInstancesWhenRequired:      true,
 				},
 			},
 		},
@@ -49,11 +67,22 @@ func TestExpandFeatures(t *testing.T) {
 			Name: "Complete Enabled",
 			Input: []interface{}{
 				map[string]interface{}{
+					"automation_variable": []interface{}{
+						map[string]interface{}{
+							"escrow_encrypted_values_to_key_vault": true,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": true,
 						},
 					},
+					"data_factory": []interface{}{
+						map[string]interface{}{
+							"validate_linked_custom_service_type_properties": true,
+							"max_concurrent_requests":                        10,
+						},
+					},
 					"key_vault": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy":    true,
@@ -65,11 +94,26 @@ func TestExpandFeatures(t *testing.T) {
 							"permanently_delete_on_destroy": true,
 						},
 					},
+					"monitor_action_group": []interface{}{
+						map[string]interface{}{
+							"detect_key_vault_secret_rotation": true,
+						},
+					},
 					"network": []interface{}{
 						map[string]interface{}{
 							"relaxed_locking": true,
 						},
 					},
+					"recovery_services_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_deleted_backup_items_on_destroy": true,
+						},
+					},
+					"stream_analytics_job": []interface{}{
+						map[string]interface{}{
+							"validate_transformation_query_references": true,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": true,
@@ -77,23 +121,32 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"virtual_machine": []interface{}{
 						map[string]interface{}{
-							"delete_os_disk_on_deletion":     true,
-							"graceful_shutdown":              true,
-							"skip_shutdown_and_force_delete": true,
+							"delete_os_disk_on_deletion":          true,
+							"graceful_shutdown":                   true,
+							"reimage_ephemeral_os_disk_on_resize": true,
+							"skip_shutdown_and_force_delete":      true,
 						},
 					},
 					"virtual_machine_scale_set": []interface{}{
 						map[string]interface{}{
-							"roll_instances_when_required": true,
-							"force_delete":                 true,
+							"roll_instances_when_required":        true,
+							"force_delete":                        true,
+							"reimage_ephemeral_os_disk_on_resize": true,
 						},
 					},
 				},
 			},
 			Expected: features.UserFeatures{
+				AutomationVariable: features.AutomationVariableFeatures{
+					EscrowEncryptedValuesToKeyVault: true,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
+				DataFactory: features.DataFactoryFeatures{
+					ValidateLinkedCustomServiceTypeProperties: true,
+					MaxConcurrentRequests:                     10,
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeleteOnDestroy:    true,
 					RecoverSoftDeletedKeyVaults: true,
@@ -101,20 +154,31 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
 				},
+				MonitorActionGroup: features.MonitorActionGroupFeatures{
+					DetectKeyVaultSecretRotation: true,
+				},
 				Network: features.NetworkFeatures{
 					RelaxedLocking: true,
 				},
+				RecoveryServicesVault: features.RecoveryServicesVaultFeatures{
+					PurgeSoftDeletedBackupItemsOnDestroy: true,
+				},
+				StreamAnalyticsJob: features.StreamAnalyticsJobFeatures{
+					ValidateTransformationQueryReferences: true,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
 				VirtualMachine: features.VirtualMachineFeatures{
-					DeleteOSDiskOnDeletion:     true,
-					GracefulShutdown:           true,
-					SkipShutdownAndForceDelete: true,
+					DeleteOSDiskOnDeletion:         true,
+					GracefulShutdown:               true,
+					ReimageEphemeralOSDiskOnResize: true,
+					SkipShutdownAndForceDelete:     true,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
-					RollInstancesWhenRequired: true,
-					ForceDelete:               true,
+					RollInstancesWhenRequired:      true,
+					ForceDelete:                    true,
+					ReimageEphemeralOSDiskOnResize: true,
 				},
 			},
 		},
@@ -122,11 +186,22 @@ func TestExpandFeatures(t *testing.T) {
 			Name: "Complete Disabled",
 			Input: []interface{}{
 				map[string]interface{}{
+					"automation_variable": []interface{}{
+						map[string]interface{}{
+							"escrow_encrypted_values_to_key_vault": false,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": false,
 						},
 					},
+					"data_factory": []interface{}{
+						map[string]interface{}{
+							"validate_linked_custom_service_type_properties": false,
+							"max_concurrent_requests":                        0,
+						},
+					},
 					"key_vault": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy":    false,
@@ -138,11 +213,26 @@ func TestExpandFeatures(t *testing.T) {
 							"permanently_delete_on_destroy": false,
 						},
 					},
+					"monitor_action_group": []interface{}{
+						map[string]interface{}{
+							"detect_key_vault_secret_rotation": false,
+						},
+					},
 					"network_locking": []interface{}{
 						map[string]interface{}{
 							"relaxed_locking": false,
 						},
 					},
+					"recovery_services_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_deleted_backup_items_on_destroy": false,
+						},
+					},
+					"stream_analytics_job": []interface{}{
+						map[string]interface{}{
+							"validate_transformation_query_references": false,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": false,
@@ -150,23 +240,32 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"virtual_machine": []interface{}{
 						map[string]interface{}{
-							"delete_os_disk_on_deletion":     false,
-							"graceful_shutdown":              false,
-							"skip_shutdown_and_force_delete": false,
+							"delete_os_disk_on_deletion":          false,
+							"graceful_shutdown":                   false,
+							"reimage_ephemeral_os_disk_on_resize": false,
+							"skip_shutdown_and_force_delete":      false,
 						},
 					},
 					"virtual_machine_scale_set": []interface{}{
 						map[string]interface{}{
-							"force_delete":                 false,
-							"roll_instances_when_required": false,
+							"force_delete":                        false,
+							"reimage_ephemeral_os_disk_on_resize": false,
+							"roll_instances_when_required":        false,
 						},
 					},
 				},
 			},
 			Expected: features.UserFeatures{
+				AutomationVariable: features.AutomationVariableFeatures{
+					EscrowEncryptedValuesToKeyVault: false,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
+				DataFactory: features.DataFactoryFeatures{
+					ValidateLinkedCustomServiceTypeProperties: false,
+					MaxConcurrentRequests:                     0,
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeleteOnDestroy:    false,
 					RecoverSoftDeletedKeyVaults: false,
@@ -174,20 +273,31 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
 				},
+				MonitorActionGroup: features.MonitorActionGroupFeatures{
+					DetectKeyVaultSecretRotation: false,
+				},
 				Network: features.NetworkFeatures{
 					RelaxedLocking: false,
 				},
+				RecoveryServicesVault: features.RecoveryServicesVaultFeatures{
+					PurgeSoftDeletedBackupItemsOnDestroy: false,
+				},
+				StreamAnalyticsJob: features.StreamAnalyticsJobFeatures{
+					ValidateTransformationQueryReferences: false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: false,
 				},
 				VirtualMachine: features.VirtualMachineFeatures{
-					DeleteOSDiskOnDeletion:     false,
-					GracefulShutdown:           false,
-					SkipShutdownAndForceDelete: false,
+					DeleteOSDiskOnDeletion:         false,
+					GracefulShutdown:               false,
+					ReimageEphemeralOSDiskOnResize: false,
+					SkipShutdownAndForceDelete:     false,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
-					ForceDelete:               false,
-					RollInstancesWhenRequired: false,
+					ForceDelete:                    false,
+					ReimageEphemeralOSDiskOnResize: false,
+					RollInstancesWhenRequired:      false,
 				},
 			},
 		},
@@ -202,6 +312,70 @@ func TestExpandFeatures(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesAutomationVariable(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"automation_variable": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				AutomationVariable: features.AutomationVariableFeatures{
+					EscrowEncryptedValuesToKeyVault: false,
+				},
+			},
+		},
+		{
+			Name: "Escrow Encrypted Values To Key Vault Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"automation_variable": []interface{}{
+						map[string]interface{}{
+							"escrow_encrypted_values_to_key_vault": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AutomationVariable: features.AutomationVariableFeatures{
+					EscrowEncryptedValuesToKeyVault: true,
+				},
+			},
+		},
+		{
+			Name: "Escrow Encrypted Values To Key Vault Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"automation_variable": []interface{}{
+						map[string]interface{}{
+							"escrow_encrypted_values_to_key_vault": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AutomationVariable: features.AutomationVariableFeatures{
+					EscrowEncryptedValuesToKeyVault: false,
+				},
+			},
+		},
+	}
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.AutomationVariable, testCase.Expected.AutomationVariable) {
+			t.Fatalf("Expected %+v but got %+v", result.AutomationVariable, testCase.Expected.AutomationVariable)
+		}
+	}
+}
+
 func TestExpandFeaturesCognitiveServices(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -267,6 +441,87 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesDataFactory(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"data_factory": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				DataFactory: features.DataFactoryFeatures{
+					ValidateLinkedCustomServiceTypeProperties: false,
+				},
+			},
+		},
+		{
+			Name: "Validate Linked Custom Service Type Properties Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"data_factory": []interface{}{
+						map[string]interface{}{
+							"validate_linked_custom_service_type_properties": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				DataFactory: features.DataFactoryFeatures{
+					ValidateLinkedCustomServiceTypeProperties: true,
+				},
+			},
+		},
+		{
+			Name: "Validate Linked Custom Service Type Properties Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"data_factory": []interface{}{
+						map[string]interface{}{
+							"validate_linked_custom_service_type_properties": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				DataFactory: features.DataFactoryFeatures{
+					ValidateLinkedCustomServiceTypeProperties: false,
+				},
+			},
+		},
+		{
+			Name: "Max Concurrent Requests",
+			Input: []interface{}{
+				map[string]interface{}{
+					"data_factory": []interface{}{
+						map[string]interface{}{
+							"max_concurrent_requests": 5,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				DataFactory: features.DataFactoryFeatures{
+					MaxConcurrentRequests: 5,
+				},
+			},
+		},
+	}
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.DataFactory, testCase.Expected.DataFactory) {
+			t.Fatalf("Expected %+v but got %+v", result.DataFactory, testCase.Expected.DataFactory)
+		}
+	}
+}
+
 func TestExpandFeaturesKeyVault(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -553,6 +808,29 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Reimage Ephemeral OS Disk On Resize Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion":          false,
+							"graceful_shutdown":                   false,
+							"reimage_ephemeral_os_disk_on_resize": true,
+							"skip_shutdown_and_force_delete":      false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion:         false,
+					GracefulShutdown:               false,
+					ReimageEphemeralOSDiskOnResize: true,
+					SkipShutdownAndForceDelete:     false,
+				},
+			},
+		},
 		{
 			Name: "All Disabled",
 			Input: []interface{}{
@@ -643,6 +921,27 @@ func TestExpandFeaturesVirtualMachineScaleSet(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Reimage Ephemeral OS Disk On Resize Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine_scale_set": []interface{}{
+						map[string]interface{}{
+							"force_delete":                        false,
+							"reimage_ephemeral_os_disk_on_resize": true,
+							"roll_instances_when_required":        false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
+					ForceDelete:                    false,
+					ReimageEphemeralOSDiskOnResize: true,
+					RollInstancesWhenRequired:      false,
+				},
+			},
+		},
 		{
 			Name: "All Fields Disabled",
 			Input: []interface{}{
@@ -736,3 +1035,195 @@ func TestExpandFeaturesLogAnalyticsWorkspace(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesRecoveryServicesVault(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"recovery_services_vault": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				RecoveryServicesVault: features.RecoveryServicesVaultFeatures{
+					PurgeSoftDeletedBackupItemsOnDestroy: false,
+				},
+			},
+		},
+		{
+			Name: "Purge Soft Deleted Backup Items Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"recovery_services_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_deleted_backup_items_on_destroy": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				RecoveryServicesVault: features.RecoveryServicesVaultFeatures{
+					PurgeSoftDeletedBackupItemsOnDestroy: true,
+				},
+			},
+		},
+		{
+			Name: "Purge Soft Deleted Backup Items Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"recovery_services_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_deleted_backup_items_on_destroy": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				RecoveryServicesVault: features.RecoveryServicesVaultFeatures{
+					PurgeSoftDeletedBackupItemsOnDestroy: false,
+				},
+			},
+		},
+	}
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.RecoveryServicesVault, testCase.Expected.RecoveryServicesVault) {
+			t.Fatalf("Expected %+v but got %+v", result.RecoveryServicesVault, testCase.Expected.RecoveryServicesVault)
+		}
+	}
+}
+
+func TestExpandFeaturesStreamAnalyticsJob(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"stream_analytics_job": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				StreamAnalyticsJob: features.StreamAnalyticsJobFeatures{
+					ValidateTransformationQueryReferences: false,
+				},
+			},
+		},
+		{
+			Name: "Validate Transformation Query References Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"stream_analytics_job": []interface{}{
+						map[string]interface{}{
+							"validate_transformation_query_references": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				StreamAnalyticsJob: features.StreamAnalyticsJobFeatures{
+					ValidateTransformationQueryReferences: true,
+				},
+			},
+		},
+		{
+			Name: "Validate Transformation Query References Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"stream_analytics_job": []interface{}{
+						map[string]interface{}{
+							"validate_transformation_query_references": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				StreamAnalyticsJob: features.StreamAnalyticsJobFeatures{
+					ValidateTransformationQueryReferences: false,
+				},
+			},
+		},
+	}
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.StreamAnalyticsJob, testCase.Expected.StreamAnalyticsJob) {
+			t.Fatalf("Expected %+v but got %+v", result.StreamAnalyticsJob, testCase.Expected.StreamAnalyticsJob)
+		}
+	}
+}
+
+func TestExpandFeaturesMonitorActionGroup(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"monitor_action_group": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				MonitorActionGroup: features.MonitorActionGroupFeatures{
+					DetectKeyVaultSecretRotation: false,
+				},
+			},
+		},
+		{
+			Name: "Detect Key Vault Secret Rotation Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"monitor_action_group": []interface{}{
+						map[string]interface{}{
+							"detect_key_vault_secret_rotation": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				MonitorActionGroup: features.MonitorActionGroupFeatures{
+					DetectKeyVaultSecretRotation: true,
+				},
+			},
+		},
+		{
+			Name: "Detect Key Vault Secret Rotation Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"monitor_action_group": []interface{}{
+						map[string]interface{}{
+							"detect_key_vault_secret_rotation": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				MonitorActionGroup: features.MonitorActionGroupFeatures{
+					DetectKeyVaultSecretRotation: false,
+				},
+			},
+		},
+	}
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.MonitorActionGroup, testCase.Expected.MonitorActionGroup) {
+			t.Fatalf("Expected %+v but got %+v", result.MonitorActionGroup, testCase.Expected.MonitorActionGroup)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package applicationinsights
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -32,14 +34,10 @@ func resourceApplicationInsightsSmartDetectionRule() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"Slow page load time",
-					"Slow server response time",
-					"Long dependency duration",
-				}, false),
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringInSlice(smartDetectionRuleDisplayNames(), false),
 				DiffSuppressFunc: smartDetectionRuleNameDiff,
 			},
 
@@ -65,7 +63,10 @@ func resourceApplicationInsightsSmartDetectionRule() *pluginsdk.Resource {
 			"additional_email_recipients": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
-				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.SmartDetectionRuleEmailAddress,
+				},
 			},
 		},
 	}
@@ -73,14 +74,14 @@ func resourceApplicationInsightsSmartDetectionRule() *pluginsdk.Resource {
 
 func resourceApplicationInsightsSmartDetectionRuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).AppInsights.SmartDetectionRuleClient
-	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Application Insights Samrt Detection Rule update.")
 
 	// The Smart Detection Rule name from the UI doesn't match what the API accepts.
-	// We'll have the user submit what the name looks like in the UI and trim it behind the scenes to match what the API accepts
-	name := strings.ToLower(strings.Join(strings.Split(d.Get("name").(string), " "), ""))
+	// We'll have the user submit what the name looks like in the UI and convert it behind the scenes to match what the API accepts
+	name := smartDetectionRuleApiName(d.Get("name").(string))
 	appInsightsID := d.Get("application_insights_id").(string)
 
 	id, err := parse.ComponentID(appInsightsID)
@@ -176,10 +177,41 @@ func resourceApplicationInsightsSmartDetectionRuleDelete(d *pluginsdk.ResourceDa
 	return nil
 }
 
+// smartDetectionRuleNames maps the names of the Smart Detection Rules displayed in the UI to the names which the API
+// accepts - the SDK doesn't define these, so this list needs updating as new Smart Detection Rules become available
+var smartDetectionRuleNames = map[string]string{
+	"Slow page load time":                 "slowpageloadtime",
+	"Slow server response time":           "slowserverresponsetime",
+	"Long dependency duration":            "longdependencyduration",
+	"Degradation in server response time": "degradationinserverresponsetime",
+	"Degradation in dependency duration":  "degradationindependencyduration",
+	"Degradation in trace severity ratio": "extension_traceseveritydetector",
+	"Abnormal rise in exception volume":   "extension_exceptionchangeextension",
+	"Potential memory leak detected":      "extension_memoryleakextension",
+	"Potential security issue detected":   "extension_securityextensionspackage",
+	"Abnormal rise in daily data volume":  "extension_billingdatavolumedailyspikeextension",
+}
+
+func smartDetectionRuleDisplayNames() []string {
+	names := make([]string, 0, len(smartDetectionRuleNames))
+	for name := range smartDetectionRuleNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func smartDetectionRuleApiName(displayName string) string {
+	if name, ok := smartDetectionRuleNames[displayName]; ok {
+		return name
+	}
+
+	return strings.ToLower(strings.Join(strings.Split(displayName, " "), ""))
+}
+
 // The Smart Detection Rule name from the UI doesn't match what the API accepts.
-// This Diff checks that the name UI name matches the API name when spaces are removed
+// This Diff checks that the name UI name matches the API name
 func smartDetectionRuleNameDiff(_, old string, new string, _ *pluginsdk.ResourceData) bool {
-	trimmedNew := strings.Join(strings.Split(strings.ToLower(new), " "), "")
-
-	return strings.EqualFold(old, trimmedNew)
+	return strings.EqualFold(old, smartDetectionRuleApiName(new))
 }
@@ -86,6 +86,23 @@ func TestAccApplicationInsightsSmartDetectionRule_longDependencyDuration(t *test
 	})
 }
 
+func TestAccApplicationInsightsSmartDetectionRule_extensionRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_smart_detection_rule", "test")
+	r := AppInsightsSmartDetectionRule{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.extensionRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("send_emails_to_subscription_owners").HasValue("false"),
+				check.That(data.ResourceName).Key("additional_email_recipients.#").HasValue("2"),
+				check.That("azurerm_application_insights_smart_detection_rule.test2").ExistsInAzure(r),
+			),
+		},
+	})
+}
+
 func (t AppInsightsSmartDetectionRule) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SmartDetectionRuleID(state.Attributes["id"])
 	if err != nil {
@@ -212,3 +229,37 @@ resource "azurerm_application_insights_smart_detection_rule" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (AppInsightsSmartDetectionRule) extensionRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  application_type    = "web"
+}
+
+resource "azurerm_application_insights_smart_detection_rule" "test" {
+  name                    = "Potential security issue detected"
+  application_insights_id = azurerm_application_insights.test.id
+
+  send_emails_to_subscription_owners = false
+  additional_email_recipients        = ["test@example.com", "test2@example.com"]
+}
+
+resource "azurerm_application_insights_smart_detection_rule" "test2" {
+  name                    = "Abnormal rise in exception volume"
+  application_insights_id = azurerm_application_insights.test.id
+  enabled                 = false
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,49 @@
+package validate
+
+import "testing"
+
+func TestSmartDetectionRuleEmailAddress(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "test",
+			Valid: false,
+		},
+		{
+			Input: "test@",
+			Valid: false,
+		},
+		{
+			Input: "test@example",
+			Valid: false,
+		},
+		{
+			Input: "test @example.com",
+			Valid: false,
+		},
+		{
+			Input: "test@example.com",
+			Valid: true,
+		},
+		{
+			Input: "first.last+alerts@sub.example.co.uk",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := SmartDetectionRuleEmailAddress(tc.Input, "additional_email_recipients")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package automation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceAutomationAccountUsage() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceAutomationAccountUsageRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"automation_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.AutomationAccountID,
+			},
+
+			// when specified, reading the Usage fails when the Automation Account is using a different SKU - such
+			// that module logic which relies on the limits of a given SKU can fail fast
+			"sku_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.AutomationAccountSkuName(),
+			},
+
+			"usage": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"unit": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"current_value": {
+							Type:     pluginsdk.TypeFloat,
+							Computed: true,
+						},
+
+						// -1 indicates that there's no limit
+						"limit": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"throttle_status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAutomationAccountUsageRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	accountClient := meta.(*clients.Client).Automation.AccountClient
+	client := meta.(*clients.Client).Automation.UsagesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AutomationAccountID(d.Get("automation_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	account, err := accountClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	skuName := ""
+	if props := account.AccountProperties; props != nil && props.Sku != nil {
+		skuName = string(props.Sku.Name)
+	}
+	if v := d.Get("sku_name").(string); v != "" && !strings.EqualFold(v, skuName) {
+		return fmt.Errorf("%s is using the SKU %q but expected %q", *id, skuName, v)
+	}
+
+	resp, err := client.ListByAutomationAccount(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing Usages for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("automation_account_id", id.ID())
+	d.Set("sku_name", skuName)
+	if err := d.Set("usage", flattenAutomationAccountUsages(resp.Value)); err != nil {
+		return fmt.Errorf("setting `usage`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenAutomationAccountUsages(input *[]automation.Usage) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		name := ""
+		displayName := ""
+		if v.Name != nil {
+			if v.Name.Value != nil {
+				name = *v.Name.Value
+			}
+			if v.Name.LocalizedValue != nil {
+				displayName = *v.Name.LocalizedValue
+			}
+		}
+
+		unit := ""
+		if v.Unit != nil {
+			unit = *v.Unit
+		}
+
+		currentValue := 0.0
+		if v.CurrentValue != nil {
+			currentValue = *v.CurrentValue
+		}
+
+		limit := 0
+		if v.Limit != nil {
+			limit = int(*v.Limit)
+		}
+
+		throttleStatus := ""
+		if v.ThrottleStatus != nil {
+			throttleStatus = *v.ThrottleStatus
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":            name,
+			"display_name":    displayName,
+			"unit":            unit,
+			"current_value":   currentValue,
+			"limit":           limit,
+			"throttle_status": throttleStatus,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,97 @@
+package automation_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AutomationAccountUsageDataSource struct {
+}
+
+func TestAccDataSourceAutomationAccountUsage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_automation_account_usage", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: AutomationAccountUsageDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sku_name").HasValue("Free"),
+				check.That(data.ResourceName).Key("usage.#").Exists(),
+				check.That(data.ResourceName).Key("usage.0.name").Exists(),
+				check.That(data.ResourceName).Key("usage.0.limit").Exists(),
+				check.That(data.ResourceName).Key("usage.0.current_value").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceAutomationAccountUsage_skuName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_automation_account_usage", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: AutomationAccountUsageDataSource{}.skuName(data, "Free"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sku_name").HasValue("Free"),
+				check.That(data.ResourceName).Key("usage.0.limit").Exists(),
+			),
+		},
+		{
+			Config:      AutomationAccountUsageDataSource{}.skuName(data, "Basic"),
+			ExpectError: regexp.MustCompile(`is using the SKU "Free" but expected "Basic"`),
+		},
+	})
+}
+
+func (AutomationAccountUsageDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctestautomationAccount-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Free"
+}
+
+data "azurerm_automation_account_usage" "test" {
+  automation_account_id = azurerm_automation_account.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (AutomationAccountUsageDataSource) skuName(data acceptance.TestData, skuName string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctestautomationAccount-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Free"
+}
+
+data "azurerm_automation_account_usage" "test" {
+  automation_account_id = azurerm_automation_account.test.id
+  sku_name              = "%[3]s"
+}
+`, data.RandomInteger, data.Locations.Primary, skuName)
+}
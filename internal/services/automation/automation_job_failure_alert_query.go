@@ -0,0 +1,61 @@
+package automation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	automationJobStatusFailed    = "Failed"
+	automationJobStatusStopped   = "Stopped"
+	automationJobStatusSuspended = "Suspended"
+)
+
+var (
+	automationJobFailureAlertResourceIdRegex = regexp.MustCompile(`\| where _ResourceId =~ "([^"]+)"`)
+	automationJobFailureAlertStatusesRegex   = regexp.MustCompile(`\| where ResultType in \(([^)]*)\)`)
+)
+
+// automationJobFailureAlertQuery builds the Log Analytics query used to detect Automation Jobs for the
+// specified Automation Account which have finished in one of the specified statuses. Job Logs are sent
+// to the `AzureDiagnostics` table where the status of the Job is exposed via the `ResultType` column.
+func automationJobFailureAlertQuery(automationAccountId string, statuses []string) string {
+	quoted := make([]string, 0)
+	for _, status := range statuses {
+		quoted = append(quoted, fmt.Sprintf("%q", status))
+	}
+
+	lines := []string{
+		"AzureDiagnostics",
+		`| where ResourceProvider == "MICROSOFT.AUTOMATION" and Category == "JobLogs"`,
+		fmt.Sprintf("| where _ResourceId =~ %q", automationAccountId),
+		fmt.Sprintf("| where ResultType in (%s)", strings.Join(quoted, ", ")),
+	}
+	return strings.Join(lines, "\n")
+}
+
+// parseAutomationJobFailureAlertQuery returns the Automation Account ID and Job Statuses from a query
+// generated by automationJobFailureAlertQuery
+func parseAutomationJobFailureAlertQuery(query string) (*string, []string, error) {
+	resourceIdMatches := automationJobFailureAlertResourceIdRegex.FindStringSubmatch(query)
+	if len(resourceIdMatches) != 2 {
+		return nil, nil, fmt.Errorf("the query doesn't filter on an Automation Account")
+	}
+	automationAccountId := resourceIdMatches[1]
+
+	statusesMatches := automationJobFailureAlertStatusesRegex.FindStringSubmatch(query)
+	if len(statusesMatches) != 2 {
+		return nil, nil, fmt.Errorf("the query doesn't filter on a Job Status")
+	}
+
+	statuses := make([]string, 0)
+	for _, v := range strings.Split(statusesMatches[1], ",") {
+		status := strings.Trim(strings.TrimSpace(v), `"`)
+		if status != "" {
+			statuses = append(statuses, status)
+		}
+	}
+
+	return &automationAccountId, statuses, nil
+}
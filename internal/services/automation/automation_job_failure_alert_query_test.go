@@ -0,0 +1,74 @@
+package automation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAutomationJobFailureAlertQueryRoundTrip(t *testing.T) {
+	automationAccountId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1"
+
+	testData := [][]string{
+		{automationJobStatusFailed},
+		{automationJobStatusFailed, automationJobStatusSuspended},
+		{automationJobStatusFailed, automationJobStatusStopped, automationJobStatusSuspended},
+	}
+
+	for _, statuses := range testData {
+		t.Logf("[DEBUG] Testing %+v..", statuses)
+
+		query := automationJobFailureAlertQuery(automationAccountId, statuses)
+		actualId, actualStatuses, err := parseAutomationJobFailureAlertQuery(query)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", query, err)
+		}
+
+		if *actualId != automationAccountId {
+			t.Fatalf("expected the Automation Account ID to be %q but got %q", automationAccountId, *actualId)
+		}
+		if !reflect.DeepEqual(actualStatuses, statuses) {
+			t.Fatalf("expected the Job Statuses to be %+v but got %+v", statuses, actualStatuses)
+		}
+	}
+}
+
+func TestParseAutomationJobFailureAlertQuery(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input string
+		Error bool
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "Missing Automation Account",
+			Input: "AzureDiagnostics\n| where ResultType in (\"Failed\")",
+			Error: true,
+		},
+		{
+			Name:  "Missing Job Statuses",
+			Input: "AzureDiagnostics\n| where _ResourceId =~ \"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1\"",
+			Error: true,
+		},
+		{
+			Name:  "Valid",
+			Input: "AzureDiagnostics\n| where _ResourceId =~ \"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1\"\n| where ResultType in (\"Failed\")",
+			Error: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		_, _, err := parseAutomationJobFailureAlertQuery(v.Input)
+		if v.Error && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
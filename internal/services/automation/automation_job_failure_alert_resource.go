@@ -0,0 +1,386 @@
+package automation
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
+	logAnalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	monitorParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	monitorValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const automationJobLogsCategory = "JobLogs"
+
+func resourceAutomationJobFailureAlert() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceAutomationJobFailureAlertCreateUpdate,
+		Read:   resourceAutomationJobFailureAlertRead,
+		Update: resourceAutomationJobFailureAlertCreateUpdate,
+		Delete: resourceAutomationJobFailureAlertDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := monitorParse.ScheduledQueryRulesID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: monitorValidate.MonitorDiagnosticSettingName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"automation_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AutomationAccountID,
+			},
+
+			"log_analytics_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceID,
+			},
+
+			"action_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: monitorValidate.ActionGroupID,
+			},
+
+			"job_statuses": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						automationJobStatusFailed,
+						automationJobStatusStopped,
+						automationJobStatusSuspended,
+					}, false),
+				},
+			},
+
+			"email_subject": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"severity": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 4),
+			},
+
+			"frequency": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(5, 1440),
+			},
+
+			"time_window": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(5, 2880),
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceAutomationJobFailureAlertCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	diagnosticSettingsClient := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	scheduledQueryRulesClient := meta.(*clients.Client).Monitor.ScheduledQueryRulesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := monitorParse.NewScheduledQueryRulesID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	automationAccountId, err := parse.AutomationAccountID(d.Get("automation_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		existing, err := scheduledQueryRulesClient.Get(ctx, id.ResourceGroup, id.ScheduledQueryRuleName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_automation_job_failure_alert", id.ID())
+		}
+	}
+
+	frequency := d.Get("frequency").(int)
+	timeWindow := d.Get("time_window").(int)
+	if timeWindow < frequency {
+		return fmt.Errorf("`time_window` must be greater than or equal to `frequency`")
+	}
+
+	statuses := *utils.ExpandStringSlice(d.Get("job_statuses").(*pluginsdk.Set).List())
+	if len(statuses) == 0 {
+		statuses = []string{automationJobStatusFailed, automationJobStatusSuspended}
+	}
+
+	// Job Logs have to be sent to the Log Analytics Workspace before they can be queried by the Alert
+	workspaceId := d.Get("log_analytics_workspace_id").(string)
+	diagnosticSetting := insights.DiagnosticSettingsResource{
+		DiagnosticSettings: &insights.DiagnosticSettings{
+			WorkspaceID: utils.String(workspaceId),
+			Logs: &[]insights.LogSettings{
+				{
+					Category: utils.String(automationJobLogsCategory),
+					Enabled:  utils.Bool(true),
+					RetentionPolicy: &insights.RetentionPolicy{
+						Enabled: utils.Bool(false),
+						Days:    utils.Int32(0),
+					},
+				},
+			},
+		},
+	}
+
+	// the Azure SDK prefixes the URI with a `/` such this makes a bad request if we don't trim the `/`
+	targetResourceId := strings.TrimPrefix(automationAccountId.ID(), "/")
+	if _, err := diagnosticSettingsClient.CreateOrUpdate(ctx, targetResourceId, diagnosticSetting, id.ScheduledQueryRuleName); err != nil {
+		return fmt.Errorf("creating/updating Diagnostic Setting %q for %s: %+v", id.ScheduledQueryRuleName, automationAccountId, err)
+	}
+
+	enabled := insights.True
+	if !d.Get("enabled").(bool) {
+		enabled = insights.False
+	}
+
+	action := insights.AlertingAction{
+		AznsAction: &insights.AzNsActionGroup{
+			ActionGroup: &[]string{d.Get("action_group_id").(string)},
+		},
+		Severity:  insights.AlertSeverity(strconv.Itoa(d.Get("severity").(int))),
+		OdataType: insights.OdataTypeMicrosoftWindowsAzureManagementMonitoringAlertsModelsMicrosoftAppInsightsNexusDataContractsResourcesScheduledQueryRulesAlertingAction,
+		Trigger: &insights.TriggerCondition{
+			ThresholdOperator: insights.ConditionalOperatorGreaterThan,
+			Threshold:         utils.Float(0),
+		},
+	}
+	if v := d.Get("email_subject").(string); v != "" {
+		action.AznsAction.EmailSubject = utils.String(v)
+	}
+
+	parameters := insights.LogSearchRuleResource{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		LogSearchRule: &insights.LogSearchRule{
+			Description: utils.String(fmt.Sprintf("Automation Jobs for %q finished with a status of %s", automationAccountId.Name, strings.Join(statuses, ", "))),
+			Enabled:     enabled,
+			Source: &insights.Source{
+				Query:        utils.String(automationJobFailureAlertQuery(automationAccountId.ID(), statuses)),
+				DataSourceID: utils.String(workspaceId),
+				QueryType:    insights.ResultCount,
+			},
+			Schedule: &insights.Schedule{
+				FrequencyInMinutes:  utils.Int32(int32(frequency)),
+				TimeWindowInMinutes: utils.Int32(int32(timeWindow)),
+			},
+			Action: action,
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := scheduledQueryRulesClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ScheduledQueryRuleName, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceAutomationJobFailureAlertRead(d, meta)
+}
+
+func resourceAutomationJobFailureAlertRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	diagnosticSettingsClient := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	scheduledQueryRulesClient := meta.(*clients.Client).Monitor.ScheduledQueryRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := monitorParse.ScheduledQueryRulesID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := scheduledQueryRulesClient.Get(ctx, id.ResourceGroup, id.ScheduledQueryRuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.ScheduledQueryRuleName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.LogSearchRule; props != nil {
+		if props.Source == nil || props.Source.Query == nil {
+			return fmt.Errorf("retrieving %s: `source.query` was nil", id)
+		}
+
+		automationAccountRaw, statuses, err := parseAutomationJobFailureAlertQuery(*props.Source.Query)
+		if err != nil {
+			return fmt.Errorf("parsing the query for %s: %+v", id, err)
+		}
+		automationAccountId, err := parse.AutomationAccountID(*automationAccountRaw)
+		if err != nil {
+			return err
+		}
+		d.Set("automation_account_id", automationAccountId.ID())
+
+		if err := d.Set("job_statuses", utils.FlattenStringSlice(&statuses)); err != nil {
+			return fmt.Errorf("setting `job_statuses`: %+v", err)
+		}
+
+		d.Set("enabled", props.Enabled == insights.True)
+
+		if schedule := props.Schedule; schedule != nil {
+			d.Set("frequency", schedule.FrequencyInMinutes)
+			d.Set("time_window", schedule.TimeWindowInMinutes)
+		}
+
+		if action, ok := props.Action.(insights.AlertingAction); ok {
+			severity, err := strconv.Atoi(string(action.Severity))
+			if err != nil {
+				return fmt.Errorf("parsing `severity` %q for %s: %+v", action.Severity, id, err)
+			}
+			d.Set("severity", severity)
+
+			actionGroupId := ""
+			emailSubject := ""
+			if v := action.AznsAction; v != nil {
+				if v.ActionGroup != nil && len(*v.ActionGroup) > 0 {
+					parsed, err := monitorParse.ActionGroupID((*v.ActionGroup)[0])
+					if err != nil {
+						return err
+					}
+					actionGroupId = parsed.ID()
+				}
+				if v.EmailSubject != nil {
+					emailSubject = *v.EmailSubject
+				}
+			}
+			d.Set("action_group_id", actionGroupId)
+			d.Set("email_subject", emailSubject)
+		}
+
+		// the Job Logs are only sent to the Workspace whilst the Diagnostic Setting exists - when it's been
+		// removed (or the Job Logs have been disabled) the Workspace is left empty so this is recreated
+		workspaceId := ""
+		targetResourceId := strings.TrimPrefix(automationAccountId.ID(), "/")
+		diagnosticSetting, err := diagnosticSettingsClient.Get(ctx, targetResourceId, id.ScheduledQueryRuleName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(diagnosticSetting.Response) {
+				return fmt.Errorf("retrieving Diagnostic Setting %q for %s: %+v", id.ScheduledQueryRuleName, automationAccountId, err)
+			}
+		}
+		if settings := diagnosticSetting.DiagnosticSettings; settings != nil && settings.WorkspaceID != nil && automationJobLogsEnabled(settings.Logs) {
+			parsed, err := logAnalyticsParse.LogAnalyticsWorkspaceID(*settings.WorkspaceID)
+			if err != nil {
+				return err
+			}
+			workspaceId = parsed.ID()
+		}
+		d.Set("log_analytics_workspace_id", workspaceId)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceAutomationJobFailureAlertDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	diagnosticSettingsClient := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	scheduledQueryRulesClient := meta.(*clients.Client).Monitor.ScheduledQueryRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := monitorParse.ScheduledQueryRulesID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	automationAccountId, err := parse.AutomationAccountID(d.Get("automation_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if resp, err := scheduledQueryRulesClient.Delete(ctx, id.ResourceGroup, id.ScheduledQueryRuleName); err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	targetResourceId := strings.TrimPrefix(automationAccountId.ID(), "/")
+	if resp, err := diagnosticSettingsClient.Delete(ctx, targetResourceId, id.ScheduledQueryRuleName); err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("deleting Diagnostic Setting %q for %s: %+v", id.ScheduledQueryRuleName, automationAccountId, err)
+		}
+	}
+
+	return nil
+}
+
+func automationJobLogsEnabled(input *[]insights.LogSettings) bool {
+	if input == nil {
+		return false
+	}
+
+	for _, v := range *input {
+		if v.Category != nil && strings.EqualFold(*v.Category, automationJobLogsCategory) && v.Enabled != nil && *v.Enabled {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,197 @@
+package automation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AutomationJobFailureAlertResource struct{}
+
+func TestAccAutomationJobFailureAlert_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_job_failure_alert", "test")
+	r := AutomationJobFailureAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("job_statuses.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAutomationJobFailureAlert_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_job_failure_alert", "test")
+	r := AutomationJobFailureAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAutomationJobFailureAlert_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_job_failure_alert", "test")
+	r := AutomationJobFailureAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("job_statuses.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAutomationJobFailureAlert_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_job_failure_alert", "test")
+	r := AutomationJobFailureAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (AutomationJobFailureAlertResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ScheduledQueryRulesID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Monitor.ScheduledQueryRulesClient.Get(ctx, id.ResourceGroup, id.ScheduledQueryRuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.LogSearchRule != nil), nil
+}
+
+func (r AutomationJobFailureAlertResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_job_failure_alert" "test" {
+  name                       = "acctest-jfa-%d"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  automation_account_id      = azurerm_automation_account.test.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  action_group_id            = azurerm_monitor_action_group.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r AutomationJobFailureAlertResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_job_failure_alert" "import" {
+  name                       = azurerm_automation_job_failure_alert.test.name
+  resource_group_name        = azurerm_automation_job_failure_alert.test.resource_group_name
+  location                   = azurerm_automation_job_failure_alert.test.location
+  automation_account_id      = azurerm_automation_job_failure_alert.test.automation_account_id
+  log_analytics_workspace_id = azurerm_automation_job_failure_alert.test.log_analytics_workspace_id
+  action_group_id            = azurerm_automation_job_failure_alert.test.action_group_id
+}
+`, r.basic(data))
+}
+
+func (r AutomationJobFailureAlertResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_job_failure_alert" "test" {
+  name                       = "acctest-jfa-%d"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  automation_account_id      = azurerm_automation_account.test.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  action_group_id            = azurerm_monitor_action_group.test.id
+  job_statuses               = ["Failed", "Stopped", "Suspended"]
+  email_subject              = "Automation Job Failed"
+  enabled                    = false
+  severity                   = 2
+  frequency                  = 15
+  time_window                = 30
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (AutomationJobFailureAlertResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
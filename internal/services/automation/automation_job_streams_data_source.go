@@ -0,0 +1,280 @@
+package automation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceAutomationJobStreams() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceAutomationJobStreamsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"automation_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.AutomationAccountID,
+			},
+
+			"job_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+				ExactlyOneOf: []string{"job_id", "runbook_name"},
+			},
+
+			// when specified, the streams of the most recently created Job for this Runbook are returned
+			"runbook_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.RunbookName(),
+				ExactlyOneOf: []string{"job_id", "runbook_name"},
+			},
+
+			"stream_types": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(automation.Debug),
+						string(automation.Error),
+						string(automation.Output),
+						string(automation.Progress),
+						string(automation.Verbose),
+						string(automation.Warning),
+					}, false),
+				},
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"status_details": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"exception": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"start_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"end_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"output": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"stream": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"text": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAutomationJobStreamsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.JobClient
+	streamClient := meta.(*clients.Client).Automation.JobStreamClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.AutomationAccountID(d.Get("automation_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	jobName := d.Get("job_id").(string)
+	if runbookName := d.Get("runbook_name").(string); jobName == "" && runbookName != "" {
+		filter := fmt.Sprintf("properties/runbook/name eq '%s'", runbookName)
+		iterator, err := client.ListByAutomationAccountComplete(ctx, accountId.ResourceGroup, accountId.Name, filter, "")
+		if err != nil {
+			return fmt.Errorf("listing Jobs for Runbook %q within %s: %+v", runbookName, *accountId, err)
+		}
+
+		var latest *time.Time
+		for iterator.NotDone() {
+			item := iterator.Value()
+			if props := item.JobCollectionItemProperties; props != nil && props.JobID != nil && props.CreationTime != nil {
+				if latest == nil || props.CreationTime.Time.After(*latest) {
+					latest = &props.CreationTime.Time
+					jobName = props.JobID.String()
+				}
+			}
+
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing Jobs for Runbook %q within %s: %+v", runbookName, *accountId, err)
+			}
+		}
+
+		if jobName == "" {
+			return fmt.Errorf("no Jobs were found for Runbook %q within %s", runbookName, *accountId)
+		}
+	}
+
+	id := parse.NewJobID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, jobName)
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	streamTypes := map[automation.JobStreamType]struct{}{
+		automation.Output:  {},
+		automation.Warning: {},
+		automation.Error:   {},
+	}
+	if v := d.Get("stream_types").(*pluginsdk.Set).List(); len(v) > 0 {
+		streamTypes = make(map[automation.JobStreamType]struct{}, len(v))
+		for _, streamType := range v {
+			streamTypes[automation.JobStreamType(streamType.(string))] = struct{}{}
+		}
+	}
+
+	streams := make([]interface{}, 0)
+	outputs := make([]string, 0)
+	iterator, err := streamClient.ListByJobComplete(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name, "", "")
+	if err != nil {
+		return fmt.Errorf("listing Streams for %s: %+v", id, err)
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if props := item.JobStreamProperties; props != nil && props.JobStreamID != nil {
+			if _, ok := streamTypes[props.StreamType]; ok {
+				// the Summary returned when listing the Streams is truncated, so the full text needs retrieving
+				stream, err := streamClient.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name, *props.JobStreamID, "")
+				if err != nil {
+					return fmt.Errorf("retrieving Stream %q for %s: %+v", *props.JobStreamID, id, err)
+				}
+
+				flattened := flattenAutomationJobStream(stream)
+				if props.StreamType == automation.Output {
+					outputs = append(outputs, flattened["text"].(string))
+				}
+				streams = append(streams, flattened)
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Streams for %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+	d.Set("automation_account_id", accountId.ID())
+	d.Set("job_id", id.Name)
+
+	if props := resp.JobProperties; props != nil {
+		runbookName := ""
+		if props.Runbook != nil && props.Runbook.Name != nil {
+			runbookName = *props.Runbook.Name
+		}
+		d.Set("runbook_name", runbookName)
+		d.Set("status", string(props.Status))
+		d.Set("status_details", props.StatusDetails)
+		d.Set("exception", props.Exception)
+
+		startTime := ""
+		if props.StartTime != nil {
+			startTime = props.StartTime.Format(time.RFC3339)
+		}
+		d.Set("start_time", startTime)
+
+		endTime := ""
+		if props.EndTime != nil {
+			endTime = props.EndTime.Format(time.RFC3339)
+		}
+		d.Set("end_time", endTime)
+	}
+
+	d.Set("output", strings.Join(outputs, "\n"))
+	if err := d.Set("stream", streams); err != nil {
+		return fmt.Errorf("setting `stream`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenAutomationJobStream(input automation.JobStream) map[string]interface{} {
+	output := map[string]interface{}{
+		"id":   "",
+		"type": "",
+		"time": "",
+		"text": "",
+	}
+
+	props := input.JobStreamProperties
+	if props == nil {
+		return output
+	}
+
+	if props.JobStreamID != nil {
+		output["id"] = *props.JobStreamID
+	}
+	output["type"] = string(props.StreamType)
+	if props.Time != nil {
+		output["time"] = props.Time.Format(time.RFC3339)
+	}
+	if props.StreamText != nil {
+		output["text"] = *props.StreamText
+	} else if props.Summary != nil {
+		output["text"] = *props.Summary
+	}
+
+	return output
+}
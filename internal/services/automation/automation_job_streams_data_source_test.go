@@ -0,0 +1,153 @@
+package automation_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AutomationJobStreamsDataSource struct {
+}
+
+func TestAccDataSourceAutomationJobStreams_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_automation_job_streams", "test")
+	r := AutomationJobStreamsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			// a One Time Schedule runs the Runbook 7 minutes after it's been created
+			Config: r.template(data),
+		},
+		{
+			PreConfig: func() { time.Sleep(15 * time.Minute) },
+			Config:    r.runbookName(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("job_id").Exists(),
+				check.That(data.ResourceName).Key("status").HasValue("Completed"),
+				check.That(data.ResourceName).Key("output").HasValue("Hello, World!"),
+				check.That(data.ResourceName).Key("stream.0.type").HasValue("Output"),
+			),
+		},
+		{
+			Config: r.jobId(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("runbook_name").HasValue("Output-HelloWorld"),
+				check.That(data.ResourceName).Key("status").HasValue("Completed"),
+				check.That(data.ResourceName).Key("output").HasValue("Hello, World!"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceAutomationJobStreams_noJobs(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_automation_job_streams", "test")
+	r := AutomationJobStreamsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      r.noJobs(data),
+			ExpectError: regexp.MustCompile(`no Jobs were found for Runbook "Output-HelloWorld"`),
+		},
+	})
+}
+
+func (r AutomationJobStreamsDataSource) runbookName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_automation_job_streams" "test" {
+  automation_account_id = azurerm_automation_account.test.id
+  runbook_name          = azurerm_automation_runbook.test.name
+}
+`, r.template(data))
+}
+
+func (r AutomationJobStreamsDataSource) jobId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_automation_job_streams" "latest" {
+  automation_account_id = azurerm_automation_account.test.id
+  runbook_name          = azurerm_automation_runbook.test.name
+}
+
+data "azurerm_automation_job_streams" "test" {
+  automation_account_id = azurerm_automation_account.test.id
+  job_id                = data.azurerm_automation_job_streams.latest.job_id
+  stream_types          = ["Output"]
+}
+`, r.template(data))
+}
+
+func (r AutomationJobStreamsDataSource) noJobs(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_automation_job_streams" "test" {
+  automation_account_id = azurerm_automation_account.test.id
+  runbook_name          = azurerm_automation_runbook.test.name
+}
+`, r.runbook(data))
+}
+
+func (r AutomationJobStreamsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_schedule" "test" {
+  name                    = "acctestAS-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  frequency               = "OneTime"
+}
+
+resource "azurerm_automation_job_schedule" "test" {
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  schedule_name           = azurerm_automation_schedule.test.name
+  runbook_name            = azurerm_automation_runbook.test.name
+}
+`, r.runbook(data), data.RandomInteger)
+}
+
+func (AutomationJobStreamsDataSource) runbook(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctestAA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+
+resource "azurerm_automation_runbook" "test" {
+  name                    = "Output-HelloWorld"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  log_verbose             = "true"
+  log_progress            = "true"
+  runbook_type            = "PowerShell"
+
+  publish_content_link {
+    uri = "https://raw.githubusercontent.com/Azure/azure-quickstart-templates/c4935ffb69246a6058eb24f54640f53f69d3ac9f/101-automation-runbook-getvms/Runbooks/Get-AzureVMTutorial.ps1"
+  }
+
+  content = <<EOF
+"Hello, World!"
+EOF
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,203 @@
+package automation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceAutomationSchedule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceAutomationScheduleRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"automation_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.AutomationAccountID,
+			},
+
+			"name_prefix": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"frequency": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(automation.Day),
+					string(automation.Hour),
+					string(automation.Minute),
+					string(automation.Month),
+					string(automation.OneTime),
+					string(automation.Week),
+				}, true),
+			},
+
+			"schedules": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"frequency": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"interval": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"start_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"expiry_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"next_run_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"timezone": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAutomationScheduleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.ScheduleClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.AutomationAccountID(d.Get("automation_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	namePrefix := d.Get("name_prefix").(string)
+	frequency := d.Get("frequency").(string)
+
+	schedules := make([]interface{}, 0)
+	iterator, err := client.ListByAutomationAccountComplete(ctx, accountId.ResourceGroup, accountId.Name)
+	if err != nil {
+		return fmt.Errorf("listing Schedules within %s: %+v", *accountId, err)
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if item.Name != nil && strings.HasPrefix(*item.Name, namePrefix) {
+			if props := item.ScheduleProperties; props != nil && (frequency == "" || strings.EqualFold(string(props.Frequency), frequency)) {
+				schedules = append(schedules, flattenAutomationSchedule(item))
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Schedules within %s: %+v", *accountId, err)
+		}
+	}
+
+	d.SetId(accountId.ID())
+	d.Set("automation_account_id", accountId.ID())
+
+	if err := d.Set("schedules", schedules); err != nil {
+		return fmt.Errorf("setting `schedules`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenAutomationSchedule(input automation.Schedule) map[string]interface{} {
+	output := map[string]interface{}{
+		"id":            "",
+		"name":          "",
+		"description":   "",
+		"enabled":       false,
+		"frequency":     "",
+		"interval":      0,
+		"start_time":    "",
+		"expiry_time":   "",
+		"next_run_time": "",
+		"timezone":      "",
+	}
+
+	if input.ID != nil {
+		output["id"] = *input.ID
+	}
+	if input.Name != nil {
+		output["name"] = *input.Name
+	}
+
+	props := input.ScheduleProperties
+	if props == nil {
+		return output
+	}
+
+	if props.Description != nil {
+		output["description"] = *props.Description
+	}
+	if props.IsEnabled != nil {
+		output["enabled"] = *props.IsEnabled
+	}
+	output["frequency"] = string(props.Frequency)
+	if props.Interval != nil {
+		output["interval"] = int(*props.Interval)
+	}
+	if props.StartTime != nil {
+		output["start_time"] = props.StartTime.Format(time.RFC3339)
+	}
+	if props.ExpiryTime != nil {
+		output["expiry_time"] = props.ExpiryTime.Format(time.RFC3339)
+	}
+	if props.NextRun != nil {
+		output["next_run_time"] = props.NextRun.Format(time.RFC3339)
+	}
+	if props.TimeZone != nil {
+		output["timezone"] = *props.TimeZone
+	}
+
+	return output
+}
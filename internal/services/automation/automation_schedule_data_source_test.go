@@ -0,0 +1,104 @@
+package automation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AutomationScheduleDataSource struct{}
+
+func TestAccDataSourceAutomationSchedule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_automation_schedule", "test")
+	r := AutomationScheduleDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("schedules.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceAutomationSchedule_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_automation_schedule", "test")
+	r := AutomationScheduleDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.filtered(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("schedules.#").HasValue("1"),
+				check.That(data.ResourceName).Key("schedules.0.name").HasValue(fmt.Sprintf("acctestAS-daily-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("schedules.0.frequency").HasValue("Day"),
+				check.That(data.ResourceName).Key("schedules.0.timezone").HasValue("Europe/London"),
+				check.That(data.ResourceName).Key("schedules.0.next_run_time").Exists(),
+			),
+		},
+	})
+}
+
+func (r AutomationScheduleDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_automation_schedule" "test" {
+  automation_account_id = azurerm_automation_account.test.id
+
+  depends_on = [azurerm_automation_schedule.daily, azurerm_automation_schedule.weekly]
+}
+`, r.template(data))
+}
+
+func (r AutomationScheduleDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_automation_schedule" "test" {
+  automation_account_id = azurerm_automation_account.test.id
+  name_prefix           = "acctestAS-"
+  frequency             = "Day"
+
+  depends_on = [azurerm_automation_schedule.daily, azurerm_automation_schedule.weekly]
+}
+`, r.template(data))
+}
+
+func (AutomationScheduleDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctestAA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+
+resource "azurerm_automation_schedule" "daily" {
+  name                    = "acctestAS-daily-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  frequency               = "Day"
+  timezone                = "Europe/London"
+}
+
+resource "azurerm_automation_schedule" "weekly" {
+  name                    = "acctestAS-weekly-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  frequency               = "Week"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -100,10 +100,11 @@ func resourceAutomationSchedule() *pluginsdk.Resource {
 			},
 
 			"timezone": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				Default:      "UTC",
-				ValidateFunc: azvalidate.AzureTimeZoneString(),
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Default:          "UTC",
+				ValidateFunc:     azvalidate.AzureTimeZoneString(),
+				DiffSuppressFunc: suppress.AzureTimeZone,
 			},
 
 			"week_days": {
@@ -179,8 +180,13 @@ func resourceAutomationSchedule() *pluginsdk.Resource {
 				return fmt.Errorf("`interval` cannot be set when frequency is `OneTime`")
 			}
 
+			// the Advanced Schedule only applies to `Week` and `Month` frequencies - the API has no concept of time
+			// slots, so an `Hour` or `Day` schedule can't be limited to certain days (or times of day)
 			_, hasWeekDays := diff.GetOk("week_days")
 			if hasWeekDays && frequency != "week" {
+				if frequency == "hour" || frequency == "day" {
+					return fmt.Errorf("`week_days` can only be set when frequency is `Week` - Azure Automation doesn't support limiting an `Hour` or `Day` schedule to specific days, instead a `Week` schedule can be created for each time of day the Runbook should run")
+				}
 				return fmt.Errorf("`week_days` can only be set when frequency is `Week`")
 			}
 
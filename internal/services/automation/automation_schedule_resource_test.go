@@ -3,6 +3,7 @@ package automation_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -169,6 +170,18 @@ func TestAccAutomationSchedule_weekly_advanced(t *testing.T) {
 	})
 }
 
+func TestAccAutomationSchedule_hourlyWithWeekDays(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_schedule", "test")
+	r := AutomationScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.hourlyWithWeekDays(data),
+			ExpectError: regexp.MustCompile("`week_days` can only be set when frequency is `Week`"),
+		},
+	})
+}
+
 func TestAccAutomationSchedule_monthly_advanced_by_day(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_automation_schedule", "test")
 	r := AutomationScheduleResource{}
@@ -309,6 +322,21 @@ resource "azurerm_automation_schedule" "test" {
 `, AutomationScheduleResource{}.template(data), data.RandomInteger, weekDay)
 }
 
+func (AutomationScheduleResource) hourlyWithWeekDays(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_schedule" "test" {
+  name                    = "acctestAS-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  frequency               = "Hour"
+  interval                = "1"
+  week_days               = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+}
+`, AutomationScheduleResource{}.template(data), data.RandomInteger)
+}
+
 func (AutomationScheduleResource) recurring_advanced_month(data acceptance.TestData, monthDay int) string {
 	return fmt.Sprintf(`
 %s
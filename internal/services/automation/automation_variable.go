@@ -1,6 +1,7 @@
 package automation
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,11 +9,14 @@ import (
 	"strings"
 	"time"
 
+	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -87,6 +91,14 @@ func resourceAutomationVariableCommonSchema(attType pluginsdk.ValueType, validat
 			Optional:     true,
 			ValidateFunc: validateFunc,
 		},
+
+		// the value of an encrypted Variable can't be retrieved once set, so it can optionally be escrowed into a
+		// Key Vault Secret (when the `escrow_encrypted_values_to_key_vault` feature is enabled)
+		"escrow_key_vault_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: keyVaultValidate.VersionlessNestedItemId,
+		},
 	}
 }
 
@@ -150,6 +162,15 @@ func resourceAutomationVariableCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	encrypted := d.Get("encrypted").(bool)
 	value := ""
 
+	if escrowSecretId := d.Get("escrow_key_vault_secret_id").(string); escrowSecretId != "" {
+		// the value is escrowed prior to updating the Variable, so that an encrypted value is never set without a copy of it
+		if d.IsNewResource() || d.HasChanges("value", "encrypted", "escrow_key_vault_secret_id") {
+			if err := escrowAutomationVariableValue(ctx, meta.(*clients.Client).KeyVault.ManagementClient, escrowSecretId, d, varTypeLower); err != nil {
+				return fmt.Errorf("escrowing the value of Automation %s Variable %q (Automation Account Name %q / Resource Group %q) into Key Vault Secret %q: %+v", varType, name, accountName, resourceGroup, escrowSecretId, err)
+			}
+		}
+	}
+
 	switch varTypeLower {
 	case "datetime":
 		vTime, parseErr := time.Parse(time.RFC3339, d.Get("value").(string))
@@ -193,6 +214,50 @@ func resourceAutomationVariableCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	return resourceAutomationVariableRead(d, meta, varType)
 }
 
+func resourceAutomationVariableCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if d.Get("escrow_key_vault_secret_id").(string) == "" {
+		return nil
+	}
+
+	if !meta.(*clients.Client).Features.AutomationVariable.EscrowEncryptedValuesToKeyVault {
+		return fmt.Errorf("`escrow_key_vault_secret_id` can only be specified when the `escrow_encrypted_values_to_key_vault` feature is enabled in the `automation_variable` block of the Provider")
+	}
+	if !d.Get("encrypted").(bool) {
+		return fmt.Errorf("`escrow_key_vault_secret_id` can only be specified when `encrypted` is `true`")
+	}
+
+	return nil
+}
+
+func escrowAutomationVariableValue(ctx context.Context, client *keyvaultmgmt.BaseClient, secretId string, d *pluginsdk.ResourceData, varTypeLower string) error {
+	id, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(secretId)
+	if err != nil {
+		return err
+	}
+
+	// the value is stored in the same format as it's specified in the configuration, rather than the serialized
+	// format used by the Automation API, so that it can be used as-is when recreating the Variable
+	value := ""
+	switch varTypeLower {
+	case "bool":
+		value = strconv.FormatBool(d.Get("value").(bool))
+	case "int":
+		value = strconv.Itoa(d.Get("value").(int))
+	case "datetime", "string":
+		value = d.Get("value").(string)
+	}
+
+	parameters := keyvaultmgmt.SecretSetParameters{
+		Value:       utils.String(value),
+		ContentType: utils.String(fmt.Sprintf("azurerm_automation_variable_%s", varTypeLower)),
+	}
+	if _, err := client.SetSecret(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func resourceAutomationVariableRead(d *pluginsdk.ResourceData, meta interface{}, varType string) error {
 	client := meta.(*clients.Client).Automation.VariableClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -24,6 +24,8 @@ func resourceAutomationVariableBool() *pluginsdk.Resource {
 		},
 
 		Schema: resourceAutomationVariableCommonSchema(pluginsdk.TypeBool, nil),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceAutomationVariableCustomizeDiff),
 	}
 }
 
@@ -25,6 +25,8 @@ func resourceAutomationVariableDateTime() *pluginsdk.Resource {
 		},
 
 		Schema: resourceAutomationVariableCommonSchema(pluginsdk.TypeString, validation.IsRFC3339Time),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceAutomationVariableCustomizeDiff),
 	}
 }
 
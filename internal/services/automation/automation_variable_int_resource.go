@@ -24,6 +24,8 @@ func resourceAutomationVariableInt() *pluginsdk.Resource {
 		},
 
 		Schema: resourceAutomationVariableCommonSchema(pluginsdk.TypeInt, nil),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceAutomationVariableCustomizeDiff),
 	}
 }
 
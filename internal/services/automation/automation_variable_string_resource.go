@@ -25,6 +25,8 @@ func resourceAutomationVariableString() *pluginsdk.Resource {
 		},
 
 		Schema: resourceAutomationVariableCommonSchema(pluginsdk.TypeString, validation.StringIsNotEmpty),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceAutomationVariableCustomizeDiff),
 	}
 }
 
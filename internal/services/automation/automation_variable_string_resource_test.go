@@ -75,6 +75,29 @@ func TestAccAutomationVariableString_update(t *testing.T) {
 	})
 }
 
+func TestAccAutomationVariableString_escrowKeyVaultSecret(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_variable_string", "test")
+	r := AutomationVariableStringResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.escrowKeyVaultSecret(data, "Hello, Terraform Escrow Test."),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// the value of an encrypted Variable can't be retrieved, and the escrow Secret is only used when writing it
+		data.ImportStep("value", "escrow_key_vault_secret_id"),
+		{
+			Config: r.escrowKeyVaultSecret(data, "Hello, Terraform Escrow Update Test."),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("value", "escrow_key_vault_secret_id"),
+	})
+}
+
 func (t AutomationVariableStringResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	return testCheckAzureRMAutomationVariableExists(ctx, clients, state, "String")
 }
@@ -133,3 +156,65 @@ resource "azurerm_automation_variable_string" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
+
+func (AutomationVariableStringResource) escrowKeyVaultSecret(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    automation_variable {
+      escrow_encrypted_values_to_key_vault = true
+    }
+
+    key_vault {
+      purge_soft_delete_on_destroy = true
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acctestkv-%[3]s"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    secret_permissions = [
+      "Get",
+      "Delete",
+      "List",
+      "Purge",
+      "Recover",
+      "Set",
+    ]
+  }
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctestAutoAcct-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+
+resource "azurerm_automation_variable_string" "test" {
+  name                       = "acctestAutoVar-%[1]d"
+  resource_group_name        = azurerm_resource_group.test.name
+  automation_account_name    = azurerm_automation_account.test.name
+  encrypted                  = true
+  value                      = "%[4]s"
+  escrow_key_vault_secret_id = "${azurerm_key_vault.test.vault_uri}secrets/acctestescrow%[1]d"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, value)
+}
@@ -0,0 +1,215 @@
+package automation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AutomationWatcherResource struct{}
+
+func TestAccAutomationWatcher_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_watcher", "test")
+	r := AutomationWatcherResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAutomationWatcher_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_watcher", "test")
+	r := AutomationWatcherResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAutomationWatcher_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_watcher", "test")
+	r := AutomationWatcherResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAutomationWatcher_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_watcher", "test")
+	r := AutomationWatcherResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("execution_frequency_in_seconds").HasValue("120"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (AutomationWatcherResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WatcherID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Automation.WatcherClient.Get(ctx, id.ResourceGroup, id.AutomationAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.WatcherProperties != nil), nil
+}
+
+func (r AutomationWatcherResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_watcher" "test" {
+  name                           = "acctest-watcher-%d"
+  automation_account_id          = azurerm_automation_account.test.id
+  location                       = azurerm_resource_group.test.location
+  script_name                    = azurerm_automation_runbook.test.name
+  script_run_on                  = "acctest-hwg-%d"
+  execution_frequency_in_seconds = 60
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r AutomationWatcherResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_watcher" "import" {
+  name                           = azurerm_automation_watcher.test.name
+  automation_account_id          = azurerm_automation_watcher.test.automation_account_id
+  location                       = azurerm_automation_watcher.test.location
+  script_name                    = azurerm_automation_watcher.test.script_name
+  script_run_on                  = azurerm_automation_watcher.test.script_run_on
+  execution_frequency_in_seconds = azurerm_automation_watcher.test.execution_frequency_in_seconds
+}
+`, r.basic(data))
+}
+
+func (r AutomationWatcherResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_watcher" "test" {
+  name                           = "acctest-watcher-%d"
+  automation_account_id          = azurerm_automation_account.test.id
+  location                       = azurerm_resource_group.test.location
+  script_name                    = azurerm_automation_runbook.test.name
+  script_run_on                  = "acctest-hwg-%d"
+  execution_frequency_in_seconds = 60
+  description                    = "example watcher"
+
+  script_parameters = {
+    FolderPath = "C:\\watched"
+    Extension  = "*.txt"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r AutomationWatcherResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_watcher" "test" {
+  name                           = "acctest-watcher-%d"
+  automation_account_id          = azurerm_automation_account.test.id
+  location                       = azurerm_resource_group.test.location
+  script_name                    = azurerm_automation_runbook.test.name
+  script_run_on                  = "acctest-hwg-%d"
+  execution_frequency_in_seconds = 120
+  description                    = "updated watcher"
+
+  tags = {
+    ENV = "Test2"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (AutomationWatcherResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+
+resource "azurerm_automation_runbook" "test" {
+  name                    = "Watch-NewFile"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  log_verbose             = "true"
+  log_progress            = "true"
+  description             = "This is a test runbook for terraform acceptance test"
+  runbook_type            = "PowerShell"
+
+  content = <<CONTENT
+param(
+  [string]$FolderPath,
+  [string]$Extension
+)
+CONTENT
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
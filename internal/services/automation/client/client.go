@@ -14,12 +14,16 @@ type Client struct {
 	CredentialClient            *automation.CredentialClient
 	DscConfigurationClient      *automation.DscConfigurationClient
 	DscNodeConfigurationClient  *automation.DscNodeConfigurationClient
+	JobClient                   *automation.JobClient
 	JobScheduleClient           *automation.JobScheduleClient
+	JobStreamClient             *automation.JobStreamClient
 	ModuleClient                *automation.ModuleClient
 	RunbookClient               *automation.RunbookClient
 	RunbookDraftClient          *automation.RunbookDraftClient
 	ScheduleClient              *automation.ScheduleClient
+	UsagesClient                *automation.UsagesClient
 	VariableClient              *automation.VariableClient
+	WatcherClient               *automation.WatcherClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -47,9 +51,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	dscNodeConfigurationClient := automation.NewDscNodeConfigurationClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dscNodeConfigurationClient.Client, o.ResourceManagerAuthorizer)
 
+	jobClient := automation.NewJobClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobClient.Client, o.ResourceManagerAuthorizer)
+
 	jobScheduleClient := automation.NewJobScheduleClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&jobScheduleClient.Client, o.ResourceManagerAuthorizer)
 
+	jobStreamClient := automation.NewJobStreamClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobStreamClient.Client, o.ResourceManagerAuthorizer)
+
 	moduleClient := automation.NewModuleClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&moduleClient.Client, o.ResourceManagerAuthorizer)
 
@@ -62,9 +72,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	scheduleClient := automation.NewScheduleClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&scheduleClient.Client, o.ResourceManagerAuthorizer)
 
+	usagesClient := automation.NewUsagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&usagesClient.Client, o.ResourceManagerAuthorizer)
+
 	variableClient := automation.NewVariableClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&variableClient.Client, o.ResourceManagerAuthorizer)
 
+	watcherClient := automation.NewWatcherClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&watcherClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AccountClient:               &accountClient,
 		AgentRegistrationInfoClient: &agentRegistrationInfoClient,
@@ -74,11 +90,15 @@ func NewClient(o *common.ClientOptions) *Client {
 		CredentialClient:            &credentialClient,
 		DscConfigurationClient:      &dscConfigurationClient,
 		DscNodeConfigurationClient:  &dscNodeConfigurationClient,
+		JobClient:                   &jobClient,
 		JobScheduleClient:           &jobScheduleClient,
+		JobStreamClient:             &jobStreamClient,
 		ModuleClient:                &moduleClient,
 		RunbookClient:               &runbookClient,
 		RunbookDraftClient:          &runbookDraftClient,
 		ScheduleClient:              &scheduleClient,
+		UsagesClient:                &usagesClient,
 		VariableClient:              &variableClient,
+		WatcherClient:               &watcherClient,
 	}
 }
@@ -22,6 +22,9 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_automation_account":           dataSourceAutomationAccount(),
+		"azurerm_automation_account_usage":     dataSourceAutomationAccountUsage(),
+		"azurerm_automation_job_streams":       dataSourceAutomationJobStreams(),
+		"azurerm_automation_schedule":          dataSourceAutomationSchedule(),
 		"azurerm_automation_variable_bool":     dataSourceAutomationVariableBool(),
 		"azurerm_automation_variable_datetime": dataSourceAutomationVariableDateTime(),
 		"azurerm_automation_variable_int":      dataSourceAutomationVariableInt(),
@@ -41,6 +44,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_automation_credential":                     resourceAutomationCredential(),
 		"azurerm_automation_dsc_configuration":              resourceAutomationDscConfiguration(),
 		"azurerm_automation_dsc_nodeconfiguration":          resourceAutomationDscNodeConfiguration(),
+		"azurerm_automation_job_failure_alert":              resourceAutomationJobFailureAlert(),
 		"azurerm_automation_job_schedule":                   resourceAutomationJobSchedule(),
 		"azurerm_automation_module":                         resourceAutomationModule(),
 		"azurerm_automation_runbook":                        resourceAutomationRunbook(),
@@ -49,5 +53,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_automation_variable_datetime":              resourceAutomationVariableDateTime(),
 		"azurerm_automation_variable_int":                   resourceAutomationVariableInt(),
 		"azurerm_automation_variable_string":                resourceAutomationVariableString(),
+		"azurerm_automation_watcher":                        resourceAutomationWatcher(),
 	}
 }
@@ -0,0 +1,15 @@
+package validate
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// AutomationAccountSkuName validates the SKU of an Automation Account, which can be either Free or Basic
+func AutomationAccountSkuName() pluginsdk.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		string(automation.Basic),
+		string(automation.Free),
+	}, false)
+}
@@ -0,0 +1,283 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// The Availability Set migration API's are only available from API Version `2023-09-01` of the Compute API,
+// which isn't available in the version of the Azure SDK for Go currently in use (`2020-12-01`) - as such the
+// request is sent using the newer API Version directly. This is pinned to `2023-09-01` (the first API Version
+// exposing `validateMigrationToVirtualMachineScaleSet`) rather than the latest, so that the shape of the request
+// and the error returned for an ineligible Availability Set don't change underneath us.
+// TODO: remove this once the Compute SDK has been upgraded
+const availabilitySetMigrationAPIVersion = "2023-09-01"
+
+func dataSourceAvailabilitySetMigration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceAvailabilitySetMigrationRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"availability_set_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.AvailabilitySetID,
+			},
+
+			"virtual_machine_scale_set_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.VirtualMachineScaleSetID,
+			},
+
+			"eligible": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"blockers": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"virtual_machine": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"size": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"managed_disks": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"extensions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"blockers": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAvailabilitySetMigrationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.AvailabilitySetsClient
+	vmClient := meta.(*clients.Client).Compute.VMClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AvailabilitySetID(d.Get("availability_set_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	blockers := make([]string, 0)
+
+	// a Virtual Machine Scale Set with Flexible Orchestration only supports Managed Disks
+	if resp.Sku == nil || resp.Sku.Name == nil || !strings.EqualFold(*resp.Sku.Name, "Aligned") {
+		blockers = append(blockers, "the Availability Set must be Managed (using the `Aligned` SKU)")
+	}
+
+	virtualMachines := make([]interface{}, 0)
+	if props := resp.AvailabilitySetProperties; props != nil && props.VirtualMachines != nil {
+		for _, v := range *props.VirtualMachines {
+			if v.ID == nil {
+				continue
+			}
+
+			vmId, err := parse.VirtualMachineID(*v.ID)
+			if err != nil {
+				return err
+			}
+
+			vm, err := vmClient.Get(ctx, vmId.ResourceGroup, vmId.Name, "")
+			if err != nil {
+				// the Availability Set can reference a Virtual Machine which is being/has been deleted
+				if utils.ResponseWasNotFound(vm.Response) {
+					log.Printf("[DEBUG] %s within %s was not found - skipping", *vmId, *id)
+					continue
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *vmId, err)
+			}
+
+			virtualMachine := flattenAvailabilitySetMigrationVirtualMachine(*vmId, vm)
+			for _, blocker := range virtualMachine["blockers"].([]string) {
+				blockers = append(blockers, fmt.Sprintf("Virtual Machine %q: %s", vmId.Name, blocker))
+			}
+			virtualMachines = append(virtualMachines, virtualMachine)
+		}
+	}
+
+	// the platform can only validate the migration when the target Virtual Machine Scale Set is known - which is
+	// also the only place that unsupported Extensions and Virtual Machine Sizes are reported, since these depend on
+	// the Virtual Machine Scale Set and the region, rather than being something which can be determined up front
+	if v := d.Get("virtual_machine_scale_set_id").(string); v != "" {
+		message, err := validateAvailabilitySetMigrationToVirtualMachineScaleSet(ctx, client, *id, v)
+		if err != nil {
+			return err
+		}
+		if message != "" {
+			blockers = append(blockers, message)
+		}
+	}
+
+	d.SetId(id.ID())
+	d.Set("availability_set_id", id.ID())
+	d.Set("eligible", len(blockers) == 0)
+	if err := d.Set("blockers", blockers); err != nil {
+		return fmt.Errorf("setting `blockers`: %+v", err)
+	}
+	if err := d.Set("virtual_machine", virtualMachines); err != nil {
+		return fmt.Errorf("setting `virtual_machine`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenAvailabilitySetMigrationVirtualMachine(id parse.VirtualMachineId, input compute.VirtualMachine) map[string]interface{} {
+	blockers := make([]string, 0)
+
+	size := ""
+	managedDisks := true
+	if props := input.VirtualMachineProperties; props != nil {
+		if props.HardwareProfile != nil {
+			size = string(props.HardwareProfile.VMSize)
+		}
+
+		if profile := props.StorageProfile; profile != nil {
+			if profile.OsDisk != nil && profile.OsDisk.ManagedDisk == nil {
+				managedDisks = false
+			}
+			if profile.DataDisks != nil {
+				for _, disk := range *profile.DataDisks {
+					if disk.ManagedDisk == nil {
+						managedDisks = false
+					}
+				}
+			}
+		}
+	}
+	if !managedDisks {
+		blockers = append(blockers, "all of the disks attached to the Virtual Machine must be Managed Disks")
+	}
+
+	extensions := make([]string, 0)
+	if input.Resources != nil {
+		for _, extension := range *input.Resources {
+			if extension.Name != nil {
+				extensions = append(extensions, *extension.Name)
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"id":            id.ID(),
+		"size":          size,
+		"managed_disks": managedDisks,
+		"extensions":    extensions,
+		"blockers":      blockers,
+	}
+}
+
+// validateAvailabilitySetMigrationToVirtualMachineScaleSet asks the platform to validate the migration of the
+// Availability Set to the specified Virtual Machine Scale Set, returning the reason why the migration isn't
+// possible, or an empty string when the Availability Set can be migrated
+func validateAvailabilitySetMigrationToVirtualMachineScaleSet(ctx context.Context, client *compute.AvailabilitySetsClient, id parse.AvailabilitySetId, virtualMachineScaleSetId string) (string, error) {
+	pathParameters := map[string]interface{}{
+		"availabilitySetName": autorest.Encode("path", id.Name),
+		"resourceGroupName":   autorest.Encode("path", id.ResourceGroup),
+		"subscriptionId":      autorest.Encode("path", id.SubscriptionId),
+	}
+	payload := map[string]interface{}{
+		"virtualMachineScaleSetFlexible": map[string]interface{}{
+			"id": virtualMachineScaleSetId,
+		},
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Compute/availabilitySets/{availabilitySetName}/validateMigrationToVirtualMachineScaleSet", pathParameters),
+		autorest.WithJSON(payload),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": availabilitySetMigrationAPIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return "", autorest.NewErrorWithError(err, "compute.AvailabilitySetsClient", "ValidateMigrationToVirtualMachineScaleSet", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return "", autorest.NewErrorWithError(err, "compute.AvailabilitySetsClient", "ValidateMigrationToVirtualMachineScaleSet", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	if err != nil {
+		// the platform returns the reason the migration isn't possible as an error
+		if requestErr, ok := err.(*azure.RequestError); ok && requestErr.ServiceError != nil && requestErr.StatusCode == http.StatusBadRequest {
+			return fmt.Sprintf("%s: %s", requestErr.ServiceError.Code, requestErr.ServiceError.Message), nil
+		}
+
+		return "", fmt.Errorf("validating the migration of %s to Virtual Machine Scale Set %q: %+v", id, virtualMachineScaleSetId, err)
+	}
+
+	return "", nil
+}
@@ -0,0 +1,67 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AvailabilitySetMigrationDataSource struct {
+}
+
+func TestAccDataSourceAvailabilitySetMigration_managed(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_availability_set_migration", "test")
+	r := AvailabilitySetMigrationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("eligible").HasValue("true"),
+				check.That(data.ResourceName).Key("blockers.#").HasValue("0"),
+				check.That(data.ResourceName).Key("virtual_machine.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceAvailabilitySetMigration_unmanaged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_availability_set_migration", "test")
+	r := AvailabilitySetMigrationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("eligible").HasValue("false"),
+				check.That(data.ResourceName).Key("blockers.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (AvailabilitySetMigrationDataSource) basic(data acceptance.TestData, managed bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_availability_set" "test" {
+  name                = "acctestavset-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  managed             = %[3]t
+}
+
+data "azurerm_availability_set_migration" "test" {
+  availability_set_id = azurerm_availability_set.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, managed)
+}
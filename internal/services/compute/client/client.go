@@ -4,32 +4,43 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/marketplaceordering/mgmt/2015-06-01/marketplaceordering"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/diskpools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/imagetemplates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/iscsitargets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/virtualmachinescalesetextensions"
 )
 
 type Client struct {
-	AvailabilitySetsClient          *compute.AvailabilitySetsClient
-	DedicatedHostsClient            *compute.DedicatedHostsClient
-	DedicatedHostGroupsClient       *compute.DedicatedHostGroupsClient
-	DisksClient                     *compute.DisksClient
-	DiskAccessClient                *compute.DiskAccessesClient
-	DiskEncryptionSetsClient        *compute.DiskEncryptionSetsClient
-	GalleriesClient                 *compute.GalleriesClient
-	GalleryImagesClient             *compute.GalleryImagesClient
-	GalleryImageVersionsClient      *compute.GalleryImageVersionsClient
-	ProximityPlacementGroupsClient  *compute.ProximityPlacementGroupsClient
-	MarketplaceAgreementsClient     *marketplaceordering.MarketplaceAgreementsClient
-	ImagesClient                    *compute.ImagesClient
-	SnapshotsClient                 *compute.SnapshotsClient
-	UsageClient                     *compute.UsageClient
-	VMExtensionImageClient          *compute.VirtualMachineExtensionImagesClient
-	VMExtensionClient               *compute.VirtualMachineExtensionsClient
-	VMScaleSetClient                *compute.VirtualMachineScaleSetsClient
-	VMScaleSetExtensionsClient      *compute.VirtualMachineScaleSetExtensionsClient
-	VMScaleSetRollingUpgradesClient *compute.VirtualMachineScaleSetRollingUpgradesClient
-	VMScaleSetVMsClient             *compute.VirtualMachineScaleSetVMsClient
-	VMClient                        *compute.VirtualMachinesClient
-	VMImageClient                   *compute.VirtualMachineImagesClient
-	SSHPublicKeysClient             *compute.SSHPublicKeysClient
+	AvailabilitySetsClient             *compute.AvailabilitySetsClient
+	DedicatedHostsClient               *compute.DedicatedHostsClient
+	DedicatedHostGroupsClient          *compute.DedicatedHostGroupsClient
+	DisksClient                        *compute.DisksClient
+	DiskAccessClient                   *compute.DiskAccessesClient
+	DiskEncryptionSetsClient           *compute.DiskEncryptionSetsClient
+	DiskPoolsClient                    *diskpools.DiskPoolsClient
+	DiskPoolIscsiTargetsClient         *iscsitargets.IscsiTargetsClient
+	GalleriesClient                    *compute.GalleriesClient
+	GalleryImagesClient                *compute.GalleryImagesClient
+	GalleryImageVersionsClient         *compute.GalleryImageVersionsClient
+	ProximityPlacementGroupsClient     *compute.ProximityPlacementGroupsClient
+	MarketplaceAgreementsClient        *marketplaceordering.MarketplaceAgreementsClient
+	ImagesClient                       *compute.ImagesClient
+	ImageTemplatesClient               *imagetemplates.VirtualMachineImageTemplatesClient
+	ResourceSkusClient                 *compute.ResourceSkusClient
+	SnapshotsClient                    *compute.SnapshotsClient
+	UsageClient                        *compute.UsageClient
+	VMExtensionImageClient             *compute.VirtualMachineExtensionImagesClient
+	VMExtensionClient                  *compute.VirtualMachineExtensionsClient
+	VMScaleSetClient                   *compute.VirtualMachineScaleSetsClient
+	VMScaleSetExtensionsClient         *compute.VirtualMachineScaleSetExtensionsClient
+	VMScaleSetExtensionsKeyVaultClient *virtualmachinescalesetextensions.VirtualMachineScaleSetExtensionsClient
+	VMScaleSetRollingUpgradesClient    *compute.VirtualMachineScaleSetRollingUpgradesClient
+	VMScaleSetVMsClient                *compute.VirtualMachineScaleSetVMsClient
+	VMClient                           *compute.VirtualMachinesClient
+	VMApplicationsClient               *virtualmachines.VirtualMachinesClient
+	VMImageClient                      *compute.VirtualMachineImagesClient
+	SSHPublicKeysClient                *compute.SSHPublicKeysClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -51,6 +62,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	diskEncryptionSetsClient := compute.NewDiskEncryptionSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&diskEncryptionSetsClient.Client, o.ResourceManagerAuthorizer)
 
+	diskPoolsClient := diskpools.NewDiskPoolsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&diskPoolsClient.Client, o.ResourceManagerAuthorizer)
+
+	diskPoolIscsiTargetsClient := iscsitargets.NewIscsiTargetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&diskPoolIscsiTargetsClient.Client, o.ResourceManagerAuthorizer)
+
 	galleriesClient := compute.NewGalleriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&galleriesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -63,12 +80,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	imagesClient := compute.NewImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&imagesClient.Client, o.ResourceManagerAuthorizer)
 
+	imageTemplatesClient := imagetemplates.NewVirtualMachineImageTemplatesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&imageTemplatesClient.Client, o.ResourceManagerAuthorizer)
+
 	marketplaceAgreementsClient := marketplaceordering.NewMarketplaceAgreementsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&marketplaceAgreementsClient.Client, o.ResourceManagerAuthorizer)
 
 	proximityPlacementGroupsClient := compute.NewProximityPlacementGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&proximityPlacementGroupsClient.Client, o.ResourceManagerAuthorizer)
 
+	resourceSkusClient := compute.NewResourceSkusClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&resourceSkusClient.Client, o.ResourceManagerAuthorizer)
+
 	snapshotsClient := compute.NewSnapshotsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&snapshotsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -90,6 +113,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	vmScaleSetExtensionsClient := compute.NewVirtualMachineScaleSetExtensionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmScaleSetExtensionsClient.Client, o.ResourceManagerAuthorizer)
 
+	vmScaleSetExtensionsKeyVaultClient := virtualmachinescalesetextensions.NewVirtualMachineScaleSetExtensionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&vmScaleSetExtensionsKeyVaultClient.Client, o.ResourceManagerAuthorizer)
+
 	vmScaleSetRollingUpgradesClient := compute.NewVirtualMachineScaleSetRollingUpgradesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmScaleSetRollingUpgradesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -99,32 +125,41 @@ func NewClient(o *common.ClientOptions) *Client {
 	vmClient := compute.NewVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmClient.Client, o.ResourceManagerAuthorizer)
 
+	vmApplicationsClient := virtualmachines.NewVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&vmApplicationsClient.Client, o.ResourceManagerAuthorizer)
+
 	sshPublicKeysClient := compute.NewSSHPublicKeysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sshPublicKeysClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AvailabilitySetsClient:          &availabilitySetsClient,
-		DedicatedHostsClient:            &dedicatedHostsClient,
-		DedicatedHostGroupsClient:       &dedicatedHostGroupsClient,
-		DisksClient:                     &disksClient,
-		DiskAccessClient:                &diskAccessClient,
-		DiskEncryptionSetsClient:        &diskEncryptionSetsClient,
-		GalleriesClient:                 &galleriesClient,
-		GalleryImagesClient:             &galleryImagesClient,
-		GalleryImageVersionsClient:      &galleryImageVersionsClient,
-		ImagesClient:                    &imagesClient,
-		MarketplaceAgreementsClient:     &marketplaceAgreementsClient,
-		ProximityPlacementGroupsClient:  &proximityPlacementGroupsClient,
-		SnapshotsClient:                 &snapshotsClient,
-		UsageClient:                     &usageClient,
-		VMExtensionImageClient:          &vmExtensionImageClient,
-		VMExtensionClient:               &vmExtensionClient,
-		VMScaleSetClient:                &vmScaleSetClient,
-		VMScaleSetExtensionsClient:      &vmScaleSetExtensionsClient,
-		VMScaleSetRollingUpgradesClient: &vmScaleSetRollingUpgradesClient,
-		VMScaleSetVMsClient:             &vmScaleSetVMsClient,
-		VMClient:                        &vmClient,
-		VMImageClient:                   &vmImageClient,
-		SSHPublicKeysClient:             &sshPublicKeysClient,
+		AvailabilitySetsClient:             &availabilitySetsClient,
+		DedicatedHostsClient:               &dedicatedHostsClient,
+		DedicatedHostGroupsClient:          &dedicatedHostGroupsClient,
+		DisksClient:                        &disksClient,
+		DiskAccessClient:                   &diskAccessClient,
+		DiskEncryptionSetsClient:           &diskEncryptionSetsClient,
+		DiskPoolsClient:                    &diskPoolsClient,
+		DiskPoolIscsiTargetsClient:         &diskPoolIscsiTargetsClient,
+		GalleriesClient:                    &galleriesClient,
+		GalleryImagesClient:                &galleryImagesClient,
+		GalleryImageVersionsClient:         &galleryImageVersionsClient,
+		ImagesClient:                       &imagesClient,
+		ImageTemplatesClient:               &imageTemplatesClient,
+		MarketplaceAgreementsClient:        &marketplaceAgreementsClient,
+		ProximityPlacementGroupsClient:     &proximityPlacementGroupsClient,
+		ResourceSkusClient:                 &resourceSkusClient,
+		SnapshotsClient:                    &snapshotsClient,
+		UsageClient:                        &usageClient,
+		VMExtensionImageClient:             &vmExtensionImageClient,
+		VMExtensionClient:                  &vmExtensionClient,
+		VMScaleSetClient:                   &vmScaleSetClient,
+		VMScaleSetExtensionsClient:         &vmScaleSetExtensionsClient,
+		VMScaleSetExtensionsKeyVaultClient: &vmScaleSetExtensionsKeyVaultClient,
+		VMScaleSetRollingUpgradesClient:    &vmScaleSetRollingUpgradesClient,
+		VMScaleSetVMsClient:                &vmScaleSetVMsClient,
+		VMClient:                           &vmClient,
+		VMApplicationsClient:               &vmApplicationsClient,
+		VMImageClient:                      &vmImageClient,
+		SSHPublicKeysClient:                &sshPublicKeysClient,
 	}
 }
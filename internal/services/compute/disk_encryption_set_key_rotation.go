@@ -0,0 +1,75 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	// diskEncryptionSetKeyRotationUpdating means the Disk Encryption Set isn't yet using the new Key
+	diskEncryptionSetKeyRotationUpdating = "Updating"
+
+	// diskEncryptionSetKeyRotationInProgress means the Disk Encryption Set is using the new Key, but
+	// the associated resources (e.g. Managed Disks) are still being re-encrypted using it
+	diskEncryptionSetKeyRotationInProgress = "InProgress"
+
+	// diskEncryptionSetKeyRotationCompleted means all associated resources are using the new Key
+	diskEncryptionSetKeyRotationCompleted = "Completed"
+)
+
+// diskEncryptionSetWaitForKeyRotation waits for the Disk Encryption Set to be using the specified Key Vault Key as the
+// Active Key - and when `waitForAssociatedResources` is set, for the associated resources to have been re-encrypted
+// using this Key, which can take several hours
+func diskEncryptionSetWaitForKeyRotation(ctx context.Context, client *compute.DiskEncryptionSetsClient, id parse.DiskEncryptionSetId, keyVaultKeyId string, waitForAssociatedResources bool, timeout time.Duration) error {
+	pending := []string{diskEncryptionSetKeyRotationUpdating}
+	target := []string{diskEncryptionSetKeyRotationInProgress, diskEncryptionSetKeyRotationCompleted}
+	if waitForAssociatedResources {
+		pending = append(pending, diskEncryptionSetKeyRotationInProgress)
+		target = []string{diskEncryptionSetKeyRotationCompleted}
+	}
+
+	log.Printf("[DEBUG] Waiting for Disk Encryption Set %q (Resource Group %q) to rotate to Key %q..", id.Name, id.ResourceGroup, keyVaultKeyId)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:                   pending,
+		Target:                    target,
+		Refresh:                   diskEncryptionSetKeyRotationRefreshFunc(ctx, client, id, keyVaultKeyId),
+		MinTimeout:                30 * time.Second,
+		ContinuousTargetOccurence: 2,
+		Timeout:                   timeout,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for Disk Encryption Set %q (Resource Group %q) to rotate to Key %q: %+v", id.Name, id.ResourceGroup, keyVaultKeyId, err)
+	}
+
+	return nil
+}
+
+// diskEncryptionSetKeyRotationRefreshFunc reports whether the Disk Encryption Set is still `Updating` to the specified
+// Key, whether the associated resources are `InProgress` of being re-encrypted (meaning the previous Keys are still
+// in use) or whether the rotation has `Completed`
+func diskEncryptionSetKeyRotationRefreshFunc(ctx context.Context, client *compute.DiskEncryptionSetsClient, id parse.DiskEncryptionSetId, keyVaultKeyId string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		props := resp.EncryptionSetProperties
+		if props == nil || props.ActiveKey == nil || props.ActiveKey.KeyURL == nil || !strings.EqualFold(*props.ActiveKey.KeyURL, keyVaultKeyId) {
+			return resp, diskEncryptionSetKeyRotationUpdating, nil
+		}
+
+		if props.PreviousKeys != nil && len(*props.PreviousKeys) > 0 {
+			return resp, diskEncryptionSetKeyRotationInProgress, nil
+		}
+
+		return resp, diskEncryptionSetKeyRotationCompleted, nil
+	}
+}
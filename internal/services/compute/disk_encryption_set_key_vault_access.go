@@ -0,0 +1,224 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	"github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// diskEncryptionSetKeyVaultRoleName is the built-in role which grants the minimum
+// data-plane permissions a Disk Encryption Set needs on an RBAC-enabled Key Vault
+const diskEncryptionSetKeyVaultRoleName = "Key Vault Crypto Service Encryption User"
+
+// diskEncryptionSetRoleAssignmentName returns a deterministic name for the Role Assignment
+// granted to a Disk Encryption Set, allowing it to be located again on update/delete
+// without needing to persist it in the state
+func diskEncryptionSetRoleAssignmentName(diskEncryptionSetId, keyVaultId string) string {
+	return uuid.NewV5(uuid.NamespaceURL, strings.ToLower(fmt.Sprintf("%s|%s", diskEncryptionSetId, keyVaultId))).String()
+}
+
+func diskEncryptionSetGrantKeyVaultAccess(ctx context.Context, meta interface{}, diskEncryptionSetId string, keyVault diskEncryptionSetKeyVault, principalId, tenantId string, timeout time.Duration) error {
+	if keyVault.rbacAuthorizationEnabled {
+		return diskEncryptionSetCreateRoleAssignment(ctx, meta, diskEncryptionSetId, keyVault, principalId, timeout)
+	}
+
+	return diskEncryptionSetUpdateAccessPolicy(ctx, meta, keyVault, principalId, tenantId, keyvault.Add, timeout)
+}
+
+func diskEncryptionSetRevokeKeyVaultAccess(ctx context.Context, meta interface{}, diskEncryptionSetId string, keyVault diskEncryptionSetKeyVault, principalId, tenantId string, timeout time.Duration) error {
+	if keyVault.rbacAuthorizationEnabled {
+		client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+		name := diskEncryptionSetRoleAssignmentName(diskEncryptionSetId, keyVault.keyVaultId)
+		resp, err := client.Delete(ctx, keyVault.keyVaultId, name, "")
+		if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("deleting Role Assignment %q for Disk Encryption Set on Key Vault %q (Resource Group %q): %+v", name, keyVault.keyVaultName, keyVault.resourceGroupName, err)
+		}
+		return nil
+	}
+
+	return diskEncryptionSetUpdateAccessPolicy(ctx, meta, keyVault, principalId, tenantId, keyvault.Remove, timeout)
+}
+
+func diskEncryptionSetCreateRoleAssignment(ctx context.Context, meta interface{}, diskEncryptionSetId string, keyVault diskEncryptionSetKeyVault, principalId string, timeout time.Duration) error {
+	roleAssignmentsClient := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+	roleDefinitionsClient := meta.(*clients.Client).Authorization.RoleDefinitionsClient
+
+	roleDefinitions, err := roleDefinitionsClient.List(ctx, keyVault.keyVaultId, fmt.Sprintf("roleName eq '%s'", diskEncryptionSetKeyVaultRoleName))
+	if err != nil {
+		return fmt.Errorf("loading Role Definition %q: %+v", diskEncryptionSetKeyVaultRoleName, err)
+	}
+	if len(roleDefinitions.Values()) != 1 || roleDefinitions.Values()[0].ID == nil {
+		return fmt.Errorf("loading Role Definition %q: expected exactly one match but got %d", diskEncryptionSetKeyVaultRoleName, len(roleDefinitions.Values()))
+	}
+	roleDefinitionId := *roleDefinitions.Values()[0].ID
+
+	name := diskEncryptionSetRoleAssignmentName(diskEncryptionSetId, keyVault.keyVaultId)
+	existing, err := roleAssignmentsClient.Get(ctx, keyVault.keyVaultId, name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing Role Assignment %q on Key Vault %q (Resource Group %q): %+v", name, keyVault.keyVaultName, keyVault.resourceGroupName, err)
+		}
+	}
+	if existing.ID != nil && *existing.ID != "" {
+		log.Printf("[DEBUG] Role Assignment %q for Disk Encryption Set already exists on Key Vault %q - skipping", name, keyVault.keyVaultName)
+		return nil
+	}
+
+	properties := authorization.RoleAssignmentCreateParameters{
+		RoleAssignmentProperties: &authorization.RoleAssignmentProperties{
+			RoleDefinitionID: utils.String(roleDefinitionId),
+			PrincipalID:      utils.String(principalId),
+			PrincipalType:    authorization.ServicePrincipal,
+		},
+	}
+
+	// the System Assigned Identity can take a little while to replicate through AAD
+	return pluginsdk.Retry(timeout, func() *pluginsdk.RetryError {
+		resp, err := roleAssignmentsClient.Create(ctx, keyVault.keyVaultId, name, properties)
+		if err != nil {
+			if utils.ResponseErrorIsRetryable(err) {
+				return pluginsdk.RetryableError(err)
+			} else if utils.ResponseWasStatusCode(resp.Response, 400) && strings.Contains(err.Error(), "PrincipalNotFound") {
+				return pluginsdk.RetryableError(err)
+			}
+
+			return pluginsdk.NonRetryableError(fmt.Errorf("creating Role Assignment %q on Key Vault %q (Resource Group %q): %+v", name, keyVault.keyVaultName, keyVault.resourceGroupName, err))
+		}
+
+		return nil
+	})
+}
+
+func diskEncryptionSetUpdateAccessPolicy(ctx context.Context, meta interface{}, keyVault diskEncryptionSetKeyVault, principalId, tenantId string, action keyvault.AccessPolicyUpdateKind, timeout time.Duration) error {
+	client := meta.(*clients.Client).KeyVault.VaultsClient
+
+	tenant, err := uuid.FromString(tenantId)
+	if err != nil {
+		return fmt.Errorf("parsing Tenant ID %q as a UUID: %+v", tenantId, err)
+	}
+
+	// Locking to prevent parallel changes to the Access Policies of this Key Vault
+	locks.ByName(keyVault.keyVaultName, "azurerm_key_vault")
+	defer locks.UnlockByName(keyVault.keyVaultName, "azurerm_key_vault")
+
+	// only the Key Permissions required by the Disk Encryption Set are sent, such that when revoking access any
+	// other permissions granted to this Identity are retained - however since Access Policies don't track where a
+	// permission came from, the `Get`, `WrapKey` and `UnwrapKey` permissions are removed even when they were also
+	// granted elsewhere (e.g. by an `azurerm_key_vault_access_policy`)
+	permissions := diskEncryptionSetKeyPermissions()
+	parameters := keyvault.VaultAccessPolicyParameters{
+		Properties: &keyvault.VaultAccessPolicyProperties{
+			AccessPolicies: &[]keyvault.AccessPolicyEntry{
+				{
+					ObjectID: utils.String(principalId),
+					TenantID: &tenant,
+					Permissions: &keyvault.Permissions{
+						Keys: &permissions,
+					},
+				},
+			},
+		},
+	}
+
+	if resp, err := client.UpdateAccessPolicy(ctx, keyVault.resourceGroupName, keyVault.keyVaultName, action, parameters); err != nil {
+		if action == keyvault.Remove && utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("updating Access Policy (Object ID %q) for Key Vault %q (Resource Group %q): %+v", principalId, keyVault.keyVaultName, keyVault.resourceGroupName, err)
+	}
+
+	target := "granted"
+	pending := []string{"revoked", "partial"}
+	if action == keyvault.Remove {
+		target = "revoked"
+		pending = []string{"granted", "partial"}
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:                   pending,
+		Target:                    []string{target},
+		Refresh:                   diskEncryptionSetAccessPolicyRefreshFunc(ctx, client, keyVault, principalId),
+		Delay:                     5 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Timeout:                   timeout,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for Access Policy (Object ID %q) on Key Vault %q (Resource Group %q) to become %q: %+v", principalId, keyVault.keyVaultName, keyVault.resourceGroupName, target, err)
+	}
+
+	return nil
+}
+
+// diskEncryptionSetKeyPermissions returns the Key Permissions which the Disk Encryption Set requires
+func diskEncryptionSetKeyPermissions() []keyvault.KeyPermissions {
+	return []keyvault.KeyPermissions{
+		keyvault.KeyPermissionsGet,
+		keyvault.KeyPermissionsWrapKey,
+		keyvault.KeyPermissionsUnwrapKey,
+	}
+}
+
+// diskEncryptionSetAccessPolicyRefreshFunc reports whether the Key Permissions required by the Disk Encryption Set
+// are `granted` to the Principal, `revoked` (none of them are granted) or `partial`
+func diskEncryptionSetAccessPolicyRefreshFunc(ctx context.Context, client *keyvault.VaultsClient, keyVault diskEncryptionSetKeyVault, principalId string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, keyVault.resourceGroupName, keyVault.keyVaultName)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving Key Vault %q (Resource Group %q): %+v", keyVault.keyVaultName, keyVault.resourceGroupName, err)
+		}
+
+		granted := make(map[keyvault.KeyPermissions]struct{})
+		if props := resp.Properties; props != nil && props.AccessPolicies != nil {
+			for _, policy := range *props.AccessPolicies {
+				if policy.ObjectID == nil || !strings.EqualFold(*policy.ObjectID, principalId) {
+					continue
+				}
+				if policy.Permissions == nil || policy.Permissions.Keys == nil {
+					continue
+				}
+
+				for _, required := range diskEncryptionSetKeyPermissions() {
+					for _, permission := range *policy.Permissions.Keys {
+						if strings.EqualFold(string(permission), string(required)) {
+							granted[required] = struct{}{}
+							break
+						}
+					}
+				}
+			}
+		}
+
+		switch len(granted) {
+		case 0:
+			return resp, "revoked", nil
+		case len(diskEncryptionSetKeyPermissions()):
+			return resp, "granted", nil
+		default:
+			return resp, "partial", nil
+		}
+	}
+}
+
+// diskEncryptionSetKeyVaultFromState resolves the Key Vault referenced by the Key Vault Key ID
+// held in the state, returning nil if the Key Vault no longer exists
+func diskEncryptionSetKeyVaultFromState(ctx context.Context, meta interface{}, keyVaultKeyId string) (*diskEncryptionSetKeyVault, error) {
+	keyVault, err := diskEncryptionSetLookupKeyVault(ctx, meta.(*clients.Client).KeyVault, meta.(*clients.Client).Resource, keyVaultKeyId)
+	if err != nil {
+		return nil, fmt.Errorf("resolving the Key Vault for Key %q: %+v", keyVaultKeyId, err)
+	}
+
+	if keyVault == nil {
+		log.Printf("[DEBUG] the Key Vault for Key %q was not found - assuming it's been removed", keyVaultKeyId)
+	}
+
+	return keyVault, nil
+}
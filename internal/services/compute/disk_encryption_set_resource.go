@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
@@ -88,6 +89,23 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 				},
 			},
 
+			"grant_key_vault_access": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"wait_for_key_rotation": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"active_key_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -160,6 +178,13 @@ func resourceDiskEncryptionSetCreate(d *pluginsdk.ResourceData, meta interface{}
 	}
 	d.SetId(*resp.ID)
 
+	if d.Get("grant_key_vault_access").(bool) {
+		principalId, tenantId := diskEncryptionSetIdentityPrincipal(resp.Identity)
+		if err := diskEncryptionSetGrantKeyVaultAccess(ctx, meta, *resp.ID, *keyVaultDetails, principalId, tenantId, d.Timeout(pluginsdk.TimeoutCreate)); err != nil {
+			return fmt.Errorf("granting Disk Encryption Set %q (Resource Group %q) access to Key Vault %q: %+v", name, resourceGroup, keyVaultDetails.keyVaultName, err)
+		}
+	}
+
 	return resourceDiskEncryptionSetRead(d, meta)
 }
 
@@ -195,6 +220,7 @@ func resourceDiskEncryptionSetRead(d *pluginsdk.ResourceData, meta interface{})
 			keyVaultKeyId = *props.ActiveKey.KeyURL
 		}
 		d.Set("key_vault_key_id", keyVaultKeyId)
+		d.Set("active_key_url", keyVaultKeyId)
 	}
 
 	if err := d.Set("identity", flattenDiskEncryptionSetIdentity(resp.Identity)); err != nil {
@@ -216,6 +242,25 @@ func resourceDiskEncryptionSetUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	principalId, tenantId := diskEncryptionSetIdentityPrincipal(existing.Identity)
+
+	// access to the new Key Vault has to be granted before the Key is rotated, since the
+	// Disk Encryption Set validates that it's able to access the new Key
+	if d.Get("grant_key_vault_access").(bool) && d.HasChanges("key_vault_key_id", "grant_key_vault_access") {
+		keyVaultKeyId := d.Get("key_vault_key_id").(string)
+		keyVaultDetails, err := diskEncryptionSetRetrieveKeyVault(ctx, keyVaultsClient, resourcesClient, keyVaultKeyId)
+		if err != nil {
+			return fmt.Errorf("Error validating Key Vault Key %q for Disk Encryption Set: %+v", keyVaultKeyId, err)
+		}
+		if err := diskEncryptionSetGrantKeyVaultAccess(ctx, meta, id.ID(), *keyVaultDetails, principalId, tenantId, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("granting Disk Encryption Set %q (Resource Group %q) access to Key Vault %q: %+v", id.Name, id.ResourceGroup, keyVaultDetails.keyVaultName, err)
+		}
+	}
+
 	update := compute.DiskEncryptionSetUpdate{}
 	if d.HasChange("tags") {
 		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
@@ -251,6 +296,40 @@ func resourceDiskEncryptionSetUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("Error waiting for update of Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
+	// the update completes before the new Key is in use, so wait for the Active Key to change (and optionally for
+	// the associated resources to be re-encrypted) so that dependent resources aren't left using the previous Key
+	if d.HasChange("key_vault_key_id") {
+		if err := diskEncryptionSetWaitForKeyRotation(ctx, client, *id, d.Get("key_vault_key_id").(string), d.Get("wait_for_key_rotation").(bool), d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	// once the Key has been rotated the access previously granted to the old Key Vault is no longer required
+	oldGrant, newGrant := d.GetChange("grant_key_vault_access")
+	oldKeyId, newKeyId := d.GetChange("key_vault_key_id")
+	if oldGrant.(bool) {
+		oldKeyVault, err := diskEncryptionSetKeyVaultFromState(ctx, meta, oldKeyId.(string))
+		if err != nil {
+			return err
+		}
+		if oldKeyVault != nil {
+			newKeyVault, err := diskEncryptionSetKeyVaultFromState(ctx, meta, newKeyId.(string))
+			if err != nil {
+				return err
+			}
+			newKeyVaultId := ""
+			if newKeyVault != nil {
+				newKeyVaultId = newKeyVault.keyVaultId
+			}
+
+			if !newGrant.(bool) || !strings.EqualFold(oldKeyVault.keyVaultId, newKeyVaultId) {
+				if err := diskEncryptionSetRevokeKeyVaultAccess(ctx, meta, id.ID(), *oldKeyVault, principalId, tenantId, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("revoking Disk Encryption Set %q (Resource Group %q) access to Key Vault %q: %+v", id.Name, id.ResourceGroup, oldKeyVault.keyVaultName, err)
+				}
+			}
+		}
+	}
+
 	return resourceDiskEncryptionSetRead(d, meta)
 }
 
@@ -264,6 +343,25 @@ func resourceDiskEncryptionSetDelete(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
+	if d.Get("grant_key_vault_access").(bool) {
+		keyVault, err := diskEncryptionSetKeyVaultFromState(ctx, meta, d.Get("key_vault_key_id").(string))
+		if err != nil {
+			return err
+		}
+
+		// if the Key Vault has been removed then so has the access granted to it
+		if keyVault != nil {
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return fmt.Errorf("Error retrieving Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+			}
+			principalId, tenantId := diskEncryptionSetIdentityPrincipal(existing.Identity)
+			if err := diskEncryptionSetRevokeKeyVaultAccess(ctx, meta, id.ID(), *keyVault, principalId, tenantId, d.Timeout(pluginsdk.TimeoutDelete)); err != nil {
+				return fmt.Errorf("revoking Disk Encryption Set %q (Resource Group %q) access to Key Vault %q: %+v", id.Name, id.ResourceGroup, keyVault.keyVaultName, err)
+			}
+		}
+	}
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("Error deleting Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
@@ -307,15 +405,44 @@ func flattenDiskEncryptionSetIdentity(input *compute.EncryptionSetIdentity) []in
 	}
 }
 
+func diskEncryptionSetIdentityPrincipal(input *compute.EncryptionSetIdentity) (principalId string, tenantId string) {
+	if input == nil {
+		return "", ""
+	}
+
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+	return principalId, tenantId
+}
+
 type diskEncryptionSetKeyVault struct {
-	keyVaultId             string
-	resourceGroupName      string
-	keyVaultName           string
-	purgeProtectionEnabled bool
-	softDeleteEnabled      bool
+	keyVaultId               string
+	resourceGroupName        string
+	keyVaultName             string
+	purgeProtectionEnabled   bool
+	softDeleteEnabled        bool
+	rbacAuthorizationEnabled bool
 }
 
 func diskEncryptionSetRetrieveKeyVault(ctx context.Context, keyVaultsClient *client.Client, resourcesClient *resourcesClient.Client, id string) (*diskEncryptionSetKeyVault, error) {
+	keyVault, err := diskEncryptionSetLookupKeyVault(ctx, keyVaultsClient, resourcesClient, id)
+	if err != nil {
+		return nil, err
+	}
+	if keyVault == nil {
+		return nil, fmt.Errorf("Unable to determine the Key Vault for the Key Vault Key %q - the Key Vault was not found", id)
+	}
+
+	return keyVault, nil
+}
+
+// diskEncryptionSetLookupKeyVault resolves the Key Vault containing the specified Key Vault Key,
+// returning nil when the Key Vault doesn't exist
+func diskEncryptionSetLookupKeyVault(ctx context.Context, keyVaultsClient *client.Client, resourcesClient *resourcesClient.Client, id string) (*diskEncryptionSetKeyVault, error) {
 	keyVaultKeyId, err := keyVaultParse.ParseNestedItemID(id)
 	if err != nil {
 		return nil, err
@@ -325,7 +452,7 @@ func diskEncryptionSetRetrieveKeyVault(ctx context.Context, keyVaultsClient *cli
 		return nil, fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", keyVaultKeyId.KeyVaultBaseUrl, err)
 	}
 	if keyVaultID == nil {
-		return nil, fmt.Errorf("Unable to determine the Resource ID for the Key Vault at URL %q", keyVaultKeyId.KeyVaultBaseUrl)
+		return nil, nil
 	}
 
 	parsedKeyVaultID, err := keyVaultParse.VaultID(*keyVaultID)
@@ -335,11 +462,15 @@ func diskEncryptionSetRetrieveKeyVault(ctx context.Context, keyVaultsClient *cli
 
 	resp, err := keyVaultsClient.VaultsClient.Get(ctx, parsedKeyVaultID.ResourceGroup, parsedKeyVaultID.Name)
 	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, nil
+		}
 		return nil, fmt.Errorf("retrieving %s: %+v", *parsedKeyVaultID, err)
 	}
 
 	purgeProtectionEnabled := false
 	softDeleteEnabled := false
+	rbacAuthorizationEnabled := false
 
 	if props := resp.Properties; props != nil {
 		if props.EnableSoftDelete != nil {
@@ -349,13 +480,18 @@ func diskEncryptionSetRetrieveKeyVault(ctx context.Context, keyVaultsClient *cli
 		if props.EnablePurgeProtection != nil {
 			purgeProtectionEnabled = *props.EnablePurgeProtection
 		}
+
+		if props.EnableRbacAuthorization != nil {
+			rbacAuthorizationEnabled = *props.EnableRbacAuthorization
+		}
 	}
 
 	return &diskEncryptionSetKeyVault{
-		keyVaultId:             *keyVaultID,
-		resourceGroupName:      parsedKeyVaultID.ResourceGroup,
-		keyVaultName:           parsedKeyVaultID.Name,
-		purgeProtectionEnabled: purgeProtectionEnabled,
-		softDeleteEnabled:      softDeleteEnabled,
+		keyVaultId:               *keyVaultID,
+		resourceGroupName:        parsedKeyVaultID.ResourceGroup,
+		keyVaultName:             parsedKeyVaultID.Name,
+		purgeProtectionEnabled:   purgeProtectionEnabled,
+		softDeleteEnabled:        softDeleteEnabled,
+		rbacAuthorizationEnabled: rbacAuthorizationEnabled,
 	}, nil
 }
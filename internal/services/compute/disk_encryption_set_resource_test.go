@@ -3,12 +3,14 @@ package compute_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -27,7 +29,7 @@ func TestAccDiskEncryptionSet_basic(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("grant_key_vault_access", "wait_for_key_rotation"),
 	})
 }
 
@@ -57,7 +59,7 @@ func TestAccDiskEncryptionSet_complete(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("grant_key_vault_access", "wait_for_key_rotation"),
 	})
 }
 
@@ -72,14 +74,14 @@ func TestAccDiskEncryptionSet_update(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("grant_key_vault_access", "wait_for_key_rotation"),
 		{
 			Config: r.complete(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("grant_key_vault_access", "wait_for_key_rotation"),
 	})
 }
 
@@ -94,7 +96,7 @@ func TestAccDiskEncryptionSet_keyRotate(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("grant_key_vault_access", "wait_for_key_rotation"),
 		// we have to first grant the permission for DiskEncryptionSet to access the KeyVault
 		{
 			Config: r.grantAccessToKeyVault(data),
@@ -102,18 +104,117 @@ func TestAccDiskEncryptionSet_keyRotate(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("grant_key_vault_access", "wait_for_key_rotation"),
 		// after the access is granted, we can rotate the key in DiskEncryptionSet
 		{
 			Config: r.keyRotate(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("active_key_url").MatchesOtherKey(check.That(data.ResourceName).Key("key_vault_key_id")),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("grant_key_vault_access", "wait_for_key_rotation"),
 	})
 }
 
+func TestAccDiskEncryptionSet_keyRotateWaitForKeyRotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_disk_encryption_set", "test")
+	r := DiskEncryptionSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyRotateWaitForKeyRotation(data, "test"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("grant_key_vault_access", "wait_for_key_rotation"),
+		{
+			Config: r.keyRotateWaitForKeyRotation(data, "new"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("active_key_url").MatchesOtherKey(check.That(data.ResourceName).Key("key_vault_key_id")),
+			),
+		},
+		data.ImportStep("grant_key_vault_access", "wait_for_key_rotation"),
+	})
+}
+
+func TestAccDiskEncryptionSet_grantKeyVaultAccess(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_disk_encryption_set", "test")
+	r := DiskEncryptionSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.grantKeyVaultAccess(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("grant_key_vault_access").HasValue("true"),
+				data.CheckWithClient(r.keyVaultAccessGranted(true)),
+			),
+		},
+		data.ImportStep("grant_key_vault_access", "wait_for_key_rotation"),
+		{
+			Config: r.grantKeyVaultAccess(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("grant_key_vault_access").HasValue("false"),
+				data.CheckWithClient(r.keyVaultAccessGranted(false)),
+			),
+		},
+		data.ImportStep("grant_key_vault_access", "wait_for_key_rotation"),
+		{
+			Config: r.grantKeyVaultAccess(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.keyVaultAccessGranted(true)),
+			),
+		},
+		data.ImportStep("grant_key_vault_access", "wait_for_key_rotation"),
+	})
+}
+
+func (DiskEncryptionSetResource) keyVaultAccessGranted(expected bool) acceptance.ClientCheckFunc {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		keyId, err := keyVaultParse.ParseNestedItemID(state.Attributes["key_vault_key_id"])
+		if err != nil {
+			return err
+		}
+		id, err := clients.KeyVault.KeyVaultIDFromBaseUrl(ctx, clients.Resource, keyId.KeyVaultBaseUrl)
+		if err != nil {
+			return err
+		}
+		if id == nil {
+			return fmt.Errorf("the Key Vault at %q was not found", keyId.KeyVaultBaseUrl)
+		}
+		keyVaultId, err := keyVaultParse.VaultID(*id)
+		if err != nil {
+			return err
+		}
+
+		resp, err := clients.KeyVault.VaultsClient.Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *keyVaultId, err)
+		}
+
+		principalId := state.Attributes["identity.0.principal_id"]
+		granted := false
+		if props := resp.Properties; props != nil && props.AccessPolicies != nil {
+			for _, policy := range *props.AccessPolicies {
+				if policy.ObjectID != nil && strings.EqualFold(*policy.ObjectID, principalId) && policy.Permissions != nil && policy.Permissions.Keys != nil && len(*policy.Permissions.Keys) > 0 {
+					granted = true
+				}
+			}
+		}
+
+		if granted != expected {
+			return fmt.Errorf("expected the Identity %q to have access to %s to be %t but got %t", principalId, *keyVaultId, expected, granted)
+		}
+
+		return nil
+	}
+}
+
 func (DiskEncryptionSetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.DiskEncryptionSetID(state.ID)
 	if err != nil {
@@ -277,6 +378,24 @@ resource "azurerm_disk_encryption_set" "test" {
 `, r.dependencies(data), data.RandomInteger)
 }
 
+func (r DiskEncryptionSetResource) grantKeyVaultAccess(data acceptance.TestData, grant bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_disk_encryption_set" "test" {
+  name                   = "acctestDES-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  key_vault_key_id       = azurerm_key_vault_key.test.id
+  grant_key_vault_access = %t
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, r.dependencies(data), data.RandomInteger, grant)
+}
+
 func (r DiskEncryptionSetResource) keyRotate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -324,3 +443,64 @@ resource "azurerm_disk_encryption_set" "test" {
 }
 `, r.dependencies(data), data.RandomInteger)
 }
+
+func (r DiskEncryptionSetResource) keyRotateWaitForKeyRotation(data acceptance.TestData, keyName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_key" "new" {
+  name         = "newKey"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  depends_on = ["azurerm_key_vault_access_policy.service-principal"]
+}
+
+resource "azurerm_key_vault_access_policy" "disk-encryption" {
+  key_vault_id = azurerm_key_vault.test.id
+
+  key_permissions = [
+    "Get",
+    "WrapKey",
+    "UnwrapKey",
+  ]
+
+  tenant_id = azurerm_disk_encryption_set.test.identity.0.tenant_id
+  object_id = azurerm_disk_encryption_set.test.identity.0.principal_id
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                   = "acctestd-%d"
+  location               = azurerm_resource_group.test.location
+  resource_group_name    = azurerm_resource_group.test.name
+  storage_account_type   = "Standard_LRS"
+  create_option          = "Empty"
+  disk_size_gb           = 1
+  disk_encryption_set_id = azurerm_disk_encryption_set.test.id
+
+  depends_on = [azurerm_key_vault_access_policy.disk-encryption]
+}
+
+resource "azurerm_disk_encryption_set" "test" {
+  name                  = "acctestDES-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  key_vault_key_id      = azurerm_key_vault_key.%s.id
+  wait_for_key_rotation = true
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, r.dependencies(data), data.RandomInteger, data.RandomInteger, keyName)
+}
@@ -0,0 +1,376 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/diskpools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/iscsitargets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDiskPoolIscsiTarget() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDiskPoolIscsiTargetCreate,
+		Read:   resourceDiskPoolIscsiTargetRead,
+		Update: resourceDiskPoolIscsiTargetUpdate,
+		Delete: resourceDiskPoolIscsiTargetDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := iscsitargets.ParseIscsiTargetID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DiskPoolIscsiTargetName,
+			},
+
+			"disk_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DiskPoolID,
+			},
+
+			"acl_mode": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(iscsitargets.IscsiTargetAclModeDynamic),
+					string(iscsitargets.IscsiTargetAclModeStatic),
+				}, false),
+			},
+
+			"target_iqn": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"lun": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 90),
+						},
+
+						"managed_disk_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ManagedDiskID,
+						},
+
+						"lun": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"static_acl": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"initiator_iqn": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"mapped_luns": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"endpoints": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"port": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDiskPoolIscsiTargetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DiskPoolIscsiTargetsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	diskPoolId, err := diskpools.ParseDiskPoolID(d.Get("disk_pool_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := iscsitargets.NewIscsiTargetID(diskPoolId.SubscriptionId, diskPoolId.ResourceGroup, diskPoolId.Name, d.Get("name").(string))
+
+	locks.ByName(diskPoolId.Name, diskPoolResourceName)
+	defer locks.UnlockByName(diskPoolId.Name, diskPoolResourceName)
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_disk_pool_iscsi_target", id.ID())
+	}
+
+	aclMode := iscsitargets.IscsiTargetAclMode(d.Get("acl_mode").(string))
+	staticAcls := expandDiskPoolIscsiTargetStaticAcls(d.Get("static_acl").([]interface{}))
+	if err := validateDiskPoolIscsiTargetStaticAcls(aclMode, staticAcls); err != nil {
+		return err
+	}
+
+	payload := iscsitargets.IscsiTargetCreate{
+		Properties: iscsitargets.IscsiTargetCreateProperties{
+			AclMode:    aclMode,
+			Luns:       expandDiskPoolIscsiTargetLuns(d.Get("lun").([]interface{})),
+			StaticAcls: staticAcls,
+		},
+	}
+	if v := d.Get("target_iqn").(string); v != "" {
+		payload.Properties.TargetIqn = utils.String(v)
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDiskPoolIscsiTargetRead(d, meta)
+}
+
+func resourceDiskPoolIscsiTargetRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DiskPoolIscsiTargetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := iscsitargets.ParseIscsiTargetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("disk_pool_id", diskpools.NewDiskPoolID(id.SubscriptionId, id.ResourceGroup, id.DiskPoolName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+		d.Set("acl_mode", string(props.AclMode))
+		d.Set("target_iqn", props.TargetIqn)
+
+		if err := d.Set("lun", flattenDiskPoolIscsiTargetLuns(props.Luns)); err != nil {
+			return fmt.Errorf("setting `lun`: %+v", err)
+		}
+
+		if err := d.Set("static_acl", flattenDiskPoolIscsiTargetStaticAcls(props.StaticAcls)); err != nil {
+			return fmt.Errorf("setting `static_acl`: %+v", err)
+		}
+
+		if err := d.Set("endpoints", utils.FlattenStringSlice(props.Endpoints)); err != nil {
+			return fmt.Errorf("setting `endpoints`: %+v", err)
+		}
+
+		port := 0
+		if props.Port != nil {
+			port = int(*props.Port)
+		}
+		d.Set("port", port)
+	}
+
+	return nil
+}
+
+func resourceDiskPoolIscsiTargetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DiskPoolIscsiTargetsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := iscsitargets.ParseIscsiTargetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.DiskPoolName, diskPoolResourceName)
+	defer locks.UnlockByName(id.DiskPoolName, diskPoolResourceName)
+
+	aclMode := iscsitargets.IscsiTargetAclMode(d.Get("acl_mode").(string))
+	staticAcls := expandDiskPoolIscsiTargetStaticAcls(d.Get("static_acl").([]interface{}))
+	if err := validateDiskPoolIscsiTargetStaticAcls(aclMode, staticAcls); err != nil {
+		return err
+	}
+
+	// the LUNs are always sent, since omitting them is treated as "no change" rather than removing them
+	luns := expandDiskPoolIscsiTargetLuns(d.Get("lun").([]interface{}))
+	if luns == nil {
+		luns = &[]iscsitargets.IscsiLun{}
+	}
+
+	payload := iscsitargets.IscsiTargetUpdate{
+		Properties: iscsitargets.IscsiTargetUpdateProperties{
+			Luns:       luns,
+			StaticAcls: staticAcls,
+		},
+	}
+	if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceDiskPoolIscsiTargetRead(d, meta)
+}
+
+func resourceDiskPoolIscsiTargetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DiskPoolIscsiTargetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := iscsitargets.ParseIscsiTargetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.DiskPoolName, diskPoolResourceName)
+	defer locks.UnlockByName(id.DiskPoolName, diskPoolResourceName)
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func validateDiskPoolIscsiTargetStaticAcls(aclMode iscsitargets.IscsiTargetAclMode, staticAcls *[]iscsitargets.Acl) error {
+	if aclMode == iscsitargets.IscsiTargetAclModeDynamic && staticAcls != nil {
+		return fmt.Errorf("`static_acl` can only be specified when `acl_mode` is set to `Static`")
+	}
+
+	return nil
+}
+
+func expandDiskPoolIscsiTargetLuns(input []interface{}) *[]iscsitargets.IscsiLun {
+	if len(input) == 0 {
+		return nil
+	}
+
+	luns := make([]iscsitargets.IscsiLun, 0)
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		luns = append(luns, iscsitargets.IscsiLun{
+			Name:                       v["name"].(string),
+			ManagedDiskAzureResourceId: v["managed_disk_id"].(string),
+		})
+	}
+
+	return &luns
+}
+
+func flattenDiskPoolIscsiTargetLuns(input *[]iscsitargets.IscsiLun) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input {
+		lun := 0
+		if v.Lun != nil {
+			lun = int(*v.Lun)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":            v.Name,
+			"managed_disk_id": v.ManagedDiskAzureResourceId,
+			"lun":             lun,
+		})
+	}
+
+	return output
+}
+
+func expandDiskPoolIscsiTargetStaticAcls(input []interface{}) *[]iscsitargets.Acl {
+	if len(input) == 0 {
+		return nil
+	}
+
+	acls := make([]iscsitargets.Acl, 0)
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		acls = append(acls, iscsitargets.Acl{
+			InitiatorIqn: v["initiator_iqn"].(string),
+			MappedLuns:   *utils.ExpandStringSlice(v["mapped_luns"].([]interface{})),
+		})
+	}
+
+	return &acls
+}
+
+func flattenDiskPoolIscsiTargetStaticAcls(input *[]iscsitargets.Acl) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input {
+		output = append(output, map[string]interface{}{
+			"initiator_iqn": v.InitiatorIqn,
+			"mapped_luns":   utils.FlattenStringSlice(&v.MappedLuns),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,139 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/iscsitargets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DiskPoolIscsiTargetResource struct{}
+
+func TestAccDiskPoolIscsiTarget_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_disk_pool_iscsi_target", "test")
+	r := DiskPoolIscsiTargetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_iqn").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDiskPoolIscsiTarget_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_disk_pool_iscsi_target", "test")
+	r := DiskPoolIscsiTargetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDiskPoolIscsiTarget_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_disk_pool_iscsi_target", "test")
+	r := DiskPoolIscsiTargetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withLun(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lun.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lun.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DiskPoolIscsiTargetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := iscsitargets.ParseIscsiTargetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.DiskPoolIscsiTargetsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DiskPoolIscsiTargetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_disk_pool_iscsi_target" "test" {
+  name         = "acctest-target-%s"
+  disk_pool_id = azurerm_disk_pool.test.id
+  acl_mode     = "Dynamic"
+
+  depends_on = [azurerm_disk_pool_managed_disk_attachment.test]
+}
+`, DiskPoolManagedDiskAttachmentResource{}.basic(data), data.RandomString)
+}
+
+func (r DiskPoolIscsiTargetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_disk_pool_iscsi_target" "import" {
+  name         = azurerm_disk_pool_iscsi_target.test.name
+  disk_pool_id = azurerm_disk_pool_iscsi_target.test.disk_pool_id
+  acl_mode     = azurerm_disk_pool_iscsi_target.test.acl_mode
+}
+`, r.basic(data))
+}
+
+func (r DiskPoolIscsiTargetResource) withLun(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_disk_pool_iscsi_target" "test" {
+  name         = "acctest-target-%s"
+  disk_pool_id = azurerm_disk_pool.test.id
+  acl_mode     = "Dynamic"
+
+  lun {
+    name            = "lun0"
+    managed_disk_id = azurerm_disk_pool_managed_disk_attachment.test.managed_disk_id
+  }
+}
+`, DiskPoolManagedDiskAttachmentResource{}.basic(data), data.RandomString)
+}
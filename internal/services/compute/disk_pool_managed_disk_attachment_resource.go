@@ -0,0 +1,200 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/diskpools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceDiskPoolManagedDiskAttachment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDiskPoolManagedDiskAttachmentCreate,
+		Read:   resourceDiskPoolManagedDiskAttachmentRead,
+		Delete: resourceDiskPoolManagedDiskAttachmentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DiskPoolManagedDiskAttachmentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"disk_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DiskPoolID,
+			},
+
+			"managed_disk_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedDiskID,
+			},
+		},
+	}
+}
+
+func resourceDiskPoolManagedDiskAttachmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DiskPoolsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	diskPoolId, err := diskpools.ParseDiskPoolID(d.Get("disk_pool_id").(string))
+	if err != nil {
+		return err
+	}
+	managedDiskId, err := parse.ManagedDiskID(d.Get("managed_disk_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewDiskPoolManagedDiskAttachmentID(*diskPoolId, *managedDiskId)
+
+	locks.ByName(diskPoolId.Name, diskPoolResourceName)
+	defer locks.UnlockByName(diskPoolId.Name, diskPoolResourceName)
+
+	resp, err := client.Get(ctx, *diskPoolId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *diskPoolId, err)
+	}
+	if resp.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *diskPoolId)
+	}
+
+	disks := make([]diskpools.Disk, 0)
+	if resp.Model.Properties.Disks != nil {
+		disks = *resp.Model.Properties.Disks
+	}
+	if diskPoolContainsManagedDisk(disks, *managedDiskId) {
+		return tf.ImportAsExistsError("azurerm_disk_pool_managed_disk_attachment", id.ID())
+	}
+
+	disks = append(disks, diskpools.Disk{
+		Id: managedDiskId.ID(),
+	})
+	payload := diskpools.DiskPoolUpdate{
+		Properties: diskpools.DiskPoolUpdateProperties{
+			Disks: &disks,
+		},
+	}
+	if err := client.UpdateThenPoll(ctx, *diskPoolId, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDiskPoolManagedDiskAttachmentRead(d, meta)
+}
+
+func resourceDiskPoolManagedDiskAttachmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DiskPoolsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DiskPoolManagedDiskAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.DiskPoolId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id.DiskPoolId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id.DiskPoolId, err)
+	}
+
+	disks := make([]diskpools.Disk, 0)
+	if model := resp.Model; model != nil && model.Properties.Disks != nil {
+		disks = *model.Properties.Disks
+	}
+	if !diskPoolContainsManagedDisk(disks, id.ManagedDiskId) {
+		log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("disk_pool_id", id.DiskPoolId.ID())
+	d.Set("managed_disk_id", id.ManagedDiskId.ID())
+
+	return nil
+}
+
+func resourceDiskPoolManagedDiskAttachmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DiskPoolsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DiskPoolManagedDiskAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.DiskPoolId.Name, diskPoolResourceName)
+	defer locks.UnlockByName(id.DiskPoolId.Name, diskPoolResourceName)
+
+	resp, err := client.Get(ctx, id.DiskPoolId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id.DiskPoolId, err)
+	}
+
+	disks := make([]diskpools.Disk, 0)
+	if model := resp.Model; model != nil && model.Properties.Disks != nil {
+		for _, disk := range *model.Properties.Disks {
+			if managedDiskMatches(disk, id.ManagedDiskId) {
+				continue
+			}
+			disks = append(disks, disk)
+		}
+	}
+
+	payload := diskpools.DiskPoolUpdate{
+		Properties: diskpools.DiskPoolUpdateProperties{
+			Disks: &disks,
+		},
+	}
+	if err := client.UpdateThenPoll(ctx, id.DiskPoolId, payload); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func diskPoolContainsManagedDisk(disks []diskpools.Disk, managedDiskId parse.ManagedDiskId) bool {
+	for _, disk := range disks {
+		if managedDiskMatches(disk, managedDiskId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func managedDiskMatches(disk diskpools.Disk, managedDiskId parse.ManagedDiskId) bool {
+	// the casing of the Resource Group can differ in the API response
+	return strings.EqualFold(disk.Id, managedDiskId.ID())
+}
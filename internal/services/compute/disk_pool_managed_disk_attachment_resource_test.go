@@ -0,0 +1,131 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DiskPoolManagedDiskAttachmentResource struct{}
+
+func TestAccDiskPoolManagedDiskAttachment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_disk_pool_managed_disk_attachment", "test")
+	r := DiskPoolManagedDiskAttachmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDiskPoolManagedDiskAttachment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_disk_pool_managed_disk_attachment", "test")
+	r := DiskPoolManagedDiskAttachmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (DiskPoolManagedDiskAttachmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DiskPoolManagedDiskAttachmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.DiskPoolsClient.Get(ctx, id.DiskPoolId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id.DiskPoolId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties.Disks != nil {
+		for _, disk := range *model.Properties.Disks {
+			if strings.EqualFold(disk.Id, id.ManagedDiskId.ID()) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r DiskPoolManagedDiskAttachmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_disk_pool_managed_disk_attachment" "test" {
+  disk_pool_id    = azurerm_disk_pool.test.id
+  managed_disk_id = azurerm_managed_disk.test.id
+
+  depends_on = [azurerm_role_assignment.test_disk_pool_operator, azurerm_role_assignment.test_vm_contributor]
+}
+`, r.template(data))
+}
+
+func (r DiskPoolManagedDiskAttachmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_disk_pool_managed_disk_attachment" "import" {
+  disk_pool_id    = azurerm_disk_pool_managed_disk_attachment.test.disk_pool_id
+  managed_disk_id = azurerm_disk_pool_managed_disk_attachment.test.managed_disk_id
+}
+`, r.basic(data))
+}
+
+func (DiskPoolManagedDiskAttachmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+%s
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctest-disk-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  location             = azurerm_resource_group.test.location
+  create_option        = "Empty"
+  storage_account_type = "Premium_LRS"
+  disk_size_gb         = 4
+  zones                = ["1"]
+}
+
+data "azuread_service_principal" "test" {
+  display_name = "StoragePool Resource Provider"
+}
+
+resource "azurerm_role_assignment" "test_disk_pool_operator" {
+  scope                = azurerm_managed_disk.test.id
+  role_definition_name = "Disk Pool Operator"
+  principal_id         = data.azuread_service_principal.test.object_id
+}
+
+resource "azurerm_role_assignment" "test_vm_contributor" {
+  scope                = azurerm_managed_disk.test.id
+  role_definition_name = "Virtual Machine Contributor"
+  principal_id         = data.azuread_service_principal.test.object_id
+}
+`, DiskPoolResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,231 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/diskpools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var diskPoolResourceName = "azurerm_disk_pool"
+
+func resourceDiskPool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDiskPoolCreate,
+		Read:   resourceDiskPoolRead,
+		Update: resourceDiskPoolUpdate,
+		Delete: resourceDiskPoolDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := diskpools.ParseDiskPoolID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DiskPoolName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Basic_B1",
+					"Standard_S1",
+					"Premium_P1",
+				}, false),
+			},
+
+			"subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+
+			"zones": azure.SchemaMultipleZones(),
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceDiskPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DiskPoolsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := diskpools.NewDiskPoolID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_disk_pool", id.ID())
+	}
+
+	payload := diskpools.DiskPoolCreate{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: diskpools.DiskPoolCreateProperties{
+			AvailabilityZones: azure.ExpandZones(d.Get("zones").([]interface{})),
+			SubnetId:          d.Get("subnet_id").(string),
+		},
+		Sku:  expandDiskPoolSku(d.Get("sku_name").(string)),
+		Tags: expandDiskPoolTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDiskPoolRead(d, meta)
+}
+
+func resourceDiskPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DiskPoolsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := diskpools.ParseDiskPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		skuName := ""
+		if model.Sku != nil {
+			skuName = model.Sku.Name
+		}
+		d.Set("sku_name", skuName)
+
+		d.Set("subnet_id", model.Properties.SubnetId)
+		if err := d.Set("zones", azure.FlattenZones(&model.Properties.AvailabilityZones)); err != nil {
+			return fmt.Errorf("setting `zones`: %+v", err)
+		}
+
+		if err := tags.FlattenAndSet(d, flattenDiskPoolTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceDiskPoolUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DiskPoolsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := diskpools.ParseDiskPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, diskPoolResourceName)
+	defer locks.UnlockByName(id.Name, diskPoolResourceName)
+
+	// the Managed Disks are attached using the `azurerm_disk_pool_managed_disk_attachment` resource, so they're
+	// intentionally omitted here such that the existing Managed Disks are retained
+	payload := diskpools.DiskPoolUpdate{}
+	if d.HasChange("sku_name") {
+		sku := expandDiskPoolSku(d.Get("sku_name").(string))
+		payload.Sku = &sku
+	}
+	if d.HasChange("tags") {
+		payload.Tags = expandDiskPoolTags(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceDiskPoolRead(d, meta)
+}
+
+func resourceDiskPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DiskPoolsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := diskpools.ParseDiskPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandDiskPoolSku(input string) diskpools.Sku {
+	// the Tier is the prefix of the Sku Name, e.g. `Basic` for `Basic_B1`
+	tier := strings.Split(input, "_")[0]
+	return diskpools.Sku{
+		Name: input,
+		Tier: utils.String(tier),
+	}
+}
+
+func expandDiskPoolTags(input map[string]interface{}) *map[string]string {
+	output := tags.ToTypedObject(tags.Expand(input))
+	return &output
+}
+
+func flattenDiskPoolTags(input *map[string]string) map[string]*string {
+	if input == nil {
+		return map[string]*string{}
+	}
+	return tags.FromTypedObject(*input)
+}
@@ -0,0 +1,1036 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/imagetemplates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	msiParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type imageBuilderTemplateIdentity = identity.UserAssigned
+
+const (
+	imageBuilderCustomizerTypeFile           = "File"
+	imageBuilderCustomizerTypePowerShell     = "PowerShell"
+	imageBuilderCustomizerTypeShell          = "Shell"
+	imageBuilderCustomizerTypeWindowsRestart = "WindowsRestart"
+)
+
+func resourceImageBuilderTemplate() *pluginsdk.Resource {
+	identitySchema := imageBuilderTemplateIdentity{}.Schema()
+	identitySchema.Optional = false
+	identitySchema.Required = true
+
+	return &pluginsdk.Resource{
+		Create: resourceImageBuilderTemplateCreate,
+		Read:   resourceImageBuilderTemplateRead,
+		Update: resourceImageBuilderTemplateUpdate,
+		Delete: resourceImageBuilderTemplateDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := imagetemplates.ParseImageTemplateID(id)
+			return err
+		}),
+
+		// running a build can take several hours, so these are deliberately generous
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(4 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(4 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ImageBuilderTemplateName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			// Image Builder only supports User Assigned Identities, which are used to read the
+			// source image & customizer artifacts and to write to the distribution targets
+			"identity": identitySchema,
+
+			"platform_image_source": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"platform_image_source", "managed_image_source_id", "shared_image_version_source_id"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"publisher": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"offer": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"sku": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"version": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "latest",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"managed_image_source_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ImageID,
+				ExactlyOneOf: []string{"platform_image_source", "managed_image_source_id", "shared_image_version_source_id"},
+			},
+
+			"shared_image_version_source_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SharedImageVersionID,
+				ExactlyOneOf: []string{"platform_image_source", "managed_image_source_id", "shared_image_version_source_id"},
+			},
+
+			// customizers are run in the order they're defined, so this is a List with a `type` rather than
+			// a block per customizer type
+			"customizer": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								imageBuilderCustomizerTypeFile,
+								imageBuilderCustomizerTypePowerShell,
+								imageBuilderCustomizerTypeShell,
+								imageBuilderCustomizerTypeWindowsRestart,
+							}, false),
+						},
+
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"script_uri": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+
+						"inline": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"sha256_checksum": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"run_elevated": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+
+						"valid_exit_codes": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeInt,
+							},
+						},
+
+						"source_uri": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+
+						"destination": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"restart_command": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"restart_check_command": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"restart_timeout": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"shared_image_distribution": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"run_output_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"shared_image_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.SharedImageID,
+						},
+
+						"replication_regions": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:             pluginsdk.TypeString,
+								ValidateFunc:     location.EnhancedValidate,
+								StateFunc:        location.StateFunc,
+								DiffSuppressFunc: location.DiffSuppressFunc,
+							},
+						},
+
+						"exclude_from_latest": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+
+						"storage_account_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  string(imagetemplates.SharedImageStorageAccountTypeStandardLRS),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(imagetemplates.SharedImageStorageAccountTypeStandardLRS),
+								string(imagetemplates.SharedImageStorageAccountTypeStandardZRS),
+							}, false),
+						},
+
+						"artifact_tags": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"build_timeout_in_minutes": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      240,
+				ValidateFunc: validation.IntBetween(0, 960),
+			},
+
+			"vm_size": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Standard_D1_v2",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"os_disk_size_gb": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+
+			// when specified a build is run once the Template has been created, and again each time
+			// `triggers` changes - this is intentionally not read back from the API
+			"run": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"triggers": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"wait_for_completion": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"last_run_status": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"run_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"run_sub_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"message": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"start_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"end_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceImageBuilderTemplateCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.ImageTemplatesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := imagetemplates.NewImageTemplateID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_image_builder_template", id.ID())
+	}
+
+	identity, err := expandImageBuilderTemplateIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	customizers, err := expandImageBuilderTemplateCustomizers(d.Get("customizer").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `customizer`: %+v", err)
+	}
+
+	vmProfile := imagetemplates.ImageTemplateVmProfile{
+		VmSize: utils.String(d.Get("vm_size").(string)),
+	}
+	if v, ok := d.GetOk("os_disk_size_gb"); ok {
+		vmProfile.OsDiskSizeGB = utils.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("subnet_id"); ok {
+		vmProfile.VnetConfig = &imagetemplates.VirtualNetworkConfig{
+			SubnetId: utils.String(v.(string)),
+		}
+	}
+
+	template := imagetemplates.ImageTemplate{
+		Identity: *identity,
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &imagetemplates.ImageTemplateProperties{
+			BuildTimeoutInMinutes: utils.Int64(int64(d.Get("build_timeout_in_minutes").(int))),
+			Customize:             customizers,
+			Distribute:            expandImageBuilderTemplateSharedImageDistributions(d.Get("shared_image_distribution").([]interface{})),
+			Source:                expandImageBuilderTemplateSource(d),
+			VmProfile:             &vmProfile,
+		},
+		Tags: expandImageBuilderTemplateTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, template); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if v := d.Get("run").([]interface{}); len(v) > 0 {
+		if err := runImageBuilderTemplate(ctx, client, id, v); err != nil {
+			return err
+		}
+	}
+
+	return resourceImageBuilderTemplateRead(d, meta)
+}
+
+func resourceImageBuilderTemplateRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.ImageTemplatesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := imagetemplates.ParseImageTemplateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		identity, err := flattenImageBuilderTemplateIdentity(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", identity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			platformImageSource := make([]interface{}, 0)
+			managedImageSourceId := ""
+			sharedImageVersionSourceId := ""
+			switch source := props.Source.(type) {
+			case imagetemplates.ImageTemplatePlatformImageSource:
+				platformImageSource = flattenImageBuilderTemplatePlatformImageSource(source)
+			case imagetemplates.ImageTemplateManagedImageSource:
+				managedImageSourceId = source.ImageId
+			case imagetemplates.ImageTemplateSharedImageVersionSource:
+				sharedImageVersionSourceId = source.ImageVersionId
+			}
+			if err := d.Set("platform_image_source", platformImageSource); err != nil {
+				return fmt.Errorf("setting `platform_image_source`: %+v", err)
+			}
+			d.Set("managed_image_source_id", managedImageSourceId)
+			d.Set("shared_image_version_source_id", sharedImageVersionSourceId)
+
+			if err := d.Set("customizer", flattenImageBuilderTemplateCustomizers(props.Customize)); err != nil {
+				return fmt.Errorf("setting `customizer`: %+v", err)
+			}
+
+			if err := d.Set("shared_image_distribution", flattenImageBuilderTemplateSharedImageDistributions(props.Distribute)); err != nil {
+				return fmt.Errorf("setting `shared_image_distribution`: %+v", err)
+			}
+
+			buildTimeoutInMinutes := 0
+			if props.BuildTimeoutInMinutes != nil {
+				buildTimeoutInMinutes = int(*props.BuildTimeoutInMinutes)
+			}
+			d.Set("build_timeout_in_minutes", buildTimeoutInMinutes)
+
+			vmSize := ""
+			osDiskSizeGB := 0
+			subnetId := ""
+			if profile := props.VmProfile; profile != nil {
+				if profile.VmSize != nil {
+					vmSize = *profile.VmSize
+				}
+				if profile.OsDiskSizeGB != nil {
+					osDiskSizeGB = int(*profile.OsDiskSizeGB)
+				}
+				if profile.VnetConfig != nil && profile.VnetConfig.SubnetId != nil {
+					subnetId = *profile.VnetConfig.SubnetId
+				}
+			}
+			d.Set("vm_size", vmSize)
+			d.Set("os_disk_size_gb", osDiskSizeGB)
+			d.Set("subnet_id", subnetId)
+
+			if err := d.Set("last_run_status", flattenImageBuilderTemplateLastRunStatus(props.LastRunStatus)); err != nil {
+				return fmt.Errorf("setting `last_run_status`: %+v", err)
+			}
+		}
+
+		if err := tags.FlattenAndSet(d, flattenImageBuilderTemplateTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceImageBuilderTemplateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.ImageTemplatesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := imagetemplates.ParseImageTemplateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("identity", "tags") {
+		identity, err := expandImageBuilderTemplateIdentity(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+
+		payload := imagetemplates.ImageTemplateUpdateParameters{
+			Identity: identity,
+			Tags:     expandImageBuilderTemplateTags(d.Get("tags").(map[string]interface{})),
+		}
+		if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	// adding the `run` block or changing the `triggers` within it queues a new build, however
+	// toggling `wait_for_completion` or removing the block doesn't
+	if d.HasChange("run") {
+		old, new := d.GetChange("run")
+		if v := new.([]interface{}); len(v) > 0 && (len(old.([]interface{})) == 0 || d.HasChange("run.0.triggers")) {
+			if err := runImageBuilderTemplate(ctx, client, *id, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceImageBuilderTemplateRead(d, meta)
+}
+
+func resourceImageBuilderTemplateDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.ImageTemplatesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := imagetemplates.ParseImageTemplateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func runImageBuilderTemplate(ctx context.Context, client *imagetemplates.VirtualMachineImageTemplatesClient, id imagetemplates.ImageTemplateId, input []interface{}) error {
+	waitForCompletion := true
+	if len(input) > 0 && input[0] != nil {
+		waitForCompletion = input[0].(map[string]interface{})["wait_for_completion"].(bool)
+	}
+
+	log.Printf("[DEBUG] Running %s..", id)
+	if !waitForCompletion {
+		if _, err := client.Run(ctx, id); err != nil {
+			return fmt.Errorf("running %s: %+v", id, err)
+		}
+		return nil
+	}
+
+	if err := client.RunThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("running %s: %+v", id, err)
+	}
+
+	// a build where only some of the distributions succeed can still complete the operation successfully,
+	// so the outcome of the build itself is taken from the Last Run Status
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.LastRunStatus != nil {
+		status := model.Properties.LastRunStatus
+		if status.RunState != nil && *status.RunState != imagetemplates.RunStateSucceeded {
+			message := ""
+			if status.Message != nil {
+				message = *status.Message
+			}
+			return fmt.Errorf("running %s: build finished in state %q: %s", id, string(*status.RunState), message)
+		}
+	}
+	log.Printf("[DEBUG] Run of %s completed.", id)
+
+	return nil
+}
+
+func expandImageBuilderTemplateIdentity(input []interface{}) (*imagetemplates.ImageTemplateIdentity, error) {
+	config, err := imageBuilderTemplateIdentity{}.Expand(input)
+	if err != nil {
+		return nil, err
+	}
+
+	identityIds := make(map[string]imagetemplates.ImageTemplateIdentityUserAssignedIdentitiesValue)
+	if config.UserAssignedIdentityIds != nil {
+		for _, id := range *config.UserAssignedIdentityIds {
+			identityIds[id] = imagetemplates.ImageTemplateIdentityUserAssignedIdentitiesValue{}
+		}
+	}
+
+	identityType := imagetemplates.ResourceIdentityType(config.Type)
+	return &imagetemplates.ImageTemplateIdentity{
+		Type:                   &identityType,
+		UserAssignedIdentities: &identityIds,
+	}, nil
+}
+
+func flattenImageBuilderTemplateIdentity(input imagetemplates.ImageTemplateIdentity) ([]interface{}, error) {
+	var config *identity.ExpandedConfig
+	if input.Type != nil {
+		identityIds := make([]string, 0)
+		if input.UserAssignedIdentities != nil {
+			for key := range *input.UserAssignedIdentities {
+				parsedId, err := msiParse.UserAssignedIdentityIDInsensitively(key)
+				if err != nil {
+					return nil, err
+				}
+				identityIds = append(identityIds, parsedId.ID())
+			}
+		}
+
+		config = &identity.ExpandedConfig{
+			Type:                    identity.Type(string(*input.Type)),
+			UserAssignedIdentityIds: &identityIds,
+		}
+	}
+	return imageBuilderTemplateIdentity{}.Flatten(config), nil
+}
+
+func expandImageBuilderTemplateSource(d *pluginsdk.ResourceData) imagetemplates.ImageTemplateSource {
+	if v, ok := d.GetOk("managed_image_source_id"); ok {
+		return imagetemplates.ImageTemplateManagedImageSource{
+			ImageId: v.(string),
+		}
+	}
+
+	if v, ok := d.GetOk("shared_image_version_source_id"); ok {
+		return imagetemplates.ImageTemplateSharedImageVersionSource{
+			ImageVersionId: v.(string),
+		}
+	}
+
+	source := imagetemplates.ImageTemplatePlatformImageSource{}
+	if v := d.Get("platform_image_source").([]interface{}); len(v) > 0 && v[0] != nil {
+		raw := v[0].(map[string]interface{})
+		source.Publisher = utils.String(raw["publisher"].(string))
+		source.Offer = utils.String(raw["offer"].(string))
+		source.Sku = utils.String(raw["sku"].(string))
+		source.Version = utils.String(raw["version"].(string))
+	}
+	return source
+}
+
+func flattenImageBuilderTemplatePlatformImageSource(input imagetemplates.ImageTemplatePlatformImageSource) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"publisher": utils.NormalizeNilableString(input.Publisher),
+			"offer":     utils.NormalizeNilableString(input.Offer),
+			"sku":       utils.NormalizeNilableString(input.Sku),
+			"version":   utils.NormalizeNilableString(input.Version),
+		},
+	}
+}
+
+func expandImageBuilderTemplateCustomizers(input []interface{}) (*[]imagetemplates.ImageTemplateCustomizer, error) {
+	customizers := make([]imagetemplates.ImageTemplateCustomizer, 0)
+	for i, item := range input {
+		if item == nil {
+			continue
+		}
+		raw := item.(map[string]interface{})
+
+		customizerType := raw["type"].(string)
+		name := utils.String(raw["name"].(string))
+		if *name == "" {
+			name = nil
+		}
+
+		scriptUri := raw["script_uri"].(string)
+		inline := utils.ExpandStringSlice(raw["inline"].([]interface{}))
+		sha256Checksum := raw["sha256_checksum"].(string)
+		runElevated := raw["run_elevated"].(bool)
+		validExitCodes := raw["valid_exit_codes"].([]interface{})
+		sourceUri := raw["source_uri"].(string)
+		destination := raw["destination"].(string)
+		restartCommand := raw["restart_command"].(string)
+		restartCheckCommand := raw["restart_check_command"].(string)
+		restartTimeout := raw["restart_timeout"].(string)
+
+		if customizerType != imageBuilderCustomizerTypeShell && customizerType != imageBuilderCustomizerTypePowerShell {
+			if scriptUri != "" || len(*inline) > 0 {
+				return nil, fmt.Errorf("`script_uri` and `inline` can only be specified when `type` is `%s` or `%s` (customizer %d)", imageBuilderCustomizerTypeShell, imageBuilderCustomizerTypePowerShell, i)
+			}
+		}
+		if customizerType != imageBuilderCustomizerTypePowerShell && (runElevated || len(validExitCodes) > 0) {
+			return nil, fmt.Errorf("`run_elevated` and `valid_exit_codes` can only be specified when `type` is `%s` (customizer %d)", imageBuilderCustomizerTypePowerShell, i)
+		}
+		if customizerType != imageBuilderCustomizerTypeFile && (sourceUri != "" || destination != "") {
+			return nil, fmt.Errorf("`source_uri` and `destination` can only be specified when `type` is `%s` (customizer %d)", imageBuilderCustomizerTypeFile, i)
+		}
+		if customizerType != imageBuilderCustomizerTypeWindowsRestart && (restartCommand != "" || restartCheckCommand != "" || restartTimeout != "") {
+			return nil, fmt.Errorf("`restart_command`, `restart_check_command` and `restart_timeout` can only be specified when `type` is `%s` (customizer %d)", imageBuilderCustomizerTypeWindowsRestart, i)
+		}
+
+		switch customizerType {
+		case imageBuilderCustomizerTypeShell, imageBuilderCustomizerTypePowerShell:
+			if (scriptUri == "") == (len(*inline) == 0) {
+				return nil, fmt.Errorf("exactly one of `script_uri` or `inline` must be specified when `type` is `%s` (customizer %d)", customizerType, i)
+			}
+
+			var scriptUriPtr, sha256ChecksumPtr *string
+			var inlinePtr *[]string
+			if scriptUri != "" {
+				scriptUriPtr = utils.String(scriptUri)
+				if sha256Checksum != "" {
+					sha256ChecksumPtr = utils.String(sha256Checksum)
+				}
+			} else {
+				inlinePtr = inline
+			}
+
+			if customizerType == imageBuilderCustomizerTypeShell {
+				customizers = append(customizers, imagetemplates.ImageTemplateShellCustomizer{
+					Name:           name,
+					ScriptUri:      scriptUriPtr,
+					Sha256Checksum: sha256ChecksumPtr,
+					Inline:         inlinePtr,
+				})
+				continue
+			}
+
+			customizer := imagetemplates.ImageTemplatePowerShellCustomizer{
+				Name:           name,
+				ScriptUri:      scriptUriPtr,
+				Sha256Checksum: sha256ChecksumPtr,
+				Inline:         inlinePtr,
+				RunElevated:    utils.Bool(runElevated),
+			}
+			if len(validExitCodes) > 0 {
+				exitCodes := make([]int64, 0)
+				for _, v := range validExitCodes {
+					exitCodes = append(exitCodes, int64(v.(int)))
+				}
+				customizer.ValidExitCodes = &exitCodes
+			}
+			customizers = append(customizers, customizer)
+
+		case imageBuilderCustomizerTypeFile:
+			if sourceUri == "" || destination == "" {
+				return nil, fmt.Errorf("`source_uri` and `destination` must be specified when `type` is `%s` (customizer %d)", imageBuilderCustomizerTypeFile, i)
+			}
+
+			customizer := imagetemplates.ImageTemplateFileCustomizer{
+				Name:        name,
+				SourceUri:   utils.String(sourceUri),
+				Destination: utils.String(destination),
+			}
+			if sha256Checksum != "" {
+				customizer.Sha256Checksum = utils.String(sha256Checksum)
+			}
+			customizers = append(customizers, customizer)
+
+		case imageBuilderCustomizerTypeWindowsRestart:
+			customizer := imagetemplates.ImageTemplateRestartCustomizer{
+				Name: name,
+			}
+			if restartCommand != "" {
+				customizer.RestartCommand = utils.String(restartCommand)
+			}
+			if restartCheckCommand != "" {
+				customizer.RestartCheckCommand = utils.String(restartCheckCommand)
+			}
+			if restartTimeout != "" {
+				customizer.RestartTimeout = utils.String(restartTimeout)
+			}
+			customizers = append(customizers, customizer)
+		}
+	}
+
+	return &customizers, nil
+}
+
+func flattenImageBuilderTemplateCustomizers(input *[]imagetemplates.ImageTemplateCustomizer) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, item := range *input {
+		customizer := map[string]interface{}{
+			"name":                  "",
+			"script_uri":            "",
+			"inline":                []interface{}{},
+			"sha256_checksum":       "",
+			"run_elevated":          false,
+			"valid_exit_codes":      []interface{}{},
+			"source_uri":            "",
+			"destination":           "",
+			"restart_command":       "",
+			"restart_check_command": "",
+			"restart_timeout":       "",
+		}
+
+		switch v := item.(type) {
+		case imagetemplates.ImageTemplateShellCustomizer:
+			customizer["type"] = imageBuilderCustomizerTypeShell
+			customizer["name"] = utils.NormalizeNilableString(v.Name)
+			customizer["script_uri"] = utils.NormalizeNilableString(v.ScriptUri)
+			customizer["sha256_checksum"] = utils.NormalizeNilableString(v.Sha256Checksum)
+			customizer["inline"] = utils.FlattenStringSlice(v.Inline)
+
+		case imagetemplates.ImageTemplatePowerShellCustomizer:
+			customizer["type"] = imageBuilderCustomizerTypePowerShell
+			customizer["name"] = utils.NormalizeNilableString(v.Name)
+			customizer["script_uri"] = utils.NormalizeNilableString(v.ScriptUri)
+			customizer["sha256_checksum"] = utils.NormalizeNilableString(v.Sha256Checksum)
+			customizer["inline"] = utils.FlattenStringSlice(v.Inline)
+			if v.RunElevated != nil {
+				customizer["run_elevated"] = *v.RunElevated
+			}
+			validExitCodes := make([]interface{}, 0)
+			if v.ValidExitCodes != nil {
+				for _, code := range *v.ValidExitCodes {
+					validExitCodes = append(validExitCodes, int(code))
+				}
+			}
+			customizer["valid_exit_codes"] = validExitCodes
+
+		case imagetemplates.ImageTemplateFileCustomizer:
+			customizer["type"] = imageBuilderCustomizerTypeFile
+			customizer["name"] = utils.NormalizeNilableString(v.Name)
+			customizer["source_uri"] = utils.NormalizeNilableString(v.SourceUri)
+			customizer["destination"] = utils.NormalizeNilableString(v.Destination)
+			customizer["sha256_checksum"] = utils.NormalizeNilableString(v.Sha256Checksum)
+
+		case imagetemplates.ImageTemplateRestartCustomizer:
+			customizer["type"] = imageBuilderCustomizerTypeWindowsRestart
+			customizer["name"] = utils.NormalizeNilableString(v.Name)
+			customizer["restart_command"] = utils.NormalizeNilableString(v.RestartCommand)
+			customizer["restart_check_command"] = utils.NormalizeNilableString(v.RestartCheckCommand)
+			customizer["restart_timeout"] = utils.NormalizeNilableString(v.RestartTimeout)
+
+		default:
+			// customizer types which aren't supported by this resource (e.g. `WindowsUpdate`) can't be represented
+			continue
+		}
+
+		output = append(output, customizer)
+	}
+
+	return output
+}
+
+func expandImageBuilderTemplateSharedImageDistributions(input []interface{}) []imagetemplates.ImageTemplateDistributor {
+	distributors := make([]imagetemplates.ImageTemplateDistributor, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		raw := item.(map[string]interface{})
+
+		replicationRegions := make([]string, 0)
+		for _, region := range raw["replication_regions"].([]interface{}) {
+			replicationRegions = append(replicationRegions, location.Normalize(region.(string)))
+		}
+
+		storageAccountType := imagetemplates.SharedImageStorageAccountType(raw["storage_account_type"].(string))
+		distributor := imagetemplates.ImageTemplateSharedImageDistributor{
+			RunOutputName:      raw["run_output_name"].(string),
+			GalleryImageId:     raw["shared_image_id"].(string),
+			ReplicationRegions: replicationRegions,
+			ExcludeFromLatest:  utils.Bool(raw["exclude_from_latest"].(bool)),
+			StorageAccountType: &storageAccountType,
+		}
+
+		if v := raw["artifact_tags"].(map[string]interface{}); len(v) > 0 {
+			artifactTags := make(map[string]string)
+			for key, value := range v {
+				artifactTags[key] = value.(string)
+			}
+			distributor.ArtifactTags = &artifactTags
+		}
+
+		distributors = append(distributors, distributor)
+	}
+
+	return distributors
+}
+
+func flattenImageBuilderTemplateSharedImageDistributions(input []imagetemplates.ImageTemplateDistributor) []interface{} {
+	output := make([]interface{}, 0)
+	for _, item := range input {
+		v, ok := item.(imagetemplates.ImageTemplateSharedImageDistributor)
+		if !ok {
+			continue
+		}
+
+		replicationRegions := make([]interface{}, 0)
+		for _, region := range v.ReplicationRegions {
+			replicationRegions = append(replicationRegions, location.Normalize(region))
+		}
+
+		excludeFromLatest := false
+		if v.ExcludeFromLatest != nil {
+			excludeFromLatest = *v.ExcludeFromLatest
+		}
+
+		storageAccountType := ""
+		if v.StorageAccountType != nil {
+			storageAccountType = string(*v.StorageAccountType)
+		}
+
+		artifactTags := make(map[string]interface{})
+		if v.ArtifactTags != nil {
+			for key, value := range *v.ArtifactTags {
+				artifactTags[key] = value
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"run_output_name":      v.RunOutputName,
+			"shared_image_id":      v.GalleryImageId,
+			"replication_regions":  replicationRegions,
+			"exclude_from_latest":  excludeFromLatest,
+			"storage_account_type": storageAccountType,
+			"artifact_tags":        artifactTags,
+		})
+	}
+
+	return output
+}
+
+func flattenImageBuilderTemplateLastRunStatus(input *imagetemplates.ImageTemplateLastRunStatus) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	runState := ""
+	if input.RunState != nil {
+		runState = string(*input.RunState)
+	}
+
+	runSubState := ""
+	if input.RunSubState != nil {
+		runSubState = string(*input.RunSubState)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"run_state":     runState,
+			"run_sub_state": runSubState,
+			"message":       utils.NormalizeNilableString(input.Message),
+			"start_time":    utils.NormalizeNilableString(input.StartTime),
+			"end_time":      utils.NormalizeNilableString(input.EndTime),
+		},
+	}
+}
+
+func expandImageBuilderTemplateTags(input map[string]interface{}) *map[string]string {
+	output := tags.ToTypedObject(tags.Expand(input))
+	return &output
+}
+
+func flattenImageBuilderTemplateTags(input *map[string]string) map[string]*string {
+	if input == nil {
+		return map[string]*string{}
+	}
+	return tags.FromTypedObject(*input)
+}
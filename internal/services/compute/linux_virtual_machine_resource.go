@@ -46,6 +46,8 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(45 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualMachineEphemeralOSDiskCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -1037,7 +1039,9 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 	// now the VM's shutdown/deallocated we can update the disk which can't be done via the VM API:
 	// Code="ResizeDiskError" Message="Managed disk resize via Virtual Machine [name] is not allowed. Please resize disk resource at [id]."
 	// Portal: "Disks can be resized or account type changed only when they are unattached or the owner VM is deallocated."
-	if d.HasChange("os_disk.0.disk_size_gb") {
+	// when opted in, the new size of an Ephemeral OS Disk is instead applied by reimaging the VM (see below)
+	reimageEphemeralOSDisk := hasEphemeralOSDisk && meta.(*clients.Client).Features.VirtualMachine.ReimageEphemeralOSDiskOnResize
+	if d.HasChange("os_disk.0.disk_size_gb") && !reimageEphemeralOSDisk {
 		diskName := d.Get("os_disk.0.name").(string)
 		newSize := d.Get("os_disk.0.disk_size_gb").(int)
 		log.Printf("[DEBUG] Resizing OS Disk %q for Linux Virtual Machine %q (Resource Group %q) to %dGB..", diskName, id.Name, id.ResourceGroup, newSize)
@@ -1107,6 +1111,20 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		log.Printf("[DEBUG] Updated Linux Virtual Machine %q (Resource Group %q).", id.Name, id.ResourceGroup)
 	}
 
+	if d.HasChange("os_disk.0.disk_size_gb") && reimageEphemeralOSDisk {
+		log.Printf("[DEBUG] Reimaging Linux Virtual Machine %q (Resource Group %q) to resize the Ephemeral OS Disk..", id.Name, id.ResourceGroup)
+		future, err := client.Reimage(ctx, id.ResourceGroup, id.Name, &compute.VirtualMachineReimageParameters{})
+		if err != nil {
+			return fmt.Errorf("reimaging Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for reimage of Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		log.Printf("[DEBUG] Reimaged Linux Virtual Machine %q (Resource Group %q).", id.Name, id.ResourceGroup)
+	}
+
 	// if we've shut it down and it was turned off, let's boot it back up
 	if shouldTurnBackOn && shouldShutDown {
 		log.Printf("[DEBUG] Starting Linux Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
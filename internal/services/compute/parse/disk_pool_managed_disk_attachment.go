@@ -0,0 +1,50 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/diskpools"
+)
+
+type DiskPoolManagedDiskAttachmentId struct {
+	DiskPoolId    diskpools.DiskPoolId
+	ManagedDiskId ManagedDiskId
+}
+
+func NewDiskPoolManagedDiskAttachmentID(diskPoolId diskpools.DiskPoolId, managedDiskId ManagedDiskId) DiskPoolManagedDiskAttachmentId {
+	return DiskPoolManagedDiskAttachmentId{
+		DiskPoolId:    diskPoolId,
+		ManagedDiskId: managedDiskId,
+	}
+}
+
+func (id DiskPoolManagedDiskAttachmentId) String() string {
+	return fmt.Sprintf("Disk Pool Managed Disk Attachment (%s / %s)", id.DiskPoolId, id.ManagedDiskId)
+}
+
+func (id DiskPoolManagedDiskAttachmentId) ID() string {
+	return fmt.Sprintf("%s|%s", id.DiskPoolId.ID(), id.ManagedDiskId.ID())
+}
+
+func DiskPoolManagedDiskAttachmentID(input string) (*DiskPoolManagedDiskAttachmentId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format `{diskPoolID}|{managedDiskID}` but got %q", input)
+	}
+
+	diskPoolId, err := diskpools.ParseDiskPoolID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Disk Pool ID %q: %+v", segments[0], err)
+	}
+
+	managedDiskId, err := ManagedDiskID(segments[1])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Managed Disk ID %q: %+v", segments[1], err)
+	}
+
+	return &DiskPoolManagedDiskAttachmentId{
+		DiskPoolId:    *diskPoolId,
+		ManagedDiskId: *managedDiskId,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/diskpools"
+)
+
+func TestDiskPoolManagedDiskAttachmentID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Error  bool
+		Expect *DiskPoolManagedDiskAttachmentId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "One Segment",
+			Input: "hello",
+			Error: true,
+		},
+		{
+			Name:  "Two Segments Invalid ID's",
+			Input: "hello|world",
+			Error: true,
+		},
+		{
+			Name:  "Disk Pool ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.StoragePool/diskPools/pool1",
+			Error: true,
+		},
+		{
+			Name:  "Managed Disk ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Compute/disks/disk1",
+			Error: true,
+		},
+		{
+			Name:  "Reversed",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Compute/disks/disk1|/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.StoragePool/diskPools/pool1",
+			Error: true,
+		},
+		{
+			Name:  "Disk Pool / Managed Disk Attachment ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.StoragePool/diskPools/pool1|/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Compute/disks/disk1",
+			Error: false,
+			Expect: &DiskPoolManagedDiskAttachmentId{
+				DiskPoolId:    diskpools.NewDiskPoolID("00000000-0000-0000-0000-000000000000", "group1", "pool1"),
+				ManagedDiskId: NewManagedDiskID("00000000-0000-0000-0000-000000000000", "group2", "disk1"),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := DiskPoolManagedDiskAttachmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if *actual != *v.Expect {
+			t.Fatalf("Expected %+v but got %+v", *v.Expect, *actual)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to be %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+type VirtualMachineGalleryApplicationAssignmentId struct {
+	VirtualMachineId            VirtualMachineId
+	GalleryApplicationVersionId GalleryApplicationVersionId
+}
+
+func NewVirtualMachineGalleryApplicationAssignmentID(virtualMachineId VirtualMachineId, galleryApplicationVersionId GalleryApplicationVersionId) VirtualMachineGalleryApplicationAssignmentId {
+	return VirtualMachineGalleryApplicationAssignmentId{
+		VirtualMachineId:            virtualMachineId,
+		GalleryApplicationVersionId: galleryApplicationVersionId,
+	}
+}
+
+func (id VirtualMachineGalleryApplicationAssignmentId) String() string {
+	return fmt.Sprintf("Virtual Machine Gallery Application Assignment (%s / %s)", id.VirtualMachineId, id.GalleryApplicationVersionId)
+}
+
+func (id VirtualMachineGalleryApplicationAssignmentId) ID() string {
+	return fmt.Sprintf("%s|%s", id.VirtualMachineId.ID(), id.GalleryApplicationVersionId.ID())
+}
+
+func VirtualMachineGalleryApplicationAssignmentID(input string) (*VirtualMachineGalleryApplicationAssignmentId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format `{virtualMachineID}|{galleryApplicationVersionID}` but got %q", input)
+	}
+
+	virtualMachineId, err := VirtualMachineID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Virtual Machine ID %q: %+v", segments[0], err)
+	}
+
+	galleryApplicationVersionId, err := GalleryApplicationVersionID(segments[1])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Gallery Application Version ID %q: %+v", segments[1], err)
+	}
+
+	return &VirtualMachineGalleryApplicationAssignmentId{
+		VirtualMachineId:            *virtualMachineId,
+		GalleryApplicationVersionId: *galleryApplicationVersionId,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestVirtualMachineGalleryApplicationAssignmentID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Error  bool
+		Expect *VirtualMachineGalleryApplicationAssignmentId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "One Segment",
+			Input: "hello",
+			Error: true,
+		},
+		{
+			Name:  "Two Segments Invalid ID's",
+			Input: "hello|world",
+			Error: true,
+		},
+		{
+			Name:  "Virtual Machine ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/machine1",
+			Error: true,
+		},
+		{
+			Name:  "Gallery Application Version ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Compute/galleries/gallery1/applications/application1/versions/1.0.0",
+			Error: true,
+		},
+		{
+			Name:  "Reversed",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Compute/galleries/gallery1/applications/application1/versions/1.0.0|/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/machine1",
+			Error: true,
+		},
+		{
+			Name:  "Virtual Machine / Gallery Application Assignment ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/machine1|/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Compute/galleries/gallery1/applications/application1/versions/1.0.0",
+			Error: false,
+			Expect: &VirtualMachineGalleryApplicationAssignmentId{
+				VirtualMachineId:            NewVirtualMachineID("00000000-0000-0000-0000-000000000000", "group1", "machine1"),
+				GalleryApplicationVersionId: NewGalleryApplicationVersionID("00000000-0000-0000-0000-000000000000", "group2", "gallery1", "application1", "1.0.0"),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := VirtualMachineGalleryApplicationAssignmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if *actual != *v.Expect {
+			t.Fatalf("Expected %+v but got %+v", *v.Expect, *actual)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to be %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
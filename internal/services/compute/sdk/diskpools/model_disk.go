@@ -0,0 +1,5 @@
+package diskpools
+
+type Disk struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,5 @@
+package diskpools
+
+type DiskPoolUpdateProperties struct {
+	Disks *[]Disk `json:"disks,omitempty"`
+}
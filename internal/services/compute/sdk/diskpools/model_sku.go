@@ -0,0 +1,6 @@
+package diskpools
+
+type Sku struct {
+	Name string  `json:"name"`
+	Tier *string `json:"tier,omitempty"`
+}
@@ -0,0 +1,15 @@
+package imagetemplates
+
+import "github.com/Azure/go-autorest/autorest"
+
+type VirtualMachineImageTemplatesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVirtualMachineImageTemplatesClientWithBaseURI(endpoint string) VirtualMachineImageTemplatesClient {
+	return VirtualMachineImageTemplatesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
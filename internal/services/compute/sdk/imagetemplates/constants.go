@@ -0,0 +1,59 @@
+package imagetemplates
+
+type ProvisioningErrorCode string
+
+const (
+	ProvisioningErrorCodeBadCustomizerType           ProvisioningErrorCode = "BadCustomizerType"
+	ProvisioningErrorCodeBadDistributeType           ProvisioningErrorCode = "BadDistributeType"
+	ProvisioningErrorCodeBadManagedImageSource       ProvisioningErrorCode = "BadManagedImageSource"
+	ProvisioningErrorCodeBadPIRSource                ProvisioningErrorCode = "BadPIRSource"
+	ProvisioningErrorCodeBadSharedImageDistribute    ProvisioningErrorCode = "BadSharedImageDistribute"
+	ProvisioningErrorCodeBadSharedImageVersionSource ProvisioningErrorCode = "BadSharedImageVersionSource"
+	ProvisioningErrorCodeBadSourceType               ProvisioningErrorCode = "BadSourceType"
+	ProvisioningErrorCodeNoCustomizerScript          ProvisioningErrorCode = "NoCustomizerScript"
+	ProvisioningErrorCodeOther                       ProvisioningErrorCode = "Other"
+	ProvisioningErrorCodeServerError                 ProvisioningErrorCode = "ServerError"
+	ProvisioningErrorCodeUnsupportedCustomizerType   ProvisioningErrorCode = "UnsupportedCustomizerType"
+)
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+type ResourceIdentityType string
+
+const (
+	ResourceIdentityTypeNone         ResourceIdentityType = "None"
+	ResourceIdentityTypeUserAssigned ResourceIdentityType = "UserAssigned"
+)
+
+type RunState string
+
+const (
+	RunStateFailed             RunState = "Failed"
+	RunStatePartiallySucceeded RunState = "PartiallySucceeded"
+	RunStateRunning            RunState = "Running"
+	RunStateSucceeded          RunState = "Succeeded"
+)
+
+type RunSubState string
+
+const (
+	RunSubStateBuilding     RunSubState = "Building"
+	RunSubStateCustomizing  RunSubState = "Customizing"
+	RunSubStateDistributing RunSubState = "Distributing"
+	RunSubStateQueued       RunSubState = "Queued"
+)
+
+type SharedImageStorageAccountType string
+
+const (
+	SharedImageStorageAccountTypeStandardLRS SharedImageStorageAccountType = "Standard_LRS"
+	SharedImageStorageAccountTypeStandardZRS SharedImageStorageAccountType = "Standard_ZRS"
+)
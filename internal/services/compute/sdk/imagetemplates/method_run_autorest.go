@@ -0,0 +1,74 @@
+package imagetemplates
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type RunResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Run ...
+func (c VirtualMachineImageTemplatesClient) Run(ctx context.Context, id ImageTemplateId) (result RunResponse, err error) {
+	req, err := c.preparerForRun(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "imagetemplates.VirtualMachineImageTemplatesClient", "Run", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForRun(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "imagetemplates.VirtualMachineImageTemplatesClient", "Run", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// RunThenPoll performs Run then polls until it's completed
+func (c VirtualMachineImageTemplatesClient) RunThenPoll(ctx context.Context, id ImageTemplateId) error {
+	result, err := c.Run(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Run: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Run: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForRun prepares the Run request.
+func (c VirtualMachineImageTemplatesClient) preparerForRun(ctx context.Context, id ImageTemplateId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/run", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForRun sends the Run request. The method will close the
+// http.Response Body if it receives an error.
+func (c VirtualMachineImageTemplatesClient) senderForRun(ctx context.Context, req *http.Request) (future RunResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
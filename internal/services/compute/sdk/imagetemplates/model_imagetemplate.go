@@ -0,0 +1,11 @@
+package imagetemplates
+
+type ImageTemplate struct {
+	Id         *string                  `json:"id,omitempty"`
+	Identity   ImageTemplateIdentity    `json:"identity"`
+	Location   string                   `json:"location"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *ImageTemplateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string       `json:"tags,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
@@ -0,0 +1,68 @@
+package imagetemplates
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type ImageTemplateCustomizer interface {
+}
+
+func unmarshalImageTemplateCustomizerImplementation(input []byte) (ImageTemplateCustomizer, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling ImageTemplateCustomizer into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["type"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "Shell") {
+		var out ImageTemplateShellCustomizer
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ImageTemplateShellCustomizer: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "PowerShell") {
+		var out ImageTemplatePowerShellCustomizer
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ImageTemplatePowerShellCustomizer: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "File") {
+		var out ImageTemplateFileCustomizer
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ImageTemplateFileCustomizer: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "WindowsRestart") {
+		var out ImageTemplateRestartCustomizer
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ImageTemplateRestartCustomizer: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawImageTemplateCustomizerImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawImageTemplateCustomizerImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+}
@@ -0,0 +1,44 @@
+package imagetemplates
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type ImageTemplateDistributor interface {
+}
+
+func unmarshalImageTemplateDistributorImplementation(input []byte) (ImageTemplateDistributor, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling ImageTemplateDistributor into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["type"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "SharedImage") {
+		var out ImageTemplateSharedImageDistributor
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ImageTemplateSharedImageDistributor: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawImageTemplateDistributorImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawImageTemplateDistributorImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+}
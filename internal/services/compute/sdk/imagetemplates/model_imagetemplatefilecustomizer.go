@@ -0,0 +1,41 @@
+package imagetemplates
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ ImageTemplateCustomizer = ImageTemplateFileCustomizer{}
+
+type ImageTemplateFileCustomizer struct {
+	Destination    *string `json:"destination,omitempty"`
+	Sha256Checksum *string `json:"sha256Checksum,omitempty"`
+	SourceUri      *string `json:"sourceUri,omitempty"`
+
+	// Fields inherited from ImageTemplateCustomizer
+	Name *string `json:"name,omitempty"`
+}
+
+var _ json.Marshaler = ImageTemplateFileCustomizer{}
+
+func (s ImageTemplateFileCustomizer) MarshalJSON() ([]byte, error) {
+	type wrapper ImageTemplateFileCustomizer
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling ImageTemplateFileCustomizer: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling ImageTemplateFileCustomizer: %+v", err)
+	}
+	decoded["type"] = "File"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling ImageTemplateFileCustomizer: %+v", err)
+	}
+
+	return encoded, nil
+}
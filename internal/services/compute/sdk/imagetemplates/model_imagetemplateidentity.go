@@ -0,0 +1,6 @@
+package imagetemplates
+
+type ImageTemplateIdentity struct {
+	Type                   *ResourceIdentityType                                        `json:"type,omitempty"`
+	UserAssignedIdentities *map[string]ImageTemplateIdentityUserAssignedIdentitiesValue `json:"userAssignedIdentities,omitempty"`
+}
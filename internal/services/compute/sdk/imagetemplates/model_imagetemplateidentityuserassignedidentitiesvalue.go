@@ -0,0 +1,6 @@
+package imagetemplates
+
+type ImageTemplateIdentityUserAssignedIdentitiesValue struct {
+	ClientId    *string `json:"clientId,omitempty"`
+	PrincipalId *string `json:"principalId,omitempty"`
+}
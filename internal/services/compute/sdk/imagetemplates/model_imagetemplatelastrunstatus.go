@@ -0,0 +1,33 @@
+package imagetemplates
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/formatting"
+)
+
+type ImageTemplateLastRunStatus struct {
+	EndTime     *string      `json:"endTime,omitempty"`
+	Message     *string      `json:"message,omitempty"`
+	RunState    *RunState    `json:"runState,omitempty"`
+	RunSubState *RunSubState `json:"runSubState,omitempty"`
+	StartTime   *string      `json:"startTime,omitempty"`
+}
+
+func (o ImageTemplateLastRunStatus) GetEndTimeAsTime() (*time.Time, error) {
+	return formatting.ParseAsDateFormat(o.EndTime, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o ImageTemplateLastRunStatus) SetEndTimeAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.EndTime = &formatted
+}
+
+func (o ImageTemplateLastRunStatus) GetStartTimeAsTime() (*time.Time, error) {
+	return formatting.ParseAsDateFormat(o.StartTime, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o ImageTemplateLastRunStatus) SetStartTimeAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.StartTime = &formatted
+}
@@ -0,0 +1,36 @@
+package imagetemplates
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ ImageTemplateSource = ImageTemplateManagedImageSource{}
+
+type ImageTemplateManagedImageSource struct {
+	ImageId string `json:"imageId"`
+}
+
+var _ json.Marshaler = ImageTemplateManagedImageSource{}
+
+func (s ImageTemplateManagedImageSource) MarshalJSON() ([]byte, error) {
+	type wrapper ImageTemplateManagedImageSource
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling ImageTemplateManagedImageSource: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling ImageTemplateManagedImageSource: %+v", err)
+	}
+	decoded["type"] = "ManagedImage"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling ImageTemplateManagedImageSource: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,40 @@
+package imagetemplates
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ ImageTemplateSource = ImageTemplatePlatformImageSource{}
+
+type ImageTemplatePlatformImageSource struct {
+	Offer     *string                    `json:"offer,omitempty"`
+	PlanInfo  *PlatformImagePurchasePlan `json:"planInfo,omitempty"`
+	Publisher *string                    `json:"publisher,omitempty"`
+	Sku       *string                    `json:"sku,omitempty"`
+	Version   *string                    `json:"version,omitempty"`
+}
+
+var _ json.Marshaler = ImageTemplatePlatformImageSource{}
+
+func (s ImageTemplatePlatformImageSource) MarshalJSON() ([]byte, error) {
+	type wrapper ImageTemplatePlatformImageSource
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling ImageTemplatePlatformImageSource: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling ImageTemplatePlatformImageSource: %+v", err)
+	}
+	decoded["type"] = "PlatformImage"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling ImageTemplatePlatformImageSource: %+v", err)
+	}
+
+	return encoded, nil
+}
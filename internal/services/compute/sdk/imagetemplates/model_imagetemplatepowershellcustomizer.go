@@ -0,0 +1,43 @@
+package imagetemplates
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ ImageTemplateCustomizer = ImageTemplatePowerShellCustomizer{}
+
+type ImageTemplatePowerShellCustomizer struct {
+	Inline         *[]string `json:"inline,omitempty"`
+	RunElevated    *bool     `json:"runElevated,omitempty"`
+	ScriptUri      *string   `json:"scriptUri,omitempty"`
+	Sha256Checksum *string   `json:"sha256Checksum,omitempty"`
+	ValidExitCodes *[]int64  `json:"validExitCodes,omitempty"`
+
+	// Fields inherited from ImageTemplateCustomizer
+	Name *string `json:"name,omitempty"`
+}
+
+var _ json.Marshaler = ImageTemplatePowerShellCustomizer{}
+
+func (s ImageTemplatePowerShellCustomizer) MarshalJSON() ([]byte, error) {
+	type wrapper ImageTemplatePowerShellCustomizer
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling ImageTemplatePowerShellCustomizer: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling ImageTemplatePowerShellCustomizer: %+v", err)
+	}
+	decoded["type"] = "PowerShell"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling ImageTemplatePowerShellCustomizer: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,81 @@
+package imagetemplates
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type ImageTemplateProperties struct {
+	BuildTimeoutInMinutes *int64                      `json:"buildTimeoutInMinutes,omitempty"`
+	Customize             *[]ImageTemplateCustomizer  `json:"customize,omitempty"`
+	Distribute            []ImageTemplateDistributor  `json:"distribute"`
+	LastRunStatus         *ImageTemplateLastRunStatus `json:"lastRunStatus,omitempty"`
+	ProvisioningError     *ProvisioningError          `json:"provisioningError,omitempty"`
+	ProvisioningState     *ProvisioningState          `json:"provisioningState,omitempty"`
+	Source                ImageTemplateSource         `json:"source"`
+	VmProfile             *ImageTemplateVmProfile     `json:"vmProfile,omitempty"`
+}
+
+var _ json.Unmarshaler = &ImageTemplateProperties{}
+
+func (s *ImageTemplateProperties) UnmarshalJSON(bytes []byte) error {
+	type alias ImageTemplateProperties
+	var decoded alias
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into ImageTemplateProperties: %+v", err)
+	}
+
+	s.BuildTimeoutInMinutes = decoded.BuildTimeoutInMinutes
+	s.LastRunStatus = decoded.LastRunStatus
+	s.ProvisioningError = decoded.ProvisioningError
+	s.ProvisioningState = decoded.ProvisioningState
+	s.VmProfile = decoded.VmProfile
+
+	var temp map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &temp); err != nil {
+		return fmt.Errorf("unmarshaling ImageTemplateProperties into map[string]json.RawMessage: %+v", err)
+	}
+
+	if v, ok := temp["customize"]; ok {
+		var listTemp []json.RawMessage
+		if err := json.Unmarshal(v, &listTemp); err != nil {
+			return fmt.Errorf("unmarshaling Customize into list []json.RawMessage: %+v", err)
+		}
+
+		output := make([]ImageTemplateCustomizer, 0)
+		for i, val := range listTemp {
+			impl, err := unmarshalImageTemplateCustomizerImplementation(val)
+			if err != nil {
+				return fmt.Errorf("unmarshaling index %d field 'Customize' for 'ImageTemplateProperties': %+v", i, err)
+			}
+			output = append(output, impl)
+		}
+		s.Customize = &output
+	}
+
+	if v, ok := temp["distribute"]; ok {
+		var listTemp []json.RawMessage
+		if err := json.Unmarshal(v, &listTemp); err != nil {
+			return fmt.Errorf("unmarshaling Distribute into list []json.RawMessage: %+v", err)
+		}
+
+		output := make([]ImageTemplateDistributor, 0)
+		for i, val := range listTemp {
+			impl, err := unmarshalImageTemplateDistributorImplementation(val)
+			if err != nil {
+				return fmt.Errorf("unmarshaling index %d field 'Distribute' for 'ImageTemplateProperties': %+v", i, err)
+			}
+			output = append(output, impl)
+		}
+		s.Distribute = output
+	}
+
+	if v, ok := temp["source"]; ok {
+		impl, err := unmarshalImageTemplateSourceImplementation(v)
+		if err != nil {
+			return fmt.Errorf("unmarshaling field 'Source' for 'ImageTemplateProperties': %+v", err)
+		}
+		s.Source = impl
+	}
+	return nil
+}
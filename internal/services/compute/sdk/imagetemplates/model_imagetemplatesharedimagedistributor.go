@@ -0,0 +1,43 @@
+package imagetemplates
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ ImageTemplateDistributor = ImageTemplateSharedImageDistributor{}
+
+type ImageTemplateSharedImageDistributor struct {
+	ExcludeFromLatest  *bool                          `json:"excludeFromLatest,omitempty"`
+	GalleryImageId     string                         `json:"galleryImageId"`
+	ReplicationRegions []string                       `json:"replicationRegions"`
+	StorageAccountType *SharedImageStorageAccountType `json:"storageAccountType,omitempty"`
+
+	// Fields inherited from ImageTemplateDistributor
+	ArtifactTags  *map[string]string `json:"artifactTags,omitempty"`
+	RunOutputName string             `json:"runOutputName"`
+}
+
+var _ json.Marshaler = ImageTemplateSharedImageDistributor{}
+
+func (s ImageTemplateSharedImageDistributor) MarshalJSON() ([]byte, error) {
+	type wrapper ImageTemplateSharedImageDistributor
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling ImageTemplateSharedImageDistributor: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling ImageTemplateSharedImageDistributor: %+v", err)
+	}
+	decoded["type"] = "SharedImage"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling ImageTemplateSharedImageDistributor: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,36 @@
+package imagetemplates
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ ImageTemplateSource = ImageTemplateSharedImageVersionSource{}
+
+type ImageTemplateSharedImageVersionSource struct {
+	ImageVersionId string `json:"imageVersionId"`
+}
+
+var _ json.Marshaler = ImageTemplateSharedImageVersionSource{}
+
+func (s ImageTemplateSharedImageVersionSource) MarshalJSON() ([]byte, error) {
+	type wrapper ImageTemplateSharedImageVersionSource
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling ImageTemplateSharedImageVersionSource: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling ImageTemplateSharedImageVersionSource: %+v", err)
+	}
+	decoded["type"] = "SharedImageVersion"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling ImageTemplateSharedImageVersionSource: %+v", err)
+	}
+
+	return encoded, nil
+}
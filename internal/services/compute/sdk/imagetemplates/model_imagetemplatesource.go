@@ -0,0 +1,60 @@
+package imagetemplates
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type ImageTemplateSource interface {
+}
+
+func unmarshalImageTemplateSourceImplementation(input []byte) (ImageTemplateSource, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling ImageTemplateSource into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["type"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "PlatformImage") {
+		var out ImageTemplatePlatformImageSource
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ImageTemplatePlatformImageSource: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "ManagedImage") {
+		var out ImageTemplateManagedImageSource
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ImageTemplateManagedImageSource: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "SharedImageVersion") {
+		var out ImageTemplateSharedImageVersionSource
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ImageTemplateSharedImageVersionSource: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawImageTemplateSourceImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawImageTemplateSourceImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+}
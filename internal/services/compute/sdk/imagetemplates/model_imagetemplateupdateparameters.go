@@ -0,0 +1,6 @@
+package imagetemplates
+
+type ImageTemplateUpdateParameters struct {
+	Identity *ImageTemplateIdentity `json:"identity,omitempty"`
+	Tags     *map[string]string     `json:"tags,omitempty"`
+}
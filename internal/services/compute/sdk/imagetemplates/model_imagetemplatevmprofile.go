@@ -0,0 +1,7 @@
+package imagetemplates
+
+type ImageTemplateVmProfile struct {
+	OsDiskSizeGB *int64                `json:"osDiskSizeGB,omitempty"`
+	VmSize       *string               `json:"vmSize,omitempty"`
+	VnetConfig   *VirtualNetworkConfig `json:"vnetConfig,omitempty"`
+}
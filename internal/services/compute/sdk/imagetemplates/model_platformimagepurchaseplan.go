@@ -0,0 +1,7 @@
+package imagetemplates
+
+type PlatformImagePurchasePlan struct {
+	PlanName      string `json:"planName"`
+	PlanProduct   string `json:"planProduct"`
+	PlanPublisher string `json:"planPublisher"`
+}
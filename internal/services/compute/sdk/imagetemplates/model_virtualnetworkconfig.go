@@ -0,0 +1,5 @@
+package imagetemplates
+
+type VirtualNetworkConfig struct {
+	SubnetId *string `json:"subnetId,omitempty"`
+}
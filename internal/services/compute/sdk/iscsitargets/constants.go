@@ -0,0 +1,34 @@
+package iscsitargets
+
+type IscsiTargetAclMode string
+
+const (
+	IscsiTargetAclModeDynamic IscsiTargetAclMode = "Dynamic"
+	IscsiTargetAclModeStatic  IscsiTargetAclMode = "Static"
+)
+
+type OperationalStatus string
+
+const (
+	OperationalStatusHealthy            OperationalStatus = "Healthy"
+	OperationalStatusInvalid            OperationalStatus = "Invalid"
+	OperationalStatusRunning            OperationalStatus = "Running"
+	OperationalStatusStopped            OperationalStatus = "Stopped"
+	OperationalStatusStoppedDeallocated OperationalStatus = "Stopped (deallocated)"
+	OperationalStatusUnhealthy          OperationalStatus = "Unhealthy"
+	OperationalStatusUnknown            OperationalStatus = "Unknown"
+	OperationalStatusUpdating           OperationalStatus = "Updating"
+)
+
+type ProvisioningStates string
+
+const (
+	ProvisioningStatesCanceled  ProvisioningStates = "Canceled"
+	ProvisioningStatesCreating  ProvisioningStates = "Creating"
+	ProvisioningStatesDeleting  ProvisioningStates = "Deleting"
+	ProvisioningStatesFailed    ProvisioningStates = "Failed"
+	ProvisioningStatesInvalid   ProvisioningStates = "Invalid"
+	ProvisioningStatesPending   ProvisioningStates = "Pending"
+	ProvisioningStatesSucceeded ProvisioningStates = "Succeeded"
+	ProvisioningStatesUpdating  ProvisioningStates = "Updating"
+)
@@ -0,0 +1,126 @@
+package iscsitargets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type IscsiTargetId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DiskPoolName   string
+	Name           string
+}
+
+func NewIscsiTargetID(subscriptionId, resourceGroup, diskPoolName, name string) IscsiTargetId {
+	return IscsiTargetId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DiskPoolName:   diskPoolName,
+		Name:           name,
+	}
+}
+
+func (id IscsiTargetId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Disk Pool Name %q", id.DiskPoolName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Iscsi Target", segmentsStr)
+}
+
+func (id IscsiTargetId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StoragePool/diskPools/%s/iscsiTargets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DiskPoolName, id.Name)
+}
+
+// ParseIscsiTargetID parses an Iscsi Target ID into an IscsiTargetId struct
+func ParseIscsiTargetID(input string) (*IscsiTargetId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := IscsiTargetId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DiskPoolName, err = id.PopSegment("diskPools"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("iscsiTargets"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseIscsiTargetIDInsensitively parses an Iscsi Target ID into an IscsiTargetId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseIscsiTargetID method should be used instead for validation etc.
+func ParseIscsiTargetIDInsensitively(input string) (*IscsiTargetId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := IscsiTargetId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'diskPools' segment
+	diskPoolsKey := "diskPools"
+	for key := range id.Path {
+		if strings.EqualFold(key, diskPoolsKey) {
+			diskPoolsKey = key
+			break
+		}
+	}
+	if resourceId.DiskPoolName, err = id.PopSegment(diskPoolsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'iscsiTargets' segment
+	iscsiTargetsKey := "iscsiTargets"
+	for key := range id.Path {
+		if strings.EqualFold(key, iscsiTargetsKey) {
+			iscsiTargetsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(iscsiTargetsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,6 @@
+package iscsitargets
+
+type IscsiTargetUpdateProperties struct {
+	Luns       *[]IscsiLun `json:"luns,omitempty"`
+	StaticAcls *[]Acl      `json:"staticAcls,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachines
+
+type ApplicationProfile struct {
+	GalleryApplications *[]VMGalleryApplication `json:"galleryApplications,omitempty"`
+}
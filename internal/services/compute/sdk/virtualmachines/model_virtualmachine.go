@@ -0,0 +1,9 @@
+package virtualmachines
+
+type VirtualMachine struct {
+	Id         *string                   `json:"id,omitempty"`
+	Location   string                    `json:"location"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *VirtualMachineProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
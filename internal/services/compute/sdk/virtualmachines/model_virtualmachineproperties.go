@@ -0,0 +1,7 @@
+package virtualmachines
+
+type VirtualMachineProperties struct {
+	ApplicationProfile *ApplicationProfile `json:"applicationProfile,omitempty"`
+	ProvisioningState  *string             `json:"provisioningState,omitempty"`
+	VmId               *string             `json:"vmId,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachines
+
+type VirtualMachineUpdate struct {
+	Properties *VirtualMachineProperties `json:"properties,omitempty"`
+}
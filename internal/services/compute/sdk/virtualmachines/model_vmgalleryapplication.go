@@ -0,0 +1,10 @@
+package virtualmachines
+
+type VMGalleryApplication struct {
+	ConfigurationReference          *string `json:"configurationReference,omitempty"`
+	EnableAutomaticUpgrade          *bool   `json:"enableAutomaticUpgrade,omitempty"`
+	Order                           *int64  `json:"order,omitempty"`
+	PackageReferenceId              string  `json:"packageReferenceId"`
+	Tags                            *string `json:"tags,omitempty"`
+	TreatFailureAsDeploymentFailure *bool   `json:"treatFailureAsDeploymentFailure,omitempty"`
+}
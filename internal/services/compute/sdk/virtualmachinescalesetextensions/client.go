@@ -0,0 +1,15 @@
+package virtualmachinescalesetextensions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type VirtualMachineScaleSetExtensionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVirtualMachineScaleSetExtensionsClientWithBaseURI(endpoint string) VirtualMachineScaleSetExtensionsClient {
+	return VirtualMachineScaleSetExtensionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
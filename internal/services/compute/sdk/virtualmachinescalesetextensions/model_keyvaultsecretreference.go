@@ -0,0 +1,6 @@
+package virtualmachinescalesetextensions
+
+type KeyVaultSecretReference struct {
+	SecretUrl   string      `json:"secretUrl"`
+	SourceVault SubResource `json:"sourceVault"`
+}
@@ -0,0 +1,5 @@
+package virtualmachinescalesetextensions
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
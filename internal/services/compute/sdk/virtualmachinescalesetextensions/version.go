@@ -0,0 +1,9 @@
+package virtualmachinescalesetextensions
+
+import "fmt"
+
+const defaultApiVersion = "2021-11-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/virtualmachinescalesetextensions/%s", defaultApiVersion)
+}
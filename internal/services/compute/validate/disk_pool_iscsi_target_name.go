@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func DiskPoolIscsiTargetName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// the name must be between 5 and 223 characters, contain only lowercase alphanumerics, hyphens, dots
+	// and colons and must start and end with an alphanumeric character
+	if matched := regexp.MustCompile(`^[a-z0-9][a-z0-9.:-]{3,221}[a-z0-9]$`).Match([]byte(v)); !matched {
+		errors = append(errors, fmt.Errorf("%s must be between 5 - 223 characters long, contain only lowercase alphanumerics, hyphens, dots and colons and must start and end with an alphanumeric", k))
+	}
+	return
+}
@@ -0,0 +1,56 @@
+package validate
+
+import "testing"
+
+func TestDiskPoolIscsiTargetName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// too short
+			input:    "tgt1",
+			expected: false,
+		},
+		{
+			// minimum length
+			input:    "tgt12",
+			expected: true,
+		},
+		{
+			// with hyphens, dots and colons
+			input:    "iqn.2005-03.org:target-1",
+			expected: true,
+		},
+		{
+			// upper case
+			input:    "Target1",
+			expected: false,
+		},
+		{
+			// ends with a hyphen
+			input:    "target-",
+			expected: false,
+		},
+		{
+			// underscores
+			input:    "target_1",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := DiskPoolIscsiTargetName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func DiskPoolName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// the name must be between 7 and 30 characters, contain only alphanumerics, underscores and hyphens
+	// and must start and end with an alphanumeric character
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{5,28}[a-zA-Z0-9]$`).Match([]byte(v)); !matched {
+		errors = append(errors, fmt.Errorf("%s must be between 7 - 30 characters long, contain only alphanumerics, underscores and hyphens and must start and end with an alphanumeric", k))
+	}
+	return
+}
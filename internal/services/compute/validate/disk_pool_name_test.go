@@ -0,0 +1,66 @@
+package validate
+
+import "testing"
+
+func TestDiskPoolName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// too short
+			input:    "pool1",
+			expected: false,
+		},
+		{
+			// minimum length
+			input:    "pool123",
+			expected: true,
+		},
+		{
+			// with hyphens and underscores
+			input:    "disk-pool_1",
+			expected: true,
+		},
+		{
+			// starts with a hyphen
+			input:    "-diskpool",
+			expected: false,
+		},
+		{
+			// ends with an underscore
+			input:    "diskpool_",
+			expected: false,
+		},
+		{
+			// invalid character
+			input:    "disk.pool1",
+			expected: false,
+		},
+		{
+			// maximum length
+			input:    "a23456789012345678901234567890",
+			expected: true,
+		},
+		{
+			// too long
+			input:    "a234567890123456789012345678901",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := DiskPoolName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
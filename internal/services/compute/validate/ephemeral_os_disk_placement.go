@@ -0,0 +1,35 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+)
+
+// EphemeralOSDiskPlacement validates the placement of an Ephemeral OS Disk.
+//
+// Whilst Azure also supports a placement of `NvmeDisk`, this is only available from API Version `2024-03-01` of
+// the Compute API - which isn't supported by the version of the Azure SDK for Go currently in use, and as such
+// is explicitly rejected rather than being sent to (and rejected by) the older API Version.
+func EphemeralOSDiskPlacement(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if strings.EqualFold(v, "NvmeDisk") {
+		errors = append(errors, fmt.Errorf("a %q of `NvmeDisk` is not supported at this time, since this requires a newer version of the Compute API", k))
+		return warnings, errors
+	}
+
+	for _, placement := range []string{string(compute.CacheDisk), string(compute.ResourceDisk)} {
+		if v == placement {
+			return warnings, errors
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("expected %q to be one of [%s, %s], got %q", k, string(compute.CacheDisk), string(compute.ResourceDisk), v))
+	return warnings, errors
+}
@@ -0,0 +1,45 @@
+package validate
+
+import "testing"
+
+func TestEphemeralOSDiskPlacement(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "CacheDisk",
+			expected: true,
+		},
+		{
+			input:    "ResourceDisk",
+			expected: true,
+		},
+		{
+			input:    "cachedisk",
+			expected: false,
+		},
+		{
+			input:    "NvmeDisk",
+			expected: false,
+		},
+		{
+			input:    "nvmedisk",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := EphemeralOSDiskPlacement(v.input, "placement")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ImageBuilderTemplateName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// Swagger says: ^[A-Za-z0-9-_.]{1,64}$
+	if matched := regexp.MustCompile(`^[A-Za-z0-9-_.]{1,64}$`).Match([]byte(v)); !matched {
+		errors = append(errors, fmt.Errorf("%s must be between 1 - 64 characters long, and contains only a-z, A-Z, 0-9, hyphens, underscores and periods", k))
+	}
+	return
+}
@@ -0,0 +1,511 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	diskEncryptionExtensionPublisher = "Microsoft.Azure.Security"
+
+	diskEncryptionOperationEnable  = "EnableEncryption"
+	diskEncryptionOperationDisable = "DisableEncryption"
+
+	diskEncryptionVolumeTypeAll  = "All"
+	diskEncryptionVolumeTypeData = "Data"
+	diskEncryptionVolumeTypeOS   = "OS"
+)
+
+// diskEncryptionExtension describes the Azure Disk Encryption extension used for a given Operating System
+type diskEncryptionExtension struct {
+	name               string
+	typeHandlerVersion string
+}
+
+var diskEncryptionExtensions = map[compute.OperatingSystemTypes]diskEncryptionExtension{
+	compute.Linux: {
+		name:               "AzureDiskEncryptionForLinux",
+		typeHandlerVersion: "1.1",
+	},
+	compute.Windows: {
+		name:               "AzureDiskEncryption",
+		typeHandlerVersion: "2.2",
+	},
+}
+
+func resourceVirtualMachineDiskEncryption() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualMachineDiskEncryptionCreate,
+		Read:   resourceVirtualMachineDiskEncryptionRead,
+		Update: resourceVirtualMachineDiskEncryptionUpdate,
+		Delete: resourceVirtualMachineDiskEncryptionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VirtualMachineExtensionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineID,
+			},
+
+			"key_vault_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.VaultID,
+			},
+
+			"key_encryption_key_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: keyVaultValidate.NestedItemId,
+			},
+
+			"key_encryption_algorithm": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "RSA-OAEP",
+				ValidateFunc: validation.StringInSlice([]string{
+					"RSA-OAEP",
+					"RSA-OAEP-256",
+					"RSA1_5",
+				}, false),
+			},
+
+			"volume_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  diskEncryptionVolumeTypeAll,
+				ValidateFunc: validation.StringInSlice([]string{
+					diskEncryptionVolumeTypeAll,
+					diskEncryptionVolumeTypeData,
+					diskEncryptionVolumeTypeOS,
+				}, false),
+			},
+
+			"type_handler_version": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"auto_upgrade_minor_version": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"disk_encryption_status": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"disk_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"encryption_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualMachineDiskEncryptionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMExtensionClient
+	vmClient := meta.(*clients.Client).Compute.VMClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualMachineId, err := parse.VirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	virtualMachine, err := vmClient.Get(ctx, virtualMachineId.ResourceGroup, virtualMachineId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *virtualMachineId, err)
+	}
+
+	extension, err := diskEncryptionExtensionForVirtualMachine(virtualMachine)
+	if err != nil {
+		return fmt.Errorf("determining the Disk Encryption Extension for %s: %+v", *virtualMachineId, err)
+	}
+
+	id := parse.NewVirtualMachineExtensionID(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroup, virtualMachineId.Name, extension.name)
+	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineName, id.ExtensionName, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_virtual_machine_disk_encryption", id.ID())
+	}
+
+	typeHandlerVersion := extension.typeHandlerVersion
+	if v := d.Get("type_handler_version").(string); v != "" {
+		typeHandlerVersion = v
+	}
+
+	if err := applyDiskEncryptionExtension(ctx, d, meta, id, *virtualMachine.Location, typeHandlerVersion, diskEncryptionOperationEnable); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualMachineDiskEncryptionRead(d, meta)
+}
+
+func resourceVirtualMachineDiskEncryptionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMExtensionClient
+	vmClient := meta.(*clients.Client).Compute.VMClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	virtualMachineId := parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName)
+	virtualMachine, err := vmClient.Get(ctx, id.ResourceGroup, id.VirtualMachineName, compute.InstanceView)
+	if err != nil {
+		if utils.ResponseWasNotFound(virtualMachine.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", virtualMachineId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", virtualMachineId, err)
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineName, id.ExtensionName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("virtual_machine_id", virtualMachineId.ID())
+
+	if props := resp.VirtualMachineExtensionProperties; props != nil {
+		if props.Publisher == nil || !strings.EqualFold(*props.Publisher, diskEncryptionExtensionPublisher) {
+			return fmt.Errorf("%s is not an Azure Disk Encryption Extension", *id)
+		}
+
+		d.Set("type_handler_version", props.TypeHandlerVersion)
+		d.Set("auto_upgrade_minor_version", props.AutoUpgradeMinorVersion)
+
+		settings := make(map[string]interface{})
+		if v, ok := props.Settings.(map[string]interface{}); ok {
+			settings = v
+		}
+
+		keyVaultId := ""
+		if v, ok := settings["KeyVaultResourceId"].(string); ok && v != "" {
+			parsed, err := keyVaultParse.VaultID(v)
+			if err != nil {
+				return fmt.Errorf("parsing the Key Vault ID %q: %+v", v, err)
+			}
+			keyVaultId = parsed.ID()
+		}
+		d.Set("key_vault_id", keyVaultId)
+
+		keyEncryptionKeyId, _ := settings["KeyEncryptionKeyURL"].(string)
+		d.Set("key_encryption_key_id", keyEncryptionKeyId)
+
+		if v, ok := settings["KeyEncryptionAlgorithm"].(string); ok && v != "" {
+			d.Set("key_encryption_algorithm", v)
+		}
+
+		if v, ok := settings["VolumeType"].(string); ok && v != "" {
+			// the Volume Type is case-insensitive, so normalize it to the casing defined in the schema
+			for _, volumeType := range []string{diskEncryptionVolumeTypeAll, diskEncryptionVolumeTypeData, diskEncryptionVolumeTypeOS} {
+				if strings.EqualFold(v, volumeType) {
+					v = volumeType
+					break
+				}
+			}
+			d.Set("volume_type", v)
+		}
+	}
+
+	var disks *[]compute.DiskInstanceView
+	if props := virtualMachine.VirtualMachineProperties; props != nil && props.InstanceView != nil {
+		disks = props.InstanceView.Disks
+	}
+	if err := d.Set("disk_encryption_status", flattenVirtualMachineDiskEncryptionStatus(disks)); err != nil {
+		return fmt.Errorf("setting `disk_encryption_status`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceVirtualMachineDiskEncryptionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	vmClient := meta.(*clients.Client).Compute.VMClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	virtualMachineId := parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName)
+	virtualMachine, err := vmClient.Get(ctx, id.ResourceGroup, id.VirtualMachineName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", virtualMachineId, err)
+	}
+
+	if err := applyDiskEncryptionExtension(ctx, d, meta, *id, *virtualMachine.Location, d.Get("type_handler_version").(string), diskEncryptionOperationEnable); err != nil {
+		return err
+	}
+
+	return resourceVirtualMachineDiskEncryptionRead(d, meta)
+}
+
+func resourceVirtualMachineDiskEncryptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMExtensionClient
+	vmClient := meta.(*clients.Client).Compute.VMClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	virtualMachineId := parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName)
+	virtualMachine, err := vmClient.Get(ctx, id.ResourceGroup, id.VirtualMachineName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", virtualMachineId, err)
+	}
+
+	// Azure Disk Encryption only supports disabling the encryption of the Data Volumes for Linux Virtual Machines,
+	// as such the OS Volume remains encrypted once the Extension has been removed
+	disable := true
+	if extension, err := diskEncryptionExtensionForVirtualMachine(virtualMachine); err == nil && extension.name == diskEncryptionExtensions[compute.Linux].name {
+		disable = d.Get("volume_type").(string) == diskEncryptionVolumeTypeData
+	}
+
+	if disable {
+		log.Printf("[DEBUG] Disabling Disk Encryption for %s..", virtualMachineId)
+		if err := applyDiskEncryptionExtension(ctx, d, meta, *id, *virtualMachine.Location, d.Get("type_handler_version").(string), diskEncryptionOperationDisable); err != nil {
+			return err
+		}
+	} else {
+		log.Printf("[DEBUG] Disabling Disk Encryption isn't supported for the OS Volume of %s - removing the Extension only", virtualMachineId)
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineName, id.ExtensionName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// applyDiskEncryptionExtension creates/updates the Disk Encryption Extension to perform the specified operation, then
+// waits for the Extension to report that the operation has completed
+func applyDiskEncryptionExtension(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id parse.VirtualMachineExtensionId, location, typeHandlerVersion, operation string) error {
+	client := meta.(*clients.Client).Compute.VMExtensionClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	resourcesClient := meta.(*clients.Client).Resource
+
+	keyVaultId, err := keyVaultParse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVault, err := keyVaultsClient.VaultsClient.Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *keyVaultId, err)
+	}
+	if keyVault.Properties == nil || keyVault.Properties.VaultURI == nil {
+		return fmt.Errorf("retrieving %s: `properties.vaultUri` was nil", *keyVaultId)
+	}
+	if keyVault.Properties.EnabledForDiskEncryption == nil || !*keyVault.Properties.EnabledForDiskEncryption {
+		return fmt.Errorf("%s must have `enabled_for_disk_encryption` set to `true` to be used for Disk Encryption", *keyVaultId)
+	}
+
+	settings := map[string]interface{}{
+		"EncryptionOperation": operation,
+		"KeyVaultURL":         *keyVault.Properties.VaultURI,
+		"KeyVaultResourceId":  keyVaultId.ID(),
+		"VolumeType":          d.Get("volume_type").(string),
+	}
+
+	if v := d.Get("key_encryption_key_id").(string); v != "" {
+		keyEncryptionKeyId, err := keyVaultParse.ParseNestedItemID(v)
+		if err != nil {
+			return err
+		}
+
+		// the Key Encryption Key can be stored in a different Key Vault to the one used for the Disk Encryption Secrets
+		keyEncryptionKeyVaultId, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, keyEncryptionKeyId.KeyVaultBaseUrl)
+		if err != nil {
+			return fmt.Errorf("retrieving the Resource ID of the Key Vault at URL %q: %+v", keyEncryptionKeyId.KeyVaultBaseUrl, err)
+		}
+		if keyEncryptionKeyVaultId == nil {
+			return fmt.Errorf("unable to determine the Resource ID of the Key Vault at URL %q", keyEncryptionKeyId.KeyVaultBaseUrl)
+		}
+
+		settings["KeyEncryptionKeyURL"] = v
+		settings["KekVaultResourceId"] = *keyEncryptionKeyVaultId
+		settings["KeyEncryptionAlgorithm"] = d.Get("key_encryption_algorithm").(string)
+	}
+
+	// the Extension only runs when its settings or the Force Update Tag change - so that re-applying the same
+	// settings (e.g. after a new Data Disk has been attached) encrypts any new volumes, a new tag is used each time
+	forceUpdateTag, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("generating the Force Update Tag: %+v", err)
+	}
+
+	parameters := compute.VirtualMachineExtension{
+		Location: utils.String(location),
+		VirtualMachineExtensionProperties: &compute.VirtualMachineExtensionProperties{
+			Publisher:               utils.String(diskEncryptionExtensionPublisher),
+			Type:                    utils.String(id.ExtensionName),
+			TypeHandlerVersion:      utils.String(typeHandlerVersion),
+			AutoUpgradeMinorVersion: utils.Bool(d.Get("auto_upgrade_minor_version").(bool)),
+			ForceUpdateTag:          utils.String(forceUpdateTag),
+			Settings:                settings,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineName, id.ExtensionName, parameters)
+	if err != nil {
+		return fmt.Errorf("performing %q using %s: %+v", operation, id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %q using %s: %+v", operation, id, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for %q using %s to complete..", operation, id)
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"transitioning", "creating", "updating"},
+		Target:     []string{"succeeded"},
+		Refresh:    virtualMachineDiskEncryptionStateRefreshFunc(ctx, client, id),
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %q using %s to complete: %+v", operation, id, err)
+	}
+
+	return nil
+}
+
+func virtualMachineDiskEncryptionStateRefreshFunc(ctx context.Context, client *compute.VirtualMachineExtensionsClient, id parse.VirtualMachineExtensionId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineName, id.ExtensionName, "instanceView")
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if props := resp.VirtualMachineExtensionProperties; props != nil && props.InstanceView != nil && props.InstanceView.Statuses != nil {
+			for _, status := range *props.InstanceView.Statuses {
+				if status.Code == nil || !strings.HasPrefix(strings.ToLower(*status.Code), "provisioningstate/") {
+					continue
+				}
+
+				// e.g. `ProvisioningState/succeeded` or `ProvisioningState/failed/-1`
+				state := strings.Split(strings.ToLower(*status.Code), "/")[1]
+				if state == "failed" {
+					return resp, state, fmt.Errorf("the Extension reported a failure: %s", utils.NormalizeNilableString(status.Message))
+				}
+
+				return resp, state, nil
+			}
+		}
+
+		return resp, "transitioning", nil
+	}
+}
+
+func diskEncryptionExtensionForVirtualMachine(input compute.VirtualMachine) (*diskEncryptionExtension, error) {
+	props := input.VirtualMachineProperties
+	if props == nil || props.StorageProfile == nil || props.StorageProfile.OsDisk == nil {
+		return nil, fmt.Errorf("`properties.storageProfile.osDisk` was nil")
+	}
+
+	extension, ok := diskEncryptionExtensions[props.StorageProfile.OsDisk.OsType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported OS Type %q", string(props.StorageProfile.OsDisk.OsType))
+	}
+
+	return &extension, nil
+}
+
+func flattenVirtualMachineDiskEncryptionStatus(input *[]compute.DiskInstanceView) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, disk := range *input {
+		encryptionState := ""
+		if disk.Statuses != nil {
+			for _, status := range *disk.Statuses {
+				// e.g. `EncryptionState/encrypted`
+				if status.Code != nil && strings.HasPrefix(strings.ToLower(*status.Code), "encryptionstate/") {
+					encryptionState = strings.SplitN(*status.Code, "/", 2)[1]
+					break
+				}
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"disk_name":        utils.NormalizeNilableString(disk.Name),
+			"encryption_state": encryptionState,
+		})
+	}
+
+	return results
+}
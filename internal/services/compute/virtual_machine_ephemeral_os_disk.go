@@ -0,0 +1,114 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// virtualMachineEphemeralOSDiskResizeCustomizeDiff determines how a change to the size of an Ephemeral OS Disk
+// should be applied when the user has opted in via the `reimage_ephemeral_os_disk_on_resize` feature - when the
+// new size fits within the Cache/Resource Disk of the Virtual Machine Size the change is applied in-place by
+// reimaging the Virtual Machine(s), otherwise the Virtual Machine (Scale Set) must be recreated. Since reimaging
+// wipes the OS Disk, the plan fails when the user hasn't opted in.
+func virtualMachineEphemeralOSDiskResizeCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, client *compute.ResourceSkusClient, reimageOnResize bool, sizeField, featureBlock string) error {
+	if diff.Id() == "" || !diff.HasChange("os_disk.0.disk_size_gb") {
+		return nil
+	}
+
+	diffDiskSettingsRaw := diff.Get("os_disk.0.diff_disk_settings").([]interface{})
+	if len(diffDiskSettingsRaw) == 0 || diffDiskSettingsRaw[0] == nil {
+		return nil
+	}
+
+	oldSizeRaw, newSizeRaw := diff.GetChange("os_disk.0.disk_size_gb")
+	if oldSizeRaw.(int) == 0 || newSizeRaw.(int) == 0 {
+		// the size is being computed from the image, so there's nothing to resize
+		return nil
+	}
+
+	if !reimageOnResize {
+		return fmt.Errorf("changing `os_disk.0.disk_size_gb` for an Ephemeral OS Disk requires the Virtual Machine(s) to be reimaged, which wipes the OS Disk - to allow this, enable the `reimage_ephemeral_os_disk_on_resize` feature within the `%s` block of the Provider `features` block", featureBlock)
+	}
+
+	// changing the size of the Virtual Machine at the same time needs the Virtual Machine to be recreated anyway
+	if diff.HasChange(sizeField) {
+		return diff.ForceNew("os_disk.0.disk_size_gb")
+	}
+
+	placement := diffDiskSettingsRaw[0].(map[string]interface{})["placement"].(string)
+	location := azure.NormalizeLocation(diff.Get("location").(string))
+	vmSize := diff.Get(sizeField).(string)
+	limit, err := ephemeralOSDiskPlacementLimitInGB(ctx, client, location, vmSize, compute.DiffDiskPlacement(placement))
+	if err != nil {
+		return err
+	}
+
+	newSize := newSizeRaw.(int)
+	if newSize > limit {
+		log.Printf("[DEBUG] Ephemeral OS Disk size %dGB exceeds the %dGB available in the %s for Virtual Machine Size %q - recreating", newSize, limit, placement, vmSize)
+		return diff.ForceNew("os_disk.0.disk_size_gb")
+	}
+
+	return nil
+}
+
+// ephemeralOSDiskPlacementLimitInGB returns the maximum size of an Ephemeral OS Disk for the specified
+// Virtual Machine Size when using the specified Placement, as reported by the Resource SKUs API
+func ephemeralOSDiskPlacementLimitInGB(ctx context.Context, client *compute.ResourceSkusClient, location, vmSize string, placement compute.DiffDiskPlacement) (int, error) {
+	capabilityName := "CachedDiskBytes"
+	if placement == compute.ResourceDisk {
+		capabilityName = "MaxResourceVolumeMB"
+	}
+
+	skus, err := client.ListComplete(ctx, fmt.Sprintf("location eq '%s'", location))
+	if err != nil {
+		return 0, fmt.Errorf("listing Resource SKUs in %q: %+v", location, err)
+	}
+
+	for skus.NotDone() {
+		sku := skus.Value()
+		if sku.ResourceType != nil && strings.EqualFold(*sku.ResourceType, "virtualMachines") && sku.Name != nil && strings.EqualFold(*sku.Name, vmSize) && sku.Capabilities != nil {
+			for _, capability := range *sku.Capabilities {
+				if capability.Name == nil || capability.Value == nil || !strings.EqualFold(*capability.Name, capabilityName) {
+					continue
+				}
+
+				value, err := strconv.ParseInt(*capability.Value, 10, 64)
+				if err != nil {
+					return 0, fmt.Errorf("parsing capability %q for Virtual Machine Size %q: %+v", capabilityName, vmSize, err)
+				}
+
+				if placement == compute.ResourceDisk {
+					return int(value / 1024), nil
+				}
+				return int(value / 1024 / 1024 / 1024), nil
+			}
+
+			return 0, fmt.Errorf("Virtual Machine Size %q in %q doesn't support an Ephemeral OS Disk with a placement of %q", vmSize, location, string(placement))
+		}
+
+		if err := skus.NextWithContext(ctx); err != nil {
+			return 0, fmt.Errorf("listing Resource SKUs in %q: %+v", location, err)
+		}
+	}
+
+	return 0, fmt.Errorf("Virtual Machine Size %q was not found in %q", vmSize, location)
+}
+
+func virtualMachineEphemeralOSDiskCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client)
+	return virtualMachineEphemeralOSDiskResizeCustomizeDiff(ctx, diff, client.Compute.ResourceSkusClient, client.Features.VirtualMachine.ReimageEphemeralOSDiskOnResize, "size", "virtual_machine")
+}
+
+func virtualMachineScaleSetEphemeralOSDiskCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client)
+	return virtualMachineEphemeralOSDiskResizeCustomizeDiff(ctx, diff, client.Compute.ResourceSkusClient, client.Features.VirtualMachineScaleSet.ReimageEphemeralOSDiskOnResize, "sku", "virtual_machine_scale_set")
+}
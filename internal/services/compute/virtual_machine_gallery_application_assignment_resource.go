@@ -0,0 +1,323 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVirtualMachineGalleryApplicationAssignment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualMachineGalleryApplicationAssignmentCreate,
+		Read:   resourceVirtualMachineGalleryApplicationAssignmentRead,
+		Update: resourceVirtualMachineGalleryApplicationAssignmentUpdate,
+		Delete: resourceVirtualMachineGalleryApplicationAssignmentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VirtualMachineGalleryApplicationAssignmentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"gallery_application_version_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.GalleryApplicationVersionID,
+			},
+
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineID,
+			},
+
+			"configuration_blob_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			// the Applications are installed in ascending `order`, those without an order are installed last
+			"order": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+
+			"tag": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"treat_failure_as_deployment_failure": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceVirtualMachineGalleryApplicationAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMApplicationsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualMachineId, err := parse.VirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+	galleryApplicationVersionId, err := parse.GalleryApplicationVersionID(d.Get("gallery_application_version_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewVirtualMachineGalleryApplicationAssignmentID(*virtualMachineId, *galleryApplicationVersionId)
+
+	locks.ByName(virtualMachineId.Name, virtualMachineResourceName)
+	defer locks.UnlockByName(virtualMachineId.Name, virtualMachineResourceName)
+
+	vmId := virtualmachines.NewVirtualMachineID(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroup, virtualMachineId.Name)
+	applications, err := getVirtualMachineGalleryApplications(ctx, client, vmId)
+	if err != nil {
+		return err
+	}
+
+	if _, existing := findVirtualMachineGalleryApplication(applications, *galleryApplicationVersionId); existing != nil {
+		return tf.ImportAsExistsError("azurerm_virtual_machine_gallery_application_assignment", id.ID())
+	}
+
+	application := virtualmachines.VMGalleryApplication{
+		PackageReferenceId:              galleryApplicationVersionId.ID(),
+		Order:                           utils.Int64(int64(d.Get("order").(int))),
+		TreatFailureAsDeploymentFailure: utils.Bool(d.Get("treat_failure_as_deployment_failure").(bool)),
+	}
+	if v, ok := d.GetOk("configuration_blob_uri"); ok {
+		application.ConfigurationReference = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		application.Tags = utils.String(v.(string))
+	}
+	applications = append(applications, application)
+
+	if err := updateVirtualMachineGalleryApplications(ctx, client, vmId, applications); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualMachineGalleryApplicationAssignmentRead(d, meta)
+}
+
+func resourceVirtualMachineGalleryApplicationAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMApplicationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineGalleryApplicationAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vmId := virtualmachines.NewVirtualMachineID(id.VirtualMachineId.SubscriptionId, id.VirtualMachineId.ResourceGroup, id.VirtualMachineId.Name)
+	resp, err := client.Get(ctx, vmId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id.VirtualMachineId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id.VirtualMachineId, err)
+	}
+
+	applications := make([]virtualmachines.VMGalleryApplication, 0)
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ApplicationProfile != nil && model.Properties.ApplicationProfile.GalleryApplications != nil {
+		applications = *model.Properties.ApplicationProfile.GalleryApplications
+	}
+
+	// the Application may have been removed from (or reconfigured on) the Virtual Machine outside of Terraform, so the
+	// configuration is taken from the Virtual Machine to surface any drift
+	_, application := findVirtualMachineGalleryApplication(applications, id.GalleryApplicationVersionId)
+	if application == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("virtual_machine_id", id.VirtualMachineId.ID())
+	d.Set("gallery_application_version_id", id.GalleryApplicationVersionId.ID())
+
+	configurationBlobUri := ""
+	if application.ConfigurationReference != nil {
+		configurationBlobUri = *application.ConfigurationReference
+	}
+	d.Set("configuration_blob_uri", configurationBlobUri)
+
+	order := 0
+	if application.Order != nil {
+		order = int(*application.Order)
+	}
+	d.Set("order", order)
+
+	tag := ""
+	if application.Tags != nil {
+		tag = *application.Tags
+	}
+	d.Set("tag", tag)
+
+	treatFailureAsDeploymentFailure := false
+	if application.TreatFailureAsDeploymentFailure != nil {
+		treatFailureAsDeploymentFailure = *application.TreatFailureAsDeploymentFailure
+	}
+	d.Set("treat_failure_as_deployment_failure", treatFailureAsDeploymentFailure)
+
+	return nil
+}
+
+func resourceVirtualMachineGalleryApplicationAssignmentUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMApplicationsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineGalleryApplicationAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualMachineId.Name, virtualMachineResourceName)
+	defer locks.UnlockByName(id.VirtualMachineId.Name, virtualMachineResourceName)
+
+	vmId := virtualmachines.NewVirtualMachineID(id.VirtualMachineId.SubscriptionId, id.VirtualMachineId.ResourceGroup, id.VirtualMachineId.Name)
+	applications, err := getVirtualMachineGalleryApplications(ctx, client, vmId)
+	if err != nil {
+		return err
+	}
+
+	index, existing := findVirtualMachineGalleryApplication(applications, id.GalleryApplicationVersionId)
+	if existing == nil {
+		return fmt.Errorf("%s was not found", *id)
+	}
+
+	if d.HasChange("order") {
+		applications[index].Order = utils.Int64(int64(d.Get("order").(int)))
+	}
+
+	if d.HasChange("treat_failure_as_deployment_failure") {
+		applications[index].TreatFailureAsDeploymentFailure = utils.Bool(d.Get("treat_failure_as_deployment_failure").(bool))
+	}
+
+	if err := updateVirtualMachineGalleryApplications(ctx, client, vmId, applications); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceVirtualMachineGalleryApplicationAssignmentRead(d, meta)
+}
+
+func resourceVirtualMachineGalleryApplicationAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMApplicationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineGalleryApplicationAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualMachineId.Name, virtualMachineResourceName)
+	defer locks.UnlockByName(id.VirtualMachineId.Name, virtualMachineResourceName)
+
+	vmId := virtualmachines.NewVirtualMachineID(id.VirtualMachineId.SubscriptionId, id.VirtualMachineId.ResourceGroup, id.VirtualMachineId.Name)
+	resp, err := client.Get(ctx, vmId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id.VirtualMachineId, err)
+	}
+
+	applications := make([]virtualmachines.VMGalleryApplication, 0)
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ApplicationProfile != nil && model.Properties.ApplicationProfile.GalleryApplications != nil {
+		for _, application := range *model.Properties.ApplicationProfile.GalleryApplications {
+			if galleryApplicationMatches(application, id.GalleryApplicationVersionId) {
+				continue
+			}
+			applications = append(applications, application)
+		}
+	}
+
+	if err := updateVirtualMachineGalleryApplications(ctx, client, vmId, applications); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func getVirtualMachineGalleryApplications(ctx context.Context, client *virtualmachines.VirtualMachinesClient, id virtualmachines.VirtualMachineId) ([]virtualmachines.VMGalleryApplication, error) {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	applications := make([]virtualmachines.VMGalleryApplication, 0)
+	if profile := resp.Model.Properties.ApplicationProfile; profile != nil && profile.GalleryApplications != nil {
+		applications = *profile.GalleryApplications
+	}
+	return applications, nil
+}
+
+func updateVirtualMachineGalleryApplications(ctx context.Context, client *virtualmachines.VirtualMachinesClient, id virtualmachines.VirtualMachineId, applications []virtualmachines.VMGalleryApplication) error {
+	payload := virtualmachines.VirtualMachineUpdate{
+		Properties: &virtualmachines.VirtualMachineProperties{
+			ApplicationProfile: &virtualmachines.ApplicationProfile{
+				GalleryApplications: &applications,
+			},
+		},
+	}
+	return client.UpdateThenPoll(ctx, id, payload)
+}
+
+func findVirtualMachineGalleryApplication(applications []virtualmachines.VMGalleryApplication, galleryApplicationVersionId parse.GalleryApplicationVersionId) (int, *virtualmachines.VMGalleryApplication) {
+	for i, application := range applications {
+		if galleryApplicationMatches(application, galleryApplicationVersionId) {
+			return i, &applications[i]
+		}
+	}
+
+	return -1, nil
+}
+
+func galleryApplicationMatches(application virtualmachines.VMGalleryApplication, galleryApplicationVersionId parse.GalleryApplicationVersionId) bool {
+	// the casing of the Resource Group can differ in the API response
+	return strings.EqualFold(application.PackageReferenceId, galleryApplicationVersionId.ID())
+}
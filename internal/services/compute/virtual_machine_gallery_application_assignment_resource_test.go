@@ -0,0 +1,156 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Gallery Application Version (which needs to be replicated to the test location) can't be provisioned
+// using Terraform at this time, so an existing one is used instead
+type VirtualMachineGalleryApplicationAssignmentResource struct {
+	galleryApplicationVersionId string
+}
+
+func NewVirtualMachineGalleryApplicationAssignmentResource(t *testing.T) VirtualMachineGalleryApplicationAssignmentResource {
+	galleryApplicationVersionId := os.Getenv("ARM_TEST_GALLERY_APPLICATION_VERSION_ID")
+	if galleryApplicationVersionId == "" {
+		t.Skip("`ARM_TEST_GALLERY_APPLICATION_VERSION_ID` must be set for acceptance tests!")
+	}
+
+	return VirtualMachineGalleryApplicationAssignmentResource{
+		galleryApplicationVersionId: galleryApplicationVersionId,
+	}
+}
+
+func TestAccVirtualMachineGalleryApplicationAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_gallery_application_assignment", "test")
+	r := NewVirtualMachineGalleryApplicationAssignmentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineGalleryApplicationAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_gallery_application_assignment", "test")
+	r := NewVirtualMachineGalleryApplicationAssignmentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualMachineGalleryApplicationAssignment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_gallery_application_assignment", "test")
+	r := NewVirtualMachineGalleryApplicationAssignmentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("order").HasValue("1"),
+				check.That(data.ResourceName).Key("treat_failure_as_deployment_failure").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachineGalleryApplicationAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	vmId := virtualmachines.NewVirtualMachineID(id.VirtualMachineId.SubscriptionId, id.VirtualMachineId.ResourceGroup, id.VirtualMachineId.Name)
+	resp, err := client.Compute.VMApplicationsClient.Get(ctx, vmId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id.VirtualMachineId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ApplicationProfile != nil && model.Properties.ApplicationProfile.GalleryApplications != nil {
+		for _, application := range *model.Properties.ApplicationProfile.GalleryApplications {
+			if application.PackageReferenceId == id.GalleryApplicationVersionId.ID() {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_gallery_application_assignment" "test" {
+  gallery_application_version_id = %q
+  virtual_machine_id             = azurerm_linux_virtual_machine.test.id
+}
+`, LinuxVirtualMachineResource{}.authPassword(data), r.galleryApplicationVersionId)
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_gallery_application_assignment" "test" {
+  gallery_application_version_id      = %q
+  virtual_machine_id                  = azurerm_linux_virtual_machine.test.id
+  order                               = 1
+  treat_failure_as_deployment_failure = true
+}
+`, LinuxVirtualMachineResource{}.authPassword(data), r.galleryApplicationVersionId)
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_gallery_application_assignment" "import" {
+  gallery_application_version_id = azurerm_virtual_machine_gallery_application_assignment.test.gallery_application_version_id
+  virtual_machine_id             = azurerm_virtual_machine_gallery_application_assignment.test.virtual_machine_id
+}
+`, r.basic(data))
+}
@@ -0,0 +1,138 @@
+package compute
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceVirtualMachineScaleSetExtension() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualMachineScaleSetExtensionRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_machine_scale_set_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.VirtualMachineScaleSetID,
+			},
+
+			"publisher": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"type_handler_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"auto_upgrade_minor_version": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"automatic_upgrade_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"force_update_tag": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provision_after_extensions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"provisioning_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"settings": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVirtualMachineScaleSetExtensionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualMachineScaleSetId, err := parse.VirtualMachineScaleSetID(d.Get("virtual_machine_scale_set_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVirtualMachineScaleSetExtensionID(virtualMachineScaleSetId.SubscriptionId, virtualMachineScaleSetId.ResourceGroup, virtualMachineScaleSetId.Name, d.Get("name").(string))
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.ExtensionName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("name", id.ExtensionName)
+	d.Set("virtual_machine_scale_set_id", virtualMachineScaleSetId.ID())
+
+	if props := resp.VirtualMachineScaleSetExtensionProperties; props != nil {
+		d.Set("auto_upgrade_minor_version", props.AutoUpgradeMinorVersion)
+		d.Set("automatic_upgrade_enabled", props.EnableAutomaticUpgrade)
+		d.Set("force_update_tag", props.ForceUpdateTag)
+		d.Set("provision_after_extensions", utils.FlattenStringSlice(props.ProvisionAfterExtensions))
+		d.Set("provisioning_state", props.ProvisioningState)
+		d.Set("publisher", props.Publisher)
+		d.Set("type", props.Type)
+		d.Set("type_handler_version", props.TypeHandlerVersion)
+
+		// the `protectedSettings` are never returned by the API, so only the (public) `settings` are exposed here -
+		// these are normalized so that the keys are consistently ordered regardless of the ordering used by the API
+		settings := ""
+		if props.Settings != nil {
+			if settingsVal, ok := props.Settings.(map[string]interface{}); ok {
+				settingsJson, err := pluginsdk.FlattenJsonToString(settingsVal)
+				if err != nil {
+					return fmt.Errorf("unable to parse settings from response: %s", err)
+				}
+				settings = settingsJson
+			}
+		}
+		d.Set("settings", settings)
+	}
+
+	return nil
+}
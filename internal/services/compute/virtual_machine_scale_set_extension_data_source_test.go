@@ -0,0 +1,41 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualMachineScaleSetExtensionDataSource struct {
+}
+
+func TestAccDataSourceVirtualMachineScaleSetExtension_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_scale_set_extension", "test")
+	r := VirtualMachineScaleSetExtensionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("publisher").HasValue("Microsoft.Azure.Extensions"),
+				check.That(data.ResourceName).Key("type").HasValue("CustomScript"),
+				check.That(data.ResourceName).Key("type_handler_version").HasValue("2.0"),
+				check.That(data.ResourceName).Key("auto_upgrade_minor_version").HasValue("true"),
+				check.That(data.ResourceName).Key("settings").HasValue(`{"commandToExecute":"echo $HOSTNAME"}`),
+			),
+		},
+	})
+}
+
+func (VirtualMachineScaleSetExtensionDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = azurerm_virtual_machine_scale_set_extension.test.name
+  virtual_machine_scale_set_id = azurerm_virtual_machine_scale_set_extension.test.virtual_machine_scale_set_id
+}
+`, VirtualMachineScaleSetExtensionResource{}.basicLinux(data))
+}
@@ -0,0 +1,140 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/virtualmachinescalesetextensions"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func expandVirtualMachineScaleSetExtensionProtectedSettingsFromKeyVault(input []interface{}) *virtualmachinescalesetextensions.KeyVaultSecretReference {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &virtualmachinescalesetextensions.KeyVaultSecretReference{
+		SecretUrl: v["secret_url"].(string),
+		SourceVault: virtualmachinescalesetextensions.SubResource{
+			Id: utils.String(v["source_vault_id"].(string)),
+		},
+	}
+}
+
+func flattenVirtualMachineScaleSetExtensionProtectedSettingsFromKeyVault(input *virtualmachinescalesetextensions.KeyVaultSecretReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	sourceVaultId := ""
+	if input.SourceVault.Id != nil {
+		sourceVaultId = *input.SourceVault.Id
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"secret_url":      input.SecretUrl,
+			"source_vault_id": sourceVaultId,
+		},
+	}
+}
+
+func virtualMachineScaleSetExtensionProtectedSettingsFromKeyVaultSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"protected_settings"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"secret_url": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: keyVaultValidate.NestedItemId,
+				},
+
+				"source_vault_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: keyVaultValidate.VaultID,
+				},
+			},
+		},
+	}
+}
+
+// createOrUpdateVirtualMachineScaleSetExtensionWithKeyVault creates/updates the Virtual Machine Scale Set Extension
+// using the newer API Version, passing the Protected Settings by reference to a Key Vault Secret - when the reference
+// is nil it's omitted from the request, which removes it from the Extension
+func createOrUpdateVirtualMachineScaleSetExtensionWithKeyVault(ctx context.Context, client *virtualmachinescalesetextensions.VirtualMachineScaleSetExtensionsClient, id virtualmachinescalesetextensions.VirtualMachineScaleSetExtensionId, extension compute.VirtualMachineScaleSetExtension, protectedSettingsFromKeyVault *virtualmachinescalesetextensions.KeyVaultSecretReference) error {
+	payload := virtualmachinescalesetextensions.VirtualMachineScaleSetExtension{
+		Name: extension.Name,
+	}
+
+	if props := extension.VirtualMachineScaleSetExtensionProperties; props != nil {
+		payload.Properties = &virtualmachinescalesetextensions.VirtualMachineScaleSetExtensionProperties{
+			AutoUpgradeMinorVersion:       props.AutoUpgradeMinorVersion,
+			EnableAutomaticUpgrade:        props.EnableAutomaticUpgrade,
+			ForceUpdateTag:                props.ForceUpdateTag,
+			ProtectedSettingsFromKeyVault: protectedSettingsFromKeyVault,
+			ProvisionAfterExtensions:      props.ProvisionAfterExtensions,
+			Publisher:                     props.Publisher,
+			Type:                          props.Type,
+			TypeHandlerVersion:            props.TypeHandlerVersion,
+		}
+		if props.ProtectedSettings != nil {
+			payload.Properties.ProtectedSettings = &props.ProtectedSettings
+		}
+		if props.Settings != nil {
+			payload.Properties.Settings = &props.Settings
+		}
+	}
+
+	return client.CreateOrUpdateThenPoll(ctx, id, payload)
+}
+
+// getVirtualMachineScaleSetExtensionProtectedSettingsFromKeyVault retrieves the reference to the Key Vault Secret
+// containing the Protected Settings for this Virtual Machine Scale Set Extension, using the newer API Version
+func getVirtualMachineScaleSetExtensionProtectedSettingsFromKeyVault(ctx context.Context, client *virtualmachinescalesetextensions.VirtualMachineScaleSetExtensionsClient, id virtualmachinescalesetextensions.VirtualMachineScaleSetExtensionId) (*virtualmachinescalesetextensions.KeyVaultSecretReference, error) {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		return model.Properties.ProtectedSettingsFromKeyVault, nil
+	}
+
+	return nil, nil
+}
+
+// importVirtualMachineScaleSetExtension populates `protected_settings_from_key_vault` during import, since this
+// is otherwise only retrieved when it's present in the state
+func importVirtualMachineScaleSetExtension(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).Compute.VMScaleSetExtensionsKeyVaultClient
+
+	id, err := parse.VirtualMachineScaleSetExtensionID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, err
+	}
+
+	extensionId := virtualmachinescalesetextensions.NewVirtualMachineScaleSetExtensionID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, id.ExtensionName)
+	protectedSettingsFromKeyVault, err := getVirtualMachineScaleSetExtensionProtectedSettingsFromKeyVault(ctx, client, extensionId)
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("retrieving `protected_settings_from_key_vault` for Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.ExtensionName, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+	}
+	if err := d.Set("protected_settings_from_key_vault", flattenVirtualMachineScaleSetExtensionProtectedSettingsFromKeyVault(protectedSettingsFromKeyVault)); err != nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("setting `protected_settings_from_key_vault`: %+v", err)
+	}
+
+	// this is a Terraform-only setting which can't be retrieved from the API
+	d.Set("ignore_settings_changes", false)
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
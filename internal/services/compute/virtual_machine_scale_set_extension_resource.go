@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/virtualmachinescalesetextensions"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -26,10 +27,10 @@ func resourceVirtualMachineScaleSetExtension() *pluginsdk.Resource {
 		Update: resourceVirtualMachineScaleSetExtensionUpdate,
 		Delete: resourceVirtualMachineScaleSetExtensionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.VirtualMachineScaleSetExtensionID(id)
 			return err
-		}),
+		}, importVirtualMachineScaleSetExtension),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -90,8 +91,11 @@ func resourceVirtualMachineScaleSetExtension() *pluginsdk.Resource {
 				Sensitive:        true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+				ConflictsWith:    []string{"protected_settings_from_key_vault"},
 			},
 
+			"protected_settings_from_key_vault": virtualMachineScaleSetExtensionProtectedSettingsFromKeyVaultSchema(),
+
 			"provision_after_extensions": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -100,11 +104,19 @@ func resourceVirtualMachineScaleSetExtension() *pluginsdk.Resource {
 				},
 			},
 
+			"rolling_upgrade": virtualMachineScaleSetExtensionRollingUpgradeSchema(),
+
 			"settings": {
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
 				ValidateFunc:     validation.StringIsJSON,
-				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+				DiffSuppressFunc: suppressVirtualMachineScaleSetExtensionSettingsDiff,
+			},
+
+			"ignore_settings_changes": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
 	}
@@ -112,6 +124,7 @@ func resourceVirtualMachineScaleSetExtension() *pluginsdk.Resource {
 
 func resourceVirtualMachineScaleSetExtensionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+	keyVaultClient := meta.(*clients.Client).Compute.VMScaleSetExtensionsKeyVaultClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -171,13 +184,30 @@ func resourceVirtualMachineScaleSetExtensionCreate(d *pluginsdk.ResourceData, me
 		props.VirtualMachineScaleSetExtensionProperties.ForceUpdateTag = utils.String(v.(string))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, vmssName, name, props)
-	if err != nil {
-		return fmt.Errorf("Error creating Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, vmssName, resourceGroup, err)
+	if protectedSettingsFromKeyVault := expandVirtualMachineScaleSetExtensionProtectedSettingsFromKeyVault(d.Get("protected_settings_from_key_vault").([]interface{})); protectedSettingsFromKeyVault != nil {
+		extensionId := virtualmachinescalesetextensions.NewVirtualMachineScaleSetExtensionID(virtualMachineScaleSetId.SubscriptionId, resourceGroup, vmssName, name)
+		if err := createOrUpdateVirtualMachineScaleSetExtensionWithKeyVault(ctx, keyVaultClient, extensionId, props, protectedSettingsFromKeyVault); err != nil {
+			return fmt.Errorf("Error creating Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, vmssName, resourceGroup, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, vmssName, name, props)
+		if err != nil {
+			return fmt.Errorf("Error creating Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, vmssName, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for creation of Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, vmssName, resourceGroup, err)
+		}
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for creation of Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, vmssName, resourceGroup, err)
+	rollingUpgrade, err := expandVirtualMachineScaleSetExtensionRollingUpgrade(d.Get("rolling_upgrade").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if rollingUpgrade != nil {
+		if err := rollVirtualMachineScaleSetExtension(ctx, meta.(*clients.Client).Compute, *virtualMachineScaleSetId, *rollingUpgrade); err != nil {
+			return fmt.Errorf("Error rolling Extension %q out to the instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, vmssName, resourceGroup, err)
+		}
 	}
 
 	resp, err = client.Get(ctx, resourceGroup, vmssName, name, "")
@@ -191,6 +221,7 @@ func resourceVirtualMachineScaleSetExtensionCreate(d *pluginsdk.ResourceData, me
 
 func resourceVirtualMachineScaleSetExtensionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+	keyVaultClient := meta.(*clients.Client).Compute.VMScaleSetExtensionsKeyVaultClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -256,13 +287,34 @@ func resourceVirtualMachineScaleSetExtensionUpdate(d *pluginsdk.ResourceData, me
 		Name: utils.String(id.ExtensionName),
 		VirtualMachineScaleSetExtensionProperties: &props,
 	}
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.ExtensionName, extension)
-	if err != nil {
-		return fmt.Errorf("Error updating Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.ExtensionName, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+	protectedSettingsFromKeyVault := expandVirtualMachineScaleSetExtensionProtectedSettingsFromKeyVault(d.Get("protected_settings_from_key_vault").([]interface{}))
+	if protectedSettingsFromKeyVault != nil || d.HasChange("protected_settings_from_key_vault") {
+		// when switching from `protected_settings_from_key_vault` to `protected_settings` the reference needs to be
+		// removed using the newer API Version, which is done by omitting it from the request
+		extensionId := virtualmachinescalesetextensions.NewVirtualMachineScaleSetExtensionID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, id.ExtensionName)
+		if err := createOrUpdateVirtualMachineScaleSetExtensionWithKeyVault(ctx, keyVaultClient, extensionId, extension, protectedSettingsFromKeyVault); err != nil {
+			return fmt.Errorf("Error updating Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.ExtensionName, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.ExtensionName, extension)
+		if err != nil {
+			return fmt.Errorf("Error updating Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.ExtensionName, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for update of Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.ExtensionName, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+		}
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for update of Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.ExtensionName, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+	rollingUpgrade, err := expandVirtualMachineScaleSetExtensionRollingUpgrade(d.Get("rolling_upgrade").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if rollingUpgrade != nil {
+		virtualMachineScaleSetId := parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+		if err := rollVirtualMachineScaleSetExtension(ctx, meta.(*clients.Client).Compute, virtualMachineScaleSetId, *rollingUpgrade); err != nil {
+			return fmt.Errorf("Error rolling Extension %q out to the instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", id.ExtensionName, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+		}
 	}
 
 	return resourceVirtualMachineScaleSetExtensionRead(d, meta)
@@ -271,6 +323,7 @@ func resourceVirtualMachineScaleSetExtensionUpdate(d *pluginsdk.ResourceData, me
 func resourceVirtualMachineScaleSetExtensionRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	vmssClient := meta.(*clients.Client).Compute.VMScaleSetClient
 	client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+	keyVaultClient := meta.(*clients.Client).Compute.VMScaleSetExtensionsKeyVaultClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -326,6 +379,19 @@ func resourceVirtualMachineScaleSetExtensionRead(d *pluginsdk.ResourceData, meta
 		d.Set("settings", settings)
 	}
 
+	// retrieving this requires an additional request using a newer API Version, so this is only done when it's
+	// in use - the importer populates this when the Extension is imported
+	if len(d.Get("protected_settings_from_key_vault").([]interface{})) > 0 {
+		extensionId := virtualmachinescalesetextensions.NewVirtualMachineScaleSetExtensionID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, id.ExtensionName)
+		protectedSettingsFromKeyVault, err := getVirtualMachineScaleSetExtensionProtectedSettingsFromKeyVault(ctx, keyVaultClient, extensionId)
+		if err != nil {
+			return fmt.Errorf("Error retrieving `protected_settings_from_key_vault` for Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.ExtensionName, id.VirtualMachineScaleSetName, id.ResourceGroup, err)
+		}
+		if err := d.Set("protected_settings_from_key_vault", flattenVirtualMachineScaleSetExtensionProtectedSettingsFromKeyVault(protectedSettingsFromKeyVault)); err != nil {
+			return fmt.Errorf("Error setting `protected_settings_from_key_vault`: %+v", err)
+		}
+	}
+
 	return nil
 }
 
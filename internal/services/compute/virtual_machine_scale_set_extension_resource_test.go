@@ -3,8 +3,11 @@ package compute_test
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -114,6 +117,27 @@ func TestAccVirtualMachineScaleSetExtension_forceUpdateTag(t *testing.T) {
 	})
 }
 
+func TestAccVirtualMachineScaleSetExtension_rollingUpgrade(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_extension", "test")
+	r := VirtualMachineScaleSetExtensionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rollingUpgrade(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("rolling_upgrade"),
+		{
+			Config: r.rollingUpgrade(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("rolling_upgrade"),
+	})
+}
+
 func TestAccVirtualMachineScaleSetExtension_protectedSettings(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_extension", "test")
 	r := VirtualMachineScaleSetExtensionResource{}
@@ -128,6 +152,36 @@ func TestAccVirtualMachineScaleSetExtension_protectedSettings(t *testing.T) {
 	})
 }
 
+func TestAccVirtualMachineScaleSetExtension_protectedSettingsFromKeyVault(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_extension", "test")
+	r := VirtualMachineScaleSetExtensionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.protectedSettingsFromKeyVault(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protected_settings_from_key_vault.#").HasValue("1"),
+				check.That(data.ResourceName).Key("protected_settings_from_key_vault.0.secret_url").MatchesOtherKey(
+					check.That("azurerm_key_vault_secret.test").Key("id"),
+				),
+				check.That(data.ResourceName).Key("protected_settings_from_key_vault.0.source_vault_id").MatchesOtherKey(
+					check.That("azurerm_key_vault.test").Key("id"),
+				),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.protectedSettings(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protected_settings_from_key_vault.#").HasValue("0"),
+				data.CheckWithClient(r.protectedSettingsFromKeyVaultRemoved),
+			),
+		},
+		data.ImportStep("protected_settings"),
+	})
+}
+
 func TestAccVirtualMachineScaleSetExtension_protectedSettingsOnly(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_extension", "test")
 	r := VirtualMachineScaleSetExtensionResource{}
@@ -164,6 +218,25 @@ func TestAccVirtualMachineScaleSetExtension_updateVersion(t *testing.T) {
 	})
 }
 
+func TestAccVirtualMachineScaleSetExtension_ignoreSettingsChanges(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_extension", "test")
+	r := VirtualMachineScaleSetExtensionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ignoreSettingsChanges(data, "echo $HOSTNAME"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("ignore_settings_changes"),
+		{
+			// the changed settings are ignored, so this should be a no-op
+			Config:   r.ignoreSettingsChanges(data, "echo $USER"),
+			PlanOnly: true,
+		},
+	})
+}
+
 func (t VirtualMachineScaleSetExtensionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.VirtualMachineScaleSetExtensionID(state.ID)
 	if err != nil {
@@ -274,6 +347,29 @@ resource "azurerm_virtual_machine_scale_set_extension" "test" {
 `, r.templateLinux(data), data.RandomInteger, tag)
 }
 
+func (r VirtualMachineScaleSetExtensionResource) rollingUpgrade(data acceptance.TestData, tag string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "acctestExt-%d"
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+  force_update_tag             = %q
+  settings = jsonencode({
+    "commandToExecute" = "echo $HOSTNAME"
+  })
+
+  rolling_upgrade {
+    max_batch_instance_percent = 50
+    pause_time_between_batches = "PT30S"
+  }
+}
+`, r.templateLinux(data), data.RandomInteger, tag)
+}
+
 func (r VirtualMachineScaleSetExtensionResource) updateVersion(data acceptance.TestData, version string) string {
 	return fmt.Sprintf(`
 %s
@@ -291,6 +387,24 @@ resource "azurerm_virtual_machine_scale_set_extension" "test" {
 `, r.templateLinux(data), data.RandomInteger, version)
 }
 
+func (r VirtualMachineScaleSetExtensionResource) ignoreSettingsChanges(data acceptance.TestData, command string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "acctestExt-%d"
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+  ignore_settings_changes      = true
+  settings = jsonencode({
+    "commandToExecute" = %q
+  })
+}
+`, r.templateLinux(data), data.RandomInteger, command)
+}
+
 func (r VirtualMachineScaleSetExtensionResource) protectedSettings(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -311,6 +425,101 @@ resource "azurerm_virtual_machine_scale_set_extension" "test" {
 `, r.templateLinux(data), data.RandomInteger)
 }
 
+// protectedSettingsFromKeyVaultRemoved checks that the reference to the Key Vault Secret has been removed from the
+// Extension - which is only returned by API Version `2021-11-01` and later, so the request is sent directly
+func (VirtualMachineScaleSetExtensionResource) protectedSettingsFromKeyVaultRemoved(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	id, err := parse.VirtualMachineScaleSetExtensionID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	client := clients.Compute.VMScaleSetExtensionsClient
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": "2021-11-01",
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("preparing request for %s: %+v", *id, err)
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	var result struct {
+		Properties *struct {
+			ProtectedSettingsFromKeyVault interface{} `json:"protectedSettingsFromKeyVault,omitempty"`
+		} `json:"properties,omitempty"`
+	}
+	if err := autorest.Respond(resp, azure.WithErrorUnlessStatusCode(http.StatusOK), autorest.ByUnmarshallingJSON(&result), autorest.ByClosing()); err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if result.Properties != nil && result.Properties.ProtectedSettingsFromKeyVault != nil {
+		return fmt.Errorf("expected `protectedSettingsFromKeyVault` to have been removed from %s but got %+v", *id, result.Properties.ProtectedSettingsFromKeyVault)
+	}
+
+	return nil
+}
+
+func (r VirtualMachineScaleSetExtensionResource) protectedSettingsFromKeyVault(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault" "test" {
+  name                   = "acctestkv%s"
+  location               = azurerm_resource_group.test.location
+  resource_group_name    = azurerm_resource_group.test.name
+  tenant_id              = data.azurerm_client_config.current.tenant_id
+  sku_name               = "standard"
+  enabled_for_deployment = true
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    secret_permissions = [
+      "Delete",
+      "Get",
+      "Purge",
+      "Set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "protectedsettings"
+  key_vault_id = azurerm_key_vault.test.id
+  value = jsonencode({
+    "secretValue" = "P@55W0rd1234!"
+  })
+}
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "acctestExt-%d"
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+  settings = jsonencode({
+    "commandToExecute" = "echo $HOSTNAME"
+  })
+
+  protected_settings_from_key_vault {
+    secret_url      = azurerm_key_vault_secret.test.id
+    source_vault_id = azurerm_key_vault.test.id
+  }
+}
+`, r.templateLinux(data), data.RandomString, data.RandomInteger)
+}
+
 func (r VirtualMachineScaleSetExtensionResource) protectedSettingsOnly(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,202 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/rickb777/date/period"
+)
+
+// virtualMachineScaleSetExtensionRollingUpgrade defines how changes to a Virtual Machine Scale Set Extension
+// are rolled out to the existing instances within the Virtual Machine Scale Set
+type virtualMachineScaleSetExtensionRollingUpgrade struct {
+	maxBatchInstancePercent int
+	pauseTimeBetweenBatches time.Duration
+	healthCheckEnabled      bool
+}
+
+func virtualMachineScaleSetExtensionRollingUpgradeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"max_batch_instance_percent": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      20,
+					ValidateFunc: validation.IntBetween(1, 100),
+				},
+
+				"pause_time_between_batches": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "PT0S",
+					ValidateFunc: azValidate.ISO8601Duration,
+				},
+
+				"health_check_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetExtensionRollingUpgrade(input []interface{}) (*virtualMachineScaleSetExtensionRollingUpgrade, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	pauseTime, err := period.Parse(raw["pause_time_between_batches"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `pause_time_between_batches`: %+v", err)
+	}
+
+	return &virtualMachineScaleSetExtensionRollingUpgrade{
+		maxBatchInstancePercent: raw["max_batch_instance_percent"].(int),
+		pauseTimeBetweenBatches: pauseTime.DurationApprox(),
+		healthCheckEnabled:      raw["health_check_enabled"].(bool),
+	}, nil
+}
+
+// rollVirtualMachineScaleSetExtension upgrades the instances within the Virtual Machine Scale Set which aren't
+// running the latest model in batches, such that changes to an Extension are applied to the existing instances.
+// This is only done for a Manual Upgrade Policy, since otherwise the platform rolls out the changes itself.
+func rollVirtualMachineScaleSetExtension(ctx context.Context, client *client.Client, id parse.VirtualMachineScaleSetId, input virtualMachineScaleSetExtensionRollingUpgrade) error {
+	vmss, err := client.VMScaleSetClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if props := vmss.VirtualMachineScaleSetProperties; props != nil && props.UpgradePolicy != nil && props.UpgradePolicy.Mode != compute.Manual {
+		log.Printf("[DEBUG] Skipping rolling the instances of Virtual Machine Scale Set %q (Resource Group %q) since the Upgrade Policy is %q and the changes are rolled out by the platform", id.Name, id.ResourceGroup, string(props.UpgradePolicy.Mode))
+		return nil
+	}
+
+	log.Printf("[DEBUG] Determining the instances to roll for Virtual Machine Scale Set %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	instances, err := client.VMScaleSetVMsClient.ListComplete(ctx, id.ResourceGroup, id.Name, "", "", "")
+	if err != nil {
+		return fmt.Errorf("listing instances for Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	totalInstances := 0
+	instanceIdsToRoll := make([]string, 0)
+	for instances.NotDone() {
+		instance := instances.Value()
+		if instance.InstanceID != nil {
+			totalInstances++
+
+			if props := instance.VirtualMachineScaleSetVMProperties; props != nil && props.LatestModelApplied != nil && !*props.LatestModelApplied {
+				instanceIdsToRoll = append(instanceIdsToRoll, *instance.InstanceID)
+			}
+		}
+
+		if err := instances.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("enumerating instances for Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	if len(instanceIdsToRoll) == 0 {
+		log.Printf("[DEBUG] All instances of Virtual Machine Scale Set %q (Resource Group %q) are running the latest model - nothing to roll", id.Name, id.ResourceGroup)
+		return nil
+	}
+
+	batchSize := int(math.Ceil(float64(totalInstances) * float64(input.maxBatchInstancePercent) / 100))
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	for start := 0; start < len(instanceIdsToRoll); start += batchSize {
+		end := start + batchSize
+		if end > len(instanceIdsToRoll) {
+			end = len(instanceIdsToRoll)
+		}
+		batch := instanceIdsToRoll[start:end]
+
+		log.Printf("[DEBUG] Updating instances %q of Virtual Machine Scale Set %q (Resource Group %q) to the Latest Configuration..", strings.Join(batch, ", "), id.Name, id.ResourceGroup)
+		future, err := client.VMScaleSetClient.UpdateInstances(ctx, id.ResourceGroup, id.Name, compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+			InstanceIds: &batch,
+		})
+		if err != nil {
+			return fmt.Errorf("updating instances %q of Virtual Machine Scale Set %q (Resource Group %q) to the Latest Configuration: %+v", strings.Join(batch, ", "), id.Name, id.ResourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.VMScaleSetClient.Client); err != nil {
+			return fmt.Errorf("waiting for update of instances %q of Virtual Machine Scale Set %q (Resource Group %q) to the Latest Configuration: %+v", strings.Join(batch, ", "), id.Name, id.ResourceGroup, err)
+		}
+
+		if input.healthCheckEnabled {
+			for _, instanceId := range batch {
+				if err := waitForVirtualMachineScaleSetInstanceToBeHealthy(ctx, client.VMScaleSetVMsClient, id, instanceId); err != nil {
+					return err
+				}
+			}
+		}
+
+		if end < len(instanceIdsToRoll) && input.pauseTimeBetweenBatches > 0 {
+			log.Printf("[DEBUG] Pausing for %s before rolling the next batch of instances..", input.pauseTimeBetweenBatches)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("pausing between batches of instances for Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, ctx.Err())
+			case <-time.After(input.pauseTimeBetweenBatches):
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Rolled the instances of Virtual Machine Scale Set %q (Resource Group %q).", id.Name, id.ResourceGroup)
+	return nil
+}
+
+func waitForVirtualMachineScaleSetInstanceToBeHealthy(ctx context.Context, client *compute.VirtualMachineScaleSetVMsClient, id parse.VirtualMachineScaleSetId, instanceId string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	log.Printf("[DEBUG] Waiting for instance %q of Virtual Machine Scale Set %q (Resource Group %q) to become healthy..", instanceId, id.Name, id.ResourceGroup)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"HealthState/initializing", "HealthState/unknown"},
+		Target:  []string{"HealthState/healthy"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetInstanceView(ctx, id.ResourceGroup, id.Name, instanceId)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving Instance View for instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, id.Name, id.ResourceGroup, err)
+			}
+
+			if resp.VMHealth == nil || resp.VMHealth.Status == nil || resp.VMHealth.Status.Code == nil {
+				return nil, "", fmt.Errorf("instance %q of Virtual Machine Scale Set %q (Resource Group %q) doesn't report a health status - a Load Balancer Health Probe or the Application Health Extension is required when `health_check_enabled` is set", instanceId, id.Name, id.ResourceGroup)
+			}
+
+			// an unhealthy instance won't recover by waiting, so there's no point rolling out the next batch
+			if strings.EqualFold(*resp.VMHealth.Status.Code, "HealthState/unhealthy") {
+				return nil, "", fmt.Errorf("instance %q of Virtual Machine Scale Set %q (Resource Group %q) is unhealthy", instanceId, id.Name, id.ResourceGroup)
+			}
+
+			return resp, *resp.VMHealth.Status.Code, nil
+		},
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 2,
+		Timeout:                   time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for instance %q of Virtual Machine Scale Set %q (Resource Group %q) to become healthy: %+v", instanceId, id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package compute
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// suppressVirtualMachineScaleSetExtensionSettingsDiff suppresses the diff for `settings` when the old and new
+// values are semantically equivalent JSON, or when `ignore_settings_changes` is enabled for an existing Extension
+// (since the settings of some Extensions are partially managed by other controllers, such as AKS or Azure Policy)
+func suppressVirtualMachineScaleSetExtensionSettingsDiff(_, old, new string, d *pluginsdk.ResourceData) bool {
+	if d.Id() != "" && d.Get("ignore_settings_changes").(bool) {
+		return true
+	}
+
+	return virtualMachineScaleSetExtensionSettingsAreEquivalent(old, new)
+}
+
+// virtualMachineScaleSetExtensionSettingsAreEquivalent compares the settings returned from the API (old) with the
+// configured settings (new) semantically - the API doesn't preserve the ordering of keys or the formatting of numbers,
+// and returns some numbers as strings - so a string is only compared as a number where the configured value is a number
+func virtualMachineScaleSetExtensionSettingsAreEquivalent(old, new string) bool {
+	oldValue, err := decodeVirtualMachineScaleSetExtensionSettings(old)
+	if err != nil {
+		return false
+	}
+
+	newValue, err := decodeVirtualMachineScaleSetExtensionSettings(new)
+	if err != nil {
+		return false
+	}
+
+	return virtualMachineScaleSetExtensionSettingsValuesAreEquivalent(oldValue, newValue)
+}
+
+func decodeVirtualMachineScaleSetExtensionSettings(input string) (interface{}, error) {
+	// omitting the settings is the same as specifying an empty object
+	if strings.TrimSpace(input) == "" {
+		return map[string]interface{}{}, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewBufferString(input))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	if value == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	return value, nil
+}
+
+func virtualMachineScaleSetExtensionSettingsValuesAreEquivalent(old, new interface{}) bool {
+	switch n := new.(type) {
+	case map[string]interface{}:
+		o, ok := old.(map[string]interface{})
+		if !ok || len(o) != len(n) {
+			return false
+		}
+		for key, value := range n {
+			existing, ok := o[key]
+			if !ok || !virtualMachineScaleSetExtensionSettingsValuesAreEquivalent(existing, value) {
+				return false
+			}
+		}
+		return true
+
+	case []interface{}:
+		o, ok := old.([]interface{})
+		if !ok || len(o) != len(n) {
+			return false
+		}
+		for i := range n {
+			if !virtualMachineScaleSetExtensionSettingsValuesAreEquivalent(o[i], n[i]) {
+				return false
+			}
+		}
+		return true
+
+	case json.Number:
+		switch o := old.(type) {
+		case json.Number:
+			return virtualMachineScaleSetExtensionSettingsNumbersAreEqual(o.String(), n.String())
+		case string:
+			// the configured value is a number, so a string returned from the API is compared as a number
+			return virtualMachineScaleSetExtensionSettingsNumbersAreEqual(o, n.String())
+		}
+		return false
+	}
+
+	return reflect.DeepEqual(old, new)
+}
+
+// virtualMachineScaleSetExtensionSettingsNumbersAreEqual compares two numbers such that `1`, `1.0` and `1e0` are
+// considered equal - without losing the precision of large integers
+func virtualMachineScaleSetExtensionSettingsNumbersAreEqual(old, new string) bool {
+	oldValue, ok := big.NewFloat(0).SetPrec(256).SetString(old)
+	if !ok {
+		return false
+	}
+
+	newValue, ok := big.NewFloat(0).SetPrec(256).SetString(new)
+	if !ok {
+		return false
+	}
+
+	return oldValue.Cmp(newValue) == 0
+}
@@ -0,0 +1,108 @@
+package compute
+
+import "testing"
+
+func TestVirtualMachineScaleSetExtensionSettingsAreEquivalent(t *testing.T) {
+	testData := []struct {
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			Old:      "",
+			New:      "",
+			Expected: true,
+		},
+		{
+			Old:      "",
+			New:      "{}",
+			Expected: true,
+		},
+		{
+			Old:      "null",
+			New:      "{}",
+			Expected: true,
+		},
+		{
+			Old:      `{"a": 1, "b": "two"}`,
+			New:      `{"b": "two", "a": 1}`,
+			Expected: true,
+		},
+		{
+			Old:      `{"timeout": 60}`,
+			New:      `{"timeout": 60.0}`,
+			Expected: true,
+		},
+		{
+			// numbers returned from the API as strings are compared as numbers when a number is configured
+			Old:      `{"timeout": "60.0"}`,
+			New:      `{"timeout": 60}`,
+			Expected: true,
+		},
+		{
+			// but a configured string is never compared as a number
+			Old:      `{"timeout": 60}`,
+			New:      `{"timeout": "60"}`,
+			Expected: false,
+		},
+		{
+			Old:      `{"value": "1000"}`,
+			New:      `{"value": "1e3"}`,
+			Expected: false,
+		},
+		{
+			Old:      `{"code": "7"}`,
+			New:      `{"code": "007"}`,
+			Expected: false,
+		},
+		{
+			Old:      `{"timeout": "sixty"}`,
+			New:      `{"timeout": 60}`,
+			Expected: false,
+		},
+		{
+			Old:      `{"timeout": 60}`,
+			New:      `{"timeout": 6e1}`,
+			Expected: true,
+		},
+		{
+			Old:      `{"id": 9007199254740993}`,
+			New:      `{"id": 9007199254740992}`,
+			Expected: false,
+		},
+		{
+			Old:      `{"timeout": 60}`,
+			New:      `{"timeout": 61}`,
+			Expected: false,
+		},
+		{
+			Old:      `{"version": "1.0"}`,
+			New:      `{"version": "1.0.0"}`,
+			Expected: false,
+		},
+		{
+			Old:      `{"list": [1, 2]}`,
+			New:      `{"list": [2, 1]}`,
+			Expected: false,
+		},
+		{
+			Old:      `{"nested": {"a": true, "b": [{"c": 1.50}]}}`,
+			New:      `{"nested": {"b": [{"c": 1.5}], "a": true}}`,
+			Expected: true,
+		},
+		{
+			Old:      `{"a": 1}`,
+			New:      `{"a": 1`,
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q", v.Old, v.New)
+
+		actual := virtualMachineScaleSetExtensionSettingsAreEquivalent(v.Old, v.New)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
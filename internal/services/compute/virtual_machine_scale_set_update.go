@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/rickb777/date/period"
 )
 
 type virtualMachineScaleSetUpdateMetaData struct {
@@ -22,6 +25,9 @@ type virtualMachineScaleSetUpdateMetaData struct {
 	// do we need to roll the instances in this scale set?
 	UpdateInstances bool
 
+	// do we need to reimage the instances in this scale set to resize the ephemeral os disk?
+	ReimageEphemeralOSDisk bool
+
 	Client   *client.Client
 	Existing compute.VirtualMachineScaleSet
 	ID       *parse.VirtualMachineScaleSetId
@@ -43,6 +49,7 @@ func (metadata virtualMachineScaleSetUpdateMetaData) performUpdate(ctx context.C
 	}
 
 	// if we update the SKU, we also need to subsequently roll the instances using the `UpdateInstances` API
+	reimagedInstanceIds := make([]string, 0)
 	if metadata.UpdateInstances {
 		userWantsToRollInstances := metadata.CanRollInstancesWhenRequired
 		upgradeMode := metadata.Existing.VirtualMachineScaleSetProperties.UpgradePolicy.Mode
@@ -57,13 +64,23 @@ func (metadata virtualMachineScaleSetUpdateMetaData) performUpdate(ctx context.C
 			}
 
 			if upgradeMode == compute.Manual {
-				if err := metadata.upgradeInstancesForManualUpgradePolicy(ctx); err != nil {
+				rolled, err := metadata.upgradeInstancesForManualUpgradePolicy(ctx)
+				if err != nil {
 					return err
 				}
+				reimagedInstanceIds = rolled
 			}
 		}
 	}
 
+	// the new size of an ephemeral os disk only takes effect once each instance has been reimaged - which the
+	// instances rolled above already have been
+	if metadata.ReimageEphemeralOSDisk {
+		if err := metadata.reimageInstancesForEphemeralOSDisk(ctx, reimagedInstanceIds); err != nil {
+			return err
+		}
+	}
+
 	if metadata.AutomaticOSUpgradeIsEnabled {
 		// Virtual Machine Scale Sets with Automatic OS Upgrade enabled must have all VM instances upgraded to same
 		// Platform Image. Upgrade all VM instances to latest Virtual Machine Scale Set model while property
@@ -121,7 +138,9 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForAutomati
 	return nil
 }
 
-func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUpgradePolicy(ctx context.Context) error {
+// upgradeInstancesForManualUpgradePolicy updates and reimages each instance which isn't using the latest model,
+// returning the ID's of the instances which have been reimaged
+func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUpgradePolicy(ctx context.Context) ([]string, error) {
 	client := metadata.Client.VMScaleSetClient
 	id := metadata.ID
 
@@ -129,7 +148,7 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUp
 	instancesClient := metadata.Client.VMScaleSetVMsClient
 	instances, err := instancesClient.ListComplete(ctx, id.ResourceGroup, id.Name, "", "", "")
 	if err != nil {
-		return fmt.Errorf("Error listing VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, err)
+		return nil, fmt.Errorf("Error listing VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, err)
 	}
 
 	log.Printf("[DEBUG] Determining instances to roll..")
@@ -145,7 +164,7 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUp
 		}
 
 		if err := instances.NextWithContext(ctx); err != nil {
-			return fmt.Errorf("Error enumerating instances: %s", err)
+			return nil, fmt.Errorf("Error enumerating instances: %s", err)
 		}
 	}
 
@@ -159,11 +178,11 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUp
 		}
 		future, err := client.UpdateInstances(ctx, id.ResourceGroup, id.Name, ids)
 		if err != nil {
-			return fmt.Errorf("Error updating Instance %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+			return nil, fmt.Errorf("Error updating Instance %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
 		}
 
 		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("Error waiting for update of Instance %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+			return nil, fmt.Errorf("Error waiting for update of Instance %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
 		}
 		log.Printf("[DEBUG] Updated Instance %q to the Latest Configuration.", instanceId)
 
@@ -174,16 +193,139 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUp
 		}
 		reimageFuture, err := client.Reimage(ctx, id.ResourceGroup, id.Name, reimageInput)
 		if err != nil {
-			return fmt.Errorf("Error reimaging Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+			return nil, fmt.Errorf("Error reimaging Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
 		}
 
 		if err = reimageFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("Error waiting for reimage of Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+			return nil, fmt.Errorf("Error waiting for reimage of Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
 		}
 		log.Printf("[DEBUG] Reimaged Instance %q..", instanceId)
 	}
 
 	log.Printf("[DEBUG] Rolled the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q).", metadata.OSType, id.Name, id.ResourceGroup)
+	return instanceIdsToRoll, nil
+}
+
+// reimageInstancesForEphemeralOSDisk reimages the instances within the Virtual Machine Scale Set so that the new
+// size of the Ephemeral OS Disk takes effect. Since reimaging wipes the OS Disk, instances are never all reimaged at
+// once: Rolling reimages them in batches per the Rolling Upgrade Policy, Automatic in batches of 20% of the instances
+// (the default for a Rolling Upgrade Policy) and Manual one at a time - but only when `roll_instances_when_required`
+// is enabled. Instances which have already been reimaged are skipped.
+func (metadata virtualMachineScaleSetUpdateMetaData) reimageInstancesForEphemeralOSDisk(ctx context.Context, alreadyReimaged []string) error {
+	client := metadata.Client.VMScaleSetClient
+	id := metadata.ID
+
+	var upgradePolicy *compute.UpgradePolicy
+	if props := metadata.Existing.VirtualMachineScaleSetProperties; props != nil {
+		upgradePolicy = props.UpgradePolicy
+	}
+	upgradeMode := compute.Manual
+	if upgradePolicy != nil {
+		upgradeMode = upgradePolicy.Mode
+	}
+
+	if upgradeMode == compute.Manual && !metadata.CanRollInstancesWhenRequired {
+		log.Printf("[DEBUG] Skipping reimaging the instances of %s Virtual Machine Scale Set %q (Resource Group %q) since the Upgrade Policy is Manual and rolling the instances is disabled - the new Ephemeral OS Disk size will apply once each instance is reimaged", metadata.OSType, id.Name, id.ResourceGroup)
+		return nil
+	}
+
+	skip := make(map[string]struct{})
+	for _, v := range alreadyReimaged {
+		skip[v] = struct{}{}
+	}
+
+	instances, err := metadata.Client.VMScaleSetVMsClient.ListComplete(ctx, id.ResourceGroup, id.Name, "", "", "")
+	if err != nil {
+		return fmt.Errorf("Error listing VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+
+	instanceIds := make([]string, 0)
+	for instances.NotDone() {
+		instance := instances.Value()
+		if instance.InstanceID != nil {
+			if _, ok := skip[*instance.InstanceID]; !ok {
+				instanceIds = append(instanceIds, *instance.InstanceID)
+			}
+		}
+
+		if err := instances.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error enumerating instances: %s", err)
+		}
+	}
+
+	if len(instanceIds) == 0 {
+		return nil
+	}
+
+	batchSize := 1
+	pauseTimeBetweenBatches := time.Duration(0)
+	if upgradeMode != compute.Manual {
+		maxBatchInstancePercent := 20
+		if upgradeMode == compute.Rolling && upgradePolicy.RollingUpgradePolicy != nil {
+			if v := upgradePolicy.RollingUpgradePolicy.MaxBatchInstancePercent; v != nil {
+				maxBatchInstancePercent = int(*v)
+			}
+			if v := upgradePolicy.RollingUpgradePolicy.PauseTimeBetweenBatches; v != nil && *v != "" {
+				pauseTime, err := period.Parse(*v)
+				if err != nil {
+					return fmt.Errorf("parsing `pause_time_between_batches` %q: %+v", *v, err)
+				}
+				pauseTimeBetweenBatches = pauseTime.DurationApprox()
+			}
+		}
+
+		batchSize = int(math.Ceil(float64(len(instanceIds)+len(alreadyReimaged)) * float64(maxBatchInstancePercent) / 100))
+		if batchSize < 1 {
+			batchSize = 1
+		}
+	}
+	// reimaging wipes the OS Disk, so always leave at least one instance running whilst the others are reimaged
+	if len(instanceIds) > 1 && batchSize >= len(instanceIds) {
+		batchSize = len(instanceIds) - 1
+	}
+
+	for start := 0; start < len(instanceIds); start += batchSize {
+		end := start + batchSize
+		if end > len(instanceIds) {
+			end = len(instanceIds)
+		}
+		batch := instanceIds[start:end]
+
+		log.Printf("[DEBUG] Updating Instances %q of %s Virtual Machine Scale Set %q (Resource Group %q) to the Latest Configuration..", strings.Join(batch, ", "), metadata.OSType, id.Name, id.ResourceGroup)
+		future, err := client.UpdateInstances(ctx, id.ResourceGroup, id.Name, compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+			InstanceIds: &batch,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating Instances %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", strings.Join(batch, ", "), metadata.OSType, id.Name, id.ResourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for update of Instances %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", strings.Join(batch, ", "), metadata.OSType, id.Name, id.ResourceGroup, err)
+		}
+
+		log.Printf("[DEBUG] Reimaging Instances %q of %s Virtual Machine Scale Set %q (Resource Group %q)..", strings.Join(batch, ", "), metadata.OSType, id.Name, id.ResourceGroup)
+		reimageFuture, err := client.Reimage(ctx, id.ResourceGroup, id.Name, &compute.VirtualMachineScaleSetReimageParameters{
+			InstanceIds: &batch,
+		})
+		if err != nil {
+			return fmt.Errorf("Error reimaging Instances %q (%s VM Scale Set %q / Resource Group %q): %+v", strings.Join(batch, ", "), metadata.OSType, id.Name, id.ResourceGroup, err)
+		}
+
+		if err = reimageFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for reimage of Instances %q (%s VM Scale Set %q / Resource Group %q): %+v", strings.Join(batch, ", "), metadata.OSType, id.Name, id.ResourceGroup, err)
+		}
+		log.Printf("[DEBUG] Reimaged Instances %q of %s Virtual Machine Scale Set %q (Resource Group %q).", strings.Join(batch, ", "), metadata.OSType, id.Name, id.ResourceGroup)
+
+		if end < len(instanceIds) && pauseTimeBetweenBatches > 0 {
+			log.Printf("[DEBUG] Pausing for %s before reimaging the next batch of instances..", pauseTimeBetweenBatches)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("pausing between batches of instances for %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, ctx.Err())
+			case <-time.After(pauseTimeBetweenBatches):
+			}
+		}
+	}
+
 	return nil
 }
 
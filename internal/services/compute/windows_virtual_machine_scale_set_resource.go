@@ -43,6 +43,8 @@ func resourceWindowsVirtualMachineScaleSet() *pluginsdk.Resource {
 		// TODO: exposing requireGuestProvisionSignal once it's available
 		// https://github.com/Azure/azure-rest-api-specs/pull/7246
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualMachineScaleSetEphemeralOSDiskCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -877,6 +879,7 @@ func resourceWindowsVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta
 		AutomaticOSUpgradeIsEnabled:  automaticOSUpgradeIsEnabled,
 		CanRollInstancesWhenRequired: meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired,
 		UpdateInstances:              updateInstances,
+		ReimageEphemeralOSDisk:       meta.(*clients.Client).Features.VirtualMachineScaleSet.ReimageEphemeralOSDiskOnResize && d.HasChange("os_disk.0.disk_size_gb") && len(d.Get("os_disk.0.diff_disk_settings").([]interface{})) > 0,
 		Client:                       meta.(*clients.Client).Compute,
 		Existing:                     existing,
 		ID:                           id,
@@ -82,6 +82,11 @@ func resourceArmConsumptionBudgetCreateUpdate(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("error expanding `time_period`: %+v", err)
 	}
 
+	notifications := d.Get("notification").(*pluginsdk.Set).List()
+	if err := ValidateConsumptionBudgetNotifications(notifications); err != nil {
+		return fmt.Errorf("error validating `notification`: %+v", err)
+	}
+
 	// The Consumption Budget API requires the category type field to be set in a budget's properties.
 	// 'Cost' is the only valid Budget type today according to the API spec.
 	category := "Cost"
@@ -91,7 +96,7 @@ func resourceArmConsumptionBudgetCreateUpdate(d *pluginsdk.ResourceData, meta in
 			Amount:        &amount,
 			Category:      &category,
 			Filter:        ExpandConsumptionBudgetFilter(d.Get("filter").([]interface{})),
-			Notifications: ExpandConsumptionBudgetNotifications(d.Get("notification").(*pluginsdk.Set).List()),
+			Notifications: ExpandConsumptionBudgetNotifications(notifications),
 			TimeGrain:     consumption.TimeGrainType(d.Get("time_grain").(string)),
 			TimePeriod:    timePeriod,
 		},
@@ -3,6 +3,7 @@ package consumption_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -108,6 +109,34 @@ func TestAccConsumptionBudgetResourceGroup_completeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccConsumptionBudgetResourceGroup_forecasted(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_consumption_budget_resource_group", "test")
+	r := ConsumptionBudgetResourceGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.forecasted(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("notification.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccConsumptionBudgetResourceGroup_notificationWithoutContacts(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_consumption_budget_resource_group", "test")
+	r := ConsumptionBudgetResourceGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.notificationWithoutContacts(data),
+			ExpectError: regexp.MustCompile("at least one of `contact_emails`, `contact_groups` or `contact_roles` must be specified"),
+		},
+	})
+}
+
 func (ConsumptionBudgetResourceGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ConsumptionBudgetResourceGroupID(state.ID)
 	if err != nil {
@@ -440,3 +469,96 @@ resource "azurerm_consumption_budget_resource_group" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, consumptionBudgetTestStartDate().Format(time.RFC3339))
 }
+
+func (ConsumptionBudgetResourceGroupResource) forecasted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestAG-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestAG"
+}
+
+resource "azurerm_consumption_budget_resource_group" "test" {
+  name              = "acctestconsumptionbudgetresourcegroup-%d"
+  resource_group_id = azurerm_resource_group.test.id
+
+  amount     = 1000
+  time_grain = "Monthly"
+
+  time_period {
+    start_date = "%s"
+  }
+
+  notification {
+    enabled        = true
+    threshold      = 90.0
+    operator       = "GreaterThan"
+    threshold_type = "Actual"
+
+    contact_groups = [
+      azurerm_monitor_action_group.test.id,
+    ]
+  }
+
+  notification {
+    enabled        = true
+    threshold      = 100.0
+    operator       = "GreaterThan"
+    threshold_type = "Forecasted"
+    locale         = "de-de"
+
+    contact_emails = [
+      "foo@example.com",
+    ]
+
+    contact_roles = [
+      "Owner",
+    ]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, consumptionBudgetTestStartDate().Format(time.RFC3339))
+}
+
+func (ConsumptionBudgetResourceGroupResource) notificationWithoutContacts(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_consumption_budget_resource_group" "test" {
+  name              = "acctestconsumptionbudgetresourcegroup-%d"
+  resource_group_id = azurerm_resource_group.test.id
+
+  amount     = 1000
+  time_grain = "Monthly"
+
+  time_period {
+    start_date = "%s"
+  }
+
+  notification {
+    enabled   = true
+    threshold = 90.0
+    operator  = "GreaterThan"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, consumptionBudgetTestStartDate().Format(time.RFC3339))
+}
@@ -2,6 +2,7 @@ package consumption
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/consumption/mgmt/2019-10-01/consumption"
@@ -76,6 +77,8 @@ func ExpandConsumptionBudgetNotifications(input []interface{}) map[string]*consu
 
 			notification.Enabled = utils.Bool(notificationRaw["enabled"].(bool))
 			notification.Operator = consumption.OperatorType(notificationRaw["operator"].(string))
+			notification.ThresholdType = consumption.ThresholdType(notificationRaw["threshold_type"].(string))
+			notification.Locale = consumption.CultureCode(notificationRaw["locale"].(string))
 
 			thresholdDecimal := decimal.NewFromInt(int64(notificationRaw["threshold"].(int)))
 			notification.Threshold = &thresholdDecimal
@@ -84,7 +87,7 @@ func ExpandConsumptionBudgetNotifications(input []interface{}) map[string]*consu
 			notification.ContactRoles = utils.ExpandStringSlice(notificationRaw["contact_roles"].([]interface{}))
 			notification.ContactGroups = utils.ExpandStringSlice(notificationRaw["contact_groups"].([]interface{}))
 
-			notificationKey := fmt.Sprintf("actual_%s_%s_Percent", string(notification.Operator), notification.Threshold.StringFixed(0))
+			notificationKey := fmt.Sprintf("%s_%s_%s_Percent", strings.ToLower(string(notification.ThresholdType)), string(notification.Operator), notification.Threshold.StringFixed(0))
 			notifications[notificationKey] = &notification
 		}
 	}
@@ -105,6 +108,19 @@ func FlattenConsumptionBudgetNotifications(input map[string]*consumption.Notific
 
 			notificationBlock["enabled"] = *v.Enabled
 			notificationBlock["operator"] = string(v.Operator)
+
+			// budgets created before these were configurable don't return the Threshold Type or Locale
+			thresholdType := string(consumption.ThresholdTypeActual)
+			if v.ThresholdType != "" {
+				thresholdType = string(v.ThresholdType)
+			}
+			notificationBlock["threshold_type"] = thresholdType
+			locale := string(consumption.CultureCodeEnUs)
+			if v.Locale != "" {
+				locale = string(v.Locale)
+			}
+			notificationBlock["locale"] = locale
+
 			threshold, _ := v.Threshold.Float64()
 			notificationBlock["threshold"] = int(threshold)
 			notificationBlock["contact_emails"] = utils.FlattenStringSlice(v.ContactEmails)
@@ -118,6 +134,23 @@ func FlattenConsumptionBudgetNotifications(input map[string]*consumption.Notific
 	return notifications
 }
 
+// ValidateConsumptionBudgetNotifications checks that each notification has somewhere to be sent, since the
+// API only returns a generic error when none of the contacts are specified
+func ValidateConsumptionBudgetNotifications(input []interface{}) error {
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		notificationRaw := v.(map[string]interface{})
+		if len(notificationRaw["contact_emails"].([]interface{})) == 0 && len(notificationRaw["contact_groups"].([]interface{})) == 0 && len(notificationRaw["contact_roles"].([]interface{})) == 0 {
+			return fmt.Errorf("at least one of `contact_emails`, `contact_groups` or `contact_roles` must be specified for the notification with a threshold of %d", notificationRaw["threshold"].(int))
+		}
+	}
+
+	return nil
+}
+
 func ExpandConsumptionBudgetComparisonExpression(input interface{}) *consumption.BudgetComparisonExpression {
 	if input == nil {
 		return nil
@@ -4,6 +4,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/consumption/mgmt/2019-10-01/consumption"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/consumption/validate"
+	monitorValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	resourceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -137,6 +138,46 @@ func SchemaConsumptionBudgetNotificationElement() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"threshold_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(consumption.ThresholdTypeActual),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(consumption.ThresholdTypeActual),
+					// TODO: use the SDK constant once the Consumption SDK has been upgraded
+					"Forecasted",
+				}, false),
+			},
+
+			"locale": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(consumption.CultureCodeEnUs),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(consumption.CultureCodeCsCz),
+					string(consumption.CultureCodeDaDk),
+					string(consumption.CultureCodeDeDe),
+					string(consumption.CultureCodeEnGb),
+					string(consumption.CultureCodeEnUs),
+					string(consumption.CultureCodeEsEs),
+					string(consumption.CultureCodeFrFr),
+					string(consumption.CultureCodeHuHu),
+					string(consumption.CultureCodeItIt),
+					string(consumption.CultureCodeJaJp),
+					string(consumption.CultureCodeKoKr),
+					string(consumption.CultureCodeNbNo),
+					string(consumption.CultureCodeNlNl),
+					string(consumption.CultureCodePlPl),
+					string(consumption.CultureCodePtBr),
+					string(consumption.CultureCodePtPt),
+					string(consumption.CultureCodeRuRu),
+					string(consumption.CultureCodeSvSe),
+					string(consumption.CultureCodeTrTr),
+					string(consumption.CultureCodeZhCn),
+					string(consumption.CultureCodeZhTw),
+				}, false),
+			},
+
 			"contact_emails": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -151,7 +192,7 @@ func SchemaConsumptionBudgetNotificationElement() *pluginsdk.Resource {
 				Optional: true,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
-					ValidateFunc: validation.StringIsNotEmpty,
+					ValidateFunc: monitorValidate.ActionGroupID,
 				},
 			},
 
@@ -159,8 +200,12 @@ func SchemaConsumptionBudgetNotificationElement() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
 				Elem: &pluginsdk.Schema{
-					Type:         pluginsdk.TypeString,
-					ValidateFunc: validation.StringIsNotEmpty,
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Contributor",
+						"Owner",
+						"Reader",
+					}, false),
 				},
 			},
 		},
@@ -3,10 +3,16 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/changedatacaptures"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/credentials"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/dataflows"
 )
 
 type Client struct {
+	ChangeDataCapturesClient      *changedatacaptures.ChangeDataCapturesClient
+	CredentialsClient             *credentials.CredentialsClient
 	DataFlowClient                *datafactory.DataFlowsClient
+	DataFlowsClient               *dataflows.DataFlowsClient
 	DatasetClient                 *datafactory.DatasetsClient
 	FactoriesClient               *datafactory.FactoriesClient
 	IntegrationRuntimesClient     *datafactory.IntegrationRuntimesClient
@@ -18,35 +24,64 @@ type Client struct {
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	var semaphore chan struct{}
+	if v := o.Features.DataFactory.MaxConcurrentRequests; v > 0 {
+		semaphore = make(chan struct{}, v)
+	}
+
+	changeDataCapturesClient := changedatacaptures.NewChangeDataCapturesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&changeDataCapturesClient.Client, o.ResourceManagerAuthorizer)
+	configureThrottling(&changeDataCapturesClient.Client, semaphore)
+
+	credentialsClient := credentials.NewCredentialsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&credentialsClient.Client, o.ResourceManagerAuthorizer)
+	configureThrottling(&credentialsClient.Client, semaphore)
+
 	dataFlowClient := datafactory.NewDataFlowsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataFlowClient.Client, o.ResourceManagerAuthorizer)
+	configureThrottling(&dataFlowClient.Client, semaphore)
+
+	dataFlowsClient := dataflows.NewDataFlowsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dataFlowsClient.Client, o.ResourceManagerAuthorizer)
+	configureThrottling(&dataFlowsClient.Client, semaphore)
 
 	DatasetClient := datafactory.NewDatasetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&DatasetClient.Client, o.ResourceManagerAuthorizer)
+	configureThrottling(&DatasetClient.Client, semaphore)
 
 	FactoriesClient := datafactory.NewFactoriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&FactoriesClient.Client, o.ResourceManagerAuthorizer)
+	configureThrottling(&FactoriesClient.Client, semaphore)
 
 	IntegrationRuntimesClient := datafactory.NewIntegrationRuntimesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&IntegrationRuntimesClient.Client, o.ResourceManagerAuthorizer)
+	configureThrottling(&IntegrationRuntimesClient.Client, semaphore)
 
 	LinkedServiceClient := datafactory.NewLinkedServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&LinkedServiceClient.Client, o.ResourceManagerAuthorizer)
+	configureThrottling(&LinkedServiceClient.Client, semaphore)
 
 	ManagedPrivateEndpointsClient := datafactory.NewManagedPrivateEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ManagedPrivateEndpointsClient.Client, o.ResourceManagerAuthorizer)
+	configureThrottling(&ManagedPrivateEndpointsClient.Client, semaphore)
 
 	ManagedVirtualNetworksClient := datafactory.NewManagedVirtualNetworksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ManagedVirtualNetworksClient.Client, o.ResourceManagerAuthorizer)
+	configureThrottling(&ManagedVirtualNetworksClient.Client, semaphore)
 
 	PipelinesClient := datafactory.NewPipelinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&PipelinesClient.Client, o.ResourceManagerAuthorizer)
+	configureThrottling(&PipelinesClient.Client, semaphore)
 
 	TriggersClient := datafactory.NewTriggersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&TriggersClient.Client, o.ResourceManagerAuthorizer)
+	configureThrottling(&TriggersClient.Client, semaphore)
 
 	return &Client{
+		ChangeDataCapturesClient:      &changeDataCapturesClient,
+		CredentialsClient:             &credentialsClient,
 		DataFlowClient:                &dataFlowClient,
+		DataFlowsClient:               &dataFlowsClient,
 		DatasetClient:                 &DatasetClient,
 		FactoriesClient:               &FactoriesClient,
 		IntegrationRuntimesClient:     &IntegrationRuntimesClient,
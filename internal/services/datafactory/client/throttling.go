@@ -0,0 +1,35 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// configureThrottling wraps the Sender of the client so that, when a semaphore is specified, the number of concurrent
+// requests is limited. The semaphore is shared between all of the Data Factory clients, since the API throttles
+// requests per Subscription rather than per client. Requests which are throttled (429) are already retried by
+// autorest, which honours the `Retry-After` header and caps the number of attempts.
+func configureThrottling(c *autorest.Client, semaphore chan struct{}) {
+	c.Sender = autorest.DecorateSender(c.Sender, withConcurrencyLimit(semaphore))
+}
+
+// withConcurrencyLimit returns a SendDecorator which holds a slot in the semaphore for the duration of each request
+func withConcurrencyLimit(semaphore chan struct{}) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		if semaphore == nil {
+			return s
+		}
+
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			select {
+			case semaphore <- struct{}{}:
+			case <-r.Context().Done():
+				return nil, r.Context().Err()
+			}
+			defer func() { <-semaphore }()
+
+			return s.Do(r)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithConcurrencyLimit(t *testing.T) {
+	limit := 2
+	var current, max int32
+	sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		v := atomic.AddInt32(&current, 1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if v <= m || atomic.CompareAndSwapInt32(&max, m, v) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&current, -1)
+
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+	})
+
+	decorated := autorest.DecorateSender(sender, withConcurrencyLimit(make(chan struct{}, limit)))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/", nil)
+			if _, err := decorated.Do(req); err != nil {
+				t.Errorf("unexpected error: %+v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if int(max) > limit {
+		t.Fatalf("expected at most %d concurrent requests but got %d", limit, max)
+	}
+}
@@ -1,14 +1,18 @@
 package datafactory
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -69,7 +73,12 @@ func flattenDataFactoryParameters(input map[string]*datafactory.ParameterSpecifi
 
 	for k, v := range input {
 		if v != nil {
-			// we only support string parameters at this time
+			// the `parameters` map only supports string parameters, typed parameters are exposed via `parameter`
+			if v.Type != "" && v.Type != datafactory.ParameterTypeString {
+				log.Printf("[DEBUG] Skipping parameter %q since it's of type %q", k, string(v.Type))
+				continue
+			}
+
 			val, ok := v.DefaultValue.(string)
 			if !ok {
 				log.Printf("[DEBUG] Skipping parameter %q since it's not a string", k)
@@ -82,6 +91,267 @@ func flattenDataFactoryParameters(input map[string]*datafactory.ParameterSpecifi
 	return output
 }
 
+func schemaDataFactoryTypedParameters() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeSet,
+		Optional:      true,
+		ConflictsWith: []string{"parameters"},
+		Set:           resourceDataFactoryTypedParameterHash,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"type": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(datafactory.ParameterTypeArray),
+						string(datafactory.ParameterTypeBool),
+						string(datafactory.ParameterTypeFloat),
+						string(datafactory.ParameterTypeInt),
+						string(datafactory.ParameterTypeObject),
+						string(datafactory.ParameterTypeSecureString),
+						string(datafactory.ParameterTypeString),
+					}, false),
+				},
+
+				"default_value": {
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: dataFactoryTypedParameterDefaultValueDiffSuppress,
+				},
+
+				"secure_default_value": {
+					Type:      pluginsdk.TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+}
+
+// resourceDataFactoryTypedParameterHash hashes the normalized `default_value` so that semantically identical
+// values (e.g. `True` and `true` for a Bool) map to the same element - `secure_default_value` is intentionally
+// excluded since it's Sensitive
+func resourceDataFactoryTypedParameterHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		parameterType := datafactory.ParameterType(m["type"].(string))
+		buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+		buf.WriteString(fmt.Sprintf("%s-", string(parameterType)))
+		if v, ok := m["default_value"]; ok {
+			buf.WriteString(normalizeDataFactoryTypedParameterValue(parameterType, v.(string)))
+		}
+	}
+
+	return pluginsdk.HashString(buf.String())
+}
+
+func dataFactoryTypedParameterDefaultValueDiffSuppress(k, old, new string, d *pluginsdk.ResourceData) bool {
+	prefix := strings.TrimSuffix(k, "default_value")
+	parameterType := datafactory.ParameterType(d.Get(prefix + "type").(string))
+	return normalizeDataFactoryTypedParameterValue(parameterType, old) == normalizeDataFactoryTypedParameterValue(parameterType, new)
+}
+
+// normalizeDataFactoryTypedParameterValue returns the value as it'd be returned from the API, or the input
+// as-is if it can't be parsed as the specified type
+func normalizeDataFactoryTypedParameterValue(parameterType datafactory.ParameterType, input string) string {
+	if input == "" {
+		return input
+	}
+
+	expanded, err := expandDataFactoryTypedParameterValue(parameterType, input)
+	if err != nil {
+		return input
+	}
+
+	return flattenDataFactoryTypedParameterValue(parameterType, expanded)
+}
+
+func expandDataFactoryTypedParameters(input []interface{}) (map[string]*datafactory.ParameterSpecification, error) {
+	output := make(map[string]*datafactory.ParameterSpecification)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		name := v["name"].(string)
+		if _, ok := output[name]; ok {
+			return nil, fmt.Errorf("duplicate parameter name %q", name)
+		}
+
+		parameterType := datafactory.ParameterType(v["type"].(string))
+		parameter := &datafactory.ParameterSpecification{
+			Type: parameterType,
+		}
+
+		secureDefaultValue := v["secure_default_value"].(string)
+		if parameterType == datafactory.ParameterTypeSecureString {
+			if v["default_value"].(string) != "" {
+				return nil, fmt.Errorf("`default_value` cannot be specified for parameter %q of type %q - use `secure_default_value` instead", name, string(parameterType))
+			}
+			if secureDefaultValue != "" {
+				parameter.DefaultValue = secureDefaultValue
+			}
+		} else if secureDefaultValue != "" {
+			return nil, fmt.Errorf("`secure_default_value` can only be specified for parameters of type %q but parameter %q is of type %q", string(datafactory.ParameterTypeSecureString), name, string(parameterType))
+		}
+
+		if raw := v["default_value"].(string); raw != "" {
+			defaultValue, err := expandDataFactoryTypedParameterValue(parameterType, raw)
+			if err != nil {
+				return nil, fmt.Errorf("parsing `default_value` for parameter %q: %+v", name, err)
+			}
+			parameter.DefaultValue = defaultValue
+		}
+
+		output[name] = parameter
+	}
+
+	return output, nil
+}
+
+func expandDataFactoryTypedParameterValue(parameterType datafactory.ParameterType, input string) (interface{}, error) {
+	switch parameterType {
+	case datafactory.ParameterTypeBool:
+		return strconv.ParseBool(input)
+
+	case datafactory.ParameterTypeFloat:
+		return strconv.ParseFloat(input, 64)
+
+	case datafactory.ParameterTypeInt:
+		return strconv.ParseInt(input, 10, 64)
+
+	case datafactory.ParameterTypeArray:
+		var output []interface{}
+		if err := json.Unmarshal([]byte(input), &output); err != nil {
+			return nil, fmt.Errorf("expected a JSON array: %+v", err)
+		}
+		return output, nil
+
+	case datafactory.ParameterTypeObject:
+		var output map[string]interface{}
+		if err := json.Unmarshal([]byte(input), &output); err != nil {
+			return nil, fmt.Errorf("expected a JSON object: %+v", err)
+		}
+		return output, nil
+	}
+
+	return input, nil
+}
+
+func flattenDataFactoryTypedParameters(input map[string]*datafactory.ParameterSpecification, existing []interface{}) []interface{} {
+	// the API doesn't return the default value for SecureString parameters, so we pull it from the config/state
+	existingSecureValues := make(map[string]string)
+	for _, item := range existing {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		if v["type"].(string) == string(datafactory.ParameterTypeSecureString) {
+			existingSecureValues[v["name"].(string)] = v["secure_default_value"].(string)
+		}
+	}
+
+	output := make([]interface{}, 0)
+	for name, v := range input {
+		if v == nil {
+			continue
+		}
+
+		parameterType := v.Type
+		if parameterType == "" {
+			parameterType = datafactory.ParameterTypeString
+		}
+
+		defaultValue := ""
+		secureDefaultValue := ""
+		if parameterType == datafactory.ParameterTypeSecureString {
+			secureDefaultValue = flattenDataFactoryTypedParameterValue(parameterType, v.DefaultValue)
+			if v.DefaultValue == nil {
+				secureDefaultValue = existingSecureValues[name]
+			}
+		} else {
+			defaultValue = flattenDataFactoryTypedParameterValue(parameterType, v.DefaultValue)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":                 name,
+			"type":                 string(parameterType),
+			"default_value":        defaultValue,
+			"secure_default_value": secureDefaultValue,
+		})
+	}
+
+	return output
+}
+
+func flattenDataFactoryTypedParameterValue(parameterType datafactory.ParameterType, input interface{}) string {
+	if input == nil {
+		return ""
+	}
+
+	switch v := input.(type) {
+	case string:
+		return v
+
+	case bool:
+		return strconv.FormatBool(v)
+
+	case float64:
+		// JSON numbers are unmarshalled as float64, so Int's need converting back
+		if parameterType == datafactory.ParameterTypeInt {
+			return strconv.FormatInt(int64(v), 10)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	output, err := json.Marshal(input)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to serialize default value of type %q: %+v", string(parameterType), err)
+		return ""
+	}
+
+	return string(output)
+}
+
+// setDataFactoryParameters sets the `parameters` map when only string parameters are in use, and otherwise
+// the typed `parameter` blocks - so that parameter types round-trip regardless of which field is configured
+func setDataFactoryParameters(d *pluginsdk.ResourceData, input map[string]*datafactory.ParameterSpecification) error {
+	useTypedParameters := len(d.Get("parameter").(*pluginsdk.Set).List()) > 0
+	for _, v := range input {
+		if v != nil && v.Type != "" && v.Type != datafactory.ParameterTypeString {
+			useTypedParameters = true
+		}
+	}
+
+	parameters := make(map[string]interface{})
+	typedParameters := make([]interface{}, 0)
+	if useTypedParameters {
+		typedParameters = flattenDataFactoryTypedParameters(input, d.Get("parameter").(*pluginsdk.Set).List())
+	} else {
+		parameters = flattenDataFactoryParameters(input)
+	}
+
+	if err := d.Set("parameters", parameters); err != nil {
+		return fmt.Errorf("setting `parameters`: %+v", err)
+	}
+
+	if err := d.Set("parameter", typedParameters); err != nil {
+		return fmt.Errorf("setting `parameter`: %+v", err)
+	}
+
+	return nil
+}
+
 func flattenDataFactoryAnnotations(input *[]interface{}) []string {
 	annotations := make([]string, 0)
 	if input == nil {
@@ -234,6 +504,53 @@ func expandAzureKeyVaultSecretReference(input []interface{}) *datafactory.AzureK
 	}
 }
 
+// validateAzureKeyVaultSecretReferenceLinkedServices ensures that the Linked Service referenced by each of the
+// specified `key_vault_*` blocks is an Azure Key Vault Linked Service - whilst the API accepts a reference to
+// any type of Linked Service, doing so results in a Data Factory which fails at runtime
+func validateAzureKeyVaultSecretReferenceLinkedServices(ctx context.Context, client *datafactory.LinkedServicesClient, resourceGroup, dataFactoryName string, inputs ...[]interface{}) error {
+	validated := make(map[string]struct{})
+
+	for _, input := range inputs {
+		for _, raw := range input {
+			if raw == nil {
+				continue
+			}
+
+			linkedServiceName := raw.(map[string]interface{})["linked_service_name"].(string)
+			// references containing an expression can only be resolved at runtime
+			if linkedServiceName == "" || strings.HasPrefix(linkedServiceName, "@") {
+				continue
+			}
+
+			// names within a Data Factory are case-insensitive
+			if _, ok := validated[strings.ToLower(linkedServiceName)]; ok {
+				continue
+			}
+
+			resp, err := client.Get(ctx, resourceGroup, dataFactoryName, linkedServiceName, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("the Azure Key Vault Linked Service %q was not found in Data Factory %q (Resource Group %q)", linkedServiceName, dataFactoryName, resourceGroup)
+				}
+
+				return fmt.Errorf("retrieving Linked Service %q (Data Factory %q / Resource Group %q): %+v", linkedServiceName, dataFactoryName, resourceGroup, err)
+			}
+
+			if resp.Properties == nil {
+				return fmt.Errorf("retrieving Linked Service %q (Data Factory %q / Resource Group %q): `properties` was nil", linkedServiceName, dataFactoryName, resourceGroup)
+			}
+
+			if _, ok := resp.Properties.AsAzureKeyVaultLinkedService(); !ok {
+				return fmt.Errorf("the Linked Service %q (Data Factory %q / Resource Group %q) must be an Azure Key Vault Linked Service to be used in a `key_vault_*` block", linkedServiceName, dataFactoryName, resourceGroup)
+			}
+
+			validated[strings.ToLower(linkedServiceName)] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
 func flattenAzureKeyVaultConnectionString(input map[string]interface{}) []interface{} {
 	if input == nil {
 		return nil
@@ -519,3 +836,50 @@ func expandDataFactoryDatasetCompression(d *pluginsdk.ResourceData) datafactory.
 
 	return nil
 }
+
+// spliceDataFactorySecureStrings splices the secure values into the `typeProperties` of a Linked Service as
+// SecureStrings - the keys are the (dot-separated) paths of the properties within the `typeProperties`, so that
+// nested properties (e.g. `servicePrincipalCredential.password`) can also be specified
+func spliceDataFactorySecureStrings(typeProperties map[string]interface{}, secure map[string]interface{}) error {
+	keys := make([]string, 0, len(secure))
+	for k := range secure {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		segments := strings.Split(key, ".")
+		current := typeProperties
+		for i, segment := range segments {
+			if segment == "" {
+				return fmt.Errorf("the secure property %q contains an empty segment", key)
+			}
+
+			if i == len(segments)-1 {
+				if _, exists := current[segment]; exists {
+					return fmt.Errorf("the secure property %q is also specified within `type_properties_json`", key)
+				}
+
+				current[segment] = map[string]interface{}{
+					"type":  "SecureString",
+					"value": secure[key],
+				}
+				break
+			}
+
+			next, exists := current[segment]
+			if !exists {
+				next = make(map[string]interface{})
+				current[segment] = next
+			}
+
+			nested, ok := next.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("the secure property %q can't be set since %q within `type_properties_json` isn't a JSON object", key, strings.Join(segments[:i+1], "."))
+			}
+			current = nested
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,584 @@
+package datafactory
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/changedatacaptures"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	dataFactoryChangeDataCaptureModeMicrobatch = "Microbatch"
+	dataFactoryChangeDataCaptureModeRealtime   = "Realtime"
+
+	dataFactoryChangeDataCaptureStatusRunning = "Running"
+)
+
+func resourceDataFactoryChangeDataCapture() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryChangeDataCaptureCreateUpdate,
+		Read:   resourceDataFactoryChangeDataCaptureRead,
+		Update: resourceDataFactoryChangeDataCaptureCreateUpdate,
+		Delete: resourceDataFactoryChangeDataCaptureDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := changedatacaptures.ParseChangeDataCaptureID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"source": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"linked_service_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						// e.g. `AzureSqlDatabase` or `AzureBlobFS`
+						"linked_service_type": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"table_names": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"target": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"linked_service_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"linked_service_type": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"table_names": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"mapping": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"source_linked_service_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"source_table_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"target_table_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"policy": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  dataFactoryChangeDataCaptureModeMicrobatch,
+							ValidateFunc: validation.StringInSlice([]string{
+								dataFactoryChangeDataCaptureModeMicrobatch,
+								dataFactoryChangeDataCaptureModeRealtime,
+							}, false),
+						},
+
+						"frequency": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(changedatacaptures.PossibleValuesForFrequencyType(), false),
+						},
+
+						"interval": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"started": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"folder": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			policies := d.Get("policy").([]interface{})
+			if len(policies) == 0 || policies[0] == nil {
+				return nil
+			}
+
+			// a recurrence is only applicable (and is then required) when the changes are processed in micro-batches
+			policy := policies[0].(map[string]interface{})
+			hasRecurrence := policy["frequency"].(string) != "" || policy["interval"].(int) != 0
+			if policy["mode"].(string) == dataFactoryChangeDataCaptureModeRealtime && hasRecurrence {
+				return fmt.Errorf("`frequency` and `interval` cannot be specified when `mode` is `%s`", dataFactoryChangeDataCaptureModeRealtime)
+			}
+			if policy["mode"].(string) == dataFactoryChangeDataCaptureModeMicrobatch && (policy["frequency"].(string) == "" || policy["interval"].(int) == 0) {
+				return fmt.Errorf("`frequency` and `interval` must be specified when `mode` is `%s`", dataFactoryChangeDataCaptureModeMicrobatch)
+			}
+
+			return nil
+		}),
+	}
+}
+
+func resourceDataFactoryChangeDataCaptureCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.ChangeDataCapturesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := changedatacaptures.NewChangeDataCaptureID(dataFactoryId.SubscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
+
+	running := false
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_data_factory_change_data_capture", id.ID())
+		}
+	} else {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		running = dataFactoryChangeDataCaptureIsRunning(existing.Model)
+
+		// a Change Data Capture has to be stopped before it can be updated
+		if running && (d.HasChangesExcept("started") || !d.Get("started").(bool)) {
+			log.Printf("[DEBUG] Stopping %s..", id)
+			if _, err := client.Stop(ctx, id); err != nil {
+				return fmt.Errorf("stopping %s: %+v", id, err)
+			}
+			running = false
+		}
+	}
+
+	if d.IsNewResource() || d.HasChangesExcept("started") {
+		changeDataCapture := changedatacaptures.ChangeDataCapture{
+			Policy:                expandDataFactoryChangeDataCapturePolicy(d.Get("policy").([]interface{})),
+			SourceConnectionsInfo: expandDataFactoryChangeDataCaptureSources(d.Get("source").([]interface{})),
+			TargetConnectionsInfo: expandDataFactoryChangeDataCaptureTargets(d.Get("target").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			changeDataCapture.Description = utils.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("folder"); ok {
+			changeDataCapture.Folder = &changedatacaptures.ChangeDataCaptureFolder{
+				Name: utils.String(v.(string)),
+			}
+		}
+
+		payload := changedatacaptures.ChangeDataCaptureResource{
+			Properties: changeDataCapture,
+		}
+
+		if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	if d.Get("started").(bool) && !running {
+		log.Printf("[DEBUG] Starting %s..", id)
+		if _, err := client.Start(ctx, id); err != nil {
+			return fmt.Errorf("starting %s: %+v", id, err)
+		}
+	}
+
+	return resourceDataFactoryChangeDataCaptureRead(d, meta)
+}
+
+func resourceDataFactoryChangeDataCaptureRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.ChangeDataCapturesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := changedatacaptures.ParseChangeDataCaptureID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("data_factory_id", parse.NewDataFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+
+		description := ""
+		if props.Description != nil {
+			description = *props.Description
+		}
+		d.Set("description", description)
+
+		folder := ""
+		if props.Folder != nil && props.Folder.Name != nil {
+			folder = *props.Folder.Name
+		}
+		d.Set("folder", folder)
+
+		if err := d.Set("policy", flattenDataFactoryChangeDataCapturePolicy(props.Policy)); err != nil {
+			return fmt.Errorf("setting `policy`: %+v", err)
+		}
+
+		if err := d.Set("source", flattenDataFactoryChangeDataCaptureSources(props.SourceConnectionsInfo)); err != nil {
+			return fmt.Errorf("setting `source`: %+v", err)
+		}
+
+		if err := d.Set("target", flattenDataFactoryChangeDataCaptureTargets(props.TargetConnectionsInfo)); err != nil {
+			return fmt.Errorf("setting `target`: %+v", err)
+		}
+
+		d.Set("started", dataFactoryChangeDataCaptureIsRunning(model))
+	}
+
+	return nil
+}
+
+func resourceDataFactoryChangeDataCaptureDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.ChangeDataCapturesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := changedatacaptures.ParseChangeDataCaptureID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// a running Change Data Capture can't be deleted, so it needs to be stopped first
+	if dataFactoryChangeDataCaptureIsRunning(existing.Model) {
+		log.Printf("[DEBUG] Stopping %s..", *id)
+		if _, err := client.Stop(ctx, *id); err != nil {
+			return fmt.Errorf("stopping %s: %+v", *id, err)
+		}
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func dataFactoryChangeDataCaptureIsRunning(input *changedatacaptures.ChangeDataCaptureResource) bool {
+	return input != nil && input.Properties.Status != nil && strings.EqualFold(*input.Properties.Status, dataFactoryChangeDataCaptureStatusRunning)
+}
+
+func expandDataFactoryChangeDataCapturePolicy(input []interface{}) changedatacaptures.MapperPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return changedatacaptures.MapperPolicy{}
+	}
+
+	raw := input[0].(map[string]interface{})
+	policy := changedatacaptures.MapperPolicy{
+		Mode: utils.String(raw["mode"].(string)),
+	}
+
+	if frequency := raw["frequency"].(string); frequency != "" {
+		frequencyType := changedatacaptures.FrequencyType(frequency)
+		policy.Recurrence = &changedatacaptures.MapperPolicyRecurrence{
+			Frequency: &frequencyType,
+			Interval:  utils.Int64(int64(raw["interval"].(int))),
+		}
+	}
+
+	return policy
+}
+
+func flattenDataFactoryChangeDataCapturePolicy(input changedatacaptures.MapperPolicy) []interface{} {
+	mode := dataFactoryChangeDataCaptureModeMicrobatch
+	if input.Mode != nil {
+		mode = *input.Mode
+	}
+
+	frequency := ""
+	interval := 0
+	if recurrence := input.Recurrence; recurrence != nil {
+		if recurrence.Frequency != nil {
+			frequency = string(*recurrence.Frequency)
+		}
+		if recurrence.Interval != nil {
+			interval = int(*recurrence.Interval)
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":      mode,
+			"frequency": frequency,
+			"interval":  interval,
+		},
+	}
+}
+
+func expandDataFactoryChangeDataCaptureConnection(linkedServiceName, linkedServiceType string) *changedatacaptures.MapperConnection {
+	return &changedatacaptures.MapperConnection{
+		LinkedService: &changedatacaptures.LinkedServiceReference{
+			ReferenceName: linkedServiceName,
+			Type:          changedatacaptures.TypeLinkedServiceReference,
+		},
+		LinkedServiceType: utils.String(linkedServiceType),
+		Type:              changedatacaptures.ConnectionTypeLinkedservicetype,
+		IsInlineDataset:   utils.Bool(true),
+	}
+}
+
+func flattenDataFactoryChangeDataCaptureConnection(input *changedatacaptures.MapperConnection) (linkedServiceName string, linkedServiceType string) {
+	if input == nil {
+		return "", ""
+	}
+
+	if input.LinkedService != nil {
+		linkedServiceName = input.LinkedService.ReferenceName
+	}
+	if input.LinkedServiceType != nil {
+		linkedServiceType = *input.LinkedServiceType
+	}
+	return linkedServiceName, linkedServiceType
+}
+
+func expandDataFactoryChangeDataCaptureTables(input []interface{}) *[]changedatacaptures.MapperTable {
+	result := make([]changedatacaptures.MapperTable, 0)
+	for _, v := range input {
+		result = append(result, changedatacaptures.MapperTable{
+			Name: utils.String(v.(string)),
+		})
+	}
+	return &result
+}
+
+func flattenDataFactoryChangeDataCaptureTables(input *[]changedatacaptures.MapperTable) []interface{} {
+	result := make([]interface{}, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		if v.Name != nil {
+			result = append(result, *v.Name)
+		}
+	}
+	return result
+}
+
+func expandDataFactoryChangeDataCaptureSources(input []interface{}) []changedatacaptures.MapperSourceConnectionsInfo {
+	result := make([]changedatacaptures.MapperSourceConnectionsInfo, 0)
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		result = append(result, changedatacaptures.MapperSourceConnectionsInfo{
+			Connection:     expandDataFactoryChangeDataCaptureConnection(raw["linked_service_name"].(string), raw["linked_service_type"].(string)),
+			SourceEntities: expandDataFactoryChangeDataCaptureTables(raw["table_names"].([]interface{})),
+		})
+	}
+	return result
+}
+
+func flattenDataFactoryChangeDataCaptureSources(input []changedatacaptures.MapperSourceConnectionsInfo) []interface{} {
+	result := make([]interface{}, 0)
+	for _, v := range input {
+		linkedServiceName, linkedServiceType := flattenDataFactoryChangeDataCaptureConnection(v.Connection)
+		result = append(result, map[string]interface{}{
+			"linked_service_name": linkedServiceName,
+			"linked_service_type": linkedServiceType,
+			"table_names":         flattenDataFactoryChangeDataCaptureTables(v.SourceEntities),
+		})
+	}
+	return result
+}
+
+func expandDataFactoryChangeDataCaptureTargets(input []interface{}) []changedatacaptures.MapperTargetConnectionsInfo {
+	result := make([]changedatacaptures.MapperTargetConnectionsInfo, 0)
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		mappings := make([]changedatacaptures.DataMapperMapping, 0)
+		for _, m := range raw["mapping"].([]interface{}) {
+			mapping := m.(map[string]interface{})
+			connectionType := changedatacaptures.ConnectionTypeLinkedservicetype
+			mappings = append(mappings, changedatacaptures.DataMapperMapping{
+				SourceConnectionReference: &changedatacaptures.MapperConnectionReference{
+					ConnectionName: utils.String(mapping["source_linked_service_name"].(string)),
+					Type:           &connectionType,
+				},
+				SourceEntityName: utils.String(mapping["source_table_name"].(string)),
+				TargetEntityName: utils.String(mapping["target_table_name"].(string)),
+			})
+		}
+
+		relationships := make([]interface{}, 0)
+		result = append(result, changedatacaptures.MapperTargetConnectionsInfo{
+			Connection:         expandDataFactoryChangeDataCaptureConnection(raw["linked_service_name"].(string), raw["linked_service_type"].(string)),
+			DataMapperMappings: &mappings,
+			Relationships:      &relationships,
+			TargetEntities:     expandDataFactoryChangeDataCaptureTables(raw["table_names"].([]interface{})),
+		})
+	}
+	return result
+}
+
+func flattenDataFactoryChangeDataCaptureTargets(input []changedatacaptures.MapperTargetConnectionsInfo) []interface{} {
+	result := make([]interface{}, 0)
+	for _, v := range input {
+		linkedServiceName, linkedServiceType := flattenDataFactoryChangeDataCaptureConnection(v.Connection)
+
+		mappings := make([]interface{}, 0)
+		if v.DataMapperMappings != nil {
+			for _, mapping := range *v.DataMapperMappings {
+				sourceLinkedServiceName := ""
+				if mapping.SourceConnectionReference != nil && mapping.SourceConnectionReference.ConnectionName != nil {
+					sourceLinkedServiceName = *mapping.SourceConnectionReference.ConnectionName
+				}
+
+				sourceTableName := ""
+				if mapping.SourceEntityName != nil {
+					sourceTableName = *mapping.SourceEntityName
+				}
+
+				targetTableName := ""
+				if mapping.TargetEntityName != nil {
+					targetTableName = *mapping.TargetEntityName
+				}
+
+				mappings = append(mappings, map[string]interface{}{
+					"source_linked_service_name": sourceLinkedServiceName,
+					"source_table_name":          sourceTableName,
+					"target_table_name":          targetTableName,
+				})
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"linked_service_name": linkedServiceName,
+			"linked_service_type": linkedServiceType,
+			"table_names":         flattenDataFactoryChangeDataCaptureTables(v.TargetEntities),
+			"mapping":             mappings,
+		})
+	}
+	return result
+}
@@ -0,0 +1,225 @@
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/changedatacaptures"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ChangeDataCaptureResource struct{}
+
+func TestAccDataFactoryChangeDataCapture_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_change_data_capture", "test")
+	r := ChangeDataCaptureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryChangeDataCapture_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_change_data_capture", "test")
+	r := ChangeDataCaptureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataFactoryChangeDataCapture_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_change_data_capture", "test")
+	r := ChangeDataCaptureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ChangeDataCaptureResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := changedatacaptures.ParseChangeDataCaptureID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DataFactory.ChangeDataCapturesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ChangeDataCaptureResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_change_data_capture" "test" {
+  name            = "acctestcdc%d"
+  data_factory_id = azurerm_data_factory.test.id
+
+  source {
+    linked_service_name = azurerm_data_factory_linked_service_azure_sql_database.source.name
+    linked_service_type = "AzureSqlDatabase"
+    table_names         = ["dbo.source"]
+  }
+
+  target {
+    linked_service_name = azurerm_data_factory_linked_service_azure_sql_database.target.name
+    linked_service_type = "AzureSqlDatabase"
+    table_names         = ["dbo.target"]
+  }
+
+  policy {
+    frequency = "Minute"
+    interval  = 15
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ChangeDataCaptureResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_change_data_capture" "test" {
+  name            = "acctestcdc%d"
+  data_factory_id = azurerm_data_factory.test.id
+  description     = "description for change data capture"
+  folder          = "folder1"
+
+  source {
+    linked_service_name = azurerm_data_factory_linked_service_azure_sql_database.source.name
+    linked_service_type = "AzureSqlDatabase"
+    table_names         = ["dbo.source", "dbo.source2"]
+  }
+
+  target {
+    linked_service_name = azurerm_data_factory_linked_service_azure_sql_database.target.name
+    linked_service_type = "AzureSqlDatabase"
+    table_names         = ["dbo.target", "dbo.target2"]
+
+    mapping {
+      source_linked_service_name = azurerm_data_factory_linked_service_azure_sql_database.source.name
+      source_table_name          = "dbo.source"
+      target_table_name          = "dbo.target"
+    }
+
+    mapping {
+      source_linked_service_name = azurerm_data_factory_linked_service_azure_sql_database.source.name
+      source_table_name          = "dbo.source2"
+      target_table_name          = "dbo.target2"
+    }
+  }
+
+  policy {
+    mode      = "Microbatch"
+    frequency = "Hour"
+    interval  = 1
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ChangeDataCaptureResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_change_data_capture" "import" {
+  name            = azurerm_data_factory_change_data_capture.test.name
+  data_factory_id = azurerm_data_factory_change_data_capture.test.data_factory_id
+
+  source {
+    linked_service_name = azurerm_data_factory_linked_service_azure_sql_database.source.name
+    linked_service_type = "AzureSqlDatabase"
+    table_names         = ["dbo.source"]
+  }
+
+  target {
+    linked_service_name = azurerm_data_factory_linked_service_azure_sql_database.target.name
+    linked_service_type = "AzureSqlDatabase"
+    table_names         = ["dbo.target"]
+  }
+
+  policy {
+    frequency = "Minute"
+    interval  = 15
+  }
+}
+`, r.basic(data))
+}
+
+func (ChangeDataCaptureResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_linked_service_azure_sql_database" "source" {
+  name                = "acctestlssqlsource%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_factory_name   = azurerm_data_factory.test.name
+  connection_string   = "data source=serverhostname;initial catalog=source;user id=testUser;Password=test;integrated security=False;encrypt=True;connection timeout=30"
+}
+
+resource "azurerm_data_factory_linked_service_azure_sql_database" "target" {
+  name                = "acctestlssqltarget%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_factory_name   = azurerm_data_factory.test.name
+  connection_string   = "data source=serverhostname;initial catalog=target;user id=testUser;Password=test;integrated security=False;encrypt=True;connection timeout=30"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
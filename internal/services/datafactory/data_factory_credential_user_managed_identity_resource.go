@@ -0,0 +1,225 @@
+package datafactory
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/credentials"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	msiParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
+	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataFactoryCredentialUserManagedIdentity() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryCredentialUserManagedIdentityCreateUpdate,
+		Read:   resourceDataFactoryCredentialUserManagedIdentityRead,
+		Update: resourceDataFactoryCredentialUserManagedIdentityCreateUpdate,
+		Delete: resourceDataFactoryCredentialUserManagedIdentityDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := credentials.ParseCredentialID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LinkedServiceDatasetName,
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"identity_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: msiValidate.UserAssignedIdentityID,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"annotations": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryCredentialUserManagedIdentityCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.CredentialsClient
+	factoriesClient := meta.(*clients.Client).DataFactory.FactoriesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := credentials.NewCredentialID(dataFactoryId.SubscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_data_factory_credential_user_managed_identity", id.ID())
+		}
+	}
+
+	identityId, err := msiParse.UserAssignedIdentityID(d.Get("identity_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the User Assigned Identity must be assigned to the Data Factory, otherwise the API returns a generic 400
+	factory, err := factoriesClient.Get(ctx, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *dataFactoryId, err)
+	}
+	assigned := false
+	if factory.Identity != nil {
+		for k := range factory.Identity.UserAssignedIdentities {
+			if strings.EqualFold(k, identityId.ID()) {
+				assigned = true
+				break
+			}
+		}
+	}
+	if !assigned {
+		return fmt.Errorf("the User Assigned Identity %q must be assigned to %s (within the `identity` block) before it can be used for a Credential", identityId.ID(), *dataFactoryId)
+	}
+
+	credential := credentials.ManagedIdentityCredential{
+		TypeProperties: &credentials.ManagedIdentityTypeProperties{
+			ResourceId: utils.String(identityId.ID()),
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		credential.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		credential.Annotations = &annotations
+	}
+
+	payload := credentials.CredentialResource{
+		Properties: credential,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryCredentialUserManagedIdentityRead(d, meta)
+}
+
+func resourceDataFactoryCredentialUserManagedIdentityRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.CredentialsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := credentials.ParseCredentialID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("data_factory_id", parse.NewDataFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName).ID())
+
+	if model := resp.Model; model != nil {
+		credential, ok := model.Properties.(credentials.ManagedIdentityCredential)
+		if !ok {
+			return fmt.Errorf("retrieving %s: expected a User Assigned Managed Identity Credential but got %+v", *id, model.Properties)
+		}
+
+		identityId := ""
+		if props := credential.TypeProperties; props != nil && props.ResourceId != nil {
+			parsed, err := msiParse.UserAssignedIdentityIDInsensitively(*props.ResourceId)
+			if err != nil {
+				return err
+			}
+			identityId = parsed.ID()
+		}
+		d.Set("identity_id", identityId)
+
+		description := ""
+		if credential.Description != nil {
+			description = *credential.Description
+		}
+		d.Set("description", description)
+
+		if err := d.Set("annotations", flattenDataFactoryAnnotations(credential.Annotations)); err != nil {
+			return fmt.Errorf("setting `annotations`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceDataFactoryCredentialUserManagedIdentityDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.CredentialsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := credentials.ParseCredentialID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
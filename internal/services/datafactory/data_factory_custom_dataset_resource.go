@@ -115,13 +115,16 @@ func resourceDataFactoryCustomDataset() *pluginsdk.Resource {
 			},
 
 			"parameters": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameter"},
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
 			},
 
+			"parameter": schemaDataFactoryTypedParameters(),
+
 			"schema_json": {
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
@@ -189,6 +192,14 @@ func resourceDataFactoryCustomDatasetCreateUpdate(d *pluginsdk.ResourceData, met
 		props["parameters"] = expandDataFactoryParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("parameter"); ok {
+		parameters, err := expandDataFactoryTypedParameters(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		props["parameters"] = parameters
+	}
+
 	if v, ok := d.GetOk("schema_json"); ok {
 		schemaJson := fmt.Sprintf(`{ "schema": %s }`, v.(string))
 		if err = json.Unmarshal([]byte(schemaJson), &props); err != nil {
@@ -298,8 +309,8 @@ func resourceDataFactoryCustomDatasetRead(d *pluginsdk.ResourceData, meta interf
 		}
 		delete(m, "parameters")
 	}
-	if err := d.Set("parameters", flattenDataFactoryParameters(parameters)); err != nil {
-		return fmt.Errorf("setting `parameters`: %+v", err)
+	if err := setDataFactoryParameters(d, parameters); err != nil {
+		return err
 	}
 
 	var linkedService *datafactory.LinkedServiceReference
@@ -85,13 +85,16 @@ func resourceDataFactoryDatasetAzureBlob() *pluginsdk.Resource {
 			},
 
 			"parameters": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameter"},
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
 			},
 
+			"parameter": schemaDataFactoryTypedParameters(),
+
 			"description": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -212,6 +215,14 @@ func resourceDataFactoryDatasetAzureBlobCreateUpdate(d *pluginsdk.ResourceData,
 		azureBlobTableset.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("parameter"); ok {
+		parameters, err := expandDataFactoryTypedParameters(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		azureBlobTableset.Parameters = parameters
+	}
+
 	if v, ok := d.GetOk("annotations"); ok {
 		annotations := v.([]interface{})
 		azureBlobTableset.Annotations = &annotations
@@ -287,9 +298,8 @@ func resourceDataFactoryDatasetAzureBlobRead(d *pluginsdk.ResourceData, meta int
 		d.Set("description", azureBlobTable.Description)
 	}
 
-	parameters := flattenDataFactoryParameters(azureBlobTable.Parameters)
-	if err := d.Set("parameters", parameters); err != nil {
-		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	if err := setDataFactoryParameters(d, azureBlobTable.Parameters); err != nil {
+		return err
 	}
 
 	annotations := flattenDataFactoryAnnotations(azureBlobTable.Annotations)
@@ -135,13 +135,16 @@ func resourceDataFactoryDatasetBinary() *pluginsdk.Resource {
 			},
 
 			"parameters": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameter"},
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
 			},
 
+			"parameter": schemaDataFactoryTypedParameters(),
+
 			"description": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -259,6 +262,14 @@ func resourceDataFactoryDatasetBinaryCreateUpdate(d *pluginsdk.ResourceData, met
 		binaryTableset.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("parameter"); ok {
+		parameters, err := expandDataFactoryTypedParameters(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		binaryTableset.Parameters = parameters
+	}
+
 	if v, ok := d.GetOk("annotations"); ok {
 		annotations := v.([]interface{})
 		binaryTableset.Annotations = &annotations
@@ -322,8 +333,8 @@ func resourceDataFactoryDatasetBinaryRead(d *pluginsdk.ResourceData, meta interf
 		d.Set("description", binaryTable.Description)
 	}
 
-	if err := d.Set("parameters", flattenDataFactoryParameters(binaryTable.Parameters)); err != nil {
-		return fmt.Errorf("setting `parameters`: %+v", err)
+	if err := setDataFactoryParameters(d, binaryTable.Parameters); err != nil {
+		return err
 	}
 
 	annotations := flattenDataFactoryAnnotations(binaryTable.Annotations)
@@ -67,13 +67,16 @@ func resourceDataFactoryDatasetCosmosDbSQLAPI() *pluginsdk.Resource {
 			},
 
 			"parameters": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameter"},
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
 			},
 
+			"parameter": schemaDataFactoryTypedParameters(),
+
 			"description": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -197,6 +200,14 @@ func resourceDataFactoryDatasetCosmosDbSQLAPICreateUpdate(d *pluginsdk.ResourceD
 		cosmosDbTableset.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("parameter"); ok {
+		parameters, err := expandDataFactoryTypedParameters(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		cosmosDbTableset.Parameters = parameters
+	}
+
 	if v, ok := d.GetOk("annotations"); ok {
 		annotations := v.([]interface{})
 		cosmosDbTableset.Annotations = &annotations
@@ -272,9 +283,8 @@ func resourceDataFactoryDatasetCosmosDbSQLAPIRead(d *pluginsdk.ResourceData, met
 		d.Set("description", cosmosDbTable.Description)
 	}
 
-	parameters := flattenDataFactoryParameters(cosmosDbTable.Parameters)
-	if err := d.Set("parameters", parameters); err != nil {
-		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	if err := setDataFactoryParameters(d, cosmosDbTable.Parameters); err != nil {
+		return err
 	}
 
 	annotations := flattenDataFactoryAnnotations(cosmosDbTable.Annotations)
@@ -188,13 +188,16 @@ func resourceDataFactoryDatasetDelimitedText() *pluginsdk.Resource {
 			},
 
 			"parameters": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameter"},
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
 			},
 
+			"parameter": schemaDataFactoryTypedParameters(),
+
 			"description": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -357,6 +360,14 @@ func resourceDataFactoryDatasetDelimitedTextCreateUpdate(d *pluginsdk.ResourceDa
 		delimited_textTableset.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("parameter"); ok {
+		parameters, err := expandDataFactoryTypedParameters(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		delimited_textTableset.Parameters = parameters
+	}
+
 	if v, ok := d.GetOk("annotations"); ok {
 		annotations := v.([]interface{})
 		delimited_textTableset.Annotations = &annotations
@@ -429,9 +440,8 @@ func resourceDataFactoryDatasetDelimitedTextRead(d *pluginsdk.ResourceData, meta
 		d.Set("description", delimited_textTable.Description)
 	}
 
-	parameters := flattenDataFactoryParameters(delimited_textTable.Parameters)
-	if err := d.Set("parameters", parameters); err != nil {
-		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	if err := setDataFactoryParameters(d, delimited_textTable.Parameters); err != nil {
+		return err
 	}
 
 	annotations := flattenDataFactoryAnnotations(delimited_textTable.Annotations)
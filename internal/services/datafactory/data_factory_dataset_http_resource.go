@@ -81,13 +81,16 @@ func resourceDataFactoryDatasetHTTP() *pluginsdk.Resource {
 			},
 
 			"parameters": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameter"},
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
 			},
 
+			"parameter": schemaDataFactoryTypedParameters(),
+
 			"description": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -213,6 +216,14 @@ func resourceDataFactoryDatasetHTTPCreateUpdate(d *pluginsdk.ResourceData, meta
 		httpTableset.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("parameter"); ok {
+		parameters, err := expandDataFactoryTypedParameters(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		httpTableset.Parameters = parameters
+	}
+
 	if v, ok := d.GetOk("annotations"); ok {
 		annotations := v.([]interface{})
 		httpTableset.Annotations = &annotations
@@ -288,9 +299,8 @@ func resourceDataFactoryDatasetHTTPRead(d *pluginsdk.ResourceData, meta interfac
 		d.Set("description", httpTable.Description)
 	}
 
-	parameters := flattenDataFactoryParameters(httpTable.Parameters)
-	if err := d.Set("parameters", parameters); err != nil {
-		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	if err := setDataFactoryParameters(d, httpTable.Parameters); err != nil {
+		return err
 	}
 
 	annotations := flattenDataFactoryAnnotations(httpTable.Annotations)
@@ -1,6 +1,7 @@
 package datafactory
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -123,13 +124,16 @@ func resourceDataFactoryDatasetJSON() *pluginsdk.Resource {
 			},
 
 			"parameters": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameter"},
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
 			},
 
+			"parameter": schemaDataFactoryTypedParameters(),
+
 			"description": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -158,9 +162,19 @@ func resourceDataFactoryDatasetJSON() *pluginsdk.Resource {
 				},
 			},
 
+			"schema_json": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: suppressJsonOrderingDifference,
+				ConflictsWith:    []string{"schema_column"},
+			},
+
 			"schema_column": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"schema_json"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -259,6 +273,14 @@ func resourceDataFactoryDatasetJSONCreateUpdate(d *pluginsdk.ResourceData, meta
 		jsonTableset.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("parameter"); ok {
+		parameters, err := expandDataFactoryTypedParameters(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		jsonTableset.Parameters = parameters
+	}
+
 	if v, ok := d.GetOk("annotations"); ok {
 		annotations := v.([]interface{})
 		jsonTableset.Annotations = &annotations
@@ -272,6 +294,14 @@ func resourceDataFactoryDatasetJSONCreateUpdate(d *pluginsdk.ResourceData, meta
 		jsonTableset.Structure = expandDataFactoryDatasetStructure(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("schema_json"); ok {
+		var schema interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &schema); err != nil {
+			return fmt.Errorf("parsing `schema_json`: %+v", err)
+		}
+		jsonTableset.Schema = schema
+	}
+
 	datasetType := string(datafactory.TypeBasicDatasetTypeJSON)
 	dataset := datafactory.DatasetResource{
 		Properties: &jsonTableset,
@@ -334,9 +364,8 @@ func resourceDataFactoryDatasetJSONRead(d *pluginsdk.ResourceData, meta interfac
 		d.Set("description", jsonTable.Description)
 	}
 
-	parameters := flattenDataFactoryParameters(jsonTable.Parameters)
-	if err := d.Set("parameters", parameters); err != nil {
-		return fmt.Errorf("Error setting `parameters`: %+v", err)
+	if err := setDataFactoryParameters(d, jsonTable.Parameters); err != nil {
+		return err
 	}
 
 	annotations := flattenDataFactoryAnnotations(jsonTable.Annotations)
@@ -381,6 +410,16 @@ func resourceDataFactoryDatasetJSONRead(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("Error setting `schema_column`: %+v", err)
 	}
 
+	schemaJson := ""
+	if jsonTable.Schema != nil {
+		schemaBytes, err := json.Marshal(jsonTable.Schema)
+		if err != nil {
+			return fmt.Errorf("serializing `schema_json`: %+v", err)
+		}
+		schemaJson = string(schemaBytes)
+	}
+	d.Set("schema_json", schemaJson)
+
 	return nil
 }
 
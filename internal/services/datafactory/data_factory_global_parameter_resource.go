@@ -0,0 +1,211 @@
+package datafactory
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const dataFactoryResourceName = "azurerm_data_factory"
+
+func resourceDataFactoryGlobalParameter() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryGlobalParameterCreateUpdate,
+		Read:   resourceDataFactoryGlobalParameterRead,
+		Update: resourceDataFactoryGlobalParameterCreateUpdate,
+		Delete: resourceDataFactoryGlobalParameterDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.GlobalParameterID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datafactory.GlobalParameterTypeArray),
+					string(datafactory.GlobalParameterTypeBool),
+					string(datafactory.GlobalParameterTypeFloat),
+					string(datafactory.GlobalParameterTypeInt),
+					string(datafactory.GlobalParameterTypeObject),
+					string(datafactory.GlobalParameterTypeString),
+				}, false),
+			},
+
+			"value": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceDataFactoryGlobalParameterCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.FactoriesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewGlobalParameterID(dataFactoryId.SubscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
+
+	// the Global Parameters are a property of the Data Factory, so changes to these must be serialized
+	locks.ByName(id.FactoryName, dataFactoryResourceName)
+	defer locks.UnlockByName(id.FactoryName, dataFactoryResourceName)
+
+	factory, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *dataFactoryId, err)
+	}
+	if factory.FactoryProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *dataFactoryId)
+	}
+
+	if factory.FactoryProperties.GlobalParameters == nil {
+		factory.FactoryProperties.GlobalParameters = make(map[string]*datafactory.GlobalParameterSpecification)
+	}
+
+	if d.IsNewResource() {
+		if _, ok := factory.FactoryProperties.GlobalParameters[id.Name]; ok {
+			return tf.ImportAsExistsError("azurerm_data_factory_global_parameter", id.ID())
+		}
+	}
+
+	parameterType := d.Get("type").(string)
+	value, err := expandDataFactoryTypedParameterValue(datafactory.ParameterType(parameterType), d.Get("value").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `value`: %+v", err)
+	}
+
+	factory.FactoryProperties.GlobalParameters[id.Name] = &datafactory.GlobalParameterSpecification{
+		Type:  datafactory.GlobalParameterType(parameterType),
+		Value: value,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, factory, ""); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryGlobalParameterRead(d, meta)
+}
+
+func resourceDataFactoryGlobalParameterRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.FactoriesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GlobalParameterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dataFactoryId := parse.NewDataFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName)
+
+	factory, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(factory.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state!", dataFactoryId, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", dataFactoryId, err)
+	}
+
+	var parameter *datafactory.GlobalParameterSpecification
+	if props := factory.FactoryProperties; props != nil {
+		parameter = props.GlobalParameters[id.Name]
+	}
+	if parameter == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("data_factory_id", dataFactoryId.ID())
+	d.Set("type", string(parameter.Type))
+	d.Set("value", flattenDataFactoryTypedParameterValue(datafactory.ParameterType(parameter.Type), parameter.Value))
+
+	return nil
+}
+
+func resourceDataFactoryGlobalParameterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.FactoriesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.GlobalParameterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dataFactoryId := parse.NewDataFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName)
+
+	locks.ByName(id.FactoryName, dataFactoryResourceName)
+	defer locks.UnlockByName(id.FactoryName, dataFactoryResourceName)
+
+	factory, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(factory.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", dataFactoryId, err)
+	}
+
+	if factory.FactoryProperties == nil || factory.FactoryProperties.GlobalParameters == nil {
+		return nil
+	}
+	if _, ok := factory.FactoryProperties.GlobalParameters[id.Name]; !ok {
+		return nil
+	}
+
+	delete(factory.FactoryProperties.GlobalParameters, id.Name)
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, factory, ""); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type GlobalParameterResource struct{}
+
+func TestAccDataFactoryGlobalParameter_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_global_parameter", "test")
+	r := GlobalParameterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "String", "foo"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryGlobalParameter_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_global_parameter", "test")
+	r := GlobalParameterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "String", "foo"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataFactoryGlobalParameter_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_global_parameter", "test")
+	r := GlobalParameterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "String", "foo"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "Int", "42"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").HasValue("42"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "Array", `[\"a\",\"b\"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryGlobalParameter_retainedWhenFactoryUpdated(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_global_parameter", "test")
+	r := GlobalParameterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "String", "foo"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.factoryTagged(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_data_factory.test").Key("global_parameter.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r GlobalParameterResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.GlobalParameterID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DataFactory.FactoriesClient.Get(ctx, id.ResourceGroup, id.FactoryName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.FactoryProperties == nil {
+		return utils.Bool(false), nil
+	}
+
+	_, exists := resp.FactoryProperties.GlobalParameters[id.Name]
+	return utils.Bool(exists), nil
+}
+
+func (r GlobalParameterResource) basic(data acceptance.TestData, parameterType, value string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_global_parameter" "test" {
+  name            = "acctestgp%d"
+  data_factory_id = azurerm_data_factory.test.id
+  type            = %q
+  value           = "%s"
+}
+`, r.template(data, ""), data.RandomInteger, parameterType, value)
+}
+
+func (r GlobalParameterResource) factoryTagged(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_global_parameter" "test" {
+  name            = "acctestgp%d"
+  data_factory_id = azurerm_data_factory.test.id
+  type            = "String"
+  value           = "foo"
+}
+`, r.template(data, `
+  tags = {
+    env = "Test"
+  }
+`), data.RandomInteger)
+}
+
+func (r GlobalParameterResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_global_parameter" "import" {
+  name            = azurerm_data_factory_global_parameter.test.name
+  data_factory_id = azurerm_data_factory_global_parameter.test.data_factory_id
+  type            = azurerm_data_factory_global_parameter.test.type
+  value           = azurerm_data_factory_global_parameter.test.value
+}
+`, r.basic(data, "String", "foo"))
+}
+
+func (r GlobalParameterResource) template(data acceptance.TestData, factoryExtra string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-adf-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+%s
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, factoryExtra)
+}
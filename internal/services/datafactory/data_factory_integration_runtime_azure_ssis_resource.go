@@ -446,6 +446,11 @@ func resourceDataFactoryIntegrationRuntimeAzureSsisCreateUpdate(d *pluginsdk.Res
 		Properties: basicIntegrationRuntime,
 	}
 
+	linkedServicesClient := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	if err := validateAzureKeyVaultSecretReferenceLinkedServices(ctx, linkedServicesClient, resourceGroup, factoryName, expandDataFactoryIntegrationRuntimeAzureSsisKeyVaultSecretReferenceBlocks(d)...); err != nil {
+		return err
+	}
+
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, factoryName, name, integrationRuntime, ""); err != nil {
 		return fmt.Errorf("Error creating/updating Data Factory Azure-SSIS Integration Runtime %q (Resource Group %q, Data Factory %q): %+v", name, resourceGroup, factoryName, err)
 	}
@@ -765,6 +770,33 @@ func expandDataFactoryIntegrationRuntimeAzureSsisPackageStore(input []interface{
 	return &result
 }
 
+// expandDataFactoryIntegrationRuntimeAzureSsisKeyVaultSecretReferenceBlocks returns each of the `key_vault_*`
+// blocks defined within the `express_custom_setup` block
+func expandDataFactoryIntegrationRuntimeAzureSsisKeyVaultSecretReferenceBlocks(d *pluginsdk.ResourceData) [][]interface{} {
+	output := make([][]interface{}, 0)
+
+	expressCustomSetups := d.Get("express_custom_setup").([]interface{})
+	if len(expressCustomSetups) == 0 || expressCustomSetups[0] == nil {
+		return output
+	}
+	raw := expressCustomSetups[0].(map[string]interface{})
+
+	for _, item := range raw["component"].([]interface{}) {
+		if item == nil {
+			continue
+		}
+		output = append(output, item.(map[string]interface{})["key_vault_license"].([]interface{}))
+	}
+	for _, item := range raw["command_key"].([]interface{}) {
+		if item == nil {
+			continue
+		}
+		output = append(output, item.(map[string]interface{})["key_vault_password"].([]interface{}))
+	}
+
+	return output
+}
+
 func expandDataFactoryIntegrationRuntimeAzureSsisKeyVaultSecretReference(input []interface{}) *datafactory.AzureKeyVaultSecretReference {
 	if len(input) == 0 || input[0] == nil {
 		return nil
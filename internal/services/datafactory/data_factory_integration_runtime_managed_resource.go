@@ -198,6 +198,53 @@ func resourceDataFactoryIntegrationRuntimeManaged() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"package_store": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"linked_service_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"proxy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"self_hosted_integration_runtime_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"staging_storage_linked_service_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"path": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -328,6 +375,14 @@ func resourceDataFactoryIntegrationRuntimeManagedRead(d *pluginsdk.ResourceData,
 		if err := d.Set("custom_setup_script", flattenDataFactoryIntegrationRuntimeManagedSsisCustomSetupScript(ssisProps.CustomSetupScriptProperties, d)); err != nil {
 			return fmt.Errorf("Error setting `vnet_integration`: %+v", err)
 		}
+
+		if err := d.Set("package_store", flattenDataFactoryIntegrationRuntimeAzureSsisPackageStore(ssisProps.PackageStores)); err != nil {
+			return fmt.Errorf("Error setting `package_store`: %+v", err)
+		}
+
+		if err := d.Set("proxy", flattenDataFactoryIntegrationRuntimeAzureSsisProxy(ssisProps.DataProxyProperties)); err != nil {
+			return fmt.Errorf("Error setting `proxy`: %+v", err)
+		}
 	}
 
 	return nil
@@ -378,8 +433,10 @@ func expandDataFactoryIntegrationRuntimeManagedComputeProperties(d *pluginsdk.Re
 
 func expandDataFactoryIntegrationRuntimeManagedSsisProperties(d *pluginsdk.ResourceData) *datafactory.IntegrationRuntimeSsisProperties {
 	ssisProperties := &datafactory.IntegrationRuntimeSsisProperties{
-		Edition:     datafactory.IntegrationRuntimeEdition(d.Get("edition").(string)),
-		LicenseType: datafactory.IntegrationRuntimeLicenseType(d.Get("license_type").(string)),
+		Edition:             datafactory.IntegrationRuntimeEdition(d.Get("edition").(string)),
+		LicenseType:         datafactory.IntegrationRuntimeLicenseType(d.Get("license_type").(string)),
+		DataProxyProperties: expandDataFactoryIntegrationRuntimeAzureSsisProxy(d.Get("proxy").([]interface{})),
+		PackageStores:       expandDataFactoryIntegrationRuntimeAzureSsisPackageStore(d.Get("package_store").([]interface{})),
 	}
 
 	if catalogInfos, ok := d.GetOk("catalog_info"); ok && len(catalogInfos.([]interface{})) > 0 {
@@ -87,6 +87,23 @@ func TestAccDataFactoryIntegrationRuntimeManaged_customSetupScript(t *testing.T)
 	})
 }
 
+func TestAccDataFactoryIntegrationRuntimeManaged_packageStoreAndProxy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_managed", "test")
+	r := IntegrationRuntimeManagedResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.packageStoreAndProxy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("package_store.#").HasValue("1"),
+				check.That(data.ResourceName).Key("proxy.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccDataFactoryIntegrationRuntimeManaged_aadAuth(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_managed", "test")
 	r := IntegrationRuntimeManagedResource{}
@@ -293,6 +310,93 @@ resource "azurerm_data_factory_integration_runtime_managed" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomString)
 }
 
+func (IntegrationRuntimeManagedResource) packageStoreAndProxy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "sharename"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 30
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfirm%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_linked_custom_service" "test" {
+  name                 = "acctestls%[1]d"
+  data_factory_id      = azurerm_data_factory.test.id
+  type                 = "AzureBlobStorage"
+  type_properties_json = <<JSON
+{
+  "connectionString": "${azurerm_storage_account.test.primary_connection_string}"
+}
+JSON
+}
+
+resource "azurerm_data_factory_linked_custom_service" "file_share_linked_service" {
+  name                 = "acctestls1%[1]d"
+  data_factory_id      = azurerm_data_factory.test.id
+  type                 = "AzureFileStorage"
+  type_properties_json = <<JSON
+{
+  "host": "${azurerm_storage_share.test.url}",
+  "password": {
+    "type": "SecureString",
+    "value": "${azurerm_storage_account.test.primary_access_key}"
+  }
+}
+JSON
+}
+
+resource "azurerm_data_factory_integration_runtime_self_hosted" "test" {
+  name                = "acctestSIRsh%[1]d"
+  data_factory_name   = azurerm_data_factory.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_integration_runtime_managed" "test" {
+  name                = "managed-integration-runtime"
+  data_factory_name   = azurerm_data_factory.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  node_size    = "Standard_D8_v3"
+  edition      = "Enterprise"
+  license_type = "LicenseIncluded"
+
+  package_store {
+    name                = "store1"
+    linked_service_name = azurerm_data_factory_linked_custom_service.file_share_linked_service.name
+  }
+
+  proxy {
+    self_hosted_integration_runtime_name = azurerm_data_factory_integration_runtime_self_hosted.test.name
+    staging_storage_linked_service_name  = azurerm_data_factory_linked_custom_service.test.name
+    path                                 = "containerpath"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (IntegrationRuntimeManagedResource) aadAuth(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,237 @@
+package datafactory
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataFactoryIntegrationRuntimeSelfHostedLink() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryIntegrationRuntimeSelfHostedLinkCreate,
+		Read:   resourceDataFactoryIntegrationRuntimeSelfHostedLinkRead,
+		Delete: resourceDataFactoryIntegrationRuntimeSelfHostedLinkDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.IntegrationRuntimeID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+					`Invalid name for Self-Hosted Integration Runtime: minimum 3 characters, must start and end with a number or a letter, may only consist of letters, numbers and dashes and no consecutive dashes.`,
+				),
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"shared_integration_runtime_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.IntegrationRuntimeID,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceDataFactoryIntegrationRuntimeSelfHostedLinkCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	factoriesClient := meta.(*clients.Client).DataFactory.FactoriesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	sharedId, err := parse.IntegrationRuntimeID(d.Get("shared_integration_runtime_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(sharedId.SubscriptionId, subscriptionId) {
+		return fmt.Errorf("the Shared %s must exist within the Subscription %q", *sharedId, subscriptionId)
+	}
+
+	id := parse.NewIntegrationRuntimeID(subscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_data_factory_integration_runtime_self_hosted_link", id.ID())
+	}
+
+	factory, err := factoriesClient.Get(ctx, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *dataFactoryId, err)
+	}
+	if factory.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", *dataFactoryId)
+	}
+
+	// grant the Data Factory access to the Shared Integration Runtime..
+	linkRequest := datafactory.CreateLinkedIntegrationRuntimeRequest{
+		Name:                utils.String(id.Name),
+		SubscriptionID:      utils.String(id.SubscriptionId),
+		DataFactoryName:     utils.String(id.FactoryName),
+		DataFactoryLocation: factory.Location,
+	}
+	if _, err := client.CreateLinkedIntegrationRuntime(ctx, sharedId.ResourceGroup, sharedId.FactoryName, sharedId.Name, linkRequest); err != nil {
+		return fmt.Errorf("linking %s to the Shared %s: %+v", id, *sharedId, err)
+	}
+
+	// .. and then create the Linked Integration Runtime within it
+	selfHostedIntegrationRuntime := datafactory.SelfHostedIntegrationRuntime{
+		Type: datafactory.TypeBasicIntegrationRuntimeTypeSelfHosted,
+		SelfHostedIntegrationRuntimeTypeProperties: &datafactory.SelfHostedIntegrationRuntimeTypeProperties{
+			LinkedInfo: &datafactory.LinkedIntegrationRuntimeRbacAuthorization{
+				ResourceID:        utils.String(sharedId.ID()),
+				AuthorizationType: datafactory.AuthorizationTypeRBAC,
+			},
+		},
+	}
+	if v := d.Get("description").(string); v != "" {
+		selfHostedIntegrationRuntime.Description = utils.String(v)
+	}
+
+	basicIntegrationRuntime, _ := selfHostedIntegrationRuntime.AsBasicIntegrationRuntime()
+
+	integrationRuntime := datafactory.IntegrationRuntimeResource{
+		Name:       utils.String(id.Name),
+		Properties: basicIntegrationRuntime,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, id.Name, integrationRuntime, ""); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryIntegrationRuntimeSelfHostedLinkRead(d, meta)
+}
+
+func resourceDataFactoryIntegrationRuntimeSelfHostedLinkRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IntegrationRuntimeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	selfHostedIntegrationRuntime, ok := resp.Properties.AsSelfHostedIntegrationRuntime()
+	if !ok {
+		return fmt.Errorf("converting %s to a Self-Hosted Integration Runtime", *id)
+	}
+
+	sharedIntegrationRuntimeId := ""
+	if props := selfHostedIntegrationRuntime.SelfHostedIntegrationRuntimeTypeProperties; props != nil && props.LinkedInfo != nil {
+		if rbacAuthorization, ok := props.LinkedInfo.AsLinkedIntegrationRuntimeRbacAuthorization(); ok && rbacAuthorization.ResourceID != nil {
+			sharedId, err := parse.IntegrationRuntimeID(*rbacAuthorization.ResourceID)
+			if err != nil {
+				return fmt.Errorf("parsing the Shared Integration Runtime ID for %s: %+v", *id, err)
+			}
+			sharedIntegrationRuntimeId = sharedId.ID()
+		}
+	}
+	if sharedIntegrationRuntimeId == "" {
+		return fmt.Errorf("%s is not linked to a Shared Integration Runtime using RBAC Authorization", *id)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("data_factory_id", parse.NewDataFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName).ID())
+	d.Set("shared_integration_runtime_id", sharedIntegrationRuntimeId)
+	d.Set("description", selfHostedIntegrationRuntime.Description)
+
+	return nil
+}
+
+func resourceDataFactoryIntegrationRuntimeSelfHostedLinkDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IntegrationRuntimeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	sharedId, err := parse.IntegrationRuntimeID(d.Get("shared_integration_runtime_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ResourceGroup, id.FactoryName, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	// then revoke the access granted to the Data Factory on the Shared Integration Runtime
+	linkRequest := datafactory.LinkedIntegrationRuntimeRequest{
+		LinkedFactoryName: utils.String(id.FactoryName),
+	}
+	if resp, err := client.RemoveLinks(ctx, sharedId.ResourceGroup, sharedId.FactoryName, sharedId.Name, linkRequest); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("removing the link between %s and the Shared %s: %+v", *id, *sharedId, err)
+		}
+	}
+
+	return nil
+}
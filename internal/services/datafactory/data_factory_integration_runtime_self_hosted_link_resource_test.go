@@ -0,0 +1,131 @@
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type IntegrationRuntimeSelfHostedLinkResource struct {
+}
+
+func TestAccDataFactoryIntegrationRuntimeSelfHostedLink_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_self_hosted_link", "test")
+	r := IntegrationRuntimeSelfHostedLinkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryIntegrationRuntimeSelfHostedLink_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_self_hosted_link", "test")
+	r := IntegrationRuntimeSelfHostedLinkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t IntegrationRuntimeSelfHostedLinkResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.IntegrationRuntimeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataFactory.IntegrationRuntimesClient.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (IntegrationRuntimeSelfHostedLinkResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "host" {
+  name     = "acctesthostRG-df-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_factory" "host" {
+  name                = "acctestdfirshh%[1]d"
+  location            = azurerm_resource_group.host.location
+  resource_group_name = azurerm_resource_group.host.name
+}
+
+resource "azurerm_data_factory_integration_runtime_self_hosted" "host" {
+  name                = "acctestirshh%[1]d"
+  data_factory_name   = azurerm_data_factory.host.name
+  resource_group_name = azurerm_resource_group.host.name
+}
+
+resource "azurerm_resource_group" "target" {
+  name     = "acctesttargetRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_factory" "target" {
+  name                = "acctestdfirsht%[1]d"
+  location            = azurerm_resource_group.target.location
+  resource_group_name = azurerm_resource_group.target.name
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_role_assignment" "target" {
+  scope                = azurerm_data_factory_integration_runtime_self_hosted.host.id
+  role_definition_name = "Contributor"
+  principal_id         = azurerm_data_factory.target.identity[0].principal_id
+}
+
+resource "azurerm_data_factory_integration_runtime_self_hosted_link" "test" {
+  name                          = "acctestirshl%[1]d"
+  data_factory_id               = azurerm_data_factory.target.id
+  shared_integration_runtime_id = azurerm_data_factory_integration_runtime_self_hosted.host.id
+  description                   = "acctest"
+
+  depends_on = [azurerm_role_assignment.target]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r IntegrationRuntimeSelfHostedLinkResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_integration_runtime_self_hosted_link" "import" {
+  name                          = azurerm_data_factory_integration_runtime_self_hosted_link.test.name
+  data_factory_id               = azurerm_data_factory_integration_runtime_self_hosted_link.test.data_factory_id
+  shared_integration_runtime_id = azurerm_data_factory_integration_runtime_self_hosted_link.test.shared_integration_runtime_id
+}
+`, r.basic(data))
+}
@@ -0,0 +1,167 @@
+package datafactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+)
+
+// dataFactoryLinkedCustomServiceCatalogEntry describes the `typeProperties` of a connector type which isn't
+// natively modelled by a Linked Service resource - the known properties are taken from the model in the Azure
+// SDK for Go (which is generated from the published Swagger) and the required properties from the Swagger.
+type dataFactoryLinkedCustomServiceCatalogEntry struct {
+	typeProperties interface{}
+	required       []string
+}
+
+// dataFactoryLinkedCustomServiceCatalog contains the connector types whose `typeProperties` can be validated,
+// keyed by the lower-cased type - connector types not in the catalog aren't validated.
+var dataFactoryLinkedCustomServiceCatalog = map[string]dataFactoryLinkedCustomServiceCatalogEntry{
+	"amazonredshift": {typeProperties: datafactory.AmazonRedshiftLinkedServiceTypeProperties{}, required: []string{"server", "database"}},
+	"azuresearch":    {typeProperties: datafactory.AzureSearchLinkedServiceTypeProperties{}, required: []string{"url"}},
+	"cassandra":      {typeProperties: datafactory.CassandraLinkedServiceTypeProperties{}, required: []string{"host"}},
+	"couchbase":      {typeProperties: datafactory.CouchbaseLinkedServiceTypeProperties{}},
+	"db2":            {typeProperties: datafactory.Db2LinkedServiceTypeProperties{}},
+	"drill":          {typeProperties: datafactory.DrillLinkedServiceTypeProperties{}},
+	"dynamics":       {typeProperties: datafactory.DynamicsLinkedServiceTypeProperties{}, required: []string{"deploymentType", "authenticationType"}},
+	"ftpserver":      {typeProperties: datafactory.FtpServerLinkedServiceTypeProperties{}, required: []string{"host"}},
+	"googlebigquery": {typeProperties: datafactory.GoogleBigQueryLinkedServiceTypeProperties{}, required: []string{"project", "authenticationType"}},
+	"greenplum":      {typeProperties: datafactory.GreenplumLinkedServiceTypeProperties{}},
+	"hbase":          {typeProperties: datafactory.HBaseLinkedServiceTypeProperties{}, required: []string{"host", "authenticationType"}},
+	"hive":           {typeProperties: datafactory.HiveLinkedServiceTypeProperties{}, required: []string{"host", "authenticationType"}},
+	"httpserver":     {typeProperties: datafactory.HTTPLinkedServiceTypeProperties{}, required: []string{"url"}},
+	"hubspot":        {typeProperties: datafactory.HubspotLinkedServiceTypeProperties{}, required: []string{"clientId"}},
+	"impala":         {typeProperties: datafactory.ImpalaLinkedServiceTypeProperties{}, required: []string{"host", "authenticationType"}},
+	"jira":           {typeProperties: datafactory.JiraLinkedServiceTypeProperties{}, required: []string{"host", "username"}},
+	"magento":        {typeProperties: datafactory.MagentoLinkedServiceTypeProperties{}, required: []string{"host"}},
+	"marketo":        {typeProperties: datafactory.MarketoLinkedServiceTypeProperties{}, required: []string{"endpoint", "clientId"}},
+	"mongodb":        {typeProperties: datafactory.MongoDbLinkedServiceTypeProperties{}, required: []string{"server", "databaseName"}},
+	"mysql":          {typeProperties: datafactory.MySQLLinkedServiceTypeProperties{}, required: []string{"connectionString"}},
+	"netezza":        {typeProperties: datafactory.NetezzaLinkedServiceTypeProperties{}},
+	"odata":          {typeProperties: datafactory.ODataLinkedServiceTypeProperties{}, required: []string{"url"}},
+	"odbc":           {typeProperties: datafactory.OdbcLinkedServiceTypeProperties{}, required: []string{"connectionString", "authenticationType"}},
+	"office365":      {typeProperties: datafactory.Office365LinkedServiceTypeProperties{}, required: []string{"office365TenantId", "servicePrincipalTenantId", "servicePrincipalId", "servicePrincipalKey"}},
+	"oracle":         {typeProperties: datafactory.OracleLinkedServiceTypeProperties{}, required: []string{"connectionString"}},
+	"phoenix":        {typeProperties: datafactory.PhoenixLinkedServiceTypeProperties{}, required: []string{"host", "authenticationType"}},
+	"postgresql":     {typeProperties: datafactory.PostgreSQLLinkedServiceTypeProperties{}, required: []string{"connectionString"}},
+	"presto":         {typeProperties: datafactory.PrestoLinkedServiceTypeProperties{}, required: []string{"host", "serverVersion", "catalog", "authenticationType"}},
+	"restservice":    {typeProperties: datafactory.RestServiceLinkedServiceTypeProperties{}, required: []string{"url", "authenticationType"}},
+	"salesforce":     {typeProperties: datafactory.SalesforceLinkedServiceTypeProperties{}},
+	"saptable":       {typeProperties: datafactory.SapTableLinkedServiceTypeProperties{}},
+	"servicenow":     {typeProperties: datafactory.ServiceNowLinkedServiceTypeProperties{}, required: []string{"endpoint", "authenticationType"}},
+	"sftp":           {typeProperties: datafactory.SftpServerLinkedServiceTypeProperties{}, required: []string{"host"}},
+	"shopify":        {typeProperties: datafactory.ShopifyLinkedServiceTypeProperties{}, required: []string{"host"}},
+	"spark":          {typeProperties: datafactory.SparkLinkedServiceTypeProperties{}, required: []string{"host", "port", "authenticationType"}},
+	"square":         {typeProperties: datafactory.SquareLinkedServiceTypeProperties{}},
+	"sybase":         {typeProperties: datafactory.SybaseLinkedServiceTypeProperties{}, required: []string{"server", "database"}},
+	"teradata":       {typeProperties: datafactory.TeradataLinkedServiceTypeProperties{}},
+	"vertica":        {typeProperties: datafactory.VerticaLinkedServiceTypeProperties{}},
+	"xero":           {typeProperties: datafactory.XeroLinkedServiceTypeProperties{}},
+	"zoho":           {typeProperties: datafactory.ZohoLinkedServiceTypeProperties{}},
+}
+
+// knownProperties returns the names of the `typeProperties` which are defined for the connector type
+func (e dataFactoryLinkedCustomServiceCatalogEntry) knownProperties() []string {
+	t := reflect.TypeOf(e.typeProperties)
+	properties := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		properties = append(properties, name)
+	}
+
+	return properties
+}
+
+// validateDataFactoryLinkedCustomServiceTypeProperties validates the `typeProperties` of a Linked Service against
+// the catalog, ensuring the required properties are specified and flagging properties which look to be a typo
+// of a known property. Properties which aren't similar to a known property are allowed, since the service may
+// support properties which are newer than the catalog.
+func validateDataFactoryLinkedCustomServiceTypeProperties(serviceType string, typePropertiesJson string) error {
+	entry, ok := dataFactoryLinkedCustomServiceCatalog[strings.ToLower(serviceType)]
+	if !ok {
+		return nil
+	}
+
+	typeProperties := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(typePropertiesJson), &typeProperties); err != nil {
+		return fmt.Errorf("`type_properties_json` must be a JSON object: %+v", err)
+	}
+
+	missing := make([]string, 0)
+	for _, property := range entry.required {
+		if _, ok := typeProperties[property]; !ok {
+			missing = append(missing, property)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("`type_properties_json` is missing the properties %q which are required for a Linked Service of type %q", missing, serviceType)
+	}
+
+	known := entry.knownProperties()
+	knownNames := make(map[string]struct{}, len(known))
+	for _, property := range known {
+		knownNames[property] = struct{}{}
+	}
+
+	names := make([]string, 0, len(typeProperties))
+	for name := range typeProperties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, ok := knownNames[name]; ok {
+			continue
+		}
+
+		for _, property := range known {
+			// short property names are only a single edit apart to avoid flagging distinct properties (e.g. `host` and `port`)
+			maxDistance := 1
+			if len(property) >= 8 {
+				maxDistance = 2
+			}
+
+			if strings.EqualFold(name, property) || levenshteinDistance(strings.ToLower(name), strings.ToLower(property)) <= maxDistance {
+				return fmt.Errorf("`type_properties_json` contains the property %q which isn't defined for a Linked Service of type %q - did you mean %q?", name, serviceType, property)
+			}
+		}
+	}
+
+	return nil
+}
+
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = previous[j] + 1
+			if v := current[j-1] + 1; v < current[j] {
+				current[j] = v
+			}
+			if v := previous[j-1] + cost; v < current[j] {
+				current[j] = v
+			}
+		}
+		previous = current
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,81 @@
+package datafactory
+
+import (
+	"testing"
+)
+
+func TestValidateDataFactoryLinkedCustomServiceTypeProperties(t *testing.T) {
+	testData := []struct {
+		Name           string
+		Type           string
+		TypeProperties string
+		Error          bool
+	}{
+		{
+			Name:           "Type not in the Catalog",
+			Type:           "SomeNewConnector",
+			TypeProperties: `{"anything": "goes"}`,
+			Error:          false,
+		},
+		{
+			Name:           "Valid",
+			Type:           "Cassandra",
+			TypeProperties: `{"host": "example.com", "port": 9042, "authenticationType": "Basic"}`,
+			Error:          false,
+		},
+		{
+			Name:           "Type is Case Insensitive",
+			Type:           "cassandra",
+			TypeProperties: `{"port": 9042}`,
+			Error:          true,
+		},
+		{
+			Name:           "Missing Required Property",
+			Type:           "MongoDb",
+			TypeProperties: `{"server": "example.com"}`,
+			Error:          true,
+		},
+		{
+			Name:           "Incorrect Casing of a Property",
+			Type:           "Cassandra",
+			TypeProperties: `{"host": "example.com", "Port": 9042}`,
+			Error:          true,
+		},
+		{
+			Name:           "Typo of a Property",
+			Type:           "ServiceNow",
+			TypeProperties: `{"endpoint": "example.service-now.com", "authenticationType": "Basic", "usernme": "admin"}`,
+			Error:          true,
+		},
+		{
+			Name:           "Property not similar to a known Property",
+			Type:           "Cassandra",
+			TypeProperties: `{"host": "example.com", "connectVersion": "2"}`,
+			Error:          false,
+		},
+		{
+			Name:           "Expression",
+			Type:           "Sftp",
+			TypeProperties: `{"host": {"value": "@linkedService().host", "type": "Expression"}}`,
+			Error:          false,
+		},
+		{
+			Name:           "Not an Object",
+			Type:           "Sftp",
+			TypeProperties: `["host"]`,
+			Error:          true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateDataFactoryLinkedCustomServiceTypeProperties(v.Type, v.TypeProperties)
+		if v.Error && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package datafactory
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -51,18 +53,29 @@ func resourceDataFactoryLinkedCustomService() *pluginsdk.Resource {
 			},
 
 			"type": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"type_properties_json": {
 				Type:             pluginsdk.TypeString,
 				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
 				StateFunc:        utils.NormalizeJson,
 				DiffSuppressFunc: suppressJsonOrderingDifference,
 			},
 
+			"secure_type_properties": {
+				Type:      pluginsdk.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"description": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -93,13 +106,16 @@ func resourceDataFactoryLinkedCustomService() *pluginsdk.Resource {
 			},
 
 			"parameters": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameter"},
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
 			},
 
+			"parameter": schemaDataFactoryTypedParameters(),
+
 			"annotations": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -116,6 +132,36 @@ func resourceDataFactoryLinkedCustomService() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			if !v.(*clients.Client).Features.DataFactory.ValidateLinkedCustomServiceTypeProperties {
+				return nil
+			}
+
+			// the values may not be known until apply (e.g. when interpolated from another resource)
+			if !d.NewValueKnown("type") || !d.NewValueKnown("type_properties_json") || !d.NewValueKnown("secure_type_properties") {
+				return nil
+			}
+
+			typePropertiesJson := d.Get("type_properties_json").(string)
+			if secure := d.Get("secure_type_properties").(map[string]interface{}); len(secure) > 0 {
+				typeProperties := make(map[string]interface{})
+				if err := json.Unmarshal([]byte(typePropertiesJson), &typeProperties); err != nil {
+					return fmt.Errorf("`type_properties_json` must be a JSON object: %+v", err)
+				}
+				if err := spliceDataFactorySecureStrings(typeProperties, secure); err != nil {
+					return err
+				}
+
+				spliced, err := json.Marshal(typeProperties)
+				if err != nil {
+					return err
+				}
+				typePropertiesJson = string(spliced)
+			}
+
+			return validateDataFactoryLinkedCustomServiceTypeProperties(d.Get("type").(string), typePropertiesJson)
+		}),
 	}
 }
 
@@ -148,10 +194,14 @@ func resourceDataFactoryLinkedCustomServiceCreateUpdate(d *pluginsdk.ResourceDat
 		"connectVia": expandDataFactoryLinkedServiceIntegrationRuntimeV2(d.Get("integration_runtime").([]interface{})),
 	}
 
-	jsonDataStr := fmt.Sprintf(`{ "typeProperties": %s }`, d.Get("type_properties_json").(string))
-	if err = json.Unmarshal([]byte(jsonDataStr), &props); err != nil {
+	typeProperties := make(map[string]interface{})
+	if err = json.Unmarshal([]byte(d.Get("type_properties_json").(string)), &typeProperties); err != nil {
+		return err
+	}
+	if err := spliceDataFactorySecureStrings(typeProperties, d.Get("secure_type_properties").(map[string]interface{})); err != nil {
 		return err
 	}
+	props["typeProperties"] = typeProperties
 
 	if v, ok := d.GetOk("description"); ok {
 		props["description"] = v.(string)
@@ -161,6 +211,14 @@ func resourceDataFactoryLinkedCustomServiceCreateUpdate(d *pluginsdk.ResourceDat
 		props["parameters"] = expandDataFactoryParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("parameter"); ok {
+		parameters, err := expandDataFactoryTypedParameters(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		props["parameters"] = parameters
+	}
+
 	if v, ok := d.GetOk("annotations"); ok {
 		props["annotations"] = v.([]interface{})
 	}
@@ -259,8 +317,8 @@ func resourceDataFactoryLinkedCustomServiceRead(d *pluginsdk.ResourceData, meta
 		}
 		delete(m, "parameters")
 	}
-	if err := d.Set("parameters", flattenDataFactoryParameters(parameters)); err != nil {
-		return fmt.Errorf("setting `parameters`: %+v", err)
+	if err := setDataFactoryParameters(d, parameters); err != nil {
+		return err
 	}
 
 	var integrationRuntime *datafactory.IntegrationRuntimeReference
@@ -120,6 +120,21 @@ func TestAccDataFactoryLinkedCustomService_search(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryLinkedCustomService_secureTypeProperties(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_custom_service", "test")
+	r := LinkedCustomServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.secureTypeProperties(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("type_properties_json", "secure_type_properties"),
+	})
+}
+
 func (t LinkedCustomServiceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.LinkedServiceID(state.ID)
 	if err != nil {
@@ -205,6 +220,28 @@ JSON
 `, r.template(data), data.RandomInteger)
 }
 
+func (r LinkedCustomServiceResource) secureTypeProperties(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_linked_custom_service" "test" {
+  name                 = "acctestls%d"
+  data_factory_id      = azurerm_data_factory.test.id
+  type                 = "AzureBlobStorage"
+  type_properties_json = <<JSON
+{
+  "serviceEndpoint": "${azurerm_storage_account.test.primary_blob_endpoint}",
+  "accountKind": "StorageV2"
+}
+JSON
+
+  secure_type_properties = {
+    sasUri = "${azurerm_storage_account.test.primary_blob_endpoint}?sv=2019-12-12"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r LinkedCustomServiceResource) web(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -72,13 +72,16 @@ func resourceDataFactoryLinkedServiceAzureSearch() *pluginsdk.Resource {
 			},
 
 			"parameters": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameter"},
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
 			},
 
+			"parameter": schemaDataFactoryTypedParameters(),
+
 			"annotations": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -143,6 +146,14 @@ func resourceDataFactoryLinkedServiceAzureSearchCreateUpdate(d *pluginsdk.Resour
 		searchLinkedService.Parameters = expandDataFactoryParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("parameter"); ok {
+		parameters, err := expandDataFactoryTypedParameters(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		searchLinkedService.Parameters = parameters
+	}
+
 	if v, ok := d.GetOk("integration_runtime_name"); ok {
 		searchLinkedService.ConnectVia = expandDataFactoryLinkedServiceIntegrationRuntime(v.(string))
 	}
@@ -219,8 +230,8 @@ func resourceDataFactoryLinkedServiceAzureSearchRead(d *pluginsdk.ResourceData,
 		return fmt.Errorf("setting `annotations`: %+v", err)
 	}
 
-	if err := d.Set("parameters", flattenDataFactoryParameters(linkedService.Parameters)); err != nil {
-		return fmt.Errorf("setting `parameters`: %+v", err)
+	if err := setDataFactoryParameters(d, linkedService.Parameters); err != nil {
+		return err
 	}
 
 	integrationRuntimeName := ""
@@ -3,6 +3,7 @@ package datafactory_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -84,6 +85,18 @@ func TestAccDataFactoryLinkedServiceSQLServer_ConnectionStringKeyVaultReference(
 	})
 }
 
+func TestAccDataFactoryLinkedServiceSQLServer_KeyVaultReferenceInvalidLinkedService(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_sql_server", "test")
+	r := LinkedServiceSQLServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.key_vault_reference_invalid_linked_service(data),
+			ExpectError: regexp.MustCompile("must be an Azure Key Vault Linked Service"),
+		},
+	})
+}
+
 func (t LinkedServiceSQLServerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -276,3 +289,41 @@ resource "azurerm_data_factory_linked_service_sql_server" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
+
+func (LinkedServiceSQLServerResource) key_vault_reference_invalid_linked_service(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_linked_service_sql_server" "other" {
+  name                = "linksqlserverother"
+  resource_group_name = azurerm_resource_group.test.name
+  data_factory_name   = azurerm_data_factory.test.name
+  connection_string   = "Integrated Security=False;Data Source=test;Initial Catalog=test;User ID=test;Password=test"
+}
+
+resource "azurerm_data_factory_linked_service_sql_server" "test" {
+  name                = "linksqlserver"
+  resource_group_name = azurerm_resource_group.test.name
+  data_factory_name   = azurerm_data_factory.test.name
+
+  connection_string = "Integrated Security=False;Data Source=test;Initial Catalog=test;User ID=test;"
+  key_vault_password {
+    linked_service_name = azurerm_data_factory_linked_service_sql_server.other.name
+    secret_name         = "secret"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -52,13 +52,16 @@ func resourceDataFactoryPipeline() *pluginsdk.Resource {
 			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
 
 			"parameters": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
+				Type:          pluginsdk.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameter"},
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
 			},
 
+			"parameter": schemaDataFactoryTypedParameters(),
+
 			"variables": {
 				Type:     pluginsdk.TypeMap,
 				Optional: true,
@@ -137,6 +140,14 @@ func resourceDataFactoryPipelineCreateUpdate(d *pluginsdk.ResourceData, meta int
 		Description: utils.String(d.Get("description").(string)),
 	}
 
+	if v, ok := d.GetOk("parameter"); ok {
+		parameters, err := expandDataFactoryTypedParameters(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		pipeline.Parameters = parameters
+	}
+
 	if v, ok := d.GetOk("activities_json"); ok {
 		activities, err := deserializeDataFactoryPipelineActivities(v.(string))
 		if err != nil {
@@ -223,9 +234,8 @@ func resourceDataFactoryPipelineRead(d *pluginsdk.ResourceData, meta interface{}
 	if props := resp.Pipeline; props != nil {
 		d.Set("description", props.Description)
 
-		parameters := flattenDataFactoryParameters(props.Parameters)
-		if err := d.Set("parameters", parameters); err != nil {
-			return fmt.Errorf("setting `parameters`: %+v", err)
+		if err := setDataFactoryParameters(d, props.Parameters); err != nil {
+			return err
 		}
 
 		annotations := flattenDataFactoryAnnotations(props.Annotations)
@@ -96,6 +96,23 @@ func TestAccDataFactoryPipeline_activities(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryPipeline_typedParameters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_pipeline", "test")
+	r := PipelineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.typedParameters(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parameter.#").HasValue("5"),
+				check.That(data.ResourceName).Key("parameters.%").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t PipelineResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -158,6 +175,61 @@ resource "azurerm_data_factory_pipeline" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (PipelineResource) typedParameters(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfv2%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name                = "acctest%d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_factory_name   = azurerm_data_factory.test.name
+
+  parameter {
+    name          = "retries"
+    type          = "Int"
+    default_value = "3"
+  }
+
+  parameter {
+    name          = "enabled"
+    type          = "Bool"
+    default_value = "true"
+  }
+
+  parameter {
+    name          = "threshold"
+    type          = "Float"
+    default_value = "0.5"
+  }
+
+  parameter {
+    name          = "regions"
+    type          = "Array"
+    default_value = jsonencode(["westeurope", "northeurope"])
+  }
+
+  parameter {
+    name          = "settings"
+    type          = "Object"
+    default_value = jsonencode({ "mode" = "full" })
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
 func (PipelineResource) update1(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,148 @@
+package datafactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	dataFactoryItemTypeDataFlow           = "DataFlow"
+	dataFactoryItemTypeDataset            = "Dataset"
+	dataFactoryItemTypeIntegrationRuntime = "IntegrationRuntime"
+	dataFactoryItemTypeLinkedService      = "LinkedService"
+	dataFactoryItemTypePipeline           = "Pipeline"
+	dataFactoryItemTypeTrigger            = "Trigger"
+
+	dataFactoryAutoResolveIntegrationRuntimeName = "AutoResolveIntegrationRuntime"
+)
+
+type dataFactoryReference struct {
+	sourceType    string
+	sourceName    string
+	referenceType string
+	referenceName string
+}
+
+// dataFactoryReferenceGraph tracks the items within a Data Factory and the references between them
+type dataFactoryReferenceGraph struct {
+	// items is a map of Item Type -> lower-cased name, since names within a Data Factory are case-insensitive
+	items      map[string]map[string]struct{}
+	references []dataFactoryReference
+}
+
+func newDataFactoryReferenceGraph() *dataFactoryReferenceGraph {
+	return &dataFactoryReferenceGraph{
+		items: map[string]map[string]struct{}{
+			// the default Integration Runtime exists implicitly within every Data Factory, but isn't returned by the API
+			dataFactoryItemTypeIntegrationRuntime: {
+				strings.ToLower(dataFactoryAutoResolveIntegrationRuntimeName): {},
+			},
+		},
+		references: make([]dataFactoryReference, 0),
+	}
+}
+
+// add registers the named item and any references contained within its (API) properties
+func (g *dataFactoryReferenceGraph) add(itemType string, name string, properties interface{}) error {
+	if _, ok := g.items[itemType]; !ok {
+		g.items[itemType] = make(map[string]struct{})
+	}
+	g.items[itemType][strings.ToLower(name)] = struct{}{}
+
+	// the references are spread throughout the (polymorphic) models, so it's simplest to walk the JSON
+	raw, err := json.Marshal(properties)
+	if err != nil {
+		return fmt.Errorf("serializing %s %q: %+v", itemType, name, err)
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return fmt.Errorf("deserializing %s %q: %+v", itemType, name, err)
+	}
+
+	for _, reference := range findDataFactoryReferences(decoded) {
+		reference.sourceType = itemType
+		reference.sourceName = name
+		g.references = append(g.references, reference)
+	}
+
+	return nil
+}
+
+// danglingReferences returns each reference to an item which doesn't exist within the Data Factory
+func (g *dataFactoryReferenceGraph) danglingReferences() []dataFactoryReference {
+	dangling := make([]dataFactoryReference, 0)
+	for _, reference := range g.references {
+		items := g.items[reference.referenceType]
+		if _, ok := items[strings.ToLower(reference.referenceName)]; ok {
+			continue
+		}
+		dangling = append(dangling, reference)
+	}
+
+	sort.Slice(dangling, func(i, j int) bool {
+		a, b := dangling[i], dangling[j]
+		if a.sourceType != b.sourceType {
+			return a.sourceType < b.sourceType
+		}
+		if a.sourceName != b.sourceName {
+			return a.sourceName < b.sourceName
+		}
+		if a.referenceType != b.referenceType {
+			return a.referenceType < b.referenceType
+		}
+		return a.referenceName < b.referenceName
+	})
+
+	return dangling
+}
+
+// findDataFactoryReferences walks the JSON representation of an item looking for references to other items,
+// which take the form `{"type": "DatasetReference", "referenceName": "example"}`
+func findDataFactoryReferences(input interface{}) []dataFactoryReference {
+	output := make([]dataFactoryReference, 0)
+
+	switch v := input.(type) {
+	case map[string]interface{}:
+		referenceType, typeOk := v["type"].(string)
+		referenceName, nameOk := v["referenceName"].(string)
+		referenceType = strings.TrimSuffix(referenceType, "Reference")
+		if typeOk && nameOk && isDataFactoryReferenceableItemType(referenceType) {
+			// references containing an expression can only be resolved at runtime
+			if !strings.HasPrefix(referenceName, "@") {
+				output = append(output, dataFactoryReference{
+					referenceType: referenceType,
+					referenceName: referenceName,
+				})
+			}
+		}
+
+		for _, value := range v {
+			output = append(output, findDataFactoryReferences(value)...)
+		}
+
+	case []interface{}:
+		for _, value := range v {
+			output = append(output, findDataFactoryReferences(value)...)
+		}
+	}
+
+	return output
+}
+
+func isDataFactoryReferenceableItemType(input string) bool {
+	for _, v := range []string{
+		dataFactoryItemTypeDataFlow,
+		dataFactoryItemTypeDataset,
+		dataFactoryItemTypeIntegrationRuntime,
+		dataFactoryItemTypeLinkedService,
+		dataFactoryItemTypePipeline,
+	} {
+		if input == v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,112 @@
+package datafactory
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDataFactoryReferenceGraphDanglingReferences(t *testing.T) {
+	graph := newDataFactoryReferenceGraph()
+	items := []struct {
+		ItemType   string
+		Name       string
+		Properties interface{}
+	}{
+		{
+			ItemType: dataFactoryItemTypeLinkedService,
+			Name:     "BlobStorage",
+		},
+		{
+			ItemType: dataFactoryItemTypeDataset,
+			Name:     "Existing",
+			Properties: map[string]interface{}{
+				"linkedServiceName": map[string]interface{}{
+					"referenceName": "blobstorage",
+					"type":          "LinkedServiceReference",
+				},
+			},
+		},
+		{
+			ItemType: dataFactoryItemTypeDataset,
+			Name:     "Dangling",
+			Properties: map[string]interface{}{
+				"linkedServiceName": map[string]interface{}{
+					"referenceName": "Missing",
+					"type":          "LinkedServiceReference",
+				},
+			},
+		},
+		{
+			ItemType: dataFactoryItemTypePipeline,
+			Name:     "Pipeline",
+			Properties: map[string]interface{}{
+				"activities": []interface{}{
+					map[string]interface{}{
+						"name": "Copy",
+						"inputs": []interface{}{
+							map[string]interface{}{
+								"referenceName": "Existing",
+								"type":          "DatasetReference",
+							},
+						},
+						"outputs": []interface{}{
+							map[string]interface{}{
+								"referenceName": "@pipeline().parameters.output",
+								"type":          "DatasetReference",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			ItemType: dataFactoryItemTypeLinkedService,
+			Name:     "AutoResolved",
+			Properties: map[string]interface{}{
+				"connectVia": map[string]interface{}{
+					"referenceName": "AutoResolveIntegrationRuntime",
+					"type":          "IntegrationRuntimeReference",
+				},
+			},
+		},
+		{
+			ItemType: dataFactoryItemTypeTrigger,
+			Name:     "Trigger",
+			Properties: map[string]interface{}{
+				"pipelines": []interface{}{
+					map[string]interface{}{
+						"pipelineReference": map[string]interface{}{
+							"referenceName": "OtherPipeline",
+							"type":          "PipelineReference",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, item := range items {
+		if err := graph.add(item.ItemType, item.Name, item.Properties); err != nil {
+			t.Fatalf("adding %s %q: %+v", item.ItemType, item.Name, err)
+		}
+	}
+
+	expected := []dataFactoryReference{
+		{
+			sourceType:    dataFactoryItemTypeDataset,
+			sourceName:    "Dangling",
+			referenceType: dataFactoryItemTypeLinkedService,
+			referenceName: "Missing",
+		},
+		{
+			sourceType:    dataFactoryItemTypeTrigger,
+			sourceName:    "Trigger",
+			referenceType: dataFactoryItemTypePipeline,
+			referenceName: "OtherPipeline",
+		},
+	}
+
+	actual := graph.danglingReferences()
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected the dangling references to be %+v but got %+v", expected, actual)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
@@ -175,9 +176,12 @@ func resourceDataFactory() *pluginsdk.Resource {
 				},
 			},
 
+			// Global Parameters can also be managed using the `azurerm_data_factory_global_parameter` resource, or
+			// created in the Data Factory UX - so these are retained unless they're specified here
 			"global_parameter": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -190,12 +194,12 @@ func resourceDataFactory() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								"Array",
-								"Bool",
-								"Float",
-								"Int",
-								"Object",
-								"String",
+								string(datafactory.GlobalParameterTypeArray),
+								string(datafactory.GlobalParameterTypeBool),
+								string(datafactory.GlobalParameterTypeFloat),
+								string(datafactory.GlobalParameterTypeInt),
+								string(datafactory.GlobalParameterTypeObject),
+								string(datafactory.GlobalParameterTypeString),
 							}, false),
 						},
 
@@ -239,19 +243,21 @@ func resourceDataFactoryCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	defer cancel()
 
 	id := parse.NewDataFactoryID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
-	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, "")
-		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
-			}
-		}
 
+	locks.ByName(id.FactoryName, dataFactoryResourceName)
+	defer locks.UnlockByName(id.FactoryName, dataFactoryResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, "")
+	if err != nil {
 		if !utils.ResponseWasNotFound(existing.Response) {
-			return tf.ImportAsExistsError("azurerm_data_factory", id.ID())
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 		}
 	}
 
+	if d.IsNewResource() && !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_data_factory", id.ID())
+	}
+
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	dataFactory := datafactory.Factory{
 		Location:          &location,
@@ -303,11 +309,16 @@ func resourceDataFactoryCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	globalParameters, err := expandDataFactoryGlobalParameters(d.Get("global_parameter").(*pluginsdk.Set).List())
-	if err != nil {
-		return err
+	if d.IsNewResource() || d.HasChange("global_parameter") {
+		globalParameters, err := expandDataFactoryGlobalParameters(d.Get("global_parameter").(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+		dataFactory.FactoryProperties.GlobalParameters = globalParameters
+	} else if existing.FactoryProperties != nil {
+		// retain any Global Parameters managed outside of this resource, since otherwise they're removed
+		dataFactory.FactoryProperties.GlobalParameters = existing.FactoryProperties.GlobalParameters
 	}
-	dataFactory.FactoryProperties.GlobalParameters = globalParameters
 
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, dataFactory, ""); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
@@ -502,13 +513,19 @@ func expandDataFactoryGlobalParameters(input []interface{}) (map[string]*datafac
 		v := item.(map[string]interface{})
 
 		name := v["name"].(string)
-		if _, ok := v[name]; ok {
-			return nil, fmt.Errorf("duplicate parameter name")
+		if _, ok := result[name]; ok {
+			return nil, fmt.Errorf("duplicate global parameter name %q", name)
+		}
+
+		parameterType := v["type"].(string)
+		value, err := expandDataFactoryTypedParameterValue(datafactory.ParameterType(parameterType), v["value"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing `value` for global parameter %q: %+v", name, err)
 		}
 
 		result[name] = &datafactory.GlobalParameterSpecification{
-			Type:  datafactory.GlobalParameterType(v["type"].(string)),
-			Value: v["value"].(string),
+			Type:  datafactory.GlobalParameterType(parameterType),
+			Value: value,
 		}
 	}
 	return result, nil
@@ -605,10 +622,13 @@ func flattenDataFactoryGlobalParameters(input map[string]*datafactory.GlobalPara
 	}
 	result := make([]interface{}, 0)
 	for name, item := range input {
+		if item == nil {
+			continue
+		}
 		result = append(result, map[string]interface{}{
 			"name":  name,
 			"type":  string(item.Type),
-			"value": item.Value,
+			"value": flattenDataFactoryTypedParameterValue(datafactory.ParameterType(item.Type), item.Value),
 		})
 	}
 	return result
@@ -1,6 +1,12 @@
 package datafactory
 
-import "testing"
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+)
 
 func TestDataFactoryLinkedServiceConnectionStringDiff(t *testing.T) {
 	cases := []struct {
@@ -203,3 +209,228 @@ func TestNormalizeJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestDataFactorySpliceSecureStrings(t *testing.T) {
+	secureString := func(value string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":  "SecureString",
+			"value": value,
+		}
+	}
+
+	cases := []struct {
+		TypeProperties map[string]interface{}
+		Secure         map[string]interface{}
+		Expected       map[string]interface{}
+		ExpectErr      bool
+	}{
+		{
+			TypeProperties: map[string]interface{}{"host": "example.com"},
+			Secure:         map[string]interface{}{},
+			Expected:       map[string]interface{}{"host": "example.com"},
+		},
+		{
+			TypeProperties: map[string]interface{}{"host": "example.com"},
+			Secure:         map[string]interface{}{"password": "secret"},
+			Expected: map[string]interface{}{
+				"host":     "example.com",
+				"password": secureString("secret"),
+			},
+		},
+		{
+			TypeProperties: map[string]interface{}{
+				"credential": map[string]interface{}{"username": "admin"},
+			},
+			Secure: map[string]interface{}{"credential.password": "secret", "other.key": "secret2"},
+			Expected: map[string]interface{}{
+				"credential": map[string]interface{}{
+					"username": "admin",
+					"password": secureString("secret"),
+				},
+				"other": map[string]interface{}{
+					"key": secureString("secret2"),
+				},
+			},
+		},
+		{
+			TypeProperties: map[string]interface{}{"password": "plaintext"},
+			Secure:         map[string]interface{}{"password": "secret"},
+			ExpectErr:      true,
+		},
+		{
+			TypeProperties: map[string]interface{}{"credential": "not-an-object"},
+			Secure:         map[string]interface{}{"credential.password": "secret"},
+			ExpectErr:      true,
+		},
+		{
+			TypeProperties: map[string]interface{}{},
+			Secure:         map[string]interface{}{"credential..password": "secret"},
+			ExpectErr:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := spliceDataFactorySecureStrings(tc.TypeProperties, tc.Secure)
+		if tc.ExpectErr {
+			if err == nil {
+				t.Fatalf("Expected an error splicing %+v into %+v but didn't get one", tc.Secure, tc.TypeProperties)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error splicing %+v but got: %+v", tc.Secure, err)
+		}
+
+		if !reflect.DeepEqual(tc.TypeProperties, tc.Expected) {
+			t.Fatalf("Expected %+v but got %+v", tc.Expected, tc.TypeProperties)
+		}
+	}
+}
+
+func TestDataFactoryTypedParameterValueRoundTrip(t *testing.T) {
+	cases := []struct {
+		Type      datafactory.ParameterType
+		Input     string
+		ExpectErr bool
+		// Expected is the value returned from the API when it differs from the Input
+		Expected string
+	}{
+		{
+			Type:  datafactory.ParameterTypeString,
+			Input: "hello",
+		},
+		{
+			Type:  datafactory.ParameterTypeSecureString,
+			Input: "s3cr3t",
+		},
+		{
+			Type:  datafactory.ParameterTypeInt,
+			Input: "42",
+		},
+		{
+			Type:      datafactory.ParameterTypeInt,
+			Input:     "4.2",
+			ExpectErr: true,
+		},
+		{
+			Type:  datafactory.ParameterTypeFloat,
+			Input: "0.25",
+		},
+		{
+			Type:  datafactory.ParameterTypeBool,
+			Input: "true",
+		},
+		{
+			Type:      datafactory.ParameterTypeBool,
+			Input:     "yes",
+			ExpectErr: true,
+		},
+		{
+			Type:  datafactory.ParameterTypeArray,
+			Input: `["a",1,true]`,
+		},
+		{
+			Type:      datafactory.ParameterTypeArray,
+			Input:     `{"a":1}`,
+			ExpectErr: true,
+		},
+		{
+			Type:  datafactory.ParameterTypeObject,
+			Input: `{"a":"b","c":{"d":1}}`,
+		},
+		{
+			Type:      datafactory.ParameterTypeObject,
+			Input:     `["a"]`,
+			ExpectErr: true,
+		},
+		{
+			Type:     datafactory.ParameterTypeBool,
+			Input:    "True",
+			Expected: "true",
+		},
+		{
+			Type:     datafactory.ParameterTypeFloat,
+			Input:    "1.50",
+			Expected: "1.5",
+		},
+		{
+			Type:     datafactory.ParameterTypeInt,
+			Input:    "01",
+			Expected: "1",
+		},
+		{
+			Type:     datafactory.ParameterTypeArray,
+			Input:    "[\n  \"a\",\n  1.0\n]",
+			Expected: `["a",1]`,
+		},
+		{
+			Type:     datafactory.ParameterTypeObject,
+			Input:    "{\n  \"c\": {\"d\": 1},\n  \"a\": \"b\"\n}",
+			Expected: `{"a":"b","c":{"d":1}}`,
+		},
+		{
+			Type:  datafactory.ParameterTypeString,
+			Input: "007",
+		},
+	}
+
+	for _, tc := range cases {
+		expanded, err := expandDataFactoryTypedParameterValue(tc.Type, tc.Input)
+		if tc.ExpectErr {
+			if err == nil {
+				t.Fatalf("Expected an error expanding %q of type %q but didn't get one", tc.Input, tc.Type)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error expanding %q of type %q but got: %+v", tc.Input, tc.Type, err)
+		}
+
+		// the API returns values as deserialized JSON, so simulate that to confirm the type round-trips
+		payload, err := json.Marshal(expanded)
+		if err != nil {
+			t.Fatalf("serializing %+v: %+v", expanded, err)
+		}
+		var returned interface{}
+		if err := json.Unmarshal(payload, &returned); err != nil {
+			t.Fatalf("deserializing %s: %+v", string(payload), err)
+		}
+
+		expected := tc.Input
+		if tc.Expected != "" {
+			expected = tc.Expected
+		}
+
+		actual := flattenDataFactoryTypedParameterValue(tc.Type, returned)
+		if actual != expected {
+			t.Fatalf("Expected %q of type %q to round-trip as %q but got %q", tc.Input, tc.Type, expected, actual)
+		}
+
+		// the configured value must be considered equal to the value returned from the API to avoid a diff
+		if normalized := normalizeDataFactoryTypedParameterValue(tc.Type, tc.Input); normalized != actual {
+			t.Fatalf("Expected %q of type %q to normalize to %q but got %q", tc.Input, tc.Type, actual, normalized)
+		}
+	}
+}
+
+func TestDataFactoryFlattenParametersSkipsTypedParameters(t *testing.T) {
+	input := map[string]*datafactory.ParameterSpecification{
+		"name": {
+			Type:         datafactory.ParameterTypeString,
+			DefaultValue: "example",
+		},
+		"count": {
+			Type:         datafactory.ParameterTypeInt,
+			DefaultValue: float64(3),
+		},
+	}
+
+	expected := map[string]interface{}{
+		"name": "example",
+	}
+	if actual := flattenDataFactoryParameters(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
@@ -0,0 +1,196 @@
+package datafactory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceDataFactoryValidation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDataFactoryValidationRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"valid": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"dangling_reference": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"source_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"source_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"reference_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"reference_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDataFactoryValidationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	dataFlowsClient := meta.(*clients.Client).DataFactory.DataFlowClient
+	datasetsClient := meta.(*clients.Client).DataFactory.DatasetClient
+	integrationRuntimesClient := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	linkedServicesClient := meta.(*clients.Client).DataFactory.LinkedServiceClient
+	pipelinesClient := meta.(*clients.Client).DataFactory.PipelinesClient
+	triggersClient := meta.(*clients.Client).DataFactory.TriggersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	factory := newDataFactoryReferenceGraph()
+
+	dataFlows, err := dataFlowsClient.ListByFactoryComplete(ctx, id.ResourceGroup, id.FactoryName)
+	if err != nil {
+		return fmt.Errorf("listing Data Flows for %s: %+v", *id, err)
+	}
+	for dataFlows.NotDone() {
+		if v := dataFlows.Value(); v.Name != nil {
+			if err := factory.add(dataFactoryItemTypeDataFlow, *v.Name, v.Properties); err != nil {
+				return err
+			}
+		}
+		if err := dataFlows.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Data Flows for %s: %+v", *id, err)
+		}
+	}
+
+	datasets, err := datasetsClient.ListByFactoryComplete(ctx, id.ResourceGroup, id.FactoryName)
+	if err != nil {
+		return fmt.Errorf("listing Datasets for %s: %+v", *id, err)
+	}
+	for datasets.NotDone() {
+		if v := datasets.Value(); v.Name != nil {
+			if err := factory.add(dataFactoryItemTypeDataset, *v.Name, v.Properties); err != nil {
+				return err
+			}
+		}
+		if err := datasets.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Datasets for %s: %+v", *id, err)
+		}
+	}
+
+	integrationRuntimes, err := integrationRuntimesClient.ListByFactoryComplete(ctx, id.ResourceGroup, id.FactoryName)
+	if err != nil {
+		return fmt.Errorf("listing Integration Runtimes for %s: %+v", *id, err)
+	}
+	for integrationRuntimes.NotDone() {
+		if v := integrationRuntimes.Value(); v.Name != nil {
+			if err := factory.add(dataFactoryItemTypeIntegrationRuntime, *v.Name, v.Properties); err != nil {
+				return err
+			}
+		}
+		if err := integrationRuntimes.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Integration Runtimes for %s: %+v", *id, err)
+		}
+	}
+
+	linkedServices, err := linkedServicesClient.ListByFactoryComplete(ctx, id.ResourceGroup, id.FactoryName)
+	if err != nil {
+		return fmt.Errorf("listing Linked Services for %s: %+v", *id, err)
+	}
+	for linkedServices.NotDone() {
+		if v := linkedServices.Value(); v.Name != nil {
+			if err := factory.add(dataFactoryItemTypeLinkedService, *v.Name, v.Properties); err != nil {
+				return err
+			}
+		}
+		if err := linkedServices.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Linked Services for %s: %+v", *id, err)
+		}
+	}
+
+	pipelines, err := pipelinesClient.ListByFactoryComplete(ctx, id.ResourceGroup, id.FactoryName)
+	if err != nil {
+		return fmt.Errorf("listing Pipelines for %s: %+v", *id, err)
+	}
+	for pipelines.NotDone() {
+		if v := pipelines.Value(); v.Name != nil {
+			if err := factory.add(dataFactoryItemTypePipeline, *v.Name, v.Pipeline); err != nil {
+				return err
+			}
+		}
+		if err := pipelines.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Pipelines for %s: %+v", *id, err)
+		}
+	}
+
+	// Triggers can't be referenced, however they can reference Pipelines which don't exist
+	triggers, err := triggersClient.ListByFactoryComplete(ctx, id.ResourceGroup, id.FactoryName)
+	if err != nil {
+		return fmt.Errorf("listing Triggers for %s: %+v", *id, err)
+	}
+	for triggers.NotDone() {
+		if v := triggers.Value(); v.Name != nil {
+			if err := factory.add(dataFactoryItemTypeTrigger, *v.Name, v.Properties); err != nil {
+				return err
+			}
+		}
+		if err := triggers.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Triggers for %s: %+v", *id, err)
+		}
+	}
+
+	dangling := factory.danglingReferences()
+
+	d.SetId(id.ID())
+	d.Set("data_factory_id", id.ID())
+	d.Set("valid", len(dangling) == 0)
+	if err := d.Set("dangling_reference", flattenDataFactoryDanglingReferences(dangling)); err != nil {
+		return fmt.Errorf("setting `dangling_reference`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDataFactoryDanglingReferences(input []dataFactoryReference) []interface{} {
+	output := make([]interface{}, 0)
+	for _, v := range input {
+		output = append(output, map[string]interface{}{
+			"source_type":    v.sourceType,
+			"source_name":    v.sourceName,
+			"reference_type": v.referenceType,
+			"reference_name": v.referenceName,
+		})
+	}
+	return output
+}
@@ -0,0 +1,95 @@
+package datafactory_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DataFactoryValidationDataSource struct {
+}
+
+func TestAccDataFactoryValidationDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_data_factory_validation", "test")
+	r := DataFactoryValidationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("valid").HasValue("true"),
+				check.That(data.ResourceName).Key("dangling_reference.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccDataFactoryValidationDataSource_danglingReference(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_data_factory_validation", "test")
+	r := DataFactoryValidationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.danglingReference(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("valid").HasValue("false"),
+				check.That(data.ResourceName).Key("dangling_reference.#").HasValue("1"),
+				check.That(data.ResourceName).Key("dangling_reference.0.source_type").HasValue("Pipeline"),
+				check.That(data.ResourceName).Key("dangling_reference.0.reference_type").HasValue("Dataset"),
+				check.That(data.ResourceName).Key("dangling_reference.0.reference_name").HasValue("missing"),
+			),
+		},
+	})
+}
+
+func (DataFactoryValidationDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_data_factory_validation" "test" {
+  data_factory_id = azurerm_data_factory.test.id
+
+  depends_on = [azurerm_data_factory_dataset_azure_blob.test]
+}
+`, DatasetAzureBlobResource{}.basic(data))
+}
+
+func (DataFactoryValidationDataSource) danglingReference(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_pipeline" "test" {
+  name                = "acctest%d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_factory_name   = azurerm_data_factory.test.name
+  activities_json     = <<JSON
+[
+  {
+    "name": "Lookup",
+    "type": "Lookup",
+    "typeProperties": {
+      "source": {
+        "type": "BlobSource"
+      },
+      "dataset": {
+        "referenceName": "missing",
+        "type": "DatasetReference"
+      }
+    }
+  }
+]
+JSON
+}
+
+data "azurerm_data_factory_validation" "test" {
+  data_factory_id = azurerm_data_factory.test.id
+
+  depends_on = [
+    azurerm_data_factory_dataset_azure_blob.test,
+    azurerm_data_factory_pipeline.test,
+  ]
+}
+`, DatasetAzureBlobResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,353 @@
+package datafactory
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/dataflows"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataFactoryWranglingDataFlow() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryWranglingDataFlowCreateUpdate,
+		Read:   resourceDataFactoryWranglingDataFlowRead,
+		Update: resourceDataFactoryWranglingDataFlowCreateUpdate,
+		Delete: resourceDataFactoryWranglingDataFlowDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := dataflows.ParseDataFlowID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			// the Power Query M mashup script
+			"script": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"source": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"dataset": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"parameters": {
+										Type:     pluginsdk.TypeMap,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"script": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"document_locale": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"annotations": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"folder": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceDataFactoryWranglingDataFlowCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.DataFlowsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := dataflows.NewDataFlowID(dataFactoryId.SubscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_data_factory_wrangling_data_flow", id.ID())
+		}
+	}
+
+	dataFlow := dataflows.WranglingDataFlow{
+		TypeProperties: &dataflows.PowerQueryTypeProperties{
+			Script:  utils.String(d.Get("script").(string)),
+			Sources: expandDataFactoryWranglingDataFlowSources(d.Get("source").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("document_locale"); ok {
+		dataFlow.TypeProperties.DocumentLocale = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		dataFlow.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		dataFlow.Annotations = &annotations
+	}
+
+	if v, ok := d.GetOk("folder"); ok {
+		dataFlow.Folder = &dataflows.DataFlowFolder{
+			Name: utils.String(v.(string)),
+		}
+	}
+
+	payload := dataflows.DataFlowResource{
+		Properties: dataFlow,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryWranglingDataFlowRead(d, meta)
+}
+
+func resourceDataFactoryWranglingDataFlowRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.DataFlowsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := dataflows.ParseDataFlowID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("data_factory_id", parse.NewDataFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName).ID())
+
+	if model := resp.Model; model != nil {
+		dataFlow, ok := model.Properties.(dataflows.WranglingDataFlow)
+		if !ok {
+			return fmt.Errorf("retrieving %s: expected a Wrangling Data Flow but got %+v", *id, model.Properties)
+		}
+
+		description := ""
+		if dataFlow.Description != nil {
+			description = *dataFlow.Description
+		}
+		d.Set("description", description)
+
+		if err := d.Set("annotations", flattenDataFactoryAnnotations(dataFlow.Annotations)); err != nil {
+			return fmt.Errorf("setting `annotations`: %+v", err)
+		}
+
+		folder := ""
+		if dataFlow.Folder != nil && dataFlow.Folder.Name != nil {
+			folder = *dataFlow.Folder.Name
+		}
+		d.Set("folder", folder)
+
+		if props := dataFlow.TypeProperties; props != nil {
+			script := ""
+			if props.Script != nil {
+				script = *props.Script
+			}
+			d.Set("script", script)
+
+			documentLocale := ""
+			if props.DocumentLocale != nil {
+				documentLocale = *props.DocumentLocale
+			}
+			d.Set("document_locale", documentLocale)
+
+			if err := d.Set("source", flattenDataFactoryWranglingDataFlowSources(props.Sources)); err != nil {
+				return fmt.Errorf("setting `source`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceDataFactoryWranglingDataFlowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.DataFlowsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := dataflows.ParseDataFlowID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandDataFactoryWranglingDataFlowSources(input []interface{}) *[]dataflows.PowerQuerySource {
+	result := make([]dataflows.PowerQuerySource, 0)
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		source := dataflows.PowerQuerySource{
+			Name: raw["name"].(string),
+		}
+
+		if v := raw["description"].(string); v != "" {
+			source.Description = utils.String(v)
+		}
+
+		if v := raw["script"].(string); v != "" {
+			source.Script = utils.String(v)
+		}
+
+		if datasets := raw["dataset"].([]interface{}); len(datasets) > 0 && datasets[0] != nil {
+			dataset := datasets[0].(map[string]interface{})
+			parameters := dataset["parameters"].(map[string]interface{})
+			source.Dataset = &dataflows.DatasetReference{
+				ReferenceName: dataset["name"].(string),
+				Type:          dataflows.DatasetReferenceTypeDatasetReference,
+				Parameters:    &parameters,
+			}
+		}
+
+		result = append(result, source)
+	}
+	return &result
+}
+
+func flattenDataFactoryWranglingDataFlowSources(input *[]dataflows.PowerQuerySource) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	result := make([]interface{}, 0)
+	for _, v := range *input {
+		description := ""
+		if v.Description != nil {
+			description = *v.Description
+		}
+
+		script := ""
+		if v.Script != nil {
+			script = *v.Script
+		}
+
+		dataset := make([]interface{}, 0)
+		if v.Dataset != nil {
+			parameters := make(map[string]interface{})
+			if v.Dataset.Parameters != nil {
+				parameters = *v.Dataset.Parameters
+			}
+			dataset = append(dataset, map[string]interface{}{
+				"name":       v.Dataset.ReferenceName,
+				"parameters": parameters,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":        v.Name,
+			"dataset":     dataset,
+			"description": description,
+			"script":      script,
+		})
+	}
+	return result
+}
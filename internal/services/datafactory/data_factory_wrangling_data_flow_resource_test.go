@@ -0,0 +1,222 @@
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/sdk/dataflows"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WranglingDataFlowResource struct{}
+
+func TestAccDataFactoryWranglingDataFlow_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_wrangling_data_flow", "test")
+	r := WranglingDataFlowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryWranglingDataFlow_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_wrangling_data_flow", "test")
+	r := WranglingDataFlowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataFactoryWranglingDataFlow_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_wrangling_data_flow", "test")
+	r := WranglingDataFlowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r WranglingDataFlowResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := dataflows.ParseDataFlowID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DataFactory.DataFlowsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r WranglingDataFlowResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_wrangling_data_flow" "test" {
+  name            = "acctestdf%d"
+  data_factory_id = azurerm_data_factory.test.id
+
+  source {
+    name = "UserQuery"
+
+    dataset {
+      name = azurerm_data_factory_dataset_delimited_text.test.name
+    }
+  }
+
+  script = <<EOT
+section Section1;
+shared UserQuery = let Source = #"${azurerm_data_factory_dataset_delimited_text.test.name}" in Source;
+EOT
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r WranglingDataFlowResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_wrangling_data_flow" "test" {
+  name            = "acctestdf%d"
+  data_factory_id = azurerm_data_factory.test.id
+  description     = "description for wrangling data flow"
+  annotations     = ["anno1", "anno2"]
+  folder          = "folder1"
+  document_locale = "en-us"
+
+  source {
+    name        = "UserQuery"
+    description = "source description"
+    script      = "source(allowSchemaDrift: true, validateSchema: false, ignoreNoFilesFound: false) ~> UserQuery"
+
+    dataset {
+      name = azurerm_data_factory_dataset_delimited_text.test.name
+    }
+  }
+
+  script = <<EOT
+section Section1;
+shared UserQuery = let Source = #"${azurerm_data_factory_dataset_delimited_text.test.name}" in Source;
+EOT
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r WranglingDataFlowResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_wrangling_data_flow" "import" {
+  name            = azurerm_data_factory_wrangling_data_flow.test.name
+  data_factory_id = azurerm_data_factory_wrangling_data_flow.test.data_factory_id
+  script          = azurerm_data_factory_wrangling_data_flow.test.script
+
+  source {
+    name = "UserQuery"
+
+    dataset {
+      name = azurerm_data_factory_dataset_delimited_text.test.name
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (WranglingDataFlowResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  location                 = azurerm_resource_group.test.location
+  resource_group_name      = azurerm_resource_group.test.name
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_linked_custom_service" "test" {
+  name                 = "acctestls%d"
+  data_factory_id      = azurerm_data_factory.test.id
+  type                 = "AzureBlobStorage"
+  type_properties_json = <<JSON
+{
+  "connectionString": "${azurerm_storage_account.test.primary_connection_string}"
+}
+JSON
+}
+
+resource "azurerm_data_factory_dataset_delimited_text" "test" {
+  name                = "acctestds%d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_factory_name   = azurerm_data_factory.test.name
+  linked_service_name = azurerm_data_factory_linked_custom_service.test.name
+
+  azure_blob_storage_location {
+    container = "container"
+    path      = "foo/bar/"
+    filename  = "foo.csv"
+  }
+
+  column_delimiter    = ","
+  encoding            = "UTF-8"
+  first_row_as_header = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,15 @@
+package changedatacaptures
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ChangeDataCapturesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewChangeDataCapturesClientWithBaseURI(endpoint string) ChangeDataCapturesClient {
+	return ChangeDataCapturesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
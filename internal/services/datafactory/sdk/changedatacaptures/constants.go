@@ -0,0 +1,41 @@
+package changedatacaptures
+
+type ConnectionType string
+
+const (
+	ConnectionTypeLinkedservicetype ConnectionType = "linkedservicetype"
+)
+
+func PossibleValuesForConnectionType() []string {
+	return []string{
+		string(ConnectionTypeLinkedservicetype),
+	}
+}
+
+type FrequencyType string
+
+const (
+	FrequencyTypeHour   FrequencyType = "Hour"
+	FrequencyTypeMinute FrequencyType = "Minute"
+	FrequencyTypeSecond FrequencyType = "Second"
+)
+
+func PossibleValuesForFrequencyType() []string {
+	return []string{
+		string(FrequencyTypeHour),
+		string(FrequencyTypeMinute),
+		string(FrequencyTypeSecond),
+	}
+}
+
+type Type string
+
+const (
+	TypeLinkedServiceReference Type = "LinkedServiceReference"
+)
+
+func PossibleValuesForType() []string {
+	return []string{
+		string(TypeLinkedServiceReference),
+	}
+}
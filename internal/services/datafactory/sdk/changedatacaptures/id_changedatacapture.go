@@ -0,0 +1,126 @@
+package changedatacaptures
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ChangeDataCaptureId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	FactoryName    string
+	Name           string
+}
+
+func NewChangeDataCaptureID(subscriptionId, resourceGroup, factoryName, name string) ChangeDataCaptureId {
+	return ChangeDataCaptureId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		FactoryName:    factoryName,
+		Name:           name,
+	}
+}
+
+func (id ChangeDataCaptureId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Factory Name %q", id.FactoryName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Change Data Capture", segmentsStr)
+}
+
+func (id ChangeDataCaptureId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s/adfcdcs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.Name)
+}
+
+// ParseChangeDataCaptureID parses a Change Data Capture ID into an ChangeDataCaptureId struct
+func ParseChangeDataCaptureID(input string) (*ChangeDataCaptureId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ChangeDataCaptureId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("adfcdcs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseChangeDataCaptureIDInsensitively parses an Change Data Capture ID into an ChangeDataCaptureId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseChangeDataCaptureID method should be used instead for validation etc.
+func ParseChangeDataCaptureIDInsensitively(input string) (*ChangeDataCaptureId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ChangeDataCaptureId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'factories' segment
+	factoriesKey := "factories"
+	for key := range id.Path {
+		if strings.EqualFold(key, factoriesKey) {
+			factoriesKey = key
+			break
+		}
+	}
+	if resourceId.FactoryName, err = id.PopSegment(factoriesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'adfcdcs' segment
+	adfcdcsKey := "adfcdcs"
+	for key := range id.Path {
+		if strings.EqualFold(key, adfcdcsKey) {
+			adfcdcsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(adfcdcsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
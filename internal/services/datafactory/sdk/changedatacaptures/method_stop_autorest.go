@@ -0,0 +1,63 @@
+package changedatacaptures
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type StopResponse struct {
+	HttpResponse *http.Response
+}
+
+// Stop ...
+func (c ChangeDataCapturesClient) Stop(ctx context.Context, id ChangeDataCaptureId) (result StopResponse, err error) {
+	req, err := c.preparerForStop(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "changedatacaptures.ChangeDataCapturesClient", "Stop", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "changedatacaptures.ChangeDataCapturesClient", "Stop", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForStop(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "changedatacaptures.ChangeDataCapturesClient", "Stop", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForStop prepares the Stop request.
+func (c ChangeDataCapturesClient) preparerForStop(ctx context.Context, id ChangeDataCaptureId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/stop", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForStop handles the response to the Stop request. The method always
+// closes the http.Response Body.
+func (c ChangeDataCapturesClient) responderForStop(resp *http.Response) (result StopResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
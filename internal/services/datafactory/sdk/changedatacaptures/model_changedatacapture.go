@@ -0,0 +1,11 @@
+package changedatacaptures
+
+type ChangeDataCapture struct {
+	AllowVNetOverride     *bool                         `json:"allowVNetOverride,omitempty"`
+	Description           *string                       `json:"description,omitempty"`
+	Folder                *ChangeDataCaptureFolder      `json:"folder,omitempty"`
+	Policy                MapperPolicy                  `json:"policy"`
+	SourceConnectionsInfo []MapperSourceConnectionsInfo `json:"sourceConnectionsInfo"`
+	Status                *string                       `json:"status,omitempty"`
+	TargetConnectionsInfo []MapperTargetConnectionsInfo `json:"targetConnectionsInfo"`
+}
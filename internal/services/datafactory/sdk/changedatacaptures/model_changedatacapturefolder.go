@@ -0,0 +1,5 @@
+package changedatacaptures
+
+type ChangeDataCaptureFolder struct {
+	Name *string `json:"name,omitempty"`
+}
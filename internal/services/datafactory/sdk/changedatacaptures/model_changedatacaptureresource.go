@@ -0,0 +1,9 @@
+package changedatacaptures
+
+type ChangeDataCaptureResource struct {
+	Etag       *string           `json:"etag,omitempty"`
+	Id         *string           `json:"id,omitempty"`
+	Name       *string           `json:"name,omitempty"`
+	Properties ChangeDataCapture `json:"properties"`
+	Type       *string           `json:"type,omitempty"`
+}
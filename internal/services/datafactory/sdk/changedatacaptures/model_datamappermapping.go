@@ -0,0 +1,9 @@
+package changedatacaptures
+
+type DataMapperMapping struct {
+	AttributeMappingInfo      *MapperAttributeMappings   `json:"attributeMappingInfo,omitempty"`
+	SourceConnectionReference *MapperConnectionReference `json:"sourceConnectionReference,omitempty"`
+	SourceDenormalizeInfo     *interface{}               `json:"sourceDenormalizeInfo,omitempty"`
+	SourceEntityName          *string                    `json:"sourceEntityName,omitempty"`
+	TargetEntityName          *string                    `json:"targetEntityName,omitempty"`
+}
@@ -0,0 +1,5 @@
+package changedatacaptures
+
+type MapperAttributeMappings struct {
+	AttributeMappings *[]interface{} `json:"attributeMappings,omitempty"`
+}
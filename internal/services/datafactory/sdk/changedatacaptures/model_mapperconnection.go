@@ -0,0 +1,9 @@
+package changedatacaptures
+
+type MapperConnection struct {
+	CommonDslConnectorProperties *[]MapperDslConnectorProperties `json:"commonDslConnectorProperties,omitempty"`
+	IsInlineDataset              *bool                           `json:"isInlineDataset,omitempty"`
+	LinkedService                *LinkedServiceReference         `json:"linkedService,omitempty"`
+	LinkedServiceType            *string                         `json:"linkedServiceType,omitempty"`
+	Type                         ConnectionType                  `json:"type"`
+}
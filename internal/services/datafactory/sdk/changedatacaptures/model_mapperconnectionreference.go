@@ -0,0 +1,6 @@
+package changedatacaptures
+
+type MapperConnectionReference struct {
+	ConnectionName *string         `json:"connectionName,omitempty"`
+	Type           *ConnectionType `json:"type,omitempty"`
+}
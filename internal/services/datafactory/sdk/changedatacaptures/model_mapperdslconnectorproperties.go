@@ -0,0 +1,6 @@
+package changedatacaptures
+
+type MapperDslConnectorProperties struct {
+	Name  *string      `json:"name,omitempty"`
+	Value *interface{} `json:"value,omitempty"`
+}
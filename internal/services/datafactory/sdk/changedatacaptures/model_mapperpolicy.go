@@ -0,0 +1,6 @@
+package changedatacaptures
+
+type MapperPolicy struct {
+	Mode       *string                 `json:"mode,omitempty"`
+	Recurrence *MapperPolicyRecurrence `json:"recurrence,omitempty"`
+}
@@ -0,0 +1,6 @@
+package changedatacaptures
+
+type MapperPolicyRecurrence struct {
+	Frequency *FrequencyType `json:"frequency,omitempty"`
+	Interval  *int64         `json:"interval,omitempty"`
+}
@@ -0,0 +1,6 @@
+package changedatacaptures
+
+type MapperSourceConnectionsInfo struct {
+	Connection     *MapperConnection `json:"connection,omitempty"`
+	SourceEntities *[]MapperTable    `json:"sourceEntities,omitempty"`
+}
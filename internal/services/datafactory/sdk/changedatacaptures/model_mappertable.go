@@ -0,0 +1,6 @@
+package changedatacaptures
+
+type MapperTable struct {
+	Name       *string                `json:"name,omitempty"`
+	Properties *MapperTableProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,6 @@
+package changedatacaptures
+
+type MapperTableProperties struct {
+	DslConnectorProperties *[]MapperDslConnectorProperties `json:"dslConnectorProperties,omitempty"`
+	Schema                 *[]MapperTableSchema            `json:"schema,omitempty"`
+}
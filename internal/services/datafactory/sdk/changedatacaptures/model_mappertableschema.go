@@ -0,0 +1,6 @@
+package changedatacaptures
+
+type MapperTableSchema struct {
+	DataType *string `json:"dataType,omitempty"`
+	Name     *string `json:"name,omitempty"`
+}
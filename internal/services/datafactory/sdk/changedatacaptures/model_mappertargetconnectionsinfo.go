@@ -0,0 +1,8 @@
+package changedatacaptures
+
+type MapperTargetConnectionsInfo struct {
+	Connection         *MapperConnection    `json:"connection,omitempty"`
+	DataMapperMappings *[]DataMapperMapping `json:"dataMapperMappings,omitempty"`
+	Relationships      *[]interface{}       `json:"relationships,omitempty"`
+	TargetEntities     *[]MapperTable       `json:"targetEntities,omitempty"`
+}
@@ -0,0 +1,9 @@
+package changedatacaptures
+
+import "fmt"
+
+const defaultApiVersion = "2018-06-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/adfcdcs/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package credentials
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CredentialsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCredentialsClientWithBaseURI(endpoint string) CredentialsClient {
+	return CredentialsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
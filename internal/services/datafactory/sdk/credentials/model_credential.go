@@ -0,0 +1,44 @@
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type Credential interface {
+}
+
+func unmarshalCredentialImplementation(input []byte) (Credential, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling Credential into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["type"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "ManagedIdentity") {
+		var out ManagedIdentityCredential
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ManagedIdentityCredential: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawCredentialImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawCredentialImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+}
@@ -0,0 +1,43 @@
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type CredentialResource struct {
+	Etag       *string    `json:"etag,omitempty"`
+	Id         *string    `json:"id,omitempty"`
+	Name       *string    `json:"name,omitempty"`
+	Properties Credential `json:"properties"`
+	Type       *string    `json:"type,omitempty"`
+}
+
+var _ json.Unmarshaler = &CredentialResource{}
+
+func (s *CredentialResource) UnmarshalJSON(bytes []byte) error {
+	type alias CredentialResource
+	var decoded alias
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into CredentialResource: %+v", err)
+	}
+
+	s.Etag = decoded.Etag
+	s.Id = decoded.Id
+	s.Name = decoded.Name
+	s.Type = decoded.Type
+
+	var temp map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &temp); err != nil {
+		return fmt.Errorf("unmarshaling CredentialResource into map[string]json.RawMessage: %+v", err)
+	}
+
+	if v, ok := temp["properties"]; ok {
+		impl, err := unmarshalCredentialImplementation(v)
+		if err != nil {
+			return fmt.Errorf("unmarshaling field 'Properties' for 'CredentialResource': %+v", err)
+		}
+		s.Properties = impl
+	}
+	return nil
+}
@@ -0,0 +1,5 @@
+package credentials
+
+type ManagedIdentityTypeProperties struct {
+	ResourceId *string `json:"resourceId,omitempty"`
+}
@@ -0,0 +1,15 @@
+package dataflows
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DataFlowsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDataFlowsClientWithBaseURI(endpoint string) DataFlowsClient {
+	return DataFlowsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
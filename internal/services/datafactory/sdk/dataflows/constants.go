@@ -0,0 +1,13 @@
+package dataflows
+
+type DatasetReferenceType string
+
+const (
+	DatasetReferenceTypeDatasetReference DatasetReferenceType = "DatasetReference"
+)
+
+type Type string
+
+const (
+	TypeLinkedServiceReference Type = "LinkedServiceReference"
+)
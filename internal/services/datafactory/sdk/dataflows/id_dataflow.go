@@ -0,0 +1,126 @@
+package dataflows
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DataFlowId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	FactoryName    string
+	Name           string
+}
+
+func NewDataFlowID(subscriptionId, resourceGroup, factoryName, name string) DataFlowId {
+	return DataFlowId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		FactoryName:    factoryName,
+		Name:           name,
+	}
+}
+
+func (id DataFlowId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Factory Name %q", id.FactoryName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Data Flow", segmentsStr)
+}
+
+func (id DataFlowId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataFactory/factories/%s/dataflows/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.Name)
+}
+
+// ParseDataFlowID parses a Data Flow ID into an DataFlowId struct
+func ParseDataFlowID(input string) (*DataFlowId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DataFlowId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("dataflows"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseDataFlowIDInsensitively parses an Data Flow ID into an DataFlowId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseDataFlowID method should be used instead for validation etc.
+func ParseDataFlowIDInsensitively(input string) (*DataFlowId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DataFlowId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'factories' segment
+	factoriesKey := "factories"
+	for key := range id.Path {
+		if strings.EqualFold(key, factoriesKey) {
+			factoriesKey = key
+			break
+		}
+	}
+	if resourceId.FactoryName, err = id.PopSegment(factoriesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'dataflows' segment
+	dataflowsKey := "dataflows"
+	for key := range id.Path {
+		if strings.EqualFold(key, dataflowsKey) {
+			dataflowsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(dataflowsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
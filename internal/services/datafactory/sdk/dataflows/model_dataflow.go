@@ -0,0 +1,44 @@
+package dataflows
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type DataFlow interface {
+}
+
+func unmarshalDataFlowImplementation(input []byte) (DataFlow, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling DataFlow into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["type"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "WranglingDataFlow") {
+		var out WranglingDataFlow
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into WranglingDataFlow: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawDataFlowImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawDataFlowImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+}
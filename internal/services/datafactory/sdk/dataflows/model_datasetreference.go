@@ -0,0 +1,7 @@
+package dataflows
+
+type DatasetReference struct {
+	Parameters    *map[string]interface{} `json:"parameters,omitempty"`
+	ReferenceName string                  `json:"referenceName"`
+	Type          DatasetReferenceType    `json:"type"`
+}
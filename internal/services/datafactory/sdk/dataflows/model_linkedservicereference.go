@@ -0,0 +1,7 @@
+package dataflows
+
+type LinkedServiceReference struct {
+	Parameters    *map[string]interface{} `json:"parameters,omitempty"`
+	ReferenceName string                  `json:"referenceName"`
+	Type          Type                    `json:"type"`
+}
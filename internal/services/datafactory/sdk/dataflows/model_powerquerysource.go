@@ -0,0 +1,10 @@
+package dataflows
+
+type PowerQuerySource struct {
+	Dataset             *DatasetReference       `json:"dataset,omitempty"`
+	Description         *string                 `json:"description,omitempty"`
+	LinkedService       *LinkedServiceReference `json:"linkedService,omitempty"`
+	Name                string                  `json:"name"`
+	SchemaLinkedService *LinkedServiceReference `json:"schemaLinkedService,omitempty"`
+	Script              *string                 `json:"script,omitempty"`
+}
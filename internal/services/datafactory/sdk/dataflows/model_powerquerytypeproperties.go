@@ -0,0 +1,7 @@
+package dataflows
+
+type PowerQueryTypeProperties struct {
+	DocumentLocale *string             `json:"documentLocale,omitempty"`
+	Script         *string             `json:"script,omitempty"`
+	Sources        *[]PowerQuerySource `json:"sources,omitempty"`
+}
@@ -3,12 +3,14 @@ package client
 import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/legacysdk/dataprotection"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/sdk/resourceguards"
 )
 
 type Client struct {
 	BackupVaultClient    *dataprotection.BackupVaultsClient
 	BackupPolicyClient   *dataprotection.BackupPoliciesClient
 	BackupInstanceClient *dataprotection.BackupInstancesClient
+	ResourceGuardClient  *resourceguards.ResourceGuardsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -21,9 +23,13 @@ func NewClient(o *common.ClientOptions) *Client {
 	backupInstanceClient := dataprotection.NewBackupInstancesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&backupInstanceClient.Client, o.ResourceManagerAuthorizer)
 
+	resourceGuardClient := resourceguards.NewResourceGuardsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&resourceGuardClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		BackupVaultClient:    &backupVaultClient,
 		BackupPolicyClient:   &backupPolicyClient,
 		BackupInstanceClient: &backupInstanceClient,
+		ResourceGuardClient:  &resourceGuardClient,
 	}
 }
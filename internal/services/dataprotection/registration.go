@@ -30,5 +30,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_data_protection_backup_policy_postgresql":   resourceDataProtectionBackupPolicyPostgreSQL(),
 		"azurerm_data_protection_backup_instance_disk":       resourceDataProtectionBackupInstanceDisk(),
 		"azurerm_data_protection_backup_instance_postgresql": resourceDataProtectionBackupInstancePostgreSQL(),
+		"azurerm_data_protection_resource_guard":             resourceDataProtectionResourceGuard(),
 	}
 }
@@ -0,0 +1,11 @@
+package resourceguards
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateFailed       ProvisioningState = "Failed"
+	ProvisioningStateProvisioning ProvisioningState = "Provisioning"
+	ProvisioningStateSucceeded    ProvisioningState = "Succeeded"
+	ProvisioningStateUnknown      ProvisioningState = "Unknown"
+	ProvisioningStateUpdating     ProvisioningState = "Updating"
+)
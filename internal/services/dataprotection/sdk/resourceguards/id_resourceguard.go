@@ -0,0 +1,108 @@
+package resourceguards
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ResourceGuardId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewResourceGuardID(subscriptionId, resourceGroup, name string) ResourceGuardId {
+	return ResourceGuardId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id ResourceGuardId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Resource Guard", segmentsStr)
+}
+
+func (id ResourceGuardId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DataProtection/resourceGuards/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseResourceGuardID parses a ResourceGuard ID into an ResourceGuardId struct
+func ParseResourceGuardID(input string) (*ResourceGuardId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ResourceGuardId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("resourceGuards"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseResourceGuardIDInsensitively parses an ResourceGuard ID into an ResourceGuardId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseResourceGuardID method should be used instead for validation etc.
+func ParseResourceGuardIDInsensitively(input string) (*ResourceGuardId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ResourceGuardId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'resourceGuards' segment
+	resourceGuardsKey := "resourceGuards"
+	for key := range id.Path {
+		if strings.EqualFold(key, resourceGuardsKey) {
+			resourceGuardsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(resourceGuardsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,9 @@
+package resourceguards
+
+import "fmt"
+
+const defaultApiVersion = "2021-07-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/resourceguards/%s", defaultApiVersion)
+}
@@ -0,0 +1,101 @@
+package devtestlabs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceDevTestLabAllowedVmSizes() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDevTestLabAllowedVmSizesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"lab_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.DevTestLabName(),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"restricted": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"sizes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDevTestLabAllowedVmSizesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	labsClient := meta.(*clients.Client).DevTestLabs.LabsClient
+	policiesClient := meta.(*clients.Client).DevTestLabs.PoliciesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	// the allowed sizes are configured through the `LabVmSize` Policy within the `default` Policy Set of the Lab
+	id := parse.NewDevTestLabPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("lab_name").(string), "default", string(dtl.PolicyFactNameLabVMSize))
+
+	lab, err := labsClient.Get(ctx, id.ResourceGroup, id.LabName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(lab.Response) {
+			return fmt.Errorf("DevTest Lab %q was not found in Resource Group %q", id.LabName, id.ResourceGroup)
+		}
+
+		return fmt.Errorf("retrieving DevTest Lab %q (Resource Group %q): %+v", id.LabName, id.ResourceGroup, err)
+	}
+
+	restricted := false
+	sizes := make([]string, 0)
+
+	policy, err := policiesClient.Get(ctx, id.ResourceGroup, id.LabName, id.PolicySetName, id.PolicyName, "")
+	if err != nil {
+		// when the Policy hasn't been configured, all sizes are allowed
+		if !utils.ResponseWasNotFound(policy.Response) {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+	}
+
+	if props := policy.PolicyProperties; props != nil && props.Status == dtl.PolicyStatusEnabled {
+		restricted = true
+
+		if props.Threshold != nil {
+			sizes, err = parseDevTestLabAllowedVmSizes(*props.Threshold)
+			if err != nil {
+				return fmt.Errorf("parsing the `threshold` for %s: %+v", id, err)
+			}
+		}
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("lab_name", id.LabName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("restricted", restricted)
+	if err := d.Set("sizes", sizes); err != nil {
+		return fmt.Errorf("setting `sizes`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package devtestlabs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DevTestLabAllowedVmSizesDataSource struct {
+}
+
+func TestAccDevTestLabAllowedVmSizesDataSource_unrestricted(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dev_test_lab_allowed_vm_sizes", "test")
+	r := DevTestLabAllowedVmSizesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.unrestricted(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("restricted").HasValue("false"),
+				check.That(data.ResourceName).Key("sizes.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccDevTestLabAllowedVmSizesDataSource_restricted(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dev_test_lab_allowed_vm_sizes", "test")
+	r := DevTestLabAllowedVmSizesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.restricted(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("restricted").HasValue("true"),
+				check.That(data.ResourceName).Key("sizes.#").HasValue("2"),
+				check.That(data.ResourceName).Key("sizes.0").HasValue("Standard_B1ms"),
+				check.That(data.ResourceName).Key("sizes.1").HasValue("Standard_F2"),
+			),
+		},
+	})
+}
+
+func (DevTestLabAllowedVmSizesDataSource) unrestricted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dev_test_lab_allowed_vm_sizes" "test" {
+  lab_name            = azurerm_dev_test_lab.test.name
+  resource_group_name = azurerm_dev_test_lab.test.resource_group_name
+}
+`, DevTestLabAllowedVmSizesDataSource{}.template(data))
+}
+
+func (DevTestLabAllowedVmSizesDataSource) restricted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_policy" "test" {
+  name                = "LabVmSize"
+  policy_set_name     = "default"
+  lab_name            = azurerm_dev_test_lab.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  threshold           = jsonencode(["Standard_B1ms", "Standard_F2"])
+  evaluator_type      = "AllowedValuesPolicy"
+}
+
+data "azurerm_dev_test_lab_allowed_vm_sizes" "test" {
+  lab_name            = azurerm_dev_test_lab.test.name
+  resource_group_name = azurerm_dev_test_lab.test.resource_group_name
+
+  depends_on = [azurerm_dev_test_policy.test]
+}
+`, DevTestLabAllowedVmSizesDataSource{}.template(data))
+}
+
+func (DevTestLabAllowedVmSizesDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
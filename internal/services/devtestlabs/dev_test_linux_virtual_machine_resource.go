@@ -6,12 +6,14 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -90,6 +92,17 @@ func resourceArmDevTestLinuxVirtualMachine() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"artifact": schemaDevTestVirtualMachineArtifact(),
+
+			"data_disk": schemaDevTestVirtualMachineDataDisk(),
+
+			"expiration_date": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
 			"allow_claim": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -179,6 +192,8 @@ func resourceArmDevTestLinuxVirtualMachineCreateUpdate(d *pluginsdk.ResourceData
 	storageType := d.Get("storage_type").(string)
 	username := d.Get("username").(string)
 
+	artifacts := expandDevTestLabVirtualMachineArtifacts(d.Get("artifact").([]interface{}))
+
 	galleryImageReferenceRaw := d.Get("gallery_image_reference").([]interface{})
 	galleryImageReference := expandDevTestLabVirtualMachineGalleryImageReference(galleryImageReferenceRaw, "Linux")
 
@@ -218,6 +233,19 @@ func resourceArmDevTestLinuxVirtualMachineCreateUpdate(d *pluginsdk.ResourceData
 		Tags: tags.Expand(t),
 	}
 
+	// the Artifacts can only be specified when the Virtual Machine is created
+	if d.IsNewResource() {
+		parameters.LabVirtualMachineProperties.Artifacts = artifacts
+	}
+
+	if v := d.Get("expiration_date").(string); v != "" {
+		expirationDate, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("parsing `expiration_date` %q: %+v", v, err)
+		}
+		parameters.LabVirtualMachineProperties.ExpirationDate = &date.Time{Time: expirationDate}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, labName, name, parameters)
 	if err != nil {
 		return fmt.Errorf("Error creating/updating DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
@@ -236,8 +264,22 @@ func resourceArmDevTestLinuxVirtualMachineCreateUpdate(d *pluginsdk.ResourceData
 		return fmt.Errorf("Cannot read DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q) ID", name, labName, resourceGroup)
 	}
 
+	// the Virtual Machine exists at this point, so track it in the state before waiting on the Artifacts/Data Disks
 	d.SetId(*read.ID)
 
+	if d.IsNewResource() {
+		if len(*artifacts) > 0 {
+			if err := waitForDevTestVirtualMachineArtifacts(ctx, client, resourceGroup, labName, name); err != nil {
+				return err
+			}
+		}
+
+		dataDisks := expandDevTestLabVirtualMachineDataDisks(d.Get("data_disk").([]interface{}))
+		if err := attachDevTestVirtualMachineDataDisks(ctx, client, resourceGroup, labName, name, dataDisks); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmDevTestLinuxVirtualMachineRead(d, meta)
 }
 
@@ -280,6 +322,12 @@ func resourceArmDevTestLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta i
 		d.Set("storage_type", props.StorageType)
 		d.Set("username", props.UserName)
 
+		expirationDate := ""
+		if props.ExpirationDate != nil {
+			expirationDate = props.ExpirationDate.Format(time.RFC3339)
+		}
+		d.Set("expiration_date", expirationDate)
+
 		flattenedImage := flattenDevTestVirtualMachineGalleryImage(props.GalleryImageReference)
 		if err := d.Set("gallery_image_reference", flattenedImage); err != nil {
 			return fmt.Errorf("Error setting `gallery_image_reference`: %+v", err)
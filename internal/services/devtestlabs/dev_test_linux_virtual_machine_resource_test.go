@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -130,6 +131,66 @@ func TestAccDevTestLinuxVirtualMachine_updateStorage(t *testing.T) {
 	})
 }
 
+func TestAccDevTestLinuxVirtualMachine_artifactsAndDataDisks(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_linux_virtual_machine", "test")
+	r := DevTestLinuxVirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.artifactsAndDataDisks(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("artifact.#").HasValue("1"),
+				check.That(data.ResourceName).Key("data_disk.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(
+			// not returned from the API
+			"artifact",
+			"data_disk",
+			"lab_subnet_name",
+			"lab_virtual_network_id",
+			"password",
+		),
+	})
+}
+
+func TestAccDevTestLinuxVirtualMachine_expirationDate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_linux_virtual_machine", "test")
+	r := DevTestLinuxVirtualMachineResource{}
+	expirationDate := time.Now().UTC().AddDate(0, 1, 0).Truncate(time.Hour).Format(time.RFC3339)
+	updatedExpirationDate := time.Now().UTC().AddDate(0, 2, 0).Truncate(time.Hour).Format(time.RFC3339)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.expirationDate(data, expirationDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("expiration_date").HasValue(expirationDate),
+			),
+		},
+		data.ImportStep(
+			// not returned from the API
+			"lab_subnet_name",
+			"lab_virtual_network_id",
+			"password",
+		),
+		{
+			Config: r.expirationDate(data, updatedExpirationDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("expiration_date").HasValue(updatedExpirationDate),
+			),
+		},
+		data.ImportStep(
+			// not returned from the API
+			"lab_subnet_name",
+			"lab_virtual_network_id",
+			"password",
+		),
+	})
+}
+
 func (DevTestLinuxVirtualMachineResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -296,6 +357,82 @@ resource "azurerm_dev_test_linux_virtual_machine" "test" {
 `, template, data.RandomInteger, storageType)
 }
 
+func (DevTestLinuxVirtualMachineResource) artifactsAndDataDisks(data acceptance.TestData) string {
+	template := DevTestLinuxVirtualMachineResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_linux_virtual_machine" "test" {
+  name                   = "acctestvm-vm%d"
+  lab_name               = azurerm_dev_test_lab.test.name
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  size                   = "Standard_F2"
+  username               = "acct5stU5er"
+  password               = "Pa$w0rd1234!"
+  lab_virtual_network_id = azurerm_dev_test_virtual_network.test.id
+  lab_subnet_name        = azurerm_dev_test_virtual_network.test.subnet[0].name
+  storage_type           = "Standard"
+
+  gallery_image_reference {
+    offer     = "UbuntuServer"
+    publisher = "Canonical"
+    sku       = "18.04-LTS"
+    version   = "latest"
+  }
+
+  artifact {
+    artifact_id = "${azurerm_dev_test_lab.test.id}/artifactSources/public repo/artifacts/linux-apt-package"
+
+    parameters = {
+      packages = "curl"
+      update   = "true"
+    }
+  }
+
+  data_disk {
+    name         = "acctestdisk1"
+    disk_size_gb = 32
+  }
+
+  data_disk {
+    name         = "acctestdisk2"
+    disk_size_gb = 64
+    storage_type = "Premium"
+    caching      = "ReadOnly"
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func (DevTestLinuxVirtualMachineResource) expirationDate(data acceptance.TestData, expirationDate string) string {
+	template := DevTestLinuxVirtualMachineResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_linux_virtual_machine" "test" {
+  name                   = "acctestvm-vm%d"
+  lab_name               = azurerm_dev_test_lab.test.name
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  size                   = "Standard_F2"
+  username               = "acct5stU5er"
+  password               = "Pa$w0rd1234!"
+  lab_virtual_network_id = azurerm_dev_test_virtual_network.test.id
+  lab_subnet_name        = azurerm_dev_test_virtual_network.test.subnet[0].name
+  storage_type           = "Standard"
+  expiration_date        = "%s"
+
+  gallery_image_reference {
+    offer     = "UbuntuServer"
+    publisher = "Canonical"
+    sku       = "18.04-LTS"
+    version   = "latest"
+  }
+}
+`, template, data.RandomInteger, expirationDate)
+}
+
 func (DevTestLinuxVirtualMachineResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
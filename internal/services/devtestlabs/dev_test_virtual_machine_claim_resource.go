@@ -0,0 +1,218 @@
+package devtestlabs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// The `unClaim` action is only available from API Version `2018-09-15` of the DevTest Labs API, which isn't
+// available in the version of the Azure SDK for Go currently in use (`2016-05-15`) - as such the request is
+// sent using the newer API Version directly.
+// TODO: remove this once the DevTest Labs SDK has been upgraded
+const devTestVirtualMachineUnClaimAPIVersion = "2018-09-15"
+
+func resourceDevTestVirtualMachineClaim() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDevTestVirtualMachineClaimCreate,
+		Read:   resourceDevTestVirtualMachineClaimRead,
+		Delete: resourceDevTestVirtualMachineClaimDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DevTestVirtualMachineID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DevTestVirtualMachineID,
+			},
+
+			"owner_object_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"owner_user_principal_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDevTestVirtualMachineClaimCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DevTestLabs.VirtualMachinesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DevTestVirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.LabName, id.VirtualmachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := existing.LabVirtualMachineProperties; props != nil {
+		if props.AllowClaim == nil || !*props.AllowClaim {
+			return fmt.Errorf("%s can't be claimed since `allow_claim` is disabled", *id)
+		}
+
+		// a claimable Virtual Machine has no owner until it's been claimed
+		if props.OwnerObjectID != nil && *props.OwnerObjectID != "" {
+			return tf.ImportAsExistsError("azurerm_dev_test_virtual_machine_claim", id.ID())
+		}
+	}
+
+	future, err := client.Claim(ctx, id.ResourceGroup, id.LabName, id.VirtualmachineName)
+	if err != nil {
+		return fmt.Errorf("claiming %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to be claimed: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDevTestVirtualMachineClaimRead(d, meta)
+}
+
+func resourceDevTestVirtualMachineClaimRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DevTestLabs.VirtualMachinesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DevTestVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.LabName, id.VirtualmachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing claim from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	ownerObjectId := ""
+	ownerUserPrincipalName := ""
+	if props := resp.LabVirtualMachineProperties; props != nil {
+		if props.OwnerObjectID != nil {
+			ownerObjectId = *props.OwnerObjectID
+		}
+		if props.OwnerUserPrincipalName != nil {
+			ownerUserPrincipalName = *props.OwnerUserPrincipalName
+		}
+	}
+
+	if ownerObjectId == "" {
+		log.Printf("[DEBUG] %s has been unclaimed - removing claim from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("virtual_machine_id", id.ID())
+	d.Set("owner_object_id", ownerObjectId)
+	d.Set("owner_user_principal_name", ownerUserPrincipalName)
+
+	return nil
+}
+
+func resourceDevTestVirtualMachineClaimDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DevTestLabs.VirtualMachinesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DevTestVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	if err := unClaimDevTestVirtualMachine(ctx, client, *id); err != nil {
+		return fmt.Errorf("unclaiming %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// unClaimDevTestVirtualMachine returns the Virtual Machine to the pool of claimable Virtual Machines within the Lab
+func unClaimDevTestVirtualMachine(ctx context.Context, client *dtl.VirtualMachinesClient, id parse.DevTestVirtualMachineId) error {
+	pathParameters := map[string]interface{}{
+		"labName":           autorest.Encode("path", id.LabName),
+		"name":              autorest.Encode("path", id.VirtualmachineName),
+		"resourceGroupName": autorest.Encode("path", id.ResourceGroup),
+		"subscriptionId":    autorest.Encode("path", id.SubscriptionId),
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPost(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.DevTestLab/labs/{labName}/virtualmachines/{name}/unClaim", pathParameters),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": devTestVirtualMachineUnClaimAPIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "dtl.VirtualMachinesClient", "UnClaim", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "dtl.VirtualMachinesClient", "UnClaim", resp, "Failure sending request")
+	}
+
+	// the Virtual Machine has been deleted, so there's nothing to unclaim
+	if resp.StatusCode == http.StatusNotFound {
+		return autorest.Respond(resp, autorest.ByClosing())
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "dtl.VirtualMachinesClient", "UnClaim", resp, "Failure sending request")
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for completion: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package devtestlabs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevTestVirtualMachineClaimResource struct {
+}
+
+func TestAccDevTestVirtualMachineClaim_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_virtual_machine_claim", "test")
+	r := DevTestVirtualMachineClaimResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owner_object_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevTestVirtualMachineClaim_unclaim(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_virtual_machine_claim", "test")
+	r := DevTestVirtualMachineClaimResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// removing the claim should return the Virtual Machine to the pool of claimable Virtual Machines
+			Config: r.template(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_dev_test_linux_virtual_machine.test").Key("allow_claim").HasValue("true"),
+			),
+		},
+		{
+			// and it can then be claimed again
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccDevTestVirtualMachineClaim_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_test_virtual_machine_claim", "test")
+	r := DevTestVirtualMachineClaimResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (DevTestVirtualMachineClaimResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DevTestVirtualMachineID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DevTestLabs.VirtualMachinesClient.Get(ctx, id.ResourceGroup, id.LabName, id.VirtualmachineName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	props := resp.LabVirtualMachineProperties
+	return utils.Bool(props != nil && props.OwnerObjectID != nil && *props.OwnerObjectID != ""), nil
+}
+
+func (r DevTestVirtualMachineClaimResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_virtual_machine_claim" "test" {
+  virtual_machine_id = azurerm_dev_test_linux_virtual_machine.test.id
+}
+`, r.template(data))
+}
+
+func (r DevTestVirtualMachineClaimResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_virtual_machine_claim" "import" {
+  virtual_machine_id = azurerm_dev_test_virtual_machine_claim.test.virtual_machine_id
+}
+`, r.basic(data))
+}
+
+func (DevTestVirtualMachineClaimResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_linux_virtual_machine" "test" {
+  name                   = "acctestvm-vm%d"
+  lab_name               = azurerm_dev_test_lab.test.name
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  size                   = "Standard_F2"
+  username               = "acct5stU5er"
+  password               = "Pa$w0rd1234!"
+  lab_virtual_network_id = azurerm_dev_test_virtual_network.test.id
+  lab_subnet_name        = azurerm_dev_test_virtual_network.test.subnet[0].name
+  storage_type           = "Standard"
+  allow_claim            = true
+
+  gallery_image_reference {
+    offer     = "UbuntuServer"
+    publisher = "Canonical"
+    sku       = "18.04-LTS"
+    version   = "latest"
+  }
+}
+`, DevTestLinuxVirtualMachineResource{}.template(data), data.RandomInteger)
+}
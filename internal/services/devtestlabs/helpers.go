@@ -1,7 +1,15 @@
 package devtestlabs
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
 	"github.com/Azure/azure-sdk-for-go/services/devtestlabs/mgmt/2016-05-15/dtl"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -142,3 +150,231 @@ func flattenDevTestVirtualMachineGalleryImage(input *dtl.GalleryImageReference)
 
 	return results
 }
+
+func schemaDevTestVirtualMachineArtifact() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		// since Artifacts can't be uninstalled from a Virtual Machine
+		ForceNew: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"artifact_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"parameters": {
+					Type:     pluginsdk.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandDevTestLabVirtualMachineArtifacts(input []interface{}) *[]dtl.ArtifactInstallProperties {
+	artifacts := make([]dtl.ArtifactInstallProperties, 0)
+
+	for _, val := range input {
+		if val == nil {
+			continue
+		}
+		v := val.(map[string]interface{})
+
+		parameters := make([]dtl.ArtifactParameterProperties, 0)
+		for name, value := range v["parameters"].(map[string]interface{}) {
+			parameters = append(parameters, dtl.ArtifactParameterProperties{
+				Name:  utils.String(name),
+				Value: utils.String(value.(string)),
+			})
+		}
+
+		artifacts = append(artifacts, dtl.ArtifactInstallProperties{
+			ArtifactID: utils.String(v["artifact_id"].(string)),
+			Parameters: &parameters,
+		})
+	}
+
+	return &artifacts
+}
+
+func schemaDevTestVirtualMachineDataDisk() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		// since the Data Disks aren't returned from the API in a form which can be mapped back to these
+		ForceNew: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"disk_size_gb": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(1, 32767),
+				},
+
+				"storage_type": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ForceNew: true,
+					Default:  string(dtl.Standard),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(dtl.Standard),
+						string(dtl.Premium),
+					}, false),
+				},
+
+				"caching": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ForceNew: true,
+					Default:  string(dtl.HostCachingOptionsNone),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(dtl.HostCachingOptionsNone),
+						string(dtl.HostCachingOptionsReadOnly),
+						string(dtl.HostCachingOptionsReadWrite),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func expandDevTestLabVirtualMachineDataDisks(input []interface{}) []dtl.DataDiskProperties {
+	disks := make([]dtl.DataDiskProperties, 0)
+
+	for _, val := range input {
+		if val == nil {
+			continue
+		}
+		v := val.(map[string]interface{})
+
+		disks = append(disks, dtl.DataDiskProperties{
+			AttachNewDataDiskOptions: &dtl.AttachNewDataDiskOptions{
+				DiskName:    utils.String(v["name"].(string)),
+				DiskSizeGiB: utils.Int32(int32(v["disk_size_gb"].(int))),
+				DiskType:    dtl.StorageType(v["storage_type"].(string)),
+			},
+			HostCaching: dtl.HostCachingOptions(v["caching"].(string)),
+		})
+	}
+
+	return disks
+}
+
+// attachDevTestVirtualMachineDataDisks attaches each of the specified Data Disks to the Virtual Machine - which is
+// only possible once the Virtual Machine has been created, since these can't be specified when creating it
+func attachDevTestVirtualMachineDataDisks(ctx context.Context, client *dtl.VirtualMachinesClient, resourceGroup, labName, name string, disks []dtl.DataDiskProperties) error {
+	for _, disk := range disks {
+		diskName := *disk.AttachNewDataDiskOptions.DiskName
+
+		log.Printf("[DEBUG] Attaching Data Disk %q to DevTest Virtual Machine %q (Lab %q / Resource Group %q)..", diskName, name, labName, resourceGroup)
+		future, err := client.AddDataDisk(ctx, resourceGroup, labName, name, disk)
+		if err != nil {
+			return fmt.Errorf("attaching Data Disk %q to DevTest Virtual Machine %q (Lab %q / Resource Group %q): %+v", diskName, name, labName, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for Data Disk %q to be attached to DevTest Virtual Machine %q (Lab %q / Resource Group %q): %+v", diskName, name, labName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// waitForDevTestVirtualMachineArtifacts polls the Virtual Machine until each of the Artifacts has been installed,
+// since the Virtual Machine is reported as provisioned before the Artifacts are installed
+func waitForDevTestVirtualMachineArtifacts(ctx context.Context, client *dtl.VirtualMachinesClient, resourceGroup, labName, name string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	log.Printf("[DEBUG] Waiting for the Artifacts to be installed on DevTest Virtual Machine %q (Lab %q / Resource Group %q)..", name, labName, resourceGroup)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Installing"},
+		Target:     []string{"Succeeded"},
+		Refresh:    devTestVirtualMachineArtifactsRefreshFunc(ctx, client, resourceGroup, labName, name),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the Artifacts to be installed on DevTest Virtual Machine %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func devTestVirtualMachineArtifactsRefreshFunc(ctx context.Context, client *dtl.VirtualMachinesClient, resourceGroup, labName, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceGroup, labName, name, "properties($expand=artifacts)")
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving DevTest Virtual Machine %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+		}
+
+		if resp.LabVirtualMachineProperties == nil || resp.LabVirtualMachineProperties.Artifacts == nil {
+			return resp, "Succeeded", nil
+		}
+
+		for _, artifact := range *resp.LabVirtualMachineProperties.Artifacts {
+			status := ""
+			if artifact.Status != nil {
+				status = *artifact.Status
+			}
+
+			switch strings.ToLower(status) {
+			case "succeeded", "skipped":
+				continue
+
+			case "failed":
+				artifactId := ""
+				if artifact.ArtifactID != nil {
+					artifactId = *artifact.ArtifactID
+				}
+				message := ""
+				if artifact.DeploymentStatusMessage != nil {
+					message = *artifact.DeploymentStatusMessage
+				}
+				if artifact.VMExtensionStatusMessage != nil {
+					message = fmt.Sprintf("%s %s", message, *artifact.VMExtensionStatusMessage)
+				}
+				return nil, "", fmt.Errorf("installing Artifact %q: %s", artifactId, strings.TrimSpace(message))
+
+			default:
+				return resp, "Installing", nil
+			}
+		}
+
+		return resp, "Succeeded", nil
+	}
+}
+
+// parseDevTestLabAllowedVmSizes parses the threshold of a `LabVmSize` Policy, which is a JSON encoded
+// list of the allowed sizes, e.g. `["Standard_A1","Standard_DS2_v2"]`
+func parseDevTestLabAllowedVmSizes(input string) ([]string, error) {
+	sizes := make([]string, 0)
+	if input == "" {
+		return sizes, nil
+	}
+
+	if err := json.Unmarshal([]byte(input), &sizes); err != nil {
+		return nil, err
+	}
+
+	return sizes, nil
+}
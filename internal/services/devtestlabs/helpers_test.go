@@ -0,0 +1,51 @@
+package devtestlabs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDevTestLabAllowedVmSizes(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected []string
+		error    bool
+	}{
+		{
+			input:    "",
+			expected: []string{},
+		},
+		{
+			input:    "[]",
+			expected: []string{},
+		},
+		{
+			input:    `["Standard_A1","Standard_DS2_v2"]`,
+			expected: []string{"Standard_A1", "Standard_DS2_v2"},
+		},
+		{
+			input: "Standard_A1",
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		actual, err := parseDevTestLabAllowedVmSizes(v.input)
+		if err != nil {
+			if v.error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(v.expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type DevTestVirtualMachineId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	LabName            string
+	VirtualmachineName string
+}
+
+func NewDevTestVirtualMachineID(subscriptionId, resourceGroup, labName, virtualmachineName string) DevTestVirtualMachineId {
+	return DevTestVirtualMachineId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		LabName:            labName,
+		VirtualmachineName: virtualmachineName,
+	}
+}
+
+func (id DevTestVirtualMachineId) String() string {
+	segments := []string{
+		fmt.Sprintf("Virtualmachine Name %q", id.VirtualmachineName),
+		fmt.Sprintf("Lab Name %q", id.LabName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Dev Test Virtual Machine", segmentsStr)
+}
+
+func (id DevTestVirtualMachineId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevTestLab/labs/%s/virtualmachines/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LabName, id.VirtualmachineName)
+}
+
+// DevTestVirtualMachineID parses a DevTestVirtualMachine ID into an DevTestVirtualMachineId struct
+func DevTestVirtualMachineID(input string) (*DevTestVirtualMachineId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DevTestVirtualMachineId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.LabName, err = id.PopSegment("labs"); err != nil {
+		return nil, err
+	}
+	if resourceId.VirtualmachineName, err = id.PopSegment("virtualmachines"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -21,8 +21,9 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_dev_test_lab":             dataSourceDevTestLab(),
-		"azurerm_dev_test_virtual_network": dataSourceArmDevTestVirtualNetwork(),
+		"azurerm_dev_test_lab":                  dataSourceDevTestLab(),
+		"azurerm_dev_test_lab_allowed_vm_sizes": dataSourceDevTestLabAllowedVmSizes(),
+		"azurerm_dev_test_virtual_network":      dataSourceArmDevTestVirtualNetwork(),
 	}
 }
 
@@ -34,6 +35,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_dev_test_schedule":                    resourceDevTestLabSchedules(),
 		"azurerm_dev_test_linux_virtual_machine":       resourceArmDevTestLinuxVirtualMachine(),
 		"azurerm_dev_test_policy":                      resourceArmDevTestPolicy(),
+		"azurerm_dev_test_virtual_machine_claim":       resourceDevTestVirtualMachineClaim(),
 		"azurerm_dev_test_virtual_network":             resourceArmDevTestVirtualNetwork(),
 		"azurerm_dev_test_windows_virtual_machine":     resourceArmDevTestWindowsVirtualMachine(),
 	}
@@ -3,10 +3,12 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/firewallpolicies"
 )
 
 type Client struct {
 	AzureFirewallsClient          *network.AzureFirewallsClient
+	FirewallPoliciesClient        *firewallpolicies.FirewallPoliciesClient
 	FirewallPolicyClient          *network.FirewallPoliciesClient
 	FirewallPolicyRuleGroupClient *network.FirewallPolicyRuleCollectionGroupsClient
 }
@@ -18,11 +20,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	policyClient := network.NewFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&policyClient.Client, o.ResourceManagerAuthorizer)
 
+	firewallPoliciesClient := firewallpolicies.NewFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&firewallPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
 	policyRuleGroupClient := network.NewFirewallPolicyRuleCollectionGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&policyRuleGroupClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
 		AzureFirewallsClient:          &firewallsClient,
+		FirewallPoliciesClient:        &firewallPoliciesClient,
 		FirewallPolicyClient:          &policyClient,
 		FirewallPolicyRuleGroupClient: &policyRuleGroupClient,
 	}
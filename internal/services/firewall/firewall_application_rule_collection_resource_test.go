@@ -368,6 +368,38 @@ func TestAccFirewallApplicationRuleCollection_noSource(t *testing.T) {
 	})
 }
 
+func TestAccFirewallApplicationRuleCollection_description(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_application_rule_collection", "test")
+	r := FirewallApplicationRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.description(data, "allow access to google"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.0.description").HasValue("allow access to google"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.description(data, "allow access to google search"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.0.description").HasValue("allow access to google search"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.0.description").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (FirewallApplicationRuleCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	var id, err = azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -491,6 +523,39 @@ resource "azurerm_firewall_application_rule_collection" "test" {
 `, template)
 }
 
+func (FirewallApplicationRuleCollectionResource) description(data acceptance.TestData, description string) string {
+	template := FirewallResource{}.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_application_rule_collection" "test" {
+  name                = "acctestarc"
+  azure_firewall_name = azurerm_firewall.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  priority            = 100
+  action              = "Allow"
+
+  rule {
+    name        = "rule1"
+    description = "%s"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    target_fqdns = [
+      "*.google.com",
+    ]
+
+    protocol {
+      port = 443
+      type = "Https"
+    }
+  }
+}
+`, template, description)
+}
+
 func (FirewallApplicationRuleCollectionResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
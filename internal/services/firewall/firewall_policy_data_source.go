@@ -4,14 +4,15 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/firewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func FirewallDataSourcePolicy() *pluginsdk.Resource {
@@ -120,55 +121,60 @@ func FirewallDataSourcePolicy() *pluginsdk.Resource {
 }
 
 func FirewallDataSourcePolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	client := meta.(*clients.Client).Firewall.FirewallPoliciesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	name := d.Get("name").(string)
-	resourceGroup := d.Get("resource_group_name").(string)
+	id := firewallpolicies.NewFirewallPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	resp, err := client.Get(ctx, resourceGroup, name, "")
+	resp, err := client.Get(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			return fmt.Errorf("Firewall Policy %q (Resource Group %q) was not found", name, resourceGroup)
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
 		}
 
-		return fmt.Errorf("retrieving Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	if resp.ID == nil || *resp.ID == "" {
-		return fmt.Errorf("empty or nil ID returned for Firewall Policy %q (Resource Group %q) ID", name, resourceGroup)
-	}
-
-	d.SetId(*resp.ID)
-
-	d.Set("name", name)
-	d.Set("resource_group_name", resourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
-
-	if prop := resp.FirewallPolicyPropertiesFormat; prop != nil {
-		basePolicyID := ""
-		if resp.BasePolicy != nil && resp.BasePolicy.ID != nil {
-			basePolicyID = *resp.BasePolicy.ID
-		}
-		d.Set("base_policy_id", basePolicyID)
-		if err := d.Set("child_policies", flattenNetworkSubResourceID(prop.ChildPolicies)); err != nil {
-			return fmt.Errorf(`setting "child_policies": %+v`, err)
-		}
-		if err := d.Set("dns", flattenFirewallPolicyDNSSetting(prop.DNSSettings)); err != nil {
-			return fmt.Errorf(`setting "dns": %+v`, err)
-		}
-		if err := d.Set("firewalls", flattenNetworkSubResourceID(prop.Firewalls)); err != nil {
-			return fmt.Errorf(`setting "firewalls": %+v`, err)
-		}
-		if err := d.Set("rule_collection_groups", flattenNetworkSubResourceID(prop.RuleCollectionGroups)); err != nil {
-			return fmt.Errorf(`setting "rule_collection_groups": %+v`, err)
-		}
-		d.Set("threat_intelligence_mode", string(prop.ThreatIntelMode))
-		if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(resp.ThreatIntelWhitelist)); err != nil {
-			return fmt.Errorf(`setting "threat_intelligence_allowlist": %+v`, err)
+	d.SetId(id.ID())
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if prop := model.Properties; prop != nil {
+			basePolicyID := ""
+			if prop.BasePolicy != nil && prop.BasePolicy.Id != nil {
+				basePolicyID = *prop.BasePolicy.Id
+			}
+			d.Set("base_policy_id", basePolicyID)
+			if err := d.Set("child_policies", flattenFirewallPolicySubResourceIDs(prop.ChildPolicies)); err != nil {
+				return fmt.Errorf(`setting "child_policies": %+v`, err)
+			}
+			if err := d.Set("dns", flattenFirewallPolicyDNSSetting(prop.DnsSettings)); err != nil {
+				return fmt.Errorf(`setting "dns": %+v`, err)
+			}
+			if err := d.Set("firewalls", flattenFirewallPolicySubResourceIDs(prop.Firewalls)); err != nil {
+				return fmt.Errorf(`setting "firewalls": %+v`, err)
+			}
+			if err := d.Set("rule_collection_groups", flattenFirewallPolicySubResourceIDs(prop.RuleCollectionGroups)); err != nil {
+				return fmt.Errorf(`setting "rule_collection_groups": %+v`, err)
+			}
+			threatIntelMode := ""
+			if prop.ThreatIntelMode != nil {
+				threatIntelMode = string(*prop.ThreatIntelMode)
+			}
+			d.Set("threat_intelligence_mode", threatIntelMode)
+			if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(prop.ThreatIntelWhitelist)); err != nil {
+				return fmt.Errorf(`setting "threat_intelligence_allowlist": %+v`, err)
+			}
 		}
+
+		return tags.FlattenAndSet(d, flattenTags(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
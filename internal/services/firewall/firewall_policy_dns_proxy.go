@@ -0,0 +1,118 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// firewallPolicyNetworkRuleCollectionsUsingFqdns returns the names of the Network Rule Collections which contain a
+// Rule using `destination_fqdns` - since these require the DNS Proxy to be enabled on the Firewall Policy
+func firewallPolicyNetworkRuleCollectionsUsingFqdns(input []network.BasicFirewallPolicyRuleCollection) []string {
+	names := make([]string, 0)
+	for _, item := range input {
+		collection, ok := item.AsFirewallPolicyFilterRuleCollection()
+		if !ok || collection == nil || collection.Rules == nil {
+			continue
+		}
+
+		for _, item := range *collection.Rules {
+			rule, ok := item.AsRule()
+			if !ok || rule == nil || rule.DestinationFqdns == nil || len(*rule.DestinationFqdns) == 0 {
+				continue
+			}
+
+			name := ""
+			if collection.Name != nil {
+				name = *collection.Name
+			}
+			names = append(names, name)
+			break
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// validateFirewallPolicyDNSProxyForRuleCollections checks that the DNS Proxy is enabled on the Firewall Policy when any
+// of the Network Rules within the Rule Collections use FQDNs, since the API otherwise rejects these at apply time
+func validateFirewallPolicyDNSProxyForRuleCollections(ctx context.Context, client *network.FirewallPoliciesClient, policyId parse.FirewallPolicyId, collections []network.BasicFirewallPolicyRuleCollection) error {
+	names := firewallPolicyNetworkRuleCollectionsUsingFqdns(collections)
+	if len(names) == 0 {
+		return nil
+	}
+
+	policy, err := client.Get(ctx, policyId.ResourceGroup, policyId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", policyId, err)
+	}
+
+	if props := policy.FirewallPolicyPropertiesFormat; props != nil && props.DNSSettings != nil && props.DNSSettings.EnableProxy != nil && *props.DNSSettings.EnableProxy {
+		return nil
+	}
+
+	return fmt.Errorf("the Network Rule Collections %q use `destination_fqdns`, which requires `dns.0.proxy_enabled` to be `true` on %s", strings.Join(names, ", "), policyId)
+}
+
+// firewallPolicyCustomizeDiff checks that the DNS Proxy isn't being disabled whilst Network Rules within the Rule
+// Collection Groups of the Firewall Policy use FQDNs
+func firewallPolicyCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("dns") {
+		return nil
+	}
+
+	if v, ok := diff.GetOk("dns"); ok {
+		dns := v.([]interface{})
+		if len(dns) > 0 && dns[0] != nil && dns[0].(map[string]interface{})["proxy_enabled"].(bool) {
+			return nil
+		}
+	}
+
+	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	groupsClient := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+
+	policyId, err := parse.FirewallPolicyID(diff.Id())
+	if err != nil {
+		return err
+	}
+
+	policy, err := client.Get(ctx, policyId.ResourceGroup, policyId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *policyId, err)
+	}
+	if policy.FirewallPolicyPropertiesFormat == nil || policy.FirewallPolicyPropertiesFormat.RuleCollectionGroups == nil {
+		return nil
+	}
+
+	for _, item := range *policy.FirewallPolicyPropertiesFormat.RuleCollectionGroups {
+		if item.ID == nil {
+			continue
+		}
+
+		groupId, err := parse.FirewallPolicyRuleCollectionGroupID(*item.ID)
+		if err != nil {
+			return err
+		}
+
+		group, err := groupsClient.Get(ctx, groupId.ResourceGroup, groupId.FirewallPolicyName, groupId.RuleCollectionGroupName)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *groupId, err)
+		}
+		if group.FirewallPolicyRuleCollectionGroupProperties == nil || group.FirewallPolicyRuleCollectionGroupProperties.RuleCollections == nil {
+			continue
+		}
+
+		if names := firewallPolicyNetworkRuleCollectionsUsingFqdns(*group.FirewallPolicyRuleCollectionGroupProperties.RuleCollections); len(names) > 0 {
+			return fmt.Errorf("`dns.0.proxy_enabled` must be `true` since the Network Rule Collections %q within %s use `destination_fqdns`", strings.Join(names, ", "), *groupId)
+		}
+	}
+
+	return nil
+}
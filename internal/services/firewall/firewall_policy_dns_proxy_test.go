@@ -0,0 +1,82 @@
+package firewall
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFirewallPolicyNetworkRuleCollectionsUsingFqdns(t *testing.T) {
+	networkRuleCollection := func(name string, destinationFqdns ...string) network.BasicFirewallPolicyRuleCollection {
+		return network.FirewallPolicyFilterRuleCollection{
+			Name:     utils.String(name),
+			Priority: utils.Int32(500),
+			Rules: &[]network.BasicFirewallPolicyRule{
+				network.Rule{
+					Name:                 utils.String("rule1"),
+					DestinationAddresses: &[]string{"10.0.0.1"},
+				},
+				network.Rule{
+					Name:             utils.String("rule2"),
+					DestinationFqdns: &destinationFqdns,
+				},
+			},
+		}
+	}
+	applicationRuleCollection := func(name string) network.BasicFirewallPolicyRuleCollection {
+		return network.FirewallPolicyFilterRuleCollection{
+			Name:     utils.String(name),
+			Priority: utils.Int32(500),
+			Rules: &[]network.BasicFirewallPolicyRule{
+				network.ApplicationRule{
+					Name:            utils.String("rule1"),
+					TargetFqdns:     &[]string{"terraform.io"},
+					SourceAddresses: &[]string{"10.0.0.1"},
+				},
+			},
+		}
+	}
+
+	testData := []struct {
+		Name     string
+		Input    []network.BasicFirewallPolicyRuleCollection
+		Expected []string
+	}{
+		{
+			Name:     "No Rule Collections",
+			Input:    []network.BasicFirewallPolicyRuleCollection{},
+			Expected: []string{},
+		},
+		{
+			Name:     "Network Rules without FQDNs",
+			Input:    []network.BasicFirewallPolicyRuleCollection{networkRuleCollection("collection1")},
+			Expected: []string{},
+		},
+		{
+			Name:     "Application Rules with FQDNs",
+			Input:    []network.BasicFirewallPolicyRuleCollection{applicationRuleCollection("collection1")},
+			Expected: []string{},
+		},
+		{
+			Name: "Network Rules with FQDNs",
+			Input: []network.BasicFirewallPolicyRuleCollection{
+				networkRuleCollection("collection3", "terraform.io"),
+				applicationRuleCollection("collection2"),
+				networkRuleCollection("collection1", "hashicorp.com", "terraform.io"),
+				networkRuleCollection("collection4"),
+			},
+			Expected: []string{"collection1", "collection3"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := firewallPolicyNetworkRuleCollectionsUsingFqdns(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -3,9 +3,9 @@ package firewall
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
 	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -13,9 +13,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/firewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -23,6 +25,10 @@ import (
 
 const azureFirewallPolicyResourceName = "azurerm_firewall_policy"
 
+// firewallPolicyIANAPrivateRanges is a special value for `private_ip_ranges` which represents the IANA Private Ranges
+// (RFC 1918), which can be combined with other ranges
+const firewallPolicyIANAPrivateRanges = "IANAPrivateRanges"
+
 func resourceFirewallPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceFirewallPolicyCreateUpdate,
@@ -58,8 +64,8 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 				Computed: true,
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.FirewallPolicySkuTierPremium),
-					string(network.FirewallPolicySkuTierStandard),
+					string(firewallpolicies.FirewallPolicySkuTierPremium),
+					string(firewallpolicies.FirewallPolicySkuTierStandard),
 				}, false),
 			},
 
@@ -83,7 +89,7 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 							Optional: true,
 							Elem: &pluginsdk.Schema{
 								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.IsIPv4Address,
+								ValidateFunc: validation.IsIPAddress,
 							},
 						},
 						"proxy_enabled": {
@@ -105,11 +111,11 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 			"threat_intelligence_mode": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				Default:  string(network.AzureFirewallThreatIntelModeAlert),
+				Default:  string(firewallpolicies.AzureFirewallThreatIntelModeAlert),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.AzureFirewallThreatIntelModeAlert),
-					string(network.AzureFirewallThreatIntelModeDeny),
-					string(network.AzureFirewallThreatIntelModeOff),
+					string(firewallpolicies.AzureFirewallThreatIntelModeAlert),
+					string(firewallpolicies.AzureFirewallThreatIntelModeDeny),
+					string(firewallpolicies.AzureFirewallThreatIntelModeOff),
 				}, false),
 			},
 
@@ -175,157 +181,224 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 					ValidateFunc: validation.Any(
 						validation.IsCIDR,
 						validation.IsIPv4Address,
+						validation.StringInSlice([]string{firewallPolicyIANAPrivateRanges}, true),
 					),
+					DiffSuppressFunc: suppress.CaseDifference,
+				},
+			},
+
+			"auto_learn_private_ranges_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"explicit_proxy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+						"http_port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 64000),
+						},
+						"https_port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 64000),
+						},
+						"enable_pac_file": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+						"pac_file_port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 64000),
+						},
+						"pac_file": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
 				},
 			},
 
+			"sql_redirect_allowed": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags": tags.SchemaEnforceLowerCaseKeys(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(firewallPolicyCustomizeDiff),
 	}
 }
 
 func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	client := meta.(*clients.Client).Firewall.FirewallPoliciesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	name := d.Get("name").(string)
-	resourceGroup := d.Get("resource_group_name").(string)
+	id := firewallpolicies.NewFirewallPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
 	if d.IsNewResource() {
-		resp, err := client.Get(ctx, resourceGroup, name, "")
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(resp.Response) {
-				return fmt.Errorf("checking for existing Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
 			}
 		}
 
-		if resp.ID != nil && *resp.ID != "" {
-			return tf.ImportAsExistsError("azurerm_firewall_policy", *resp.ID)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_firewall_policy", id.ID())
 		}
 	}
 
-	props := network.FirewallPolicy{
-		FirewallPolicyPropertiesFormat: &network.FirewallPolicyPropertiesFormat{
-			ThreatIntelMode:      network.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string)),
+	threatIntelMode := firewallpolicies.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string))
+	props := firewallpolicies.FirewallPolicy{
+		Properties: &firewallpolicies.FirewallPolicyPropertiesFormat{
+			ThreatIntelMode:      &threatIntelMode,
 			ThreatIntelWhitelist: expandFirewallPolicyThreatIntelWhitelist(d.Get("threat_intelligence_allowlist").([]interface{})),
-			DNSSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
+			DnsSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
+			Snat:                 expandFirewallPolicySNAT(d),
 		},
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     expandTags(d.Get("tags").(map[string]interface{})),
 	}
-	if id, ok := d.GetOk("base_policy_id"); ok {
-		props.FirewallPolicyPropertiesFormat.BasePolicy = &network.SubResource{ID: utils.String(id.(string))}
+	if v, ok := d.GetOk("base_policy_id"); ok {
+		props.Properties.BasePolicy = &firewallpolicies.SubResource{Id: utils.String(v.(string))}
 	}
 
 	if v, ok := d.GetOk("sku"); ok {
-		props.FirewallPolicyPropertiesFormat.Sku = &network.FirewallPolicySku{
-			Tier: network.FirewallPolicySkuTier(v.(string)),
+		tier := firewallpolicies.FirewallPolicySkuTier(v.(string))
+		props.Properties.Sku = &firewallpolicies.FirewallPolicySku{
+			Tier: &tier,
 		}
 	}
 
-	if v, ok := d.GetOk("private_ip_ranges"); ok {
-		privateIpRanges := utils.ExpandStringSlice(v.([]interface{}))
-		props.FirewallPolicyPropertiesFormat.Snat = &network.FirewallPolicySNAT{
-			PrivateRanges: privateIpRanges,
-		}
-	}
-
-	locks.ByName(name, azureFirewallPolicyResourceName)
-	defer locks.UnlockByName(name, azureFirewallPolicyResourceName)
+	locks.ByName(id.Name, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.Name, azureFirewallPolicyResourceName)
 
-	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, props); err != nil {
-		return fmt.Errorf("creating Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	if err := client.CreateOrUpdateThenPoll(ctx, id, props); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	resp, err := client.Get(ctx, resourceGroup, name, "")
-	if err != nil {
-		return fmt.Errorf("retrieving Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
-	}
-	if resp.ID == nil || *resp.ID == "" {
-		return fmt.Errorf("empty or nil ID returned for Firewall Policy %q (Resource Group %q) ID", name, resourceGroup)
-	}
-	d.SetId(*resp.ID)
+	d.SetId(id.ID())
 
 	return resourceFirewallPolicyRead(d, meta)
 }
 
 func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	client := meta.(*clients.Client).Firewall.FirewallPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.FirewallPolicyID(d.Id())
+	id, err := firewallpolicies.ParseFirewallPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			log.Printf("[DEBUG] Firewall Policy %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
 			d.SetId("")
 			return nil
 		}
 
-		return fmt.Errorf("retrieving Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
 
-	if prop := resp.FirewallPolicyPropertiesFormat; prop != nil {
-		basePolicyID := ""
-		if resp.BasePolicy != nil && resp.BasePolicy.ID != nil {
-			basePolicyID = *resp.BasePolicy.ID
-		}
-		d.Set("base_policy_id", basePolicyID)
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
 
-		d.Set("threat_intelligence_mode", string(prop.ThreatIntelMode))
+		if prop := model.Properties; prop != nil {
+			basePolicyID := ""
+			if prop.BasePolicy != nil && prop.BasePolicy.Id != nil {
+				basePolicyID = *prop.BasePolicy.Id
+			}
+			d.Set("base_policy_id", basePolicyID)
 
-		if sku := prop.Sku; sku != nil {
-			d.Set("sku", string(sku.Tier))
-		}
+			threatIntelMode := ""
+			if prop.ThreatIntelMode != nil {
+				threatIntelMode = string(*prop.ThreatIntelMode)
+			}
+			d.Set("threat_intelligence_mode", threatIntelMode)
 
-		if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(resp.ThreatIntelWhitelist)); err != nil {
-			return fmt.Errorf(`setting "threat_intelligence_allowlist": %+v`, err)
-		}
+			if sku := prop.Sku; sku != nil && sku.Tier != nil {
+				d.Set("sku", string(*sku.Tier))
+			}
 
-		if err := d.Set("dns", flattenFirewallPolicyDNSSetting(prop.DNSSettings)); err != nil {
-			return fmt.Errorf(`setting "dns": %+v`, err)
-		}
+			if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(prop.ThreatIntelWhitelist)); err != nil {
+				return fmt.Errorf(`setting "threat_intelligence_allowlist": %+v`, err)
+			}
 
-		if err := d.Set("child_policies", flattenNetworkSubResourceID(prop.ChildPolicies)); err != nil {
-			return fmt.Errorf(`setting "child_policies": %+v`, err)
-		}
+			if err := d.Set("dns", flattenFirewallPolicyDNSSetting(prop.DnsSettings)); err != nil {
+				return fmt.Errorf(`setting "dns": %+v`, err)
+			}
 
-		if err := d.Set("firewalls", flattenNetworkSubResourceID(prop.Firewalls)); err != nil {
-			return fmt.Errorf(`setting "firewalls": %+v`, err)
-		}
+			if err := d.Set("child_policies", flattenFirewallPolicySubResourceIDs(prop.ChildPolicies)); err != nil {
+				return fmt.Errorf(`setting "child_policies": %+v`, err)
+			}
 
-		if err := d.Set("rule_collection_groups", flattenNetworkSubResourceID(prop.RuleCollectionGroups)); err != nil {
-			return fmt.Errorf(`setting "rule_collection_groups": %+v`, err)
-		}
+			if err := d.Set("firewalls", flattenFirewallPolicySubResourceIDs(prop.Firewalls)); err != nil {
+				return fmt.Errorf(`setting "firewalls": %+v`, err)
+			}
 
-		var privateIpRanges []interface{}
-		if prop.Snat != nil {
-			privateIpRanges = utils.FlattenStringSlice(prop.Snat.PrivateRanges)
-		}
-		if err := d.Set("private_ip_ranges", privateIpRanges); err != nil {
-			return fmt.Errorf("Error setting `private_ip_ranges`: %+v", err)
+			if err := d.Set("rule_collection_groups", flattenFirewallPolicySubResourceIDs(prop.RuleCollectionGroups)); err != nil {
+				return fmt.Errorf(`setting "rule_collection_groups": %+v`, err)
+			}
+
+			var privateIpRanges []interface{}
+			autoLearnPrivateRangesEnabled := false
+			if prop.Snat != nil {
+				privateIpRanges = utils.FlattenStringSlice(prop.Snat.PrivateRanges)
+				if v := prop.Snat.AutoLearnPrivateRanges; v != nil {
+					autoLearnPrivateRangesEnabled = *v == firewallpolicies.AutoLearnPrivateRangesModeEnabled
+				}
+			}
+			if err := d.Set("private_ip_ranges", privateIpRanges); err != nil {
+				return fmt.Errorf("Error setting `private_ip_ranges`: %+v", err)
+			}
+			d.Set("auto_learn_private_ranges_enabled", autoLearnPrivateRangesEnabled)
+
+			if err := d.Set("explicit_proxy", flattenFirewallPolicyExplicitProxy(prop.ExplicitProxy)); err != nil {
+				return fmt.Errorf(`setting "explicit_proxy": %+v`, err)
+			}
+
+			sqlRedirectAllowed := false
+			if prop.Sql != nil && prop.Sql.AllowSqlRedirect != nil {
+				sqlRedirectAllowed = *prop.Sql.AllowSqlRedirect
+			}
+			d.Set("sql_redirect_allowed", sqlRedirectAllowed)
 		}
+
+		return tags.FlattenAndSet(d, flattenTags(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	client := meta.(*clients.Client).Firewall.FirewallPoliciesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.FirewallPolicyID(d.Id())
+	id, err := firewallpolicies.ParseFirewallPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -333,40 +406,34 @@ func resourceFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.ByName(id.Name, azureFirewallPolicyResourceName)
 	defer locks.UnlockByName(id.Name, azureFirewallPolicyResourceName)
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
-		return fmt.Errorf("deleting Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("waiting for deleting Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-		}
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
 	return nil
 }
 
-func expandFirewallPolicyThreatIntelWhitelist(input []interface{}) *network.FirewallPolicyThreatIntelWhitelist {
+func expandFirewallPolicyThreatIntelWhitelist(input []interface{}) *firewallpolicies.FirewallPolicyThreatIntelWhitelist {
 	if len(input) == 0 || input[0] == nil {
 		return nil
 	}
 
 	raw := input[0].(map[string]interface{})
-	output := &network.FirewallPolicyThreatIntelWhitelist{
-		IPAddresses: utils.ExpandStringSlice(raw["ip_addresses"].(*pluginsdk.Set).List()),
+	output := &firewallpolicies.FirewallPolicyThreatIntelWhitelist{
+		IpAddresses: utils.ExpandStringSlice(raw["ip_addresses"].(*pluginsdk.Set).List()),
 		Fqdns:       utils.ExpandStringSlice(raw["fqdns"].(*pluginsdk.Set).List()),
 	}
 
 	return output
 }
 
-func expandFirewallPolicyDNSSetting(input []interface{}) *network.DNSSettings {
+func expandFirewallPolicyDNSSetting(input []interface{}) *firewallpolicies.DnsSettings {
 	if len(input) == 0 || input[0] == nil {
 		return nil
 	}
 
 	raw := input[0].(map[string]interface{})
-	output := &network.DNSSettings{
+	output := &firewallpolicies.DnsSettings{
 		Servers:     utils.ExpandStringSlice(raw["servers"].(*pluginsdk.Set).List()),
 		EnableProxy: utils.Bool(raw["proxy_enabled"].(bool)),
 	}
@@ -374,20 +441,67 @@ func expandFirewallPolicyDNSSetting(input []interface{}) *network.DNSSettings {
 	return output
 }
 
-func flattenFirewallPolicyThreatIntelWhitelist(input *network.FirewallPolicyThreatIntelWhitelist) []interface{} {
+func expandFirewallPolicySNAT(d *pluginsdk.ResourceData) *firewallpolicies.FirewallPolicySNAT {
+	autoLearnPrivateRanges := firewallpolicies.AutoLearnPrivateRangesModeDisabled
+	if d.Get("auto_learn_private_ranges_enabled").(bool) {
+		autoLearnPrivateRanges = firewallpolicies.AutoLearnPrivateRangesModeEnabled
+	}
+
+	output := &firewallpolicies.FirewallPolicySNAT{
+		AutoLearnPrivateRanges: &autoLearnPrivateRanges,
+	}
+
+	if v, ok := d.GetOk("private_ip_ranges"); ok {
+		privateIpRanges := make([]string, 0)
+		for _, item := range v.([]interface{}) {
+			privateIpRange := item.(string)
+			// the API only accepts the IANA Private Ranges token in this casing
+			if strings.EqualFold(privateIpRange, firewallPolicyIANAPrivateRanges) {
+				privateIpRange = firewallPolicyIANAPrivateRanges
+			}
+			privateIpRanges = append(privateIpRanges, privateIpRange)
+		}
+		output.PrivateRanges = &privateIpRanges
+	}
+
+	return output
+}
+
+func expandFirewallPolicyExplicitProxy(input []interface{}) *firewallpolicies.ExplicitProxy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := &firewallpolicies.ExplicitProxy{
+		EnableExplicitProxy: utils.Bool(raw["enabled"].(bool)),
+		HttpPort:            utils.Int64(int64(raw["http_port"].(int))),
+		HttpsPort:           utils.Int64(int64(raw["https_port"].(int))),
+		EnablePacFile:       utils.Bool(raw["enable_pac_file"].(bool)),
+		PacFilePort:         utils.Int64(int64(raw["pac_file_port"].(int))),
+	}
+
+	if v := raw["pac_file"].(string); v != "" {
+		output.PacFile = utils.String(v)
+	}
+
+	return output
+}
+
+func flattenFirewallPolicyThreatIntelWhitelist(input *firewallpolicies.FirewallPolicyThreatIntelWhitelist) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
 
 	return []interface{}{
 		map[string]interface{}{
-			"ip_addresses": utils.FlattenStringSlice(input.IPAddresses),
+			"ip_addresses": utils.FlattenStringSlice(input.IpAddresses),
 			"fqdns":        utils.FlattenStringSlice(input.Fqdns),
 		},
 	}
 }
 
-func flattenFirewallPolicyDNSSetting(input *network.DNSSettings) []interface{} {
+func flattenFirewallPolicyDNSSetting(input *firewallpolicies.DnsSettings) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
@@ -406,3 +520,65 @@ func flattenFirewallPolicyDNSSetting(input *network.DNSSettings) []interface{} {
 		},
 	}
 }
+
+func flattenFirewallPolicyExplicitProxy(input *firewallpolicies.ExplicitProxy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if input.EnableExplicitProxy != nil {
+		enabled = *input.EnableExplicitProxy
+	}
+
+	var httpPort int64
+	if input.HttpPort != nil {
+		httpPort = *input.HttpPort
+	}
+
+	var httpsPort int64
+	if input.HttpsPort != nil {
+		httpsPort = *input.HttpsPort
+	}
+
+	enablePacFile := false
+	if input.EnablePacFile != nil {
+		enablePacFile = *input.EnablePacFile
+	}
+
+	var pacFilePort int64
+	if input.PacFilePort != nil {
+		pacFilePort = *input.PacFilePort
+	}
+
+	pacFile := ""
+	if input.PacFile != nil {
+		pacFile = *input.PacFile
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":         enabled,
+			"http_port":       int(httpPort),
+			"https_port":      int(httpsPort),
+			"enable_pac_file": enablePacFile,
+			"pac_file_port":   int(pacFilePort),
+			"pac_file":        pacFile,
+		},
+	}
+}
+
+func flattenFirewallPolicySubResourceIDs(input *[]firewallpolicies.SubResource) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.Id != nil {
+			results = append(results, *item.Id)
+		}
+	}
+
+	return results
+}
@@ -90,6 +90,28 @@ func TestAccFirewallPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicy_autoLearnPrivateRanges(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoLearnPrivateRanges(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.autoLearnPrivateRanges(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccFirewallPolicy_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
 	r := FirewallPolicyResource{}
@@ -180,6 +202,15 @@ resource "azurerm_firewall_policy" "test" {
     proxy_enabled = true
   }
   private_ip_ranges = ["172.16.0.0/12", "192.168.0.0/16"]
+  explicit_proxy {
+    enabled         = true
+    http_port       = 8087
+    https_port      = 8088
+    enable_pac_file = true
+    pac_file_port   = 8089
+    pac_file        = "https://tinyurl.com/acctest-pacfile"
+  }
+  sql_redirect_allowed = true
   tags = {
     env = "Test"
   }
@@ -187,6 +218,21 @@ resource "azurerm_firewall_policy" "test" {
 `, template, data.RandomInteger)
 }
 
+func (FirewallPolicyResource) autoLearnPrivateRanges(data acceptance.TestData, enabled bool) string {
+	template := FirewallPolicyResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy" "test" {
+  name                              = "acctest-networkfw-Policy-%d"
+  resource_group_name               = azurerm_resource_group.test.name
+  location                          = azurerm_resource_group.test.location
+  private_ip_ranges                 = ["IANAPrivateRanges", "100.64.0.0/10"]
+  auto_learn_private_ranges_enabled = %t
+}
+`, template, data.RandomInteger, enabled)
+}
+
 func (FirewallPolicyResource) requiresImport(data acceptance.TestData) string {
 	template := FirewallPolicyResource{}.basic(data)
 	return fmt.Sprintf(`
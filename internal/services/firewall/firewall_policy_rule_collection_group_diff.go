@@ -0,0 +1,144 @@
+package firewall
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+)
+
+// firewallPolicyRuleCollectionChanges describes the differences between the Rule Collections of a Firewall Policy
+// Rule Collection Group held in the state and the Rule Collections which are defined in the configuration
+type firewallPolicyRuleCollectionChanges struct {
+	Added   []string
+	Removed []string
+	Changed []string
+}
+
+func (c firewallPolicyRuleCollectionChanges) HasChanges() bool {
+	return len(c.Added) > 0 || len(c.Removed) > 0 || len(c.Changed) > 0
+}
+
+func (c firewallPolicyRuleCollectionChanges) String() string {
+	return fmt.Sprintf("added %v / removed %v / changed %v", c.Added, c.Removed, c.Changed)
+}
+
+// diffFirewallPolicyRuleCollections compares the existing and desired Rule Collections by name. Since the ordering of
+// the Rule Collections, Rules and their values isn't meaningful (these are all Sets in the Schema) and empty values
+// aren't sent to the API, each Rule Collection is compared using a normalized JSON representation.
+func diffFirewallPolicyRuleCollections(existing *[]network.BasicFirewallPolicyRuleCollection, desired []network.BasicFirewallPolicyRuleCollection) (*firewallPolicyRuleCollectionChanges, error) {
+	existingCollections := make(map[string]string)
+	if existing != nil {
+		for _, collection := range *existing {
+			name, normalized, err := normalizeFirewallPolicyRuleCollection(collection)
+			if err != nil {
+				return nil, err
+			}
+			existingCollections[name] = normalized
+		}
+	}
+
+	changes := firewallPolicyRuleCollectionChanges{
+		Added:   make([]string, 0),
+		Removed: make([]string, 0),
+		Changed: make([]string, 0),
+	}
+	desiredCollections := make(map[string]struct{})
+	for _, collection := range desired {
+		name, normalized, err := normalizeFirewallPolicyRuleCollection(collection)
+		if err != nil {
+			return nil, err
+		}
+		desiredCollections[name] = struct{}{}
+
+		v, ok := existingCollections[name]
+		if !ok {
+			changes.Added = append(changes.Added, name)
+			continue
+		}
+		if v != normalized {
+			changes.Changed = append(changes.Changed, name)
+		}
+	}
+
+	for name := range existingCollections {
+		if _, ok := desiredCollections[name]; !ok {
+			changes.Removed = append(changes.Removed, name)
+		}
+	}
+
+	sort.Strings(changes.Added)
+	sort.Strings(changes.Removed)
+	sort.Strings(changes.Changed)
+
+	return &changes, nil
+}
+
+func normalizeFirewallPolicyRuleCollection(input network.BasicFirewallPolicyRuleCollection) (string, string, error) {
+	serialized, err := json.Marshal(input)
+	if err != nil {
+		return "", "", fmt.Errorf("serializing Rule Collection: %+v", err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(serialized, &raw); err != nil {
+		return "", "", fmt.Errorf("deserializing Rule Collection: %+v", err)
+	}
+
+	name, _ := raw["name"].(string)
+	normalized, err := json.Marshal(normalizeFirewallPolicyRuleCollectionValue(raw))
+	if err != nil {
+		return "", "", fmt.Errorf("serializing Rule Collection %q: %+v", name, err)
+	}
+
+	return name, string(normalized), nil
+}
+
+// normalizeFirewallPolicyRuleCollectionValue removes empty values and sorts all lists, so that two Rule Collections
+// which only differ in the ordering of their Rules/values (or in the omission of empty values) are considered equal
+func normalizeFirewallPolicyRuleCollectionValue(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		output := make(map[string]interface{})
+		for key, value := range v {
+			if normalized := normalizeFirewallPolicyRuleCollectionValue(value); normalized != nil {
+				output[key] = normalized
+			}
+		}
+		if len(output) == 0 {
+			return nil
+		}
+		return output
+
+	case []interface{}:
+		items := make([]string, 0)
+		for _, value := range v {
+			normalized := normalizeFirewallPolicyRuleCollectionValue(value)
+			if normalized == nil {
+				continue
+			}
+			// the value was already deserialized from JSON, so it can always be serialized again
+			serialized, _ := json.Marshal(normalized)
+			items = append(items, string(serialized))
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		sort.Strings(items)
+
+		output := make([]interface{}, 0)
+		for _, item := range items {
+			output = append(output, json.RawMessage(item))
+		}
+		return output
+
+	case string:
+		if v == "" {
+			return nil
+		}
+		return v
+	}
+
+	return input
+}
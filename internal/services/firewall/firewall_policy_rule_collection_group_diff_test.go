@@ -0,0 +1,95 @@
+package firewall
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestDiffFirewallPolicyRuleCollections(t *testing.T) {
+	networkRuleCollection := func(name string, destinationPorts ...string) network.BasicFirewallPolicyRuleCollection {
+		return network.FirewallPolicyFilterRuleCollection{
+			Name:     utils.String(name),
+			Priority: utils.Int32(500),
+			Action: &network.FirewallPolicyFilterRuleCollectionAction{
+				Type: network.FirewallPolicyFilterRuleCollectionActionTypeAllow,
+			},
+			Rules: &[]network.BasicFirewallPolicyRule{
+				network.Rule{
+					Name:             utils.String("rule1"),
+					IPProtocols:      &[]network.FirewallPolicyRuleNetworkProtocol{network.FirewallPolicyRuleNetworkProtocolTCP},
+					SourceAddresses:  &[]string{"10.0.0.1", "10.0.0.2"},
+					DestinationPorts: &destinationPorts,
+					SourceIPGroups:   &[]string{},
+				},
+			},
+		}
+	}
+
+	testData := []struct {
+		Name     string
+		Existing *[]network.BasicFirewallPolicyRuleCollection
+		Desired  []network.BasicFirewallPolicyRuleCollection
+		Expected firewallPolicyRuleCollectionChanges
+	}{
+		{
+			Name:     "No Existing Rule Collections",
+			Existing: nil,
+			Desired:  []network.BasicFirewallPolicyRuleCollection{networkRuleCollection("collection1", "80")},
+			Expected: firewallPolicyRuleCollectionChanges{
+				Added:   []string{"collection1"},
+				Removed: []string{},
+				Changed: []string{},
+			},
+		},
+		{
+			Name:     "Unchanged",
+			Existing: &[]network.BasicFirewallPolicyRuleCollection{networkRuleCollection("collection1", "80", "443")},
+			Desired:  []network.BasicFirewallPolicyRuleCollection{networkRuleCollection("collection1", "80", "443")},
+			Expected: firewallPolicyRuleCollectionChanges{
+				Added:   []string{},
+				Removed: []string{},
+				Changed: []string{},
+			},
+		},
+		{
+			Name:     "Different Ordering",
+			Existing: &[]network.BasicFirewallPolicyRuleCollection{networkRuleCollection("collection1", "443", "80"), networkRuleCollection("collection2", "22")},
+			Desired:  []network.BasicFirewallPolicyRuleCollection{networkRuleCollection("collection2", "22"), networkRuleCollection("collection1", "80", "443")},
+			Expected: firewallPolicyRuleCollectionChanges{
+				Added:   []string{},
+				Removed: []string{},
+				Changed: []string{},
+			},
+		},
+		{
+			Name:     "Added, Removed and Changed",
+			Existing: &[]network.BasicFirewallPolicyRuleCollection{networkRuleCollection("collection1", "80"), networkRuleCollection("collection2", "22")},
+			Desired:  []network.BasicFirewallPolicyRuleCollection{networkRuleCollection("collection1", "8080"), networkRuleCollection("collection3", "22")},
+			Expected: firewallPolicyRuleCollectionChanges{
+				Added:   []string{"collection3"},
+				Removed: []string{"collection2"},
+				Changed: []string{"collection1"},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := diffFirewallPolicyRuleCollections(v.Existing, v.Desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if !reflect.DeepEqual(*actual, v.Expected) {
+			t.Fatalf("expected %s but got %s", v.Expected, *actual)
+		}
+
+		if actual.HasChanges() != v.Expected.HasChanges() {
+			t.Fatalf("expected HasChanges to be %t but got %t", v.Expected.HasChanges(), actual.HasChanges())
+		}
+	}
+}
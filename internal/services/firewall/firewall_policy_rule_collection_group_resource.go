@@ -413,6 +413,38 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNat(d.Get("nat_rule_collection").(*pluginsdk.Set).List())...)
 	param.FirewallPolicyRuleCollectionGroupProperties.RuleCollections = &rulesCollections
 
+	// Network Rules using FQDNs require the DNS Proxy to be enabled on the Firewall Policy - this is checked here rather
+	// than at plan time since the DNS Proxy may be enabled on the Firewall Policy within the same apply
+	if err := validateFirewallPolicyDNSProxyForRuleCollections(ctx, meta.(*clients.Client).Firewall.FirewallPolicyClient, *policyId, rulesCollections); err != nil {
+		return err
+	}
+
+	// the API only supports replacing the entire Rule Collection Group (there's no PATCH or per-collection endpoint),
+	// which can take a long time for large groups - as such the update is skipped when the Rule Collections in the
+	// state only differ from the configuration in ordering or empty values (e.g. due to the hashing of the Sets)
+	if !d.IsNewResource() && !d.HasChange("priority") {
+		oldApplicationRuleCollections, _ := d.GetChange("application_rule_collection")
+		oldNetworkRuleCollections, _ := d.GetChange("network_rule_collection")
+		oldNatRuleCollections, _ := d.GetChange("nat_rule_collection")
+
+		var existing []network.BasicFirewallPolicyRuleCollection
+		existing = append(existing, expandFirewallPolicyRuleCollectionApplication(oldApplicationRuleCollections.(*pluginsdk.Set).List())...)
+		existing = append(existing, expandFirewallPolicyRuleCollectionNetwork(oldNetworkRuleCollections.(*pluginsdk.Set).List())...)
+		existing = append(existing, expandFirewallPolicyRuleCollectionNat(oldNatRuleCollections.(*pluginsdk.Set).List())...)
+
+		changes, err := diffFirewallPolicyRuleCollections(&existing, rulesCollections)
+		if err != nil {
+			return fmt.Errorf("comparing the Rule Collections for Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", name, policyId.ResourceGroup, policyId.Name, err)
+		}
+
+		if !changes.HasChanges() {
+			log.Printf("[DEBUG] The Rule Collections for Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q) are up to date - skipping update", name, policyId.ResourceGroup, policyId.Name)
+			return resourceFirewallPolicyRuleCollectionGroupRead(d, meta)
+		}
+
+		log.Printf("[DEBUG] Updating Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): Rule Collections %s", name, policyId.ResourceGroup, policyId.Name, changes)
+	}
+
 	future, err := client.CreateOrUpdate(ctx, policyId.ResourceGroup, policyId.Name, name, param)
 	if err != nil {
 		return fmt.Errorf("creating Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", name, policyId.ResourceGroup, policyId.Name, err)
@@ -0,0 +1,65 @@
+package firewallpolicies
+
+type AutoLearnPrivateRangesMode string
+
+const (
+	AutoLearnPrivateRangesModeDisabled AutoLearnPrivateRangesMode = "Disabled"
+	AutoLearnPrivateRangesModeEnabled  AutoLearnPrivateRangesMode = "Enabled"
+)
+
+func PossibleValuesForAutoLearnPrivateRangesMode() []string {
+	return []string{
+		string(AutoLearnPrivateRangesModeDisabled),
+		string(AutoLearnPrivateRangesModeEnabled),
+	}
+}
+
+type AzureFirewallThreatIntelMode string
+
+const (
+	AzureFirewallThreatIntelModeAlert AzureFirewallThreatIntelMode = "Alert"
+	AzureFirewallThreatIntelModeDeny  AzureFirewallThreatIntelMode = "Deny"
+	AzureFirewallThreatIntelModeOff   AzureFirewallThreatIntelMode = "Off"
+)
+
+func PossibleValuesForAzureFirewallThreatIntelMode() []string {
+	return []string{
+		string(AzureFirewallThreatIntelModeAlert),
+		string(AzureFirewallThreatIntelModeDeny),
+		string(AzureFirewallThreatIntelModeOff),
+	}
+}
+
+type FirewallPolicySkuTier string
+
+const (
+	FirewallPolicySkuTierBasic    FirewallPolicySkuTier = "Basic"
+	FirewallPolicySkuTierPremium  FirewallPolicySkuTier = "Premium"
+	FirewallPolicySkuTierStandard FirewallPolicySkuTier = "Standard"
+)
+
+func PossibleValuesForFirewallPolicySkuTier() []string {
+	return []string{
+		string(FirewallPolicySkuTierBasic),
+		string(FirewallPolicySkuTierPremium),
+		string(FirewallPolicySkuTierStandard),
+	}
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
@@ -0,0 +1,7 @@
+package firewallpolicies
+
+type DnsSettings struct {
+	EnableProxy                 *bool     `json:"enableProxy,omitempty"`
+	RequireProxyForNetworkRules *bool     `json:"requireProxyForNetworkRules,omitempty"`
+	Servers                     *[]string `json:"servers,omitempty"`
+}
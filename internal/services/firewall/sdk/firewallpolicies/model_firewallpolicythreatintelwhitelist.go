@@ -0,0 +1,6 @@
+package firewallpolicies
+
+type FirewallPolicyThreatIntelWhitelist struct {
+	Fqdns       *[]string `json:"fqdns,omitempty"`
+	IpAddresses *[]string `json:"ipAddresses,omitempty"`
+}
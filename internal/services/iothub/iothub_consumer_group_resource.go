@@ -1,27 +1,36 @@
 package iothub
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+const (
+	iotHubBuiltInEndpointEvents                     = "events"
+	iotHubBuiltInEndpointOperationsMonitoringEvents = "operationsMonitoringEvents"
+)
+
 func resourceIotHubConsumerGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceIotHubConsumerGroupCreate,
 		Read:   resourceIotHubConsumerGroupRead,
 		Delete: resourceIotHubConsumerGroupDelete,
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(validateIotHubConsumerGroupImportId, resourceIotHubConsumerGroupImport),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -49,6 +58,10 @@ func resourceIotHubConsumerGroup() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Required: true,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					iotHubBuiltInEndpointEvents,
+					iotHubBuiltInEndpointOperationsMonitoringEvents,
+				}, false),
 			},
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
@@ -58,45 +71,32 @@ func resourceIotHubConsumerGroup() *pluginsdk.Resource {
 
 func resourceIotHubConsumerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).IoTHub.ResourceClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM IoTHub Consumer Group creation.")
 
-	name := d.Get("name").(string)
-	iotHubName := d.Get("iothub_name").(string)
-	endpointName := d.Get("eventhub_endpoint_name").(string)
-	resourceGroup := d.Get("resource_group_name").(string)
+	id := parse.NewConsumerGroupID(subscriptionId, d.Get("resource_group_name").(string), d.Get("iothub_name").(string), d.Get("eventhub_endpoint_name").(string), d.Get("name").(string))
 
-	locks.ByName(iotHubName, IothubResourceName)
-	defer locks.UnlockByName(iotHubName, IothubResourceName)
-
-	if d.IsNewResource() {
-		existing, err := client.GetEventHubConsumerGroup(ctx, resourceGroup, iotHubName, endpointName, name)
-		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Consumer Group %q (Endpoint %q / IoTHub %q / Resource Group %q): %s", name, endpointName, iotHubName, resourceGroup, err)
-			}
-		}
+	locks.ByName(id.IotHubName, IothubResourceName)
+	defer locks.UnlockByName(id.IotHubName, IothubResourceName)
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_iothub_consumer_group", *existing.ID)
+	existing, err := client.GetEventHubConsumerGroup(ctx, id.ResourceGroup, id.IotHubName, id.EventHubEndpointName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 		}
 	}
 
-	if _, err := client.CreateEventHubConsumerGroup(ctx, resourceGroup, iotHubName, endpointName, name); err != nil {
-		return fmt.Errorf("Error creating Consumer Group %q (Endpoint %q / IoTHub %q / Resource Group %q): %+v", name, endpointName, iotHubName, resourceGroup, err)
-	}
-
-	read, err := client.GetEventHubConsumerGroup(ctx, resourceGroup, iotHubName, endpointName, name)
-	if err != nil {
-		return fmt.Errorf("Error retrieving Consumer Group %q (Endpoint %q / IoTHub %q / Resource Group %q): %+v", name, endpointName, iotHubName, resourceGroup, err)
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_iothub_consumer_group", id.ID())
 	}
 
-	if read.ID == nil {
-		return fmt.Errorf("Cannot read ID for Consumer Group %q (Endpoint %q / IoTHub %q / Resource Group %q): %+v", name, endpointName, iotHubName, resourceGroup, err)
+	if _, err := client.CreateEventHubConsumerGroup(ctx, id.ResourceGroup, id.IotHubName, id.EventHubEndpointName, id.Name); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	d.SetId(*read.ID)
+	d.SetId(id.ID())
 
 	return resourceIotHubConsumerGroupRead(d, meta)
 }
@@ -106,29 +106,26 @@ func resourceIotHubConsumerGroupRead(d *pluginsdk.ResourceData, meta interface{}
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := parse.ConsumerGroupID(d.Id())
 	if err != nil {
 		return err
 	}
-	resourceGroup := id.ResourceGroup
-	iotHubName := id.Path["IotHubs"]
-	endpointName := id.Path["eventHubEndpoints"]
-	name := id.Path["ConsumerGroups"]
 
-	resp, err := client.GetEventHubConsumerGroup(ctx, resourceGroup, iotHubName, endpointName, name)
+	resp, err := client.GetEventHubConsumerGroup(ctx, id.ResourceGroup, id.IotHubName, id.EventHubEndpointName, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
 			d.SetId("")
 			return nil
 		}
 
-		return fmt.Errorf("Error making read request for Consumer Group %q (Endpoint %q / IoTHub %q / Resource Group %q): %+v", name, endpointName, iotHubName, resourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	d.Set("name", name)
-	d.Set("iothub_name", iotHubName)
-	d.Set("eventhub_endpoint_name", endpointName)
-	d.Set("resource_group_name", resourceGroup)
+	d.Set("name", id.Name)
+	d.Set("iothub_name", id.IotHubName)
+	d.Set("eventhub_endpoint_name", id.EventHubEndpointName)
+	d.Set("resource_group_name", id.ResourceGroup)
 
 	return nil
 }
@@ -138,24 +135,80 @@ func resourceIotHubConsumerGroupDelete(d *pluginsdk.ResourceData, meta interface
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := parse.ConsumerGroupID(d.Id())
 	if err != nil {
 		return err
 	}
-	resourceGroup := id.ResourceGroup
-	iotHubName := id.Path["IotHubs"]
-	endpointName := id.Path["eventHubEndpoints"]
-	name := id.Path["ConsumerGroups"]
 
-	locks.ByName(iotHubName, IothubResourceName)
-	defer locks.UnlockByName(iotHubName, IothubResourceName)
+	locks.ByName(id.IotHubName, IothubResourceName)
+	defer locks.UnlockByName(id.IotHubName, IothubResourceName)
 
-	resp, err := client.DeleteEventHubConsumerGroup(ctx, resourceGroup, iotHubName, endpointName, name)
+	resp, err := client.DeleteEventHubConsumerGroup(ctx, id.ResourceGroup, id.IotHubName, id.EventHubEndpointName, id.Name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
-			return fmt.Errorf("Error deleting Consumer Group %q (Endpoint %q / IoTHub %q / Resource Group %q): %+v", name, endpointName, iotHubName, resourceGroup, err)
+			return fmt.Errorf("deleting %s: %+v", *id, err)
 		}
 	}
 
 	return nil
 }
+
+// validateIotHubConsumerGroupImportId accepts either the Resource ID of the Consumer Group, or the shorthand
+// `{iotHubName}/{eventHubEndpointName}/{consumerGroupName}` - since the IoT Hub name is globally unique
+func validateIotHubConsumerGroupImportId(input string) error {
+	if strings.HasPrefix(input, "/") {
+		_, err := parse.ConsumerGroupID(input)
+		return err
+	}
+
+	_, _, _, err := parseIotHubConsumerGroupImportShorthand(input)
+	return err
+}
+
+func parseIotHubConsumerGroupImportShorthand(input string) (iotHubName, endpointName, name string, err error) {
+	segments := strings.Split(input, "/")
+	if len(segments) != 3 || segments[0] == "" || segments[1] == "" || segments[2] == "" {
+		return "", "", "", fmt.Errorf("expected either a Resource ID or an ID in the format `{iotHubName}/{eventHubEndpointName}/{consumerGroupName}` but got %q", input)
+	}
+
+	return segments[0], segments[1], segments[2], nil
+}
+
+func resourceIotHubConsumerGroupImport(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "/") {
+		return []*pluginsdk.ResourceData{d}, nil
+	}
+
+	client := meta.(*clients.Client).IoTHub.ResourceClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+
+	iotHubName, endpointName, name, err := parseIotHubConsumerGroupImportShorthand(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	iterator, err := client.ListBySubscriptionComplete(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing IoT Hubs within Subscription %q: %+v", subscriptionId, err)
+	}
+
+	for iterator.NotDone() {
+		hub := iterator.Value()
+		if hub.Name != nil && strings.EqualFold(*hub.Name, iotHubName) && hub.ID != nil {
+			hubId, err := parse.IotHubID(*hub.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			id := parse.NewConsumerGroupID(hubId.SubscriptionId, hubId.ResourceGroup, hubId.Name, endpointName, name)
+			d.SetId(id.ID())
+			return []*pluginsdk.ResourceData{d}, nil
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing IoT Hubs within Subscription %q: %+v", subscriptionId, err)
+		}
+	}
+
+	return nil, fmt.Errorf("IoT Hub %q was not found within Subscription %q", iotHubName, subscriptionId)
+}
@@ -5,10 +5,10 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -81,24 +81,49 @@ func TestAccIotHubConsumerGroup_withSharedAccessPolicy(t *testing.T) {
 	})
 }
 
+func TestAccIotHubConsumerGroup_importShorthand(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_consumer_group", "test")
+	r := IotHubConsumerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "events"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		r.importShorthandStep(data),
+	})
+}
+
 func (t IotHubConsumerGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	id, err := azure.ParseAzureResourceID(state.ID)
+	id, err := parse.ConsumerGroupID(state.ID)
 	if err != nil {
 		return nil, err
 	}
-	resourceGroup := id.ResourceGroup
-	iotHubName := id.Path["IotHubs"]
-	endpointName := id.Path["eventHubEndpoints"]
-	name := id.Path["ConsumerGroups"]
 
-	resp, err := clients.IoTHub.ResourceClient.GetEventHubConsumerGroup(ctx, resourceGroup, iotHubName, endpointName, name)
+	resp, err := clients.IoTHub.ResourceClient.GetEventHubConsumerGroup(ctx, id.ResourceGroup, id.IotHubName, id.EventHubEndpointName, id.Name)
 	if err != nil {
-		return nil, fmt.Errorf("reading IotHuB Consumer Group (%s): %+v", id, err)
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
 	return utils.Bool(resp.ID != nil), nil
 }
 
+// importShorthandStep imports the Consumer Group using the `{iotHubName}/{eventHubEndpointName}/{name}` shorthand
+func (IotHubConsumerGroupResource) importShorthandStep(data acceptance.TestData) acceptance.TestStep {
+	step := data.ImportStep()
+	step.ImportStateIdFunc = func(state *acceptance.State) (string, error) {
+		rs, ok := state.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return "", fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["iothub_name"], rs.Primary.Attributes["eventhub_endpoint_name"], rs.Primary.Attributes["name"]), nil
+	}
+	return step
+}
+
 func (IotHubConsumerGroupResource) basic(data acceptance.TestData, eventName string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
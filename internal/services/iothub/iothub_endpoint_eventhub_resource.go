@@ -78,70 +78,64 @@ func resourceIotHubEndpointEventHubCreateUpdate(d *pluginsdk.ResourceData, meta
 	locks.ByName(iothubName, IothubResourceName)
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
-	iothub, err := client.Get(ctx, resourceGroup, iothubName)
-	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+	var resourceId string
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		endpointName := d.Get("name").(string)
+		resourceId = fmt.Sprintf("%s/Endpoints/%s", *iothub.ID, endpointName)
+
+		eventhubEndpoint := devices.RoutingEventHubProperties{
+			ConnectionString: utils.String(d.Get("connection_string").(string)),
+			Name:             utils.String(endpointName),
+			SubscriptionID:   utils.String(meta.(*clients.Client).Account.SubscriptionId),
+			ResourceGroup:    utils.String(resourceGroup),
 		}
 
-		return fmt.Errorf("Error loading IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
-
-	endpointName := d.Get("name").(string)
-	resourceId := fmt.Sprintf("%s/Endpoints/%s", *iothub.ID, endpointName)
-
-	eventhubEndpoint := devices.RoutingEventHubProperties{
-		ConnectionString: utils.String(d.Get("connection_string").(string)),
-		Name:             utils.String(endpointName),
-		SubscriptionID:   utils.String(meta.(*clients.Client).Account.SubscriptionId),
-		ResourceGroup:    utils.String(resourceGroup),
-	}
-
-	routing := iothub.Properties.Routing
-	if routing == nil {
-		routing = &devices.RoutingProperties{}
-	}
+		routing := iothub.Properties.Routing
+		if routing == nil {
+			routing = &devices.RoutingProperties{}
+		}
 
-	if routing.Endpoints == nil {
-		routing.Endpoints = &devices.RoutingEndpoints{}
-	}
+		if routing.Endpoints == nil {
+			routing.Endpoints = &devices.RoutingEndpoints{}
+		}
 
-	if routing.Endpoints.EventHubs == nil {
-		eventHubs := make([]devices.RoutingEventHubProperties, 0)
-		routing.Endpoints.EventHubs = &eventHubs
-	}
+		if routing.Endpoints.EventHubs == nil {
+			eventHubs := make([]devices.RoutingEventHubProperties, 0)
+			routing.Endpoints.EventHubs = &eventHubs
+		}
 
-	endpoints := make([]devices.RoutingEventHubProperties, 0)
+		endpoints := make([]devices.RoutingEventHubProperties, 0)
 
-	alreadyExists := false
-	for _, existingEndpoint := range *routing.Endpoints.EventHubs {
-		if existingEndpointName := existingEndpoint.Name; existingEndpointName != nil {
-			if strings.EqualFold(*existingEndpointName, endpointName) {
-				if d.IsNewResource() {
-					return tf.ImportAsExistsError("azurerm_iothub_endpoint_eventhub", resourceId)
+		alreadyExists := false
+		for _, existingEndpoint := range *routing.Endpoints.EventHubs {
+			if existingEndpointName := existingEndpoint.Name; existingEndpointName != nil {
+				if strings.EqualFold(*existingEndpointName, endpointName) {
+					if d.IsNewResource() {
+						return tf.ImportAsExistsError("azurerm_iothub_endpoint_eventhub", resourceId)
+					}
+					endpoints = append(endpoints, eventhubEndpoint)
+					alreadyExists = true
+				} else {
+					endpoints = append(endpoints, existingEndpoint)
 				}
-				endpoints = append(endpoints, eventhubEndpoint)
-				alreadyExists = true
-			} else {
-				endpoints = append(endpoints, existingEndpoint)
 			}
 		}
-	}
 
-	if d.IsNewResource() {
-		endpoints = append(endpoints, eventhubEndpoint)
-	} else if !alreadyExists {
-		return fmt.Errorf("Unable to find EventHub Endpoint %q defined for IotHub %q (Resource Group %q)", endpointName, iothubName, resourceGroup)
-	}
-	routing.Endpoints.EventHubs = &endpoints
+		if d.IsNewResource() {
+			endpoints = append(endpoints, eventhubEndpoint)
+		} else if !alreadyExists {
+			return fmt.Errorf("Unable to find EventHub Endpoint %q defined for IotHub %q (Resource Group %q)", endpointName, iothubName, resourceGroup)
+		}
+		routing.Endpoints.EventHubs = &endpoints
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, iothubName, iothub, "")
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error creating/updating IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the completion of the creating/updating of IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
+		return err
 	}
 
 	d.SetId(resourceId)
@@ -205,40 +199,34 @@ func resourceIotHubEndpointEventHubDelete(d *pluginsdk.ResourceData, meta interf
 	locks.ByName(iothubName, IothubResourceName)
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
-	iothub, err := client.Get(ctx, resourceGroup, iothubName)
-	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		if iothub.Properties == nil || iothub.Properties.Routing == nil || iothub.Properties.Routing.Endpoints == nil {
+			return errIotHubUnchanged
 		}
-		return fmt.Errorf("Error loading IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
-
-	if iothub.Properties == nil || iothub.Properties.Routing == nil || iothub.Properties.Routing.Endpoints == nil {
-		return nil
-	}
-	endpoints := iothub.Properties.Routing.Endpoints.EventHubs
+		endpoints := iothub.Properties.Routing.Endpoints.EventHubs
 
-	if endpoints == nil {
-		return nil
-	}
+		if endpoints == nil {
+			return errIotHubUnchanged
+		}
 
-	updatedEndpoints := make([]devices.RoutingEventHubProperties, 0)
-	for _, endpoint := range *endpoints {
-		if existingEndpointName := endpoint.Name; existingEndpointName != nil {
-			if !strings.EqualFold(*existingEndpointName, endpointName) {
-				updatedEndpoints = append(updatedEndpoints, endpoint)
+		updatedEndpoints := make([]devices.RoutingEventHubProperties, 0)
+		for _, endpoint := range *endpoints {
+			if existingEndpointName := endpoint.Name; existingEndpointName != nil {
+				if !strings.EqualFold(*existingEndpointName, endpointName) {
+					updatedEndpoints = append(updatedEndpoints, endpoint)
+				}
 			}
 		}
-	}
-	iothub.Properties.Routing.Endpoints.EventHubs = &updatedEndpoints
+		iothub.Properties.Routing.Endpoints.EventHubs = &updatedEndpoints
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, iothubName, iothub, "")
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error updating IotHub %q (Resource Group %q) with EventHub Endpoint %q: %+v", iothubName, resourceGroup, endpointName, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for IotHub %q (Resource Group %q) to finish updating EventHub Endpoint %q: %+v", iothubName, resourceGroup, endpointName, err)
+		return err
 	}
 
 	return nil
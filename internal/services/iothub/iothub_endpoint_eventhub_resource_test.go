@@ -50,6 +50,26 @@ func TestAccIotHubEndpointEventHub_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccIotHubEndpointEventHub_multipleInParallel(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_endpoint_eventhub", "test")
+	r := IotHubEndpointEventHubResource{}
+
+	// the Endpoints within this configuration are all applied in parallel against the same IoT Hub
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleInParallel(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_iothub_endpoint_eventhub.test.0").ExistsInAzure(r),
+				check.That("azurerm_iothub_endpoint_eventhub.test.1").ExistsInAzure(r),
+				check.That("azurerm_iothub_endpoint_eventhub.test.2").ExistsInAzure(r),
+				check.That("azurerm_iothub_endpoint_eventhub.test.3").ExistsInAzure(r),
+			),
+		},
+		data.ImportStepFor("azurerm_iothub_endpoint_eventhub.test.0"),
+		data.ImportStepFor("azurerm_iothub_endpoint_eventhub.test.3"),
+	})
+}
+
 func (IotHubEndpointEventHubResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -126,6 +146,69 @@ resource "azurerm_iothub_endpoint_eventhub" "import" {
 `, r.basic(data))
 }
 
+func (IotHubEndpointEventHubResource) multipleInParallel(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-iothub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Basic"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%[1]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = 2
+  message_retention   = 1
+}
+
+resource "azurerm_eventhub_authorization_rule" "test" {
+  name                = "acctest-%[1]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  eventhub_name       = azurerm_eventhub.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  listen = false
+  send   = true
+  manage = false
+}
+
+resource "azurerm_iothub" "test" {
+  name                = "acctestIoTHub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "B1"
+    capacity = "1"
+  }
+
+  tags = {
+    purpose = "testing"
+  }
+}
+
+resource "azurerm_iothub_endpoint_eventhub" "test" {
+  count               = 4
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+  name                = "acctest${count.index}"
+
+  connection_string = azurerm_eventhub_authorization_rule.test.primary_connection_string
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (t IotHubEndpointEventHubResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
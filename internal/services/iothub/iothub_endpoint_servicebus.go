@@ -0,0 +1,131 @@
+package iothub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/iothub/mgmt/2020-03-01/devices"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// expandIotHubEndpointServiceBusAuthentication validates the combination of authentication fields for a
+// Service Bus Queue/Topic Endpoint and returns the Connection String, Endpoint URI and Entity Path to send
+func expandIotHubEndpointServiceBusAuthentication(d *pluginsdk.ResourceData) (connectionString *string, endpointUri *string, entityPath *string, err error) {
+	authenticationType := devices.AuthenticationType(d.Get("authentication_type").(string))
+	connStr := d.Get("connection_string").(string)
+	uri := d.Get("endpoint_uri").(string)
+	path := d.Get("entity_path").(string)
+
+	if authenticationType == devices.IdentityBased {
+		if connStr != "" {
+			return nil, nil, nil, fmt.Errorf("`connection_string` cannot be specified when `authentication_type` is `%s`", string(devices.IdentityBased))
+		}
+		if uri == "" || path == "" {
+			return nil, nil, nil, fmt.Errorf("`endpoint_uri` and `entity_path` must be specified when `authentication_type` is `%s`", string(devices.IdentityBased))
+		}
+
+		return nil, utils.String(uri), utils.String(path), nil
+	}
+
+	if connStr == "" {
+		return nil, nil, nil, fmt.Errorf("`connection_string` must be specified when `authentication_type` is `%s`", string(devices.KeyBased))
+	}
+	if uri != "" || path != "" {
+		return nil, nil, nil, fmt.Errorf("`endpoint_uri` and `entity_path` can only be specified when `authentication_type` is `%s`", string(devices.IdentityBased))
+	}
+
+	return utils.String(connStr), nil, nil, nil
+}
+
+// iothubEndpointServiceBusEntity returns the name of the Service Bus Namespace and the Queue/Topic which
+// the Endpoint refers to, using either the Connection String or the Endpoint URI and Entity Path
+func iothubEndpointServiceBusEntity(connectionString, endpointUri, entityPath string) (namespaceName string, entityName string) {
+	if connectionString != "" {
+		for _, part := range strings.Split(connectionString, ";") {
+			kv := strings.SplitN(part, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+
+			switch strings.ToLower(strings.TrimSpace(kv[0])) {
+			case "endpoint":
+				endpointUri = kv[1]
+			case "entitypath":
+				entityPath = kv[1]
+			}
+		}
+	}
+
+	if u, err := url.Parse(strings.TrimSpace(endpointUri)); err == nil && u.Hostname() != "" {
+		namespaceName = strings.Split(u.Hostname(), ".")[0]
+	}
+
+	return namespaceName, strings.TrimSpace(entityPath)
+}
+
+// checkIotHubEndpointServiceBusEntityExists ensures that the Queue/Topic referenced by the Endpoint exists, since
+// otherwise messages routed to the Endpoint are only dead-lettered. This check is only possible when the Service
+// Bus Namespace can be found within the Subscription that the Provider is configured for - when it can't be found
+// (for example when it's in another Subscription) the check is skipped.
+func checkIotHubEndpointServiceBusEntityExists(ctx context.Context, client *clients.Client, namespaceName, entityName string, isTopic bool) error {
+	if namespaceName == "" || entityName == "" {
+		return nil
+	}
+
+	namespaces, err := client.ServiceBus.NamespacesClient.ListComplete(ctx)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to list Service Bus Namespaces to check the Endpoint entity %q exists - skipping: %+v", entityName, err)
+		return nil
+	}
+
+	resourceGroup := ""
+	for namespaces.NotDone() {
+		namespace := namespaces.Value()
+		if namespace.Name != nil && strings.EqualFold(*namespace.Name, namespaceName) && namespace.ID != nil {
+			id, err := azure.ParseAzureResourceID(*namespace.ID)
+			if err != nil {
+				return err
+			}
+			resourceGroup = id.ResourceGroup
+			break
+		}
+
+		if err := namespaces.NextWithContext(ctx); err != nil {
+			log.Printf("[DEBUG] Unable to list Service Bus Namespaces to check the Endpoint entity %q exists - skipping: %+v", entityName, err)
+			return nil
+		}
+	}
+
+	if resourceGroup == "" {
+		log.Printf("[DEBUG] Service Bus Namespace %q was not found within the Subscription - skipping checking the Endpoint entity %q exists", namespaceName, entityName)
+		return nil
+	}
+
+	if isTopic {
+		resp, err := client.ServiceBus.TopicsClient.Get(ctx, resourceGroup, namespaceName, entityName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Service Bus Topic %q was not found within Namespace %q (Resource Group %q)", entityName, namespaceName, resourceGroup)
+			}
+			return fmt.Errorf("retrieving Service Bus Topic %q (Namespace %q / Resource Group %q): %+v", entityName, namespaceName, resourceGroup, err)
+		}
+
+		return nil
+	}
+
+	resp, err := client.ServiceBus.QueuesClient.Get(ctx, resourceGroup, namespaceName, entityName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Service Bus Queue %q was not found within Namespace %q (Resource Group %q)", entityName, namespaceName, resourceGroup)
+		}
+		return fmt.Errorf("retrieving Service Bus Queue %q (Namespace %q / Resource Group %q): %+v", entityName, namespaceName, resourceGroup, err)
+	}
+
+	return nil
+}
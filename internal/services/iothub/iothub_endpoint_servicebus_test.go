@@ -0,0 +1,53 @@
+package iothub
+
+import (
+	"testing"
+)
+
+func TestIotHubEndpointServiceBusEntity(t *testing.T) {
+	testData := []struct {
+		Name              string
+		ConnectionString  string
+		EndpointUri       string
+		EntityPath        string
+		ExpectedNamespace string
+		ExpectedEntity    string
+	}{
+		{
+			Name:              "Connection String",
+			ConnectionString:  "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=abc123=;EntityPath=orders",
+			ExpectedNamespace: "example",
+			ExpectedEntity:    "orders",
+		},
+		{
+			Name:              "Connection String without Entity Path",
+			ConnectionString:  "Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=abc123=",
+			ExpectedNamespace: "example",
+			ExpectedEntity:    "",
+		},
+		{
+			Name:              "Endpoint URI and Entity Path",
+			EndpointUri:       "sb://example.servicebus.windows.net",
+			EntityPath:        "orders",
+			ExpectedNamespace: "example",
+			ExpectedEntity:    "orders",
+		},
+		{
+			Name:              "Empty",
+			ExpectedNamespace: "",
+			ExpectedEntity:    "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		namespace, entity := iothubEndpointServiceBusEntity(v.ConnectionString, v.EndpointUri, v.EntityPath)
+		if namespace != v.ExpectedNamespace {
+			t.Fatalf("Expected the Namespace to be %q but got %q", v.ExpectedNamespace, namespace)
+		}
+		if entity != v.ExpectedEntity {
+			t.Fatalf("Expected the Entity to be %q but got %q", v.ExpectedEntity, entity)
+		}
+	}
+}
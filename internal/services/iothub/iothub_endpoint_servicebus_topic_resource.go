@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -50,9 +51,31 @@ func resourceIotHubEndpointServiceBusTopic() *pluginsdk.Resource {
 				ValidateFunc: validate.IoTHubName,
 			},
 
+			"authentication_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(devices.KeyBased),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(devices.KeyBased),
+					string(devices.IdentityBased),
+				}, false),
+			},
+
+			"endpoint_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"sb"}),
+			},
+
+			"entity_path": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"connection_string": {
 				Type:     pluginsdk.TypeString,
-				Required: true,
+				Optional: true,
 				DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
 					sharedAccessKeyRegex := regexp.MustCompile("SharedAccessKey=[^;]+")
 					sbProtocolRegex := regexp.MustCompile("sb://([^:]+)(:5671)?/;")
@@ -76,72 +99,80 @@ func resourceIotHubEndpointServiceBusTopicCreateUpdate(d *pluginsdk.ResourceData
 	iothubName := d.Get("iothub_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(iothubName, IothubResourceName)
-	defer locks.UnlockByName(iothubName, IothubResourceName)
-
-	iothub, err := client.Get(ctx, resourceGroup, iothubName)
+	connectionString, endpointUri, entityPath, err := expandIotHubEndpointServiceBusAuthentication(d)
 	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
-		}
+		return err
+	}
 
-		return fmt.Errorf("Error loading IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
+	// a typo'd entity only surfaces as dead-lettered messages once routed to, so check it exists up-front
+	namespaceName, entityName := iothubEndpointServiceBusEntity(d.Get("connection_string").(string), d.Get("endpoint_uri").(string), d.Get("entity_path").(string))
+	if err := checkIotHubEndpointServiceBusEntityExists(ctx, meta.(*clients.Client), namespaceName, entityName, true); err != nil {
+		return err
 	}
 
-	endpointName := d.Get("name").(string)
-	resourceId := fmt.Sprintf("%s/Endpoints/%s", *iothub.ID, endpointName)
+	locks.ByName(iothubName, IothubResourceName)
+	defer locks.UnlockByName(iothubName, IothubResourceName)
 
-	topicEndpoint := devices.RoutingServiceBusTopicEndpointProperties{
-		ConnectionString: utils.String(d.Get("connection_string").(string)),
-		Name:             utils.String(endpointName),
-		SubscriptionID:   utils.String(subscriptionID),
-		ResourceGroup:    utils.String(resourceGroup),
-	}
+	var resourceId string
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		endpointName := d.Get("name").(string)
+		resourceId = fmt.Sprintf("%s/Endpoints/%s", *iothub.ID, endpointName)
+
+		topicEndpoint := devices.RoutingServiceBusTopicEndpointProperties{
+			AuthenticationType: devices.AuthenticationType(d.Get("authentication_type").(string)),
+			ConnectionString:   connectionString,
+			EndpointURI:        endpointUri,
+			EntityPath:         entityPath,
+			Name:               utils.String(endpointName),
+			SubscriptionID:     utils.String(subscriptionID),
+			ResourceGroup:      utils.String(resourceGroup),
+		}
 
-	routing := iothub.Properties.Routing
-	if routing == nil {
-		routing = &devices.RoutingProperties{}
-	}
+		routing := iothub.Properties.Routing
+		if routing == nil {
+			routing = &devices.RoutingProperties{}
+		}
 
-	if routing.Endpoints == nil {
-		routing.Endpoints = &devices.RoutingEndpoints{}
-	}
+		if routing.Endpoints == nil {
+			routing.Endpoints = &devices.RoutingEndpoints{}
+		}
 
-	if routing.Endpoints.EventHubs == nil {
-		topics := make([]devices.RoutingServiceBusTopicEndpointProperties, 0)
-		routing.Endpoints.ServiceBusTopics = &topics
-	}
-	endpoints := make([]devices.RoutingServiceBusTopicEndpointProperties, 0)
-
-	alreadyExists := false
-	for _, existingEndpoint := range *routing.Endpoints.ServiceBusTopics {
-		if existingEndpointName := existingEndpoint.Name; existingEndpointName != nil {
-			if strings.EqualFold(*existingEndpointName, endpointName) {
-				if d.IsNewResource() {
-					return tf.ImportAsExistsError("azurerm_iothub_endpoint_servicebus_topic", resourceId)
+		if routing.Endpoints.EventHubs == nil {
+			topics := make([]devices.RoutingServiceBusTopicEndpointProperties, 0)
+			routing.Endpoints.ServiceBusTopics = &topics
+		}
+		endpoints := make([]devices.RoutingServiceBusTopicEndpointProperties, 0)
+
+		alreadyExists := false
+		for _, existingEndpoint := range *routing.Endpoints.ServiceBusTopics {
+			if existingEndpointName := existingEndpoint.Name; existingEndpointName != nil {
+				if strings.EqualFold(*existingEndpointName, endpointName) {
+					if d.IsNewResource() {
+						return tf.ImportAsExistsError("azurerm_iothub_endpoint_servicebus_topic", resourceId)
+					}
+					endpoints = append(endpoints, topicEndpoint)
+					alreadyExists = true
+				} else {
+					endpoints = append(endpoints, existingEndpoint)
 				}
-				endpoints = append(endpoints, topicEndpoint)
-				alreadyExists = true
-			} else {
-				endpoints = append(endpoints, existingEndpoint)
 			}
 		}
-	}
 
-	if d.IsNewResource() {
-		endpoints = append(endpoints, topicEndpoint)
-	} else if !alreadyExists {
-		return fmt.Errorf("Unable to find ServiceBus Queue Endpoint %q defined for IotHub %q (Resource Group %q)", endpointName, iothubName, resourceGroup)
-	}
-	routing.Endpoints.ServiceBusTopics = &endpoints
+		if d.IsNewResource() {
+			endpoints = append(endpoints, topicEndpoint)
+		} else if !alreadyExists {
+			return fmt.Errorf("Unable to find ServiceBus Queue Endpoint %q defined for IotHub %q (Resource Group %q)", endpointName, iothubName, resourceGroup)
+		}
+		routing.Endpoints.ServiceBusTopics = &endpoints
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, iothubName, iothub, "")
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error creating/updating IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the completion of the creating/updating of IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
+		return err
 	}
 
 	d.SetId(resourceId)
@@ -180,7 +211,25 @@ func resourceIotHubEndpointServiceBusTopicRead(d *pluginsdk.ResourceData, meta i
 		for _, endpoint := range *endpoints {
 			if existingEndpointName := endpoint.Name; existingEndpointName != nil {
 				if strings.EqualFold(*existingEndpointName, endpointName) {
+					authenticationType := string(devices.KeyBased)
+					if endpoint.AuthenticationType != "" {
+						authenticationType = string(endpoint.AuthenticationType)
+					}
+					d.Set("authentication_type", authenticationType)
 					d.Set("connection_string", endpoint.ConnectionString)
+
+					endpointUri := ""
+					entityPath := ""
+					if authenticationType == string(devices.IdentityBased) {
+						if endpoint.EndpointURI != nil {
+							endpointUri = *endpoint.EndpointURI
+						}
+						if endpoint.EntityPath != nil {
+							entityPath = *endpoint.EntityPath
+						}
+					}
+					d.Set("endpoint_uri", endpointUri)
+					d.Set("entity_path", entityPath)
 				}
 			}
 		}
@@ -206,41 +255,34 @@ func resourceIotHubEndpointServiceBusTopicDelete(d *pluginsdk.ResourceData, meta
 	locks.ByName(iothubName, IothubResourceName)
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
-	iothub, err := client.Get(ctx, resourceGroup, iothubName)
-	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		if iothub.Properties == nil || iothub.Properties.Routing == nil || iothub.Properties.Routing.Endpoints == nil {
+			return errIotHubUnchanged
 		}
+		endpoints := iothub.Properties.Routing.Endpoints.ServiceBusTopics
 
-		return fmt.Errorf("Error loading IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
-
-	if iothub.Properties == nil || iothub.Properties.Routing == nil || iothub.Properties.Routing.Endpoints == nil {
-		return nil
-	}
-	endpoints := iothub.Properties.Routing.Endpoints.ServiceBusTopics
-
-	if endpoints == nil {
-		return nil
-	}
+		if endpoints == nil {
+			return errIotHubUnchanged
+		}
 
-	updatedEndpoints := make([]devices.RoutingServiceBusTopicEndpointProperties, 0)
-	for _, endpoint := range *endpoints {
-		if existingEndpointName := endpoint.Name; existingEndpointName != nil {
-			if !strings.EqualFold(*existingEndpointName, endpointName) {
-				updatedEndpoints = append(updatedEndpoints, endpoint)
+		updatedEndpoints := make([]devices.RoutingServiceBusTopicEndpointProperties, 0)
+		for _, endpoint := range *endpoints {
+			if existingEndpointName := endpoint.Name; existingEndpointName != nil {
+				if !strings.EqualFold(*existingEndpointName, endpointName) {
+					updatedEndpoints = append(updatedEndpoints, endpoint)
+				}
 			}
 		}
-	}
-	iothub.Properties.Routing.Endpoints.ServiceBusTopics = &updatedEndpoints
+		iothub.Properties.Routing.Endpoints.ServiceBusTopics = &updatedEndpoints
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, iothubName, iothub, "")
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error updating IotHub %q (Resource Group %q) with ServiceBus Queue Endpoint %q: %+v", iothubName, resourceGroup, endpointName, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for IotHub %q (Resource Group %q) to finish updating ServiceBus Queue Endpoint %q: %+v", iothubName, resourceGroup, endpointName, err)
+		return err
 	}
 
 	return nil
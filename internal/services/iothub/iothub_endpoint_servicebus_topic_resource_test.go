@@ -3,6 +3,7 @@ package iothub_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -50,6 +51,38 @@ func TestAccIotHubEndpointServiceBusTopic_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccIotHubEndpointServiceBusTopic_multipleInParallel(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_endpoint_servicebus_topic", "test")
+	r := IotHubEndpointServiceBusTopicResource{}
+
+	// the Endpoints within this configuration are all applied in parallel against the same IoT Hub
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleInParallel(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_iothub_endpoint_servicebus_topic.test.0").ExistsInAzure(r),
+				check.That("azurerm_iothub_endpoint_servicebus_topic.test.1").ExistsInAzure(r),
+				check.That("azurerm_iothub_endpoint_servicebus_topic.test.2").ExistsInAzure(r),
+				check.That("azurerm_iothub_endpoint_servicebus_topic.test.3").ExistsInAzure(r),
+			),
+		},
+		data.ImportStepFor("azurerm_iothub_endpoint_servicebus_topic.test.0"),
+		data.ImportStepFor("azurerm_iothub_endpoint_servicebus_topic.test.3"),
+	})
+}
+
+func TestAccIotHubEndpointServiceBusTopic_entityDoesNotExist(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_endpoint_servicebus_topic", "test")
+	r := IotHubEndpointServiceBusTopicResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.entityDoesNotExist(data),
+			ExpectError: regexp.MustCompile("Service Bus Topic \"doesnotexist\" was not found"),
+		},
+	})
+}
+
 func (IotHubEndpointServiceBusTopicResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -124,6 +157,83 @@ resource "azurerm_iothub_endpoint_servicebus_topic" "import" {
 `, r.basic(data))
 }
 
+func (r IotHubEndpointServiceBusTopicResource) entityDoesNotExist(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_endpoint_servicebus_topic" "missing" {
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+  name                = "acctestmissing"
+
+  authentication_type = "identityBased"
+  endpoint_uri        = "sb://${azurerm_servicebus_namespace.test.name}.servicebus.windows.net"
+  entity_path         = "doesnotexist"
+}
+`, r.basic(data))
+}
+
+func (IotHubEndpointServiceBusTopicResource) multipleInParallel(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-iothub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctest-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_topic" "test" {
+  name                = "acctestservicebustopic-%[1]d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_servicebus_topic_authorization_rule" "test" {
+  name                = "acctest-%[1]d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  topic_name          = azurerm_servicebus_topic.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  listen = false
+  send   = true
+  manage = false
+}
+
+resource "azurerm_iothub" "test" {
+  name                = "acctestIoTHub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "B1"
+    capacity = "1"
+  }
+
+  tags = {
+    purpose = "testing"
+  }
+}
+
+resource "azurerm_iothub_endpoint_servicebus_topic" "test" {
+  count               = 4
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+  name                = "acctest${count.index}"
+
+  connection_string = azurerm_servicebus_topic_authorization_rule.test.primary_connection_string
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (t IotHubEndpointServiceBusTopicResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
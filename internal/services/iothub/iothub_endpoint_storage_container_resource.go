@@ -1,6 +1,7 @@
 package iothub
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -59,9 +60,10 @@ func resourceIotHubEndpointStorageContainer() *pluginsdk.Resource {
 			},
 
 			"file_name_format": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				Default:  false,
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "{iothub}/{partition}/{YYYY}/{MM}/{DD}/{HH}/{mm}",
+				ValidateFunc: iothubValidate.FileNameFormat,
 			},
 
 			"batch_frequency_in_seconds": {
@@ -100,6 +102,21 @@ func resourceIotHubEndpointStorageContainer() *pluginsdk.Resource {
 				}, true),
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			// the encoding of a Storage Container Endpoint can only be set when it's created, changing it afterwards
+			// is rejected by the API whilst updating the IoT Hub - so the Endpoint has to be recreated instead
+			if d.Id() != "" && d.HasChange("encoding") {
+				oldEncoding, newEncoding := d.GetChange("encoding")
+				if oldEncoding.(string) != "" && newEncoding.(string) != "" && !strings.EqualFold(oldEncoding.(string), newEncoding.(string)) {
+					if err := d.ForceNew("encoding"); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		}),
 	}
 }
 
@@ -115,83 +132,77 @@ func resourceIotHubEndpointStorageContainerCreateUpdate(d *pluginsdk.ResourceDat
 	locks.ByName(iothubName, IothubResourceName)
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
-	iothub, err := client.Get(ctx, resourceGroup, iothubName)
-	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+	var resourceId string
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		endpointName := d.Get("name").(string)
+		resourceId = fmt.Sprintf("%s/Endpoints/%s", *iothub.ID, endpointName)
+
+		connectionStr := d.Get("connection_string").(string)
+		containerName := d.Get("container_name").(string)
+		fileNameFormat := d.Get("file_name_format").(string)
+		batchFrequencyInSeconds := int32(d.Get("batch_frequency_in_seconds").(int))
+		maxChunkSizeInBytes := int32(d.Get("max_chunk_size_in_bytes").(int))
+		encoding := d.Get("encoding").(string)
+
+		storageContainerEndpoint := devices.RoutingStorageContainerProperties{
+			ConnectionString:        &connectionStr,
+			Name:                    &endpointName,
+			SubscriptionID:          &subscriptionID,
+			ResourceGroup:           &resourceGroup,
+			ContainerName:           &containerName,
+			FileNameFormat:          &fileNameFormat,
+			BatchFrequencyInSeconds: &batchFrequencyInSeconds,
+			MaxChunkSizeInBytes:     &maxChunkSizeInBytes,
+			Encoding:                devices.Encoding(encoding),
 		}
 
-		return fmt.Errorf("Error loading IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
+		routing := iothub.Properties.Routing
 
-	endpointName := d.Get("name").(string)
-	resourceId := fmt.Sprintf("%s/Endpoints/%s", *iothub.ID, endpointName)
-
-	connectionStr := d.Get("connection_string").(string)
-	containerName := d.Get("container_name").(string)
-	fileNameFormat := d.Get("file_name_format").(string)
-	batchFrequencyInSeconds := int32(d.Get("batch_frequency_in_seconds").(int))
-	maxChunkSizeInBytes := int32(d.Get("max_chunk_size_in_bytes").(int))
-	encoding := d.Get("encoding").(string)
-
-	storageContainerEndpoint := devices.RoutingStorageContainerProperties{
-		ConnectionString:        &connectionStr,
-		Name:                    &endpointName,
-		SubscriptionID:          &subscriptionID,
-		ResourceGroup:           &resourceGroup,
-		ContainerName:           &containerName,
-		FileNameFormat:          &fileNameFormat,
-		BatchFrequencyInSeconds: &batchFrequencyInSeconds,
-		MaxChunkSizeInBytes:     &maxChunkSizeInBytes,
-		Encoding:                devices.Encoding(encoding),
-	}
-
-	routing := iothub.Properties.Routing
-
-	if routing == nil {
-		routing = &devices.RoutingProperties{}
-	}
+		if routing == nil {
+			routing = &devices.RoutingProperties{}
+		}
 
-	if routing.Endpoints == nil {
-		routing.Endpoints = &devices.RoutingEndpoints{}
-	}
+		if routing.Endpoints == nil {
+			routing.Endpoints = &devices.RoutingEndpoints{}
+		}
 
-	if routing.Endpoints.StorageContainers == nil {
-		storageContainers := make([]devices.RoutingStorageContainerProperties, 0)
-		routing.Endpoints.StorageContainers = &storageContainers
-	}
+		if routing.Endpoints.StorageContainers == nil {
+			storageContainers := make([]devices.RoutingStorageContainerProperties, 0)
+			routing.Endpoints.StorageContainers = &storageContainers
+		}
 
-	endpoints := make([]devices.RoutingStorageContainerProperties, 0)
+		endpoints := make([]devices.RoutingStorageContainerProperties, 0)
 
-	alreadyExists := false
-	for _, existingEndpoint := range *routing.Endpoints.StorageContainers {
-		if existingEndpointName := existingEndpoint.Name; existingEndpointName != nil {
-			if strings.EqualFold(*existingEndpointName, endpointName) {
-				if d.IsNewResource() {
-					return tf.ImportAsExistsError("azurerm_iothub_endpoint_storage_container", resourceId)
+		alreadyExists := false
+		for _, existingEndpoint := range *routing.Endpoints.StorageContainers {
+			if existingEndpointName := existingEndpoint.Name; existingEndpointName != nil {
+				if strings.EqualFold(*existingEndpointName, endpointName) {
+					if d.IsNewResource() {
+						return tf.ImportAsExistsError("azurerm_iothub_endpoint_storage_container", resourceId)
+					}
+					endpoints = append(endpoints, storageContainerEndpoint)
+					alreadyExists = true
+				} else {
+					endpoints = append(endpoints, existingEndpoint)
 				}
-				endpoints = append(endpoints, storageContainerEndpoint)
-				alreadyExists = true
-			} else {
-				endpoints = append(endpoints, existingEndpoint)
 			}
 		}
-	}
 
-	if d.IsNewResource() {
-		endpoints = append(endpoints, storageContainerEndpoint)
-	} else if !alreadyExists {
-		return fmt.Errorf("Unable to find Storage Container Endpoint %q defined for IotHub %q (Resource Group %q)", endpointName, iothubName, resourceGroup)
-	}
-	routing.Endpoints.StorageContainers = &endpoints
+		if d.IsNewResource() {
+			endpoints = append(endpoints, storageContainerEndpoint)
+		} else if !alreadyExists {
+			return fmt.Errorf("Unable to find Storage Container Endpoint %q defined for IotHub %q (Resource Group %q)", endpointName, iothubName, resourceGroup)
+		}
+		routing.Endpoints.StorageContainers = &endpoints
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, iothubName, iothub, "")
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error creating/updating IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the completion of the creating/updating of IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
+		return err
 	}
 
 	d.SetId(resourceId)
@@ -261,41 +272,34 @@ func resourceIotHubEndpointStorageContainerDelete(d *pluginsdk.ResourceData, met
 	locks.ByName(iothubName, IothubResourceName)
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
-	iothub, err := client.Get(ctx, resourceGroup, iothubName)
-	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		if iothub.Properties == nil || iothub.Properties.Routing == nil || iothub.Properties.Routing.Endpoints == nil {
+			return errIotHubUnchanged
 		}
+		endpoints := iothub.Properties.Routing.Endpoints.StorageContainers
 
-		return fmt.Errorf("Error loading IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
-
-	if iothub.Properties == nil || iothub.Properties.Routing == nil || iothub.Properties.Routing.Endpoints == nil {
-		return nil
-	}
-	endpoints := iothub.Properties.Routing.Endpoints.StorageContainers
-
-	if endpoints == nil {
-		return nil
-	}
+		if endpoints == nil {
+			return errIotHubUnchanged
+		}
 
-	updatedEndpoints := make([]devices.RoutingStorageContainerProperties, 0)
-	for _, endpoint := range *endpoints {
-		if existingEndpointName := endpoint.Name; existingEndpointName != nil {
-			if !strings.EqualFold(*existingEndpointName, endpointName) {
-				updatedEndpoints = append(updatedEndpoints, endpoint)
+		updatedEndpoints := make([]devices.RoutingStorageContainerProperties, 0)
+		for _, endpoint := range *endpoints {
+			if existingEndpointName := endpoint.Name; existingEndpointName != nil {
+				if !strings.EqualFold(*existingEndpointName, endpointName) {
+					updatedEndpoints = append(updatedEndpoints, endpoint)
+				}
 			}
 		}
-	}
-	iothub.Properties.Routing.Endpoints.StorageContainers = &updatedEndpoints
+		iothub.Properties.Routing.Endpoints.StorageContainers = &updatedEndpoints
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, iothubName, iothub, "")
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error updating IotHub %q (Resource Group %q) with Storage Container Endpoint %q: %+v", iothubName, resourceGroup, endpointName, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for IotHub %q (Resource Group %q) to finish updating Storage Container Endpoint %q: %+v", iothubName, resourceGroup, endpointName, err)
+		return err
 	}
 
 	return nil
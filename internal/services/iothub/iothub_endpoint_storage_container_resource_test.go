@@ -54,6 +54,26 @@ func TestAccIotHubEndpointStorageContainer_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccIotHubEndpointStorageContainer_multipleInParallel(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_endpoint_storage_container", "test")
+	r := IotHubEndpointStorageContainerResource{}
+
+	// the Endpoints within this configuration are all applied in parallel against the same IoT Hub
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleInParallel(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_iothub_endpoint_storage_container.test.0").ExistsInAzure(r),
+				check.That("azurerm_iothub_endpoint_storage_container.test.1").ExistsInAzure(r),
+				check.That("azurerm_iothub_endpoint_storage_container.test.2").ExistsInAzure(r),
+				check.That("azurerm_iothub_endpoint_storage_container.test.3").ExistsInAzure(r),
+			),
+		},
+		data.ImportStepFor("azurerm_iothub_endpoint_storage_container.test.0"),
+		data.ImportStepFor("azurerm_iothub_endpoint_storage_container.test.3"),
+	})
+}
+
 func (IotHubEndpointStorageContainerResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -130,6 +150,63 @@ resource "azurerm_iothub_endpoint_storage_container" "import" {
 `, r.basic(data))
 }
 
+func (IotHubEndpointStorageContainerResource) multipleInParallel(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-iothub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acc%[1]d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestcont"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_iothub" "test" {
+  name                = "acctestIoTHub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "B1"
+    capacity = "1"
+  }
+
+  tags = {
+    purpose = "testing"
+  }
+}
+
+resource "azurerm_iothub_endpoint_storage_container" "test" {
+  count               = 4
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+  name                = "acctest${count.index}"
+
+  container_name    = "acctestcont"
+  connection_string = azurerm_storage_account.test.primary_blob_connection_string
+
+  file_name_format           = "{iothub}/{partition}_{YYYY}_{MM}_{DD}_{HH}_{mm}"
+  batch_frequency_in_seconds = 60
+  max_chunk_size_in_bytes    = 10485760
+  encoding                   = "JSON"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (t IotHubEndpointStorageContainerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -90,15 +90,6 @@ func resourceArmIotHubEnrichmentCreateUpdate(d *pluginsdk.ResourceData, meta int
 	locks.ByName(iothubName, IothubResourceName)
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
-	iothub, err := client.Get(ctx, resourceGroup, iothubName)
-	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
-		}
-
-		return fmt.Errorf("Error loading IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
-
 	enrichmentKey := d.Get("key").(string)
 	enrichmentValue := d.Get("value").(string)
 	endpointNamesRaw := d.Get("endpoint_names").([]interface{})
@@ -109,48 +100,50 @@ func resourceArmIotHubEnrichmentCreateUpdate(d *pluginsdk.ResourceData, meta int
 		EndpointNames: utils.ExpandStringSlice(endpointNamesRaw),
 	}
 
-	routing := iothub.Properties.Routing
-	if routing == nil {
-		routing = &devices.RoutingProperties{}
-	}
+	id := parse.NewEnrichmentID(subscriptionId, resourceGroup, iothubName, enrichmentKey)
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		routing := iothub.Properties.Routing
+		if routing == nil {
+			routing = &devices.RoutingProperties{}
+		}
 
-	if routing.Enrichments == nil {
-		enrichments := make([]devices.EnrichmentProperties, 0)
-		routing.Enrichments = &enrichments
-	}
+		if routing.Enrichments == nil {
+			enrichments := make([]devices.EnrichmentProperties, 0)
+			routing.Enrichments = &enrichments
+		}
 
-	enrichments := make([]devices.EnrichmentProperties, 0)
+		enrichments := make([]devices.EnrichmentProperties, 0)
 
-	id := parse.NewEnrichmentID(subscriptionId, resourceGroup, iothubName, enrichmentKey)
-	alreadyExists := false
-	for _, existingEnrichment := range *routing.Enrichments {
-		if existingEnrichment.Key != nil {
-			if strings.EqualFold(*existingEnrichment.Key, enrichmentKey) {
-				if d.IsNewResource() {
-					return tf.ImportAsExistsError("azurerm_iothub_enrichment", id.ID())
+		alreadyExists := false
+		for _, existingEnrichment := range *routing.Enrichments {
+			if existingEnrichment.Key != nil {
+				if strings.EqualFold(*existingEnrichment.Key, enrichmentKey) {
+					if d.IsNewResource() {
+						return tf.ImportAsExistsError("azurerm_iothub_enrichment", id.ID())
+					}
+					enrichments = append(enrichments, enrichment)
+					alreadyExists = true
+				} else {
+					enrichments = append(enrichments, existingEnrichment)
 				}
-				enrichments = append(enrichments, enrichment)
-				alreadyExists = true
-			} else {
-				enrichments = append(enrichments, existingEnrichment)
 			}
 		}
-	}
 
-	if d.IsNewResource() {
-		enrichments = append(enrichments, enrichment)
-	} else if !alreadyExists {
-		return fmt.Errorf("Unable to find Enrichment %q defined for IotHub %q (Resource Group %q)", enrichmentKey, iothubName, resourceGroup)
-	}
-	routing.Enrichments = &enrichments
+		if d.IsNewResource() {
+			enrichments = append(enrichments, enrichment)
+		} else if !alreadyExists {
+			return fmt.Errorf("Unable to find Enrichment %q defined for IotHub %q (Resource Group %q)", enrichmentKey, iothubName, resourceGroup)
+		}
+		routing.Enrichments = &enrichments
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, iothubName, iothub, "")
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error creating/updating IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the completion of the creating/updating of IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
+		return err
 	}
 
 	d.SetId(id.ID())
@@ -218,40 +211,34 @@ func resourceArmIotHubEnrichmentDelete(d *pluginsdk.ResourceData, meta interface
 	locks.ByName(id.IotHubName, IothubResourceName)
 	defer locks.UnlockByName(id.IotHubName, IothubResourceName)
 
-	iothub, err := client.Get(ctx, id.ResourceGroup, id.IotHubName)
-	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", id.IotHubName, id.ResourceGroup)
+	iothub, err := updateIotHubWithETag(ctx, client, id.ResourceGroup, id.IotHubName, func(iothub *devices.IotHubDescription) error {
+		if iothub.Properties == nil || iothub.Properties.Routing == nil {
+			return errIotHubUnchanged
 		}
-		return fmt.Errorf("retrieving IotHub %q (Resource Group %q): %+v", id.IotHubName, id.ResourceGroup, err)
-	}
 
-	if iothub.Properties == nil || iothub.Properties.Routing == nil {
-		return nil
-	}
-
-	enrichments := iothub.Properties.Routing.Enrichments
-	if enrichments == nil {
-		return nil
-	}
+		enrichments := iothub.Properties.Routing.Enrichments
+		if enrichments == nil {
+			return errIotHubUnchanged
+		}
 
-	updatedEnrichments := make([]devices.EnrichmentProperties, 0)
-	for _, enrichment := range *enrichments {
-		if enrichment.Key != nil {
-			if !strings.EqualFold(*enrichment.Key, id.Name) {
-				updatedEnrichments = append(updatedEnrichments, enrichment)
+		updatedEnrichments := make([]devices.EnrichmentProperties, 0)
+		for _, enrichment := range *enrichments {
+			if enrichment.Key != nil {
+				if !strings.EqualFold(*enrichment.Key, id.Name) {
+					updatedEnrichments = append(updatedEnrichments, enrichment)
+				}
 			}
 		}
-	}
-	iothub.Properties.Routing.Enrichments = &updatedEnrichments
+		iothub.Properties.Routing.Enrichments = &updatedEnrichments
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.IotHubName, iothub, "")
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("deleting %s: %+v", *id, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", id.IotHubName, id.ResourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+		return err
 	}
 
 	return nil
@@ -0,0 +1,83 @@
+package iothub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/iothub/mgmt/2020-03-01/devices"
+)
+
+// The Endpoints, Routes, Enrichments, Fallback Route, File Upload and Shared Access Policies of an IoT Hub are
+// all stored on the IoT Hub itself - as such the resources managing these must follow a
+// read-modify-write contract in order to be safe to apply in parallel (e.g. `-parallelism=10`):
+//
+//   1. lock the IoT Hub using `locks.ByName(iothubName, IothubResourceName)`
+//   2. update the IoT Hub using `updateIotHubWithETag`, modifying only the item being managed on the IoT Hub
+//
+// The lock serialises updates made from within a single Terraform run, whilst the ETag ensures
+// that changes made outside of the lock (e.g. by another Terraform run) are surfaced as a
+// `412 Precondition Failed` rather than being silently overwritten - in which case the IoT Hub
+// is retrieved again and the change re-applied to the latest IoT Hub.
+//
+// This contract is enforced by `TestIotHubSideResourcesFollowLockingContract`.
+
+// iothubETagMaxAttempts is the number of times an IoT Hub is retrieved and updated before giving up
+// when the IoT Hub keeps being modified elsewhere in the interim
+const iothubETagMaxAttempts = 5
+
+// errIotHubUnchanged can be returned from the `update` func passed to `updateIotHubWithETag` when
+// no changes are required to the IoT Hub, in which case the IoT Hub isn't updated
+var errIotHubUnchanged = errors.New("no changes are required to the IoT Hub")
+
+// updateIotHubWithETag retrieves the IoT Hub, applies `update` to it and then updates the IoT Hub - sending the
+// ETag of the IoT Hub which was retrieved. Should the IoT Hub have been modified since it was retrieved the
+// update fails with a `412 Precondition Failed`, in which case the IoT Hub is retrieved again and `update` is
+// re-applied to it. The IoT Hub which was retrieved is returned, so that callers can check if it wasn't found.
+func updateIotHubWithETag(ctx context.Context, client *devices.IotHubResourceClient, resourceGroup, name string, update func(iothub *devices.IotHubDescription) error) (devices.IotHubDescription, error) {
+	for attempt := 1; ; attempt++ {
+		iothub, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return iothub, fmt.Errorf("retrieving IotHub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := update(&iothub); err != nil {
+			if err == errIotHubUnchanged {
+				return iothub, nil
+			}
+			return iothub, err
+		}
+
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, name, iothub, iothubETag(iothub))
+		if err != nil {
+			if future.FutureAPI != nil && iothubWasPreconditionFailed(future.Response()) && attempt < iothubETagMaxAttempts {
+				log.Printf("[DEBUG] IotHub %q (Resource Group %q) was modified since it was retrieved - retrieving it again to re-apply the changes (attempt %d/%d)", name, resourceGroup, attempt, iothubETagMaxAttempts)
+				continue
+			}
+
+			return iothub, fmt.Errorf("updating IotHub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return iothub, fmt.Errorf("waiting for the update of IotHub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		return iothub, nil
+	}
+}
+
+// iothubETag returns the ETag which should be sent as the `If-Match` header when updating
+// an IoT Hub which has previously been retrieved
+func iothubETag(input devices.IotHubDescription) string {
+	if input.Etag == nil {
+		return ""
+	}
+
+	return *input.Etag
+}
+
+func iothubWasPreconditionFailed(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusPreconditionFailed
+}
@@ -0,0 +1,109 @@
+package iothub
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestIotHubSideResourcesFollowLockingContract ensures that every function which updates an
+// IoT Hub does so via `updateIotHubWithETag` whilst holding the IoT Hub lock - see `iothub_etag.go`
+func TestIotHubSideResourcesFollowLockingContract(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatalf("parsing package: %+v", err)
+	}
+
+	pkg, ok := pkgs["iothub"]
+	if !ok {
+		t.Fatalf("package `iothub` was not found")
+	}
+
+	checked := 0
+	for fileName, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+
+			updatesIotHub := false
+			updatesIotHubWithETag := false
+			sendsETag := false
+			locksIotHub := false
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+
+				if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "updateIotHubWithETag" {
+					updatesIotHubWithETag = true
+					return true
+				}
+
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+
+				switch sel.Sel.Name {
+				case "CreateOrUpdate":
+					// only the IoT Hub Resource Client takes 5 arguments (ctx, resourceGroup, name, iothub, ifMatch)
+					if len(call.Args) != 5 {
+						return true
+					}
+					updatesIotHub = true
+					if etag, ok := call.Args[4].(*ast.CallExpr); ok {
+						if ident, ok := etag.Fun.(*ast.Ident); ok && ident.Name == "iothubETag" {
+							sendsETag = true
+						}
+					}
+
+				case "ByName":
+					if pkgIdent, ok := sel.X.(*ast.Ident); ok && pkgIdent.Name == "locks" && len(call.Args) == 2 {
+						if ident, ok := call.Args[1].(*ast.Ident); ok && ident.Name == "IothubResourceName" {
+							locksIotHub = true
+						}
+					}
+				}
+
+				return true
+			})
+
+			if updatesIotHubWithETag {
+				checked++
+				if !locksIotHub {
+					t.Errorf("%s: %s updates the IoT Hub without acquiring the IoT Hub lock", fileName, fn.Name.Name)
+				}
+			}
+
+			if !updatesIotHub {
+				continue
+			}
+
+			switch fn.Name.Name {
+			case "updateIotHubWithETag":
+				if !sendsETag {
+					t.Errorf("%s: %s updates the IoT Hub without sending the ETag of the IoT Hub", fileName, fn.Name.Name)
+				}
+
+			case "resourceIotHubCreateUpdate":
+				// the IoT Hub resource itself is the only place where the IoT Hub can be created, at which point there's no ETag
+
+			default:
+				t.Errorf("%s: %s updates the IoT Hub directly rather than via `updateIotHubWithETag`", fileName, fn.Name.Name)
+			}
+		}
+	}
+
+	if checked == 0 {
+		t.Fatalf("expected to find functions updating an IoT Hub but didn't find any")
+	}
+}
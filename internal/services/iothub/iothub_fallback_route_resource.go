@@ -43,9 +43,10 @@ func resourceIotHubFallbackRoute() *pluginsdk.Resource {
 			"condition": {
 				// The condition is a string value representing device-to-cloud message routes query expression
 				// https://docs.microsoft.com/en-us/azure/iot-hub/iot-hub-devguide-query-language#device-to-cloud-message-routes-query-expressions
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				Default:  "true",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "true",
+				ValidateFunc: validate.IoTHubRouteCondition,
 			},
 
 			"endpoint_names": {
@@ -78,38 +79,31 @@ func resourceIotHubFallbackRouteCreateUpdate(d *pluginsdk.ResourceData, meta int
 	locks.ByName(iothubName, IothubResourceName)
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
-	iothub, err := client.Get(ctx, resourceGroup, iothubName)
-	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
-		}
-
-		return fmt.Errorf("Error loading IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		// NOTE: this resource intentionally doesn't support Requires Import
+		//       since a fallback route is created by default
 
-	// NOTE: this resource intentionally doesn't support Requires Import
-	//       since a fallback route is created by default
+		routing := iothub.Properties.Routing
 
-	routing := iothub.Properties.Routing
-
-	if routing == nil {
-		routing = &devices.RoutingProperties{}
-	}
+		if routing == nil {
+			routing = &devices.RoutingProperties{}
+		}
 
-	routing.FallbackRoute = &devices.FallbackRouteProperties{
-		Source:        utils.String(string(devices.RoutingSourceDeviceMessages)),
-		Condition:     utils.String(d.Get("condition").(string)),
-		EndpointNames: utils.ExpandStringSlice(d.Get("endpoint_names").([]interface{})),
-		IsEnabled:     utils.Bool(d.Get("enabled").(bool)),
-	}
+		routing.FallbackRoute = &devices.FallbackRouteProperties{
+			Source:        utils.String(string(devices.RoutingSourceDeviceMessages)),
+			Condition:     utils.String(d.Get("condition").(string)),
+			EndpointNames: utils.ExpandStringSlice(d.Get("endpoint_names").([]interface{})),
+			IsEnabled:     utils.Bool(d.Get("enabled").(bool)),
+		}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, iothubName, iothub, "")
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error creating/updating IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the completion of the creating/updating of IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
+		return err
 	}
 
 	resourceId := fmt.Sprintf("%s/FallbackRoute/defined", *iothub.ID)
@@ -168,27 +162,21 @@ func resourceIotHubFallbackRouteDelete(d *pluginsdk.ResourceData, meta interface
 	locks.ByName(iothubName, IothubResourceName)
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
-	iothub, err := client.Get(ctx, resourceGroup, iothubName)
-	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		if iothub.Properties == nil || iothub.Properties.Routing == nil || iothub.Properties.Routing.FallbackRoute == nil {
+			return errIotHubUnchanged
 		}
 
-		return fmt.Errorf("Error loading IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
+		iothub.Properties.Routing.FallbackRoute = nil
 
-	if iothub.Properties == nil || iothub.Properties.Routing == nil || iothub.Properties.Routing.FallbackRoute == nil {
 		return nil
-	}
-
-	iothub.Properties.Routing.FallbackRoute = nil
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, iothubName, iothub, "")
+	})
 	if err != nil {
-		return fmt.Errorf("Error updating IotHub %q (Resource Group %q) with Fallback Route: %+v", iothubName, resourceGroup, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for IotHub %q (Resource Group %q) to finish updating Fallback Route: %+v", iothubName, resourceGroup, err)
+		return err
 	}
 
 	return nil
@@ -0,0 +1,296 @@
+package iothub
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/iothub/mgmt/2020-03-01/devices"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
+	iothubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	// the File Upload configuration is stored within these (fixed) Storage and Messaging Endpoints on the IoT Hub
+	iothubFileUploadStorageEndpointName   = "$default"
+	iothubFileUploadMessagingEndpointName = "fileNotifications"
+)
+
+func resourceIotHubFileUpload() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceIotHubFileUploadCreateUpdate,
+		Read:   resourceIotHubFileUploadRead,
+		Update: resourceIotHubFileUploadCreateUpdate,
+		Delete: resourceIotHubFileUploadDelete,
+
+		// the File Upload configuration is a singleton within the IoT Hub, so uses the ID of the IoT Hub
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.IotHubID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"iothub_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: iothubValidate.IoTHubName,
+			},
+
+			"connection_string": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: iothubFileUploadConnectionStringDiffSuppress,
+			},
+
+			"container_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"authentication_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(devices.KeyBased),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(devices.KeyBased),
+					string(devices.IdentityBased),
+				}, false),
+			},
+
+			"notifications_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"max_delivery_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"sas_ttl": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "PT1H",
+				ValidateFunc: validate.ISO8601Duration,
+			},
+
+			"default_ttl": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "PT1H",
+				ValidateFunc: validate.ISO8601Duration,
+			},
+
+			"lock_duration": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "PT1M",
+				ValidateFunc: validate.ISO8601Duration,
+			},
+		},
+	}
+}
+
+func resourceIotHubFileUploadCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTHub.ResourceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	iothubName := d.Get("iothub_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	locks.ByName(iothubName, IothubResourceName)
+	defer locks.UnlockByName(iothubName, IothubResourceName)
+
+	id := parse.NewIotHubID(meta.(*clients.Client).Account.SubscriptionId, resourceGroup, iothubName)
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		if iothub.Properties == nil {
+			iothub.Properties = &devices.IotHubProperties{}
+		}
+		props := iothub.Properties
+
+		if d.IsNewResource() && iothubFileUploadIsConfigured(props.StorageEndpoints) {
+			return tf.ImportAsExistsError("azurerm_iothub_file_upload", id.ID())
+		}
+
+		if props.StorageEndpoints == nil {
+			props.StorageEndpoints = make(map[string]*devices.StorageEndpointProperties)
+		}
+		props.StorageEndpoints[iothubFileUploadStorageEndpointName] = &devices.StorageEndpointProperties{
+			ConnectionString:   utils.String(d.Get("connection_string").(string)),
+			ContainerName:      utils.String(d.Get("container_name").(string)),
+			SasTTLAsIso8601:    utils.String(d.Get("sas_ttl").(string)),
+			AuthenticationType: devices.AuthenticationType(d.Get("authentication_type").(string)),
+		}
+
+		if props.MessagingEndpoints == nil {
+			props.MessagingEndpoints = make(map[string]*devices.MessagingEndpointProperties)
+		}
+		props.MessagingEndpoints[iothubFileUploadMessagingEndpointName] = &devices.MessagingEndpointProperties{
+			LockDurationAsIso8601: utils.String(d.Get("lock_duration").(string)),
+			TTLAsIso8601:          utils.String(d.Get("default_ttl").(string)),
+			MaxDeliveryCount:      utils.Int32(int32(d.Get("max_delivery_count").(int))),
+		}
+
+		props.EnableFileUploadNotifications = utils.Bool(d.Get("notifications_enabled").(bool))
+
+		return nil
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
+
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceIotHubFileUploadRead(d, meta)
+}
+
+func resourceIotHubFileUploadRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTHub.ResourceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IotHubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	iothub, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(iothub.Response) {
+			log.Printf("[DEBUG] %s was not found - removing the File Upload configuration from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if iothub.Properties == nil || !iothubFileUploadIsConfigured(iothub.Properties.StorageEndpoints) {
+		log.Printf("[DEBUG] The File Upload configuration for %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+	props := iothub.Properties
+
+	d.Set("iothub_name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	storageEndpoint := props.StorageEndpoints[iothubFileUploadStorageEndpointName]
+	d.Set("connection_string", storageEndpoint.ConnectionString)
+	d.Set("container_name", storageEndpoint.ContainerName)
+	d.Set("sas_ttl", storageEndpoint.SasTTLAsIso8601)
+
+	authenticationType := string(devices.KeyBased)
+	if storageEndpoint.AuthenticationType != "" {
+		authenticationType = string(storageEndpoint.AuthenticationType)
+	}
+	d.Set("authentication_type", authenticationType)
+
+	if messagingEndpoint, ok := props.MessagingEndpoints[iothubFileUploadMessagingEndpointName]; ok && messagingEndpoint != nil {
+		d.Set("lock_duration", messagingEndpoint.LockDurationAsIso8601)
+		d.Set("default_ttl", messagingEndpoint.TTLAsIso8601)
+
+		maxDeliveryCount := 0
+		if messagingEndpoint.MaxDeliveryCount != nil {
+			maxDeliveryCount = int(*messagingEndpoint.MaxDeliveryCount)
+		}
+		d.Set("max_delivery_count", maxDeliveryCount)
+	}
+
+	notificationsEnabled := false
+	if props.EnableFileUploadNotifications != nil {
+		notificationsEnabled = *props.EnableFileUploadNotifications
+	}
+	d.Set("notifications_enabled", notificationsEnabled)
+
+	return nil
+}
+
+func resourceIotHubFileUploadDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).IoTHub.ResourceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IotHubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, IothubResourceName)
+	defer locks.UnlockByName(id.Name, IothubResourceName)
+
+	iothub, err := updateIotHubWithETag(ctx, client, id.ResourceGroup, id.Name, func(iothub *devices.IotHubDescription) error {
+		if iothub.Properties == nil || !iothubFileUploadIsConfigured(iothub.Properties.StorageEndpoints) {
+			return errIotHubUnchanged
+		}
+
+		// the File Upload Endpoints can't be removed from the IoT Hub, so these are reset to their defaults instead
+		iothub.Properties.StorageEndpoints[iothubFileUploadStorageEndpointName] = &devices.StorageEndpointProperties{
+			ConnectionString: utils.String(""),
+			ContainerName:    utils.String(""),
+		}
+		delete(iothub.Properties.MessagingEndpoints, iothubFileUploadMessagingEndpointName)
+		iothub.Properties.EnableFileUploadNotifications = utils.Bool(false)
+
+		return nil
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// iothubFileUploadIsConfigured returns whether File Upload has been configured on the IoT Hub - the API can return
+// the default Storage Endpoint with an empty Connection String when File Upload hasn't been configured
+func iothubFileUploadIsConfigured(input map[string]*devices.StorageEndpointProperties) bool {
+	endpoint, ok := input[iothubFileUploadStorageEndpointName]
+	return ok && endpoint != nil && endpoint.ConnectionString != nil && *endpoint.ConnectionString != ""
+}
+
+// iothubFileUploadConnectionStringDiffSuppress suppresses the diff for the Connection String of the Storage Account,
+// since Azure masks the Access Keys and includes the port number in the Connection String returned from the API
+func iothubFileUploadConnectionStringDiffSuppress(k, old, new string, d *pluginsdk.ResourceData) bool {
+	secretKeyRegex := regexp.MustCompile("(SharedAccessKey|AccountKey)=[^;]+")
+	sbProtocolRegex := regexp.MustCompile("sb://([^:]+)(:5671)?/;")
+
+	// 5671 is the default port for Azure Service Bus connections
+	maskedNew := sbProtocolRegex.ReplaceAllString(new, "sb://$1:5671/;")
+	maskedNew = secretKeyRegex.ReplaceAllString(maskedNew, "$1=****")
+	return (new == d.Get(k).(string)) && (maskedNew == old)
+}
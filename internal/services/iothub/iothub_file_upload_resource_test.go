@@ -0,0 +1,184 @@
+package iothub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type IotHubFileUploadResource struct {
+}
+
+func TestAccIotHubFileUpload_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_file_upload", "test")
+	r := IotHubFileUploadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+	})
+}
+
+func TestAccIotHubFileUpload_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_file_upload", "test")
+	r := IotHubFileUploadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccIotHubFileUpload_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_file_upload", "test")
+	r := IotHubFileUploadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("notifications_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("lock_duration").HasValue("PT5M"),
+			),
+		},
+		data.ImportStep("connection_string"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("connection_string"),
+	})
+}
+
+func (t IotHubFileUploadResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.IotHubID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.IoTHub.ResourceClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Properties == nil || resp.Properties.StorageEndpoints == nil {
+		return utils.Bool(false), nil
+	}
+
+	endpoint, ok := resp.Properties.StorageEndpoints["$default"]
+	return utils.Bool(ok && endpoint != nil && endpoint.ConnectionString != nil && *endpoint.ConnectionString != ""), nil
+}
+
+func (r IotHubFileUploadResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_file_upload" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+  connection_string   = azurerm_storage_account.test.primary_blob_connection_string
+  container_name      = azurerm_storage_container.test.name
+}
+`, r.template(data))
+}
+
+func (r IotHubFileUploadResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_file_upload" "test" {
+  resource_group_name   = azurerm_resource_group.test.name
+  iothub_name           = azurerm_iothub.test.name
+  connection_string     = azurerm_storage_account.test.primary_blob_connection_string
+  container_name        = azurerm_storage_container.test.name
+  authentication_type   = "KeyBased"
+  notifications_enabled = true
+  max_delivery_count    = 12
+  sas_ttl               = "PT2H"
+  default_ttl           = "PT3H"
+  lock_duration         = "PT5M"
+}
+`, r.template(data))
+}
+
+func (r IotHubFileUploadResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_file_upload" "import" {
+  resource_group_name = azurerm_iothub_file_upload.test.resource_group_name
+  iothub_name         = azurerm_iothub_file_upload.test.iothub_name
+  connection_string   = azurerm_iothub_file_upload.test.connection_string
+  container_name      = azurerm_iothub_file_upload.test.container_name
+}
+`, r.basic(data))
+}
+
+func (IotHubFileUploadResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-iothub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_iothub" "test" {
+  name                = "acctestIoTHub-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "S1"
+    capacity = "1"
+  }
+
+  tags = {
+    purpose = "testing"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
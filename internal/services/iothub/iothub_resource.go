@@ -153,30 +153,32 @@ func resourceIotHub() *pluginsdk.Resource {
 			},
 
 			"file_upload": {
-				Type:     pluginsdk.TypeList,
-				MaxItems: 1,
-				Optional: true,
+				Type:       pluginsdk.TypeList,
+				MaxItems:   1,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: pluginsdk.SchemaConfigModeAttr,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"connection_string": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
-								secretKeyRegex := regexp.MustCompile("(SharedAccessKey|AccountKey)=[^;]+")
-								sbProtocolRegex := regexp.MustCompile("sb://([^:]+)(:5671)?/;")
-
-								// Azure will always mask the Access Keys and will include the port number in the GET response
-								// 5671 is the default port for Azure Service Bus connections
-								maskedNew := sbProtocolRegex.ReplaceAllString(new, "sb://$1:5671/;")
-								maskedNew = secretKeyRegex.ReplaceAllString(maskedNew, "$1=****")
-								return (new == d.Get(k).(string)) && (maskedNew == old)
-							},
-							Sensitive: true,
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							DiffSuppressFunc: iothubFileUploadConnectionStringDiffSuppress,
+							Sensitive:        true,
 						},
 						"container_name": {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 						},
+						"authentication_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(devices.KeyBased),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(devices.KeyBased),
+								string(devices.IdentityBased),
+							}, false),
+						},
 						"notifications": {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
@@ -326,9 +328,10 @@ func resourceIotHub() *pluginsdk.Resource {
 						"condition": {
 							// The condition is a string value representing device-to-cloud message routes query expression
 							// https://docs.microsoft.com/en-us/azure/iot-hub/iot-hub-devguide-query-language#device-to-cloud-message-routes-query-expressions
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-							Default:  "true",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "true",
+							ValidateFunc: iothubValidate.IoTHubRouteCondition,
 						},
 						"endpoint_names": {
 							Type: pluginsdk.TypeList,
@@ -401,9 +404,10 @@ func resourceIotHub() *pluginsdk.Resource {
 						"condition": {
 							// The condition is a string value representing device-to-cloud message routes query expression
 							// https://docs.microsoft.com/en-us/azure/iot-hub/iot-hub-devguide-query-language#device-to-cloud-message-routes-query-expressions
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-							Default:  "true",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "true",
+							ValidateFunc: iothubValidate.IoTHubRouteCondition,
 						},
 						"endpoint_names": {
 							Type:     pluginsdk.TypeList,
@@ -554,8 +558,22 @@ func resourceIotHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 
 	storageEndpoints, messagingEndpoints, enableFileUploadNotifications := expandIoTHubFileUpload(d)
-	if err != nil {
-		return fmt.Errorf("Error expanding `file_upload`: %+v", err)
+
+	// File Upload can also be configured using the `azurerm_iothub_file_upload` resource, in which case the existing
+	// configuration is retained when the `file_upload` block isn't specified - it's only removed when explicitly
+	// cleared using `file_upload = []`
+	if !d.IsNewResource() && !d.HasChange("file_upload") && len(d.Get("file_upload").([]interface{})) == 0 {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("retrieving IotHub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+		if props := existing.Properties; props != nil {
+			storageEndpoints = props.StorageEndpoints
+			messagingEndpoints = props.MessagingEndpoints
+			if props.EnableFileUploadNotifications != nil {
+				enableFileUploadNotifications = *props.EnableFileUploadNotifications
+			}
+		}
 	}
 
 	props := devices.IotHubDescription{
@@ -891,13 +909,14 @@ func expandIoTHubFileUpload(d *pluginsdk.ResourceData) (map[string]*devices.Stor
 		defaultTTL := fileUploadMap["default_ttl"].(string)
 		lockDuration := fileUploadMap["lock_duration"].(string)
 
-		storageEndpointProperties["$default"] = &devices.StorageEndpointProperties{
-			SasTTLAsIso8601:  &sasTTL,
-			ConnectionString: &connectionStr,
-			ContainerName:    &containerName,
+		storageEndpointProperties[iothubFileUploadStorageEndpointName] = &devices.StorageEndpointProperties{
+			SasTTLAsIso8601:    &sasTTL,
+			ConnectionString:   &connectionStr,
+			ContainerName:      &containerName,
+			AuthenticationType: devices.AuthenticationType(fileUploadMap["authentication_type"].(string)),
 		}
 
-		messagingEndpointProperties["fileNotifications"] = &devices.MessagingEndpointProperties{
+		messagingEndpointProperties[iothubFileUploadMessagingEndpointName] = &devices.MessagingEndpointProperties{
 			LockDurationAsIso8601: &lockDuration,
 			TTLAsIso8601:          &defaultTTL,
 			MaxDeliveryCount:      &maxDeliveryCount,
@@ -1054,7 +1073,7 @@ func flattenIoTHubFileUpload(storageEndpoints map[string]*devices.StorageEndpoin
 	results := make([]interface{}, 0)
 	output := make(map[string]interface{})
 
-	if storageEndpointProperties, ok := storageEndpoints["$default"]; ok {
+	if storageEndpointProperties, ok := storageEndpoints[iothubFileUploadStorageEndpointName]; ok && iothubFileUploadIsConfigured(storageEndpoints) {
 		if connString := storageEndpointProperties.ConnectionString; connString != nil {
 			output["connection_string"] = *connString
 		}
@@ -1065,7 +1084,13 @@ func flattenIoTHubFileUpload(storageEndpoints map[string]*devices.StorageEndpoin
 			output["sas_ttl"] = *sasTTLAsIso8601
 		}
 
-		if messagingEndpointProperties, ok := messagingEndpoints["fileNotifications"]; ok {
+		authenticationType := string(devices.KeyBased)
+		if storageEndpointProperties.AuthenticationType != "" {
+			authenticationType = string(storageEndpointProperties.AuthenticationType)
+		}
+		output["authentication_type"] = authenticationType
+
+		if messagingEndpointProperties, ok := messagingEndpoints[iothubFileUploadMessagingEndpointName]; ok {
 			if lockDurationAsIso8601 := messagingEndpointProperties.LockDurationAsIso8601; lockDurationAsIso8601 != nil {
 				output["lock_duration"] = *lockDurationAsIso8601
 			}
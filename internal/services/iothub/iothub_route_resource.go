@@ -70,9 +70,10 @@ func resourceIotHubRoute() *pluginsdk.Resource {
 			"condition": {
 				// The condition is a string value representing device-to-cloud message routes query expression
 				// https://docs.microsoft.com/en-us/azure/iot-hub/iot-hub-devguide-query-language#device-to-cloud-message-routes-query-expressions
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				Default:  "true",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "true",
+				ValidateFunc: validate.IoTHubRouteCondition,
 			},
 			"endpoint_names": {
 				Type: pluginsdk.TypeList,
@@ -103,75 +104,69 @@ func resourceIotHubRouteCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	locks.ByName(iothubName, IothubResourceName)
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
-	iothub, err := client.Get(ctx, resourceGroup, iothubName)
-	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
-		}
+	var resourceId string
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		routeName := d.Get("name").(string)
 
-		return fmt.Errorf("Error loading IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
-
-	routeName := d.Get("name").(string)
+		resourceId = fmt.Sprintf("%s/Routes/%s", *iothub.ID, routeName)
 
-	resourceId := fmt.Sprintf("%s/Routes/%s", *iothub.ID, routeName)
+		source := devices.RoutingSource(d.Get("source").(string))
+		condition := d.Get("condition").(string)
+		endpointNamesRaw := d.Get("endpoint_names").([]interface{})
+		isEnabled := d.Get("enabled").(bool)
 
-	source := devices.RoutingSource(d.Get("source").(string))
-	condition := d.Get("condition").(string)
-	endpointNamesRaw := d.Get("endpoint_names").([]interface{})
-	isEnabled := d.Get("enabled").(bool)
+		route := devices.RouteProperties{
+			Name:          &routeName,
+			Source:        source,
+			Condition:     &condition,
+			EndpointNames: utils.ExpandStringSlice(endpointNamesRaw),
+			IsEnabled:     &isEnabled,
+		}
 
-	route := devices.RouteProperties{
-		Name:          &routeName,
-		Source:        source,
-		Condition:     &condition,
-		EndpointNames: utils.ExpandStringSlice(endpointNamesRaw),
-		IsEnabled:     &isEnabled,
-	}
+		routing := iothub.Properties.Routing
 
-	routing := iothub.Properties.Routing
+		if routing == nil {
+			routing = &devices.RoutingProperties{}
+		}
 
-	if routing == nil {
-		routing = &devices.RoutingProperties{}
-	}
+		if routing.Routes == nil {
+			routes := make([]devices.RouteProperties, 0)
+			routing.Routes = &routes
+		}
 
-	if routing.Routes == nil {
 		routes := make([]devices.RouteProperties, 0)
-		routing.Routes = &routes
-	}
 
-	routes := make([]devices.RouteProperties, 0)
-
-	alreadyExists := false
-	for _, existingRoute := range *routing.Routes {
-		if existingRoute.Name != nil {
-			if strings.EqualFold(*existingRoute.Name, routeName) {
-				if d.IsNewResource() {
-					return tf.ImportAsExistsError("azurerm_iothub_route", resourceId)
+		alreadyExists := false
+		for _, existingRoute := range *routing.Routes {
+			if existingRoute.Name != nil {
+				if strings.EqualFold(*existingRoute.Name, routeName) {
+					if d.IsNewResource() {
+						return tf.ImportAsExistsError("azurerm_iothub_route", resourceId)
+					}
+					routes = append(routes, route)
+					alreadyExists = true
+				} else {
+					routes = append(routes, existingRoute)
 				}
-				routes = append(routes, route)
-				alreadyExists = true
-			} else {
-				routes = append(routes, existingRoute)
 			}
 		}
-	}
 
-	if d.IsNewResource() {
-		routes = append(routes, route)
-	} else if !alreadyExists {
-		return fmt.Errorf("Unable to find Route %q defined for IotHub %q (Resource Group %q)", routeName, iothubName, resourceGroup)
-	}
+		if d.IsNewResource() {
+			routes = append(routes, route)
+		} else if !alreadyExists {
+			return fmt.Errorf("Unable to find Route %q defined for IotHub %q (Resource Group %q)", routeName, iothubName, resourceGroup)
+		}
 
-	routing.Routes = &routes
+		routing.Routes = &routes
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, iothubName, iothub, "")
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error creating/updating IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the completion of the creating/updating of IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
+		return err
 	}
 
 	d.SetId(resourceId)
@@ -239,42 +234,35 @@ func resourceIotHubRouteDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 	locks.ByName(iothubName, IothubResourceName)
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
-	iothub, err := client.Get(ctx, resourceGroup, iothubName)
-	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		if iothub.Properties == nil || iothub.Properties.Routing == nil {
+			return errIotHubUnchanged
 		}
+		routes := iothub.Properties.Routing.Routes
 
-		return fmt.Errorf("Error loading IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
-
-	if iothub.Properties == nil || iothub.Properties.Routing == nil {
-		return nil
-	}
-	routes := iothub.Properties.Routing.Routes
-
-	if routes == nil {
-		return nil
-	}
+		if routes == nil {
+			return errIotHubUnchanged
+		}
 
-	updatedRoutes := make([]devices.RouteProperties, 0)
-	for _, route := range *routes {
-		if route.Name != nil {
-			if !strings.EqualFold(*route.Name, routeName) {
-				updatedRoutes = append(updatedRoutes, route)
+		updatedRoutes := make([]devices.RouteProperties, 0)
+		for _, route := range *routes {
+			if route.Name != nil {
+				if !strings.EqualFold(*route.Name, routeName) {
+					updatedRoutes = append(updatedRoutes, route)
+				}
 			}
 		}
-	}
 
-	iothub.Properties.Routing.Routes = &updatedRoutes
+		iothub.Properties.Routing.Routes = &updatedRoutes
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, iothubName, iothub, "")
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error updating IotHub %q (Resource Group %q) with Route %q: %+v", iothubName, resourceGroup, routeName, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for IotHub %q (Resource Group %q) to finish updating Route %q: %+v", iothubName, resourceGroup, routeName, err)
+		return err
 	}
 
 	return nil
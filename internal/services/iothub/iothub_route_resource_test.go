@@ -3,6 +3,7 @@ package iothub_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -71,6 +72,38 @@ func TestAccIotHubRoute_update(t *testing.T) {
 	})
 }
 
+func TestAccIotHubRoute_invalidCondition(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_route", "test")
+	r := IotHubRouteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidCondition(data),
+			ExpectError: regexp.MustCompile("unbalanced parentheses"),
+		},
+	})
+}
+
+func TestAccIotHubRoute_multipleInParallel(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_route", "test")
+	r := IotHubRouteResource{}
+
+	// the Endpoints and Routes within this configuration are all applied in parallel against the same IoT Hub
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleInParallel(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_iothub_route.test.0").ExistsInAzure(r),
+				check.That("azurerm_iothub_route.test.1").ExistsInAzure(r),
+				check.That("azurerm_iothub_route.test.2").ExistsInAzure(r),
+				check.That("azurerm_iothub_route.test.3").ExistsInAzure(r),
+			),
+		},
+		data.ImportStepFor("azurerm_iothub_route.test.0"),
+		data.ImportStepFor("azurerm_iothub_route.test.3"),
+	})
+}
+
 func (t IotHubRouteResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -178,6 +211,25 @@ resource "azurerm_iothub_route" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (IotHubRouteResource) invalidCondition(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_iothub_route" "test" {
+  resource_group_name = "acctestRG-iothub-%d"
+  iothub_name         = "acctestIoTHub%d"
+  name                = "acctest"
+
+  source         = "DeviceMessages"
+  condition      = "(level = 'critical' AND $contentType = 'application/json'"
+  endpoint_names = ["acctest"]
+  enabled        = true
+}
+`, data.RandomInteger, data.RandomInteger)
+}
+
 func (IotHubRouteResource) update(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -243,3 +295,68 @@ resource "azurerm_iothub_route" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
+
+func (IotHubRouteResource) multipleInParallel(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-iothub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  count                 = 4
+  name                  = "test%[1]d-${count.index}"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_iothub" "test" {
+  name                = "acctestIoTHub%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "S1"
+    capacity = "1"
+  }
+}
+
+resource "azurerm_iothub_endpoint_storage_container" "test" {
+  count               = 4
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+  name                = "acctest${count.index}"
+
+  connection_string          = azurerm_storage_account.test.primary_blob_connection_string
+  batch_frequency_in_seconds = 60
+  max_chunk_size_in_bytes    = 10485760
+  container_name             = azurerm_storage_container.test[count.index].name
+  encoding                   = "Avro"
+  file_name_format           = "{iothub}/{partition}_{YYYY}_{MM}_{DD}_{HH}_{mm}"
+}
+
+resource "azurerm_iothub_route" "test" {
+  count               = 4
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+  name                = "acctest${count.index}"
+
+  source         = "DeviceMessages"
+  condition      = "true"
+  endpoint_names = [azurerm_iothub_endpoint_storage_container.test[count.index].name]
+  enabled        = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -136,68 +136,62 @@ func resourceIotHubSharedAccessPolicyCreateUpdate(d *pluginsdk.ResourceData, met
 	locks.ByName(iothubName, IothubResourceName)
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
-	iothub, err := client.Get(ctx, resourceGroup, iothubName)
-	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
-		}
+	var resourceId string
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		keyName := d.Get("name").(string)
 
-		return fmt.Errorf("Error loading IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
+		resourceId = fmt.Sprintf("%s/IotHubKeys/%s", *iothub.ID, keyName)
 
-	keyName := d.Get("name").(string)
+		expandedAccessPolicy := devices.SharedAccessSignatureAuthorizationRule{
+			KeyName: &keyName,
+			Rights:  devices.AccessRights(expandAccessRights(d)),
+		}
 
-	resourceId := fmt.Sprintf("%s/IotHubKeys/%s", *iothub.ID, keyName)
+		accessPolicies := make([]devices.SharedAccessSignatureAuthorizationRule, 0)
 
-	expandedAccessPolicy := devices.SharedAccessSignatureAuthorizationRule{
-		KeyName: &keyName,
-		Rights:  devices.AccessRights(expandAccessRights(d)),
-	}
+		alreadyExists := false
+		for accessPolicyIterator, err := client.ListKeysComplete(ctx, resourceGroup, iothubName); accessPolicyIterator.NotDone(); err = accessPolicyIterator.NextWithContext(ctx) {
+			if err != nil {
+				return fmt.Errorf("Error loading Shared Access Profiles of IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
+			}
+			existingAccessPolicy := accessPolicyIterator.Value()
 
-	accessPolicies := make([]devices.SharedAccessSignatureAuthorizationRule, 0)
+			if strings.EqualFold(*existingAccessPolicy.KeyName, keyName) {
+				if d.IsNewResource() {
+					return tf.ImportAsExistsError("azurerm_iothub_shared_access_policy", resourceId)
+				}
 
-	alreadyExists := false
-	for accessPolicyIterator, err := client.ListKeysComplete(ctx, resourceGroup, iothubName); accessPolicyIterator.NotDone(); err = accessPolicyIterator.NextWithContext(ctx) {
-		if err != nil {
-			return fmt.Errorf("Error loading Shared Access Profiles of IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-		}
-		existingAccessPolicy := accessPolicyIterator.Value()
+				if existingAccessPolicy.PrimaryKey != nil {
+					expandedAccessPolicy.PrimaryKey = existingAccessPolicy.PrimaryKey
+				}
 
-		if strings.EqualFold(*existingAccessPolicy.KeyName, keyName) {
-			if d.IsNewResource() {
-				return tf.ImportAsExistsError("azurerm_iothub_shared_access_policy", resourceId)
-			}
-
-			if existingAccessPolicy.PrimaryKey != nil {
-				expandedAccessPolicy.PrimaryKey = existingAccessPolicy.PrimaryKey
-			}
+				if existingAccessPolicy.SecondaryKey != nil {
+					expandedAccessPolicy.SecondaryKey = existingAccessPolicy.SecondaryKey
+				}
 
-			if existingAccessPolicy.SecondaryKey != nil {
-				expandedAccessPolicy.SecondaryKey = existingAccessPolicy.SecondaryKey
+				accessPolicies = append(accessPolicies, expandedAccessPolicy)
+				alreadyExists = true
+			} else {
+				accessPolicies = append(accessPolicies, existingAccessPolicy)
 			}
+		}
 
+		if d.IsNewResource() {
 			accessPolicies = append(accessPolicies, expandedAccessPolicy)
-			alreadyExists = true
-		} else {
-			accessPolicies = append(accessPolicies, existingAccessPolicy)
+		} else if !alreadyExists {
+			return fmt.Errorf("Unable to find Shared Access Policy %q defined for IotHub %q (Resource Group %q)", keyName, iothubName, resourceGroup)
 		}
-	}
 
-	if d.IsNewResource() {
-		accessPolicies = append(accessPolicies, expandedAccessPolicy)
-	} else if !alreadyExists {
-		return fmt.Errorf("Unable to find Shared Access Policy %q defined for IotHub %q (Resource Group %q)", keyName, iothubName, resourceGroup)
-	}
-
-	iothub.Properties.AuthorizationPolicies = &accessPolicies
+		iothub.Properties.AuthorizationPolicies = &accessPolicies
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, iothubName, iothub, "")
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error updating IotHub %q (Resource Group %q) with Shared Access Profile %q: %+v", iothubName, resourceGroup, keyName, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for IotHub %q (Resource Group %q) to finish updating Shared Access Profile %q: %+v", iothubName, resourceGroup, keyName, err)
+		return err
 	}
 
 	d.SetId(resourceId)
@@ -274,37 +268,30 @@ func resourceIotHubSharedAccessPolicyDelete(d *pluginsdk.ResourceData, meta inte
 	locks.ByName(iothubName, IothubResourceName)
 	defer locks.UnlockByName(iothubName, IothubResourceName)
 
-	iothub, err := client.Get(ctx, resourceGroup, iothubName)
-	if err != nil {
-		if utils.ResponseWasNotFound(iothub.Response) {
-			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
-		}
-
-		return fmt.Errorf("Error loading IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-	}
-
-	accessPolicies := make([]devices.SharedAccessSignatureAuthorizationRule, 0)
+	iothub, err := updateIotHubWithETag(ctx, client, resourceGroup, iothubName, func(iothub *devices.IotHubDescription) error {
+		accessPolicies := make([]devices.SharedAccessSignatureAuthorizationRule, 0)
 
-	for accessPolicyIterator, err := client.ListKeysComplete(ctx, resourceGroup, iothubName); accessPolicyIterator.NotDone(); err = accessPolicyIterator.NextWithContext(ctx) {
-		if err != nil {
-			return fmt.Errorf("Error loading Shared Access Profiles of IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
-		}
-		existingAccessPolicy := accessPolicyIterator.Value()
+		for accessPolicyIterator, err := client.ListKeysComplete(ctx, resourceGroup, iothubName); accessPolicyIterator.NotDone(); err = accessPolicyIterator.NextWithContext(ctx) {
+			if err != nil {
+				return fmt.Errorf("Error loading Shared Access Profiles of IotHub %q (Resource Group %q): %+v", iothubName, resourceGroup, err)
+			}
+			existingAccessPolicy := accessPolicyIterator.Value()
 
-		if !strings.EqualFold(*existingAccessPolicy.KeyName, keyName) {
-			accessPolicies = append(accessPolicies, existingAccessPolicy)
+			if !strings.EqualFold(*existingAccessPolicy.KeyName, keyName) {
+				accessPolicies = append(accessPolicies, existingAccessPolicy)
+			}
 		}
-	}
 
-	iothub.Properties.AuthorizationPolicies = &accessPolicies
+		iothub.Properties.AuthorizationPolicies = &accessPolicies
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, iothubName, iothub, "")
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error updating IotHub %q (Resource Group %q) with Shared Access Profile %q: %+v", iothubName, resourceGroup, keyName, err)
-	}
+		if utils.ResponseWasNotFound(iothub.Response) {
+			return fmt.Errorf("IotHub %q (Resource Group %q) was not found", iothubName, resourceGroup)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for IotHub %q (Resource Group %q) to finish updating Shared Access Profile %q: %+v", iothubName, resourceGroup, keyName, err)
+		return err
 	}
 
 	return nil
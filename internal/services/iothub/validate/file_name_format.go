@@ -2,7 +2,10 @@ package validate
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func FileNameFormat(v interface{}, k string) (warnings []string, errors []error) {
@@ -24,5 +27,13 @@ func FileNameFormat(v interface{}, k string) (warnings []string, errors []error)
 		}
 	}
 
+	// the tokens are case-sensitive (`{MM}` is the month whereas `{mm}` is the minute) and any other token is
+	// rejected by the API when the Endpoint is provisioned, rather than being treated as a literal
+	for _, token := range regexp.MustCompile(`{[^{}]*}`).FindAllString(value, -1) {
+		if !utils.SliceContainsValue(requiredComponents, token) {
+			errors = append(errors, fmt.Errorf("%s contains the unsupported token %q, the supported tokens are %s", k, token, strings.Join(requiredComponents, ", ")))
+		}
+	}
+
 	return warnings, errors
 }
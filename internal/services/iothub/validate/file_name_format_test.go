@@ -0,0 +1,35 @@
+package validate
+
+import "testing"
+
+func TestFileNameFormat(t *testing.T) {
+	validFormats := []string{
+		"{iothub}/{partition}/{YYYY}/{MM}/{DD}/{HH}/{mm}",
+		"{iothub}/{partition}_{YYYY}_{MM}_{DD}_{HH}_{mm}",
+		"{YYYY}/{MM}/{DD}/{HH}/{mm}/{iothub}/{partition}.avro",
+		"prefix/{iothub}-{partition}-{YYYY}{MM}{DD}{HH}{mm}",
+	}
+	for _, v := range validFormats {
+		_, errors := FileNameFormat(v, "file_name_format")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid File Name Format: %q", v, errors)
+		}
+	}
+
+	invalidFormats := []string{
+		"",
+		"false",
+		"{iothub}/{partition}/{YYYY}/{MM}/{DD}/{HH}",
+		"{iothub}/{YYYY}/{MM}/{DD}/{HH}/{mm}",
+		"{IOTHUB}/{PARTITION}/{YYYY}/{MM}/{DD}/{HH}/{MM}",
+		"{iothub}/{partition}/{YYYY}/{MM}/{DD}/{HH}/{mm}/{ss}",
+		"{iothub}/{partition}/{yyyy}/{YYYY}/{MM}/{DD}/{HH}/{mm}",
+		"{iothub}/{partition}/{YYYY}/{MM}/{DD}/{HH}/{mm}/{}",
+	}
+	for _, v := range invalidFormats {
+		_, errors := FileNameFormat(v, "file_name_format")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid File Name Format", v)
+		}
+	}
+}
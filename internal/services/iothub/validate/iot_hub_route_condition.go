@@ -0,0 +1,441 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// IoTHubRouteCondition parses a device-to-cloud message routing query so that malformed conditions are
+// caught at plan time rather than after the IoT Hub update has been submitted.
+// https://docs.microsoft.com/en-us/azure/iot-hub/iot-hub-devguide-routing-query-syntax
+func IoTHubRouteCondition(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	tokens, err := tokenizeRouteCondition(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s is not a valid routing query: %+v", k, err))
+		return
+	}
+
+	p := &routeConditionParser{tokens: tokens}
+	if err := p.parse(); err != nil {
+		errors = append(errors, fmt.Errorf("%s is not a valid routing query: %+v", k, err))
+	}
+
+	return
+}
+
+// routeConditionSystemProperties are the message system properties which can be referenced using `$`
+var routeConditionSystemProperties = []string{
+	"absoluteExpiryTime",
+	"body",
+	"connectionDeviceGenerationId",
+	"connectionDeviceId",
+	"connectionModuleId",
+	"contentEncoding",
+	"contentType",
+	"correlationId",
+	"dt-dataschema",
+	"dt-subject",
+	"iothub-connection-auth-generation-id",
+	"iothub-connection-auth-method",
+	"iothub-connection-device-id",
+	"iothub-connection-module-id",
+	"iothub-creation-time-utc",
+	"iothub-enqueuedtime",
+	"iothub-interface-name",
+	"messageId",
+	"to",
+	"twin",
+	"userId",
+}
+
+var routeConditionFunctions = []string{
+	"ABS",
+	"AS_NUMBER",
+	"CEILING",
+	"CONCAT",
+	"CONTAINS",
+	"ENDS_WITH",
+	"EXP",
+	"FLOOR",
+	"INDEX_OF",
+	"IS_ARRAY",
+	"IS_BOOL",
+	"IS_DEFINED",
+	"IS_NULL",
+	"IS_NUMBER",
+	"IS_OBJECT",
+	"IS_PRIMITIVE",
+	"IS_STRING",
+	"LENGTH",
+	"LOWER",
+	"POWER",
+	"SIGN",
+	"SQRT",
+	"SQUARE",
+	"STARTS_WITH",
+	"SUBSTRING",
+	"UPPER",
+}
+
+type routeConditionTokenType int
+
+const (
+	routeConditionTokenEOF routeConditionTokenType = iota
+	routeConditionTokenIdentifier
+	routeConditionTokenSystemProperty
+	routeConditionTokenString
+	routeConditionTokenNumber
+	routeConditionTokenOperator
+	routeConditionTokenPunctuation
+)
+
+type routeConditionToken struct {
+	kind     routeConditionTokenType
+	value    string
+	position int
+}
+
+func tokenizeRouteCondition(input string) ([]routeConditionToken, error) {
+	tokens := make([]routeConditionToken, 0)
+	runes := []rune(input)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '\'' || r == '"':
+			// strings are terminated by the same quote, which can be escaped with a backslash
+			i++
+			for i < len(runes) && runes[i] != r {
+				if runes[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string starting at position %d", start)
+			}
+			i++
+			tokens = append(tokens, routeConditionToken{kind: routeConditionTokenString, value: string(runes[start:i]), position: start})
+
+		case unicode.IsDigit(r):
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, routeConditionToken{kind: routeConditionTokenNumber, value: string(runes[start:i]), position: start})
+
+		case r == '$':
+			i++
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '-') {
+				i++
+			}
+			name := string(runes[start+1 : i])
+			if name == "" {
+				return nil, fmt.Errorf("expected a system property name after `$` at position %d", start)
+			}
+			tokens = append(tokens, routeConditionToken{kind: routeConditionTokenSystemProperty, value: name, position: start})
+
+		case unicode.IsLetter(r) || r == '_':
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, routeConditionToken{kind: routeConditionTokenIdentifier, value: string(runes[start:i]), position: start})
+
+		case strings.ContainsRune("()[],.", r):
+			i++
+			tokens = append(tokens, routeConditionToken{kind: routeConditionTokenPunctuation, value: string(r), position: start})
+
+		default:
+			operator, err := readRouteConditionOperator(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			i += len(operator)
+			tokens = append(tokens, routeConditionToken{kind: routeConditionTokenOperator, value: operator, position: start})
+		}
+	}
+
+	return append(tokens, routeConditionToken{kind: routeConditionTokenEOF, position: len(runes)}), nil
+}
+
+func readRouteConditionOperator(runes []rune, i int) (string, error) {
+	next := rune(0)
+	if i+1 < len(runes) {
+		next = runes[i+1]
+	}
+	pair := string([]rune{runes[i], next})
+
+	// the most common mistakes come from other languages, so call these out explicitly
+	invalid := map[string]string{
+		"==": "=",
+		"&&": "AND",
+		"||": "OR",
+	}
+	if replacement, ok := invalid[pair]; ok {
+		return "", fmt.Errorf("invalid operator `%s` at position %d, use `%s` instead", pair, i, replacement)
+	}
+
+	switch pair {
+	case "!=", "<>", "<=", ">=":
+		return pair, nil
+	}
+
+	switch runes[i] {
+	case '=', '<', '>', '+', '-', '*', '/', '%':
+		return string(runes[i]), nil
+	case '!':
+		return "", fmt.Errorf("invalid operator `!` at position %d, use `NOT` instead", i)
+	}
+
+	return "", fmt.Errorf("unexpected character %q at position %d", runes[i], i)
+}
+
+type routeConditionParser struct {
+	tokens []routeConditionToken
+	index  int
+}
+
+func (p *routeConditionParser) parse() error {
+	if p.peek().kind == routeConditionTokenEOF {
+		return fmt.Errorf("the condition cannot be empty")
+	}
+
+	if err := p.parseOr(); err != nil {
+		return err
+	}
+
+	if t := p.peek(); t.kind != routeConditionTokenEOF {
+		if t.value == ")" {
+			return fmt.Errorf("unbalanced parentheses: unexpected `)` at position %d", t.position)
+		}
+		return fmt.Errorf("unexpected %q at position %d", t.value, t.position)
+	}
+
+	return nil
+}
+
+func (p *routeConditionParser) peek() routeConditionToken {
+	return p.tokens[p.index]
+}
+
+func (p *routeConditionParser) next() routeConditionToken {
+	t := p.tokens[p.index]
+	if t.kind != routeConditionTokenEOF {
+		p.index++
+	}
+	return t
+}
+
+func (p *routeConditionParser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == routeConditionTokenIdentifier && strings.EqualFold(t.value, keyword)
+}
+
+func (p *routeConditionParser) isPunctuation(value string) bool {
+	t := p.peek()
+	return t.kind == routeConditionTokenPunctuation && t.value == value
+}
+
+func (p *routeConditionParser) parseOr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+	for p.isKeyword("OR") {
+		p.next()
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *routeConditionParser) parseAnd() error {
+	if err := p.parseNot(); err != nil {
+		return err
+	}
+	for p.isKeyword("AND") {
+		p.next()
+		if err := p.parseNot(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *routeConditionParser) parseNot() error {
+	if p.isKeyword("NOT") {
+		p.next()
+		return p.parseNot()
+	}
+	return p.parseComparison()
+}
+
+func (p *routeConditionParser) parseComparison() error {
+	if err := p.parseAdditive(); err != nil {
+		return err
+	}
+
+	if t := p.peek(); t.kind == routeConditionTokenOperator {
+		switch t.value {
+		case "=", "!=", "<>", "<", "<=", ">", ">=":
+			p.next()
+			return p.parseAdditive()
+		}
+	}
+
+	return nil
+}
+
+func (p *routeConditionParser) parseAdditive() error {
+	if err := p.parseMultiplicative(); err != nil {
+		return err
+	}
+	for t := p.peek(); t.kind == routeConditionTokenOperator && (t.value == "+" || t.value == "-"); t = p.peek() {
+		p.next()
+		if err := p.parseMultiplicative(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *routeConditionParser) parseMultiplicative() error {
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+	for t := p.peek(); t.kind == routeConditionTokenOperator && (t.value == "*" || t.value == "/" || t.value == "%"); t = p.peek() {
+		p.next()
+		if err := p.parseUnary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *routeConditionParser) parseUnary() error {
+	if t := p.peek(); t.kind == routeConditionTokenOperator && t.value == "-" {
+		p.next()
+		return p.parseUnary()
+	}
+	return p.parsePrimary()
+}
+
+func (p *routeConditionParser) parsePrimary() error {
+	t := p.next()
+
+	switch t.kind {
+	case routeConditionTokenEOF:
+		return fmt.Errorf("unexpected end of condition")
+
+	case routeConditionTokenString, routeConditionTokenNumber:
+		return nil
+
+	case routeConditionTokenSystemProperty:
+		if !routeConditionContains(routeConditionSystemProperties, t.value) {
+			return fmt.Errorf("unknown system property `$%s` at position %d", t.value, t.position)
+		}
+		return p.parsePath()
+
+	case routeConditionTokenIdentifier:
+		for _, keyword := range []string{"AND", "OR", "NOT"} {
+			if strings.EqualFold(t.value, keyword) {
+				return fmt.Errorf("expected an operand but got `%s` at position %d", t.value, t.position)
+			}
+		}
+
+		if p.isPunctuation("(") {
+			if !routeConditionContains(routeConditionFunctions, t.value) {
+				return fmt.Errorf("unknown function `%s` at position %d", t.value, t.position)
+			}
+			p.next()
+			return p.parseArguments(t)
+		}
+
+		// anything else is either a literal (`true`, `false`, `null`) or an application property
+		return p.parsePath()
+
+	case routeConditionTokenPunctuation:
+		if t.value == "(" {
+			if err := p.parseOr(); err != nil {
+				return err
+			}
+			if !p.isPunctuation(")") {
+				return fmt.Errorf("unbalanced parentheses: missing `)` for `(` at position %d", t.position)
+			}
+			p.next()
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unexpected %q at position %d", t.value, t.position)
+}
+
+func (p *routeConditionParser) parseArguments(function routeConditionToken) error {
+	if p.isPunctuation(")") {
+		p.next()
+		return nil
+	}
+
+	for {
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+
+		if p.isPunctuation(",") {
+			p.next()
+			continue
+		}
+
+		if !p.isPunctuation(")") {
+			return fmt.Errorf("unbalanced parentheses: missing `)` for function `%s` at position %d", function.value, function.position)
+		}
+		p.next()
+		return nil
+	}
+}
+
+// parsePath parses any property accessors following a reference, e.g. `$body.Weather.HistoricalData[0].Month`
+func (p *routeConditionParser) parsePath() error {
+	for {
+		switch {
+		case p.isPunctuation("."):
+			p.next()
+			if t := p.next(); t.kind != routeConditionTokenIdentifier {
+				return fmt.Errorf("expected a property name after `.` at position %d", t.position)
+			}
+
+		case p.isPunctuation("["):
+			open := p.next()
+			if t := p.next(); t.kind != routeConditionTokenNumber && t.kind != routeConditionTokenString {
+				return fmt.Errorf("expected an index or property name after `[` at position %d", open.position)
+			}
+			if !p.isPunctuation("]") {
+				return fmt.Errorf("missing `]` for `[` at position %d", open.position)
+			}
+			p.next()
+
+		default:
+			return nil
+		}
+	}
+}
+
+func routeConditionContains(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package validate
+
+import "testing"
+
+func TestIoTHubRouteCondition(t *testing.T) {
+	validConditions := []string{
+		"true",
+		"false",
+		"level = 'critical'",
+		"processingPath = 'hot' AND level != 'info'",
+		"$contentType = 'application/json' AND $contentEncoding = 'UTF-8'",
+		"$body.Weather.Temperature > 50",
+		"$body.Weather.HistoricalData[0].Month = 'Feb'",
+		"$twin.properties.desired.telemetryConfig.sendFrequency = '5m'",
+		"$twin.tags.deploymentLocation.floor = 1",
+		"$iothub-connection-device-id = 'device1'",
+		"NOT (temperature > 50 OR humidity < 10)",
+		"((a = 1) and (b <> 2)) or not c <= -3",
+		"IS_DEFINED($body.Weather) AND STARTS_WITH($connectionDeviceId, 'sensor')",
+		"length(name) % 2 = 0",
+		"temperature * 1.8 + 32 >= 100",
+	}
+	for _, v := range validConditions {
+		t.Logf("[DEBUG] Testing %q..", v)
+
+		_, errors := IoTHubRouteCondition(v, "condition")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Route Condition: %q", v, errors)
+		}
+	}
+
+	invalidConditions := []string{
+		"",
+		"   ",
+		"(level = 'critical'",
+		"level = 'critical')",
+		"((a = 1) AND (b = 2)",
+		"level = 'critical",
+		"level == 'critical'",
+		"a = 1 && b = 2",
+		"a = 1 || b = 2",
+		"!a",
+		"a = 1 AND",
+		"AND a = 1",
+		"$unknownProperty = 1",
+		"$ = 1",
+		"UNKNOWN_FUNCTION(a)",
+		"IS_DEFINED(a",
+		"$body.Weather. = 1",
+		"$body.Data[0 = 1",
+		"a = 1 b = 2",
+		"a = b = c",
+		"level ~ 'critical'",
+	}
+	for _, v := range invalidConditions {
+		t.Logf("[DEBUG] Testing %q..", v)
+
+		_, errors := IoTHubRouteCondition(v, "condition")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Route Condition", v)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package loadbalancer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceArmLoadBalancerBackendAddressPoolAssociations() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceArmLoadBalancerBackendAddressPoolAssociationsRead,
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"backend_address_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.LoadBalancerBackendAddressPoolID,
+			},
+
+			"ip_configuration_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"network_interface_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmLoadBalancerBackendAddressPoolAssociationsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LoadBalancers.LoadBalancerBackendAddressPoolsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.LoadBalancerBackendAddressPoolID(d.Get("backend_address_pool_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	ipConfigurationIds := make([]string, 0)
+	networkInterfaceIds := make([]string, 0)
+	if props := resp.BackendAddressPoolPropertiesFormat; props != nil && props.BackendIPConfigurations != nil {
+		for _, config := range *props.BackendIPConfigurations {
+			if config.ID == nil {
+				continue
+			}
+			ipConfigurationIds = append(ipConfigurationIds, *config.ID)
+
+			// the IP Configurations are nested within the Network Interface (or the Network Interface of a
+			// Virtual Machine Scale Set instance) - so we can determine the Network Interface from it
+			networkInterfaceId := *config.ID
+			if index := strings.LastIndex(strings.ToLower(networkInterfaceId), "/ipconfigurations/"); index > 0 {
+				networkInterfaceId = networkInterfaceId[:index]
+			}
+			if !utils.SliceContainsValue(networkInterfaceIds, networkInterfaceId) {
+				networkInterfaceIds = append(networkInterfaceIds, networkInterfaceId)
+			}
+		}
+	}
+
+	d.Set("backend_address_pool_id", id.ID())
+	if err := d.Set("ip_configuration_ids", ipConfigurationIds); err != nil {
+		return fmt.Errorf("setting `ip_configuration_ids`: %+v", err)
+	}
+	if err := d.Set("network_interface_ids", networkInterfaceIds); err != nil {
+		return fmt.Errorf("setting `network_interface_ids`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package loadbalancer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type LoadBalancerBackendAddressPoolAssociationsDataSource struct{}
+
+func TestAccDataSourceBackendAddressPoolAssociations_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_lb_backend_address_pool_associations", "test")
+	r := LoadBalancerBackendAddressPoolAssociationsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("ip_configuration_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("network_interface_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("network_interface_ids.0").MatchesOtherKey(check.That("azurerm_network_interface.test").Key("id")),
+			),
+		},
+	})
+}
+
+func (LoadBalancerBackendAddressPoolAssociationsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["192.168.1.0/24"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-${local.number}"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_network_interface_backend_address_pool_association" "test" {
+  network_interface_id    = azurerm_network_interface.test.id
+  ip_configuration_name   = "testconfiguration1"
+  backend_address_pool_id = azurerm_lb_backend_address_pool.test.id
+}
+
+data "azurerm_lb_backend_address_pool_associations" "test" {
+  backend_address_pool_id = azurerm_lb_backend_address_pool.test.id
+
+  depends_on = [azurerm_network_interface_backend_address_pool_association.test]
+}
+`, LoadBalancerBackendAddressPool{}.basicSkuBasic(data))
+}
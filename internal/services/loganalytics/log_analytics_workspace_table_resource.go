@@ -0,0 +1,219 @@
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceLogAnalyticsWorkspaceTable() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Read:   resourceLogAnalyticsWorkspaceTableRead,
+		Update: resourceLogAnalyticsWorkspaceTableCreateUpdate,
+		Delete: resourceLogAnalyticsWorkspaceTableDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := tables.ParseTableID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.LogAnalyticsWorkspaceID,
+			},
+
+			"plan": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(tables.TablePlanEnumAnalytics),
+				ValidateFunc: validation.StringInSlice(tables.PossibleValuesForTablePlanEnum(), false),
+			},
+
+			"retention_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(4, 730),
+			},
+
+			"total_retention_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(4, 2555),
+			},
+
+			"archive_retention_in_days": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			// the interactive retention of a Basic table is fixed at 8 days
+			if d.Get("plan").(string) == string(tables.TablePlanEnumBasic) && d.HasChange("retention_in_days") {
+				return fmt.Errorf("`retention_in_days` cannot be set when `plan` is `%s`", string(tables.TablePlanEnumBasic))
+			}
+
+			return nil
+		}),
+	}
+}
+
+func resourceLogAnalyticsWorkspaceTableCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := parse.LogAnalyticsWorkspaceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, d.Get("name").(string))
+
+	// the Tables within a Workspace are created by Azure (or by ingesting data), so this resource manages the settings
+	// of an existing Table rather than creating one
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("%s was not found", id)
+			}
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+	}
+
+	plan := tables.TablePlanEnum(d.Get("plan").(string))
+	parameters := tables.Table{
+		Properties: &tables.TableProperties{
+			Plan: &plan,
+		},
+	}
+
+	if v, ok := d.GetOk("retention_in_days"); ok && plan != tables.TablePlanEnumBasic {
+		parameters.Properties.RetentionInDays = utils.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("total_retention_in_days"); ok {
+		parameters.Properties.TotalRetentionInDays = utils.Int64(int64(v.(int)))
+	}
+
+	if err := client.UpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceLogAnalyticsWorkspaceTableRead(d, meta)
+}
+
+func resourceLogAnalyticsWorkspaceTableRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := tables.ParseTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("workspace_id", parse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			plan := string(tables.TablePlanEnumAnalytics)
+			if props.Plan != nil {
+				plan = string(*props.Plan)
+			}
+			d.Set("plan", plan)
+
+			retentionInDays := 0
+			if props.RetentionInDays != nil {
+				retentionInDays = int(*props.RetentionInDays)
+			}
+			d.Set("retention_in_days", retentionInDays)
+
+			totalRetentionInDays := 0
+			if props.TotalRetentionInDays != nil {
+				totalRetentionInDays = int(*props.TotalRetentionInDays)
+			}
+			d.Set("total_retention_in_days", totalRetentionInDays)
+
+			archiveRetentionInDays := 0
+			if props.ArchiveRetentionInDays != nil {
+				archiveRetentionInDays = int(*props.ArchiveRetentionInDays)
+			}
+			d.Set("archive_retention_in_days", archiveRetentionInDays)
+		}
+	}
+
+	return nil
+}
+
+func resourceLogAnalyticsWorkspaceTableDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LogAnalytics.TablesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := tables.ParseTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the Table itself can't be deleted, so the retention is reset to the defaults of the Workspace instead
+	parameters := tables.Table{
+		Properties: &tables.TableProperties{
+			TotalRetentionInDays: utils.Int64(-1),
+		},
+	}
+	if d.Get("plan").(string) != string(tables.TablePlanEnumBasic) {
+		parameters.Properties.RetentionInDays = utils.Int64(-1)
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("resetting the retention of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package tables
+
+type Table struct {
+	Id         *string          `json:"id,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	Properties *TableProperties `json:"properties,omitempty"`
+	Type       *string          `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package tables
+
+type TableProperties struct {
+	ArchiveRetentionInDays *int64                 `json:"archiveRetentionInDays,omitempty"`
+	Plan                   *TablePlanEnum         `json:"plan,omitempty"`
+	ProvisioningState      *ProvisioningStateEnum `json:"provisioningState,omitempty"`
+	RetentionInDays        *int64                 `json:"retentionInDays,omitempty"`
+	TotalRetentionInDays   *int64                 `json:"totalRetentionInDays,omitempty"`
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -109,9 +110,10 @@ func resourceArmMaintenanceConfiguration() *pluginsdk.Resource {
 							),
 						},
 						"time_zone": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validate.MaintenanceTimeZone(),
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateFunc:     validate.MaintenanceTimeZone(),
+							DiffSuppressFunc: suppress.AzureTimeZone,
 						},
 						"recur_every": {
 							Type:         pluginsdk.TypeString,
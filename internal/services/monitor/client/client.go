@@ -6,6 +6,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/alertsmanagement/mgmt/2019-06-01-preview/alertsmanagement"
 	classic "github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/actiongroupsapis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/alertrulerecommendations"
 )
 
 type Client struct {
@@ -21,12 +23,16 @@ type Client struct {
 
 	// Monitor
 	ActionGroupsClient               *classic.ActionGroupsClient
+	ActionGroupsAPIsClient           *actiongroupsapis.ActionGroupsAPIsClient
 	ActivityLogAlertsClient          *insights.ActivityLogAlertsClient
+	AlertRuleRecommendationsClient   *alertrulerecommendations.AlertRuleRecommendationsClient
 	AlertRulesClient                 *classic.AlertRulesClient
 	DiagnosticSettingsClient         *classic.DiagnosticSettingsClient
 	DiagnosticSettingsCategoryClient *classic.DiagnosticSettingsCategoryClient
 	LogProfilesClient                *classic.LogProfilesClient
 	MetricAlertsClient               *classic.MetricAlertsClient
+	MetricDefinitionsClient          *classic.MetricDefinitionsClient
+	MetricNamespacesClient           *classic.MetricNamespacesClient
 	ScheduledQueryRulesClient        *classic.ScheduledQueryRulesClient
 }
 
@@ -46,9 +52,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	ActionGroupsClient := classic.NewActionGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ActionGroupsClient.Client, o.ResourceManagerAuthorizer)
 
+	ActionGroupsAPIsClient := actiongroupsapis.NewActionGroupsAPIsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ActionGroupsAPIsClient.Client, o.ResourceManagerAuthorizer)
+
 	ActivityLogAlertsClient := insights.NewActivityLogAlertsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ActivityLogAlertsClient.Client, o.ResourceManagerAuthorizer)
 
+	AlertRuleRecommendationsClient := alertrulerecommendations.NewAlertRuleRecommendationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&AlertRuleRecommendationsClient.Client, o.ResourceManagerAuthorizer)
+
 	AlertRulesClient := classic.NewAlertRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&AlertRulesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -64,6 +76,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	MetricAlertsClient := classic.NewMetricAlertsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&MetricAlertsClient.Client, o.ResourceManagerAuthorizer)
 
+	MetricDefinitionsClient := classic.NewMetricDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&MetricDefinitionsClient.Client, o.ResourceManagerAuthorizer)
+
+	MetricNamespacesClient := classic.NewMetricNamespacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&MetricNamespacesClient.Client, o.ResourceManagerAuthorizer)
+
 	ScheduledQueryRulesClient := classic.NewScheduledQueryRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ScheduledQueryRulesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -73,12 +91,16 @@ func NewClient(o *common.ClientOptions) *Client {
 		ActionRulesClient:                &ActionRulesClient,
 		SmartDetectorAlertRulesClient:    &SmartDetectorAlertRulesClient,
 		ActionGroupsClient:               &ActionGroupsClient,
+		ActionGroupsAPIsClient:           &ActionGroupsAPIsClient,
 		ActivityLogAlertsClient:          &ActivityLogAlertsClient,
+		AlertRuleRecommendationsClient:   &AlertRuleRecommendationsClient,
 		AlertRulesClient:                 &AlertRulesClient,
 		DiagnosticSettingsClient:         &DiagnosticSettingsClient,
 		DiagnosticSettingsCategoryClient: &DiagnosticSettingsCategoryClient,
 		LogProfilesClient:                &LogProfilesClient,
 		MetricAlertsClient:               &MetricAlertsClient,
+		MetricDefinitionsClient:          &MetricDefinitionsClient,
+		MetricNamespacesClient:           &MetricNamespacesClient,
 		ScheduledQueryRulesClient:        &ScheduledQueryRulesClient,
 	}
 }
@@ -0,0 +1,341 @@
+package migration
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ pluginsdk.StateUpgrade = ActionGroupV0ToV1{}
+
+type ActionGroupV0ToV1 struct{}
+
+func (ActionGroupV0ToV1) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"location": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "global",
+		},
+
+		"short_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"email_receiver": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1000,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"email_address": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"use_common_alert_schema": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+
+		"itsm_receiver": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"workspace_id": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"connection_id": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"ticket_configuration": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"region": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"azure_app_push_receiver": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"email_address": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"sms_receiver": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"country_code": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"phone_number": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"webhook_receiver": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"service_uri": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"use_common_alert_schema": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+
+					"aad_auth": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"object_id": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+
+								"identifier_uri": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+									Computed: true,
+								},
+
+								"tenant_id": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"automation_runbook_receiver": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"automation_account_id": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"runbook_name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"webhook_resource_id": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"is_global_runbook": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+					"service_uri": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"use_common_alert_schema": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"voice_receiver": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"country_code": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"phone_number": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"logic_app_receiver": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"resource_id": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"callback_url": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"use_common_alert_schema": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+
+		"azure_function_receiver": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"function_app_resource_id": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"function_name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"http_trigger_url": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"use_common_alert_schema": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+
+		"arm_role_receiver": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"role_id": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"use_common_alert_schema": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (ActionGroupV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		// the Receiver blocks have changed from Lists to Sets, which share the same representation
+		// within the raw state - so there's nothing to transform here
+		log.Printf("[DEBUG] Migrating the Receivers for Monitor Action Group %q from Lists to Sets", rawState["id"])
+
+		return rawState, nil
+	}
+}
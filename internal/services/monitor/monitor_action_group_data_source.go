@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -29,6 +30,8 @@ func dataSourceMonitorActionGroup() *pluginsdk.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
 
+			"location": location.SchemaComputed(),
+
 			"short_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -322,6 +325,8 @@ func dataSourceMonitorActionGroupRead(d *pluginsdk.ResourceData, meta interface{
 	}
 	d.SetId(*resp.ID)
 
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
 	if group := resp.ActionGroup; group != nil {
 		d.Set("short_name", group.GroupShortName)
 		d.Set("enabled", group.Enabled)
@@ -0,0 +1,239 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMonitorActionGroupEmailReceiver() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorActionGroupEmailReceiverCreateUpdate,
+		Read:   resourceMonitorActionGroupEmailReceiverRead,
+		Update: resourceMonitorActionGroupEmailReceiverCreateUpdate,
+		Delete: resourceMonitorActionGroupEmailReceiverDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ActionGroupEmailReceiverID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"action_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ActionGroupID,
+			},
+
+			"email_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"use_common_alert_schema": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceMonitorActionGroupEmailReceiverCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActionGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	actionGroupId, err := parse.ActionGroupID(d.Get("action_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewActionGroupEmailReceiverID(actionGroupId.SubscriptionId, actionGroupId.ResourceGroup, actionGroupId.Name, d.Get("name").(string))
+
+	locks.ByName(actionGroupId.Name, monitorActionGroupResourceName)
+	defer locks.UnlockByName(actionGroupId.Name, monitorActionGroupResourceName)
+
+	actionGroup, err := client.Get(ctx, actionGroupId.ResourceGroup, actionGroupId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(actionGroup.Response) {
+			return fmt.Errorf("%s was not found", *actionGroupId)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *actionGroupId, err)
+	}
+
+	if actionGroup.ActionGroup == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *actionGroupId)
+	}
+
+	receiver := insights.EmailReceiver{
+		Name:                 utils.String(id.EmailReceiverName),
+		EmailAddress:         utils.String(d.Get("email_address").(string)),
+		UseCommonAlertSchema: utils.Bool(d.Get("use_common_alert_schema").(bool)),
+	}
+
+	receivers := make([]insights.EmailReceiver, 0)
+	alreadyExists := false
+	if existing := actionGroup.ActionGroup.EmailReceivers; existing != nil {
+		for _, existingReceiver := range *existing {
+			if existingReceiver.Name == nil || !strings.EqualFold(*existingReceiver.Name, id.EmailReceiverName) {
+				receivers = append(receivers, existingReceiver)
+				continue
+			}
+
+			if d.IsNewResource() {
+				return tf.ImportAsExistsError("azurerm_monitor_action_group_email_receiver", id.ID())
+			}
+			receivers = append(receivers, receiver)
+			alreadyExists = true
+		}
+	}
+
+	if d.IsNewResource() {
+		// check this up-front, since the API otherwise rejects the Action Group with an opaque error
+		if len(receivers) >= monitorActionGroupMaxEmailReceivers {
+			return fmt.Errorf("adding %s: %s already contains the maximum of %d Email Receivers", id, *actionGroupId, monitorActionGroupMaxEmailReceivers)
+		}
+		receivers = append(receivers, receiver)
+	} else if !alreadyExists {
+		return fmt.Errorf("%s was not found", id)
+	}
+
+	actionGroup.ActionGroup.EmailReceivers = &receivers
+
+	if _, err := client.CreateOrUpdate(ctx, actionGroupId.ResourceGroup, actionGroupId.Name, actionGroup); err != nil {
+		return fmt.Errorf("updating %s with %s: %+v", *actionGroupId, id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorActionGroupEmailReceiverRead(d, meta)
+}
+
+func resourceMonitorActionGroupEmailReceiverRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActionGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ActionGroupEmailReceiverID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	actionGroupId := parse.NewActionGroupID(id.SubscriptionId, id.ResourceGroup, id.ActionGroupName)
+
+	actionGroup, err := client.Get(ctx, id.ResourceGroup, id.ActionGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(actionGroup.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", actionGroupId, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", actionGroupId, err)
+	}
+
+	var receiver *insights.EmailReceiver
+	if props := actionGroup.ActionGroup; props != nil && props.EmailReceivers != nil {
+		for _, item := range *props.EmailReceivers {
+			if item.Name != nil && strings.EqualFold(*item.Name, id.EmailReceiverName) {
+				v := item
+				receiver = &v
+				break
+			}
+		}
+	}
+
+	if receiver == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.EmailReceiverName)
+	d.Set("action_group_id", actionGroupId.ID())
+	d.Set("email_address", receiver.EmailAddress)
+	d.Set("use_common_alert_schema", receiver.UseCommonAlertSchema)
+
+	return nil
+}
+
+func resourceMonitorActionGroupEmailReceiverDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActionGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ActionGroupEmailReceiverID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	actionGroupId := parse.NewActionGroupID(id.SubscriptionId, id.ResourceGroup, id.ActionGroupName)
+
+	locks.ByName(id.ActionGroupName, monitorActionGroupResourceName)
+	defer locks.UnlockByName(id.ActionGroupName, monitorActionGroupResourceName)
+
+	actionGroup, err := client.Get(ctx, id.ResourceGroup, id.ActionGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(actionGroup.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", actionGroupId, err)
+	}
+
+	if actionGroup.ActionGroup == nil || actionGroup.ActionGroup.EmailReceivers == nil {
+		return nil
+	}
+
+	// an empty list (rather than nil) is sent so that removing the last Email Receiver clears them
+	receivers := make([]insights.EmailReceiver, 0)
+	found := false
+	for _, receiver := range *actionGroup.ActionGroup.EmailReceivers {
+		if receiver.Name != nil && strings.EqualFold(*receiver.Name, id.EmailReceiverName) {
+			found = true
+			continue
+		}
+		receivers = append(receivers, receiver)
+	}
+
+	if !found {
+		return nil
+	}
+
+	actionGroup.ActionGroup.EmailReceivers = &receivers
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ActionGroupName, actionGroup); err != nil {
+		return fmt.Errorf("removing %s from %s: %+v", *id, actionGroupId, err)
+	}
+
+	return nil
+}
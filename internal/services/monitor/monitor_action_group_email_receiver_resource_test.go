@@ -0,0 +1,217 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorActionGroupEmailReceiverResource struct {
+}
+
+func TestAccMonitorActionGroupEmailReceiver_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_action_group_email_receiver", "test")
+	r := MonitorActionGroupEmailReceiverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorActionGroupEmailReceiver_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_action_group_email_receiver", "test")
+	r := MonitorActionGroupEmailReceiverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorActionGroupEmailReceiver_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_action_group_email_receiver", "test")
+	r := MonitorActionGroupEmailReceiverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("email_address").HasValue("devops@contoso.com"),
+				check.That(data.ResourceName).Key("use_common_alert_schema").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorActionGroupEmailReceiver_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_action_group_email_receiver", "test")
+	r := MonitorActionGroupEmailReceiverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_monitor_action_group_email_receiver.second").ExistsInAzure(r),
+				check.That("azurerm_monitor_action_group_email_receiver.third").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorActionGroupEmailReceiver_removeLast(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_action_group_email_receiver", "test")
+	r := MonitorActionGroupEmailReceiverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// removing the only Email Receiver should leave the Action Group without any Email Receivers
+			Config: r.template(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_monitor_action_group.test").Key("email_receiver.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (t MonitorActionGroupEmailReceiverResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ActionGroupEmailReceiverID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Monitor.ActionGroupsClient.Get(ctx, id.ResourceGroup, id.ActionGroupName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.ActionGroup != nil && resp.ActionGroup.EmailReceivers != nil {
+		for _, receiver := range *resp.ActionGroup.EmailReceivers {
+			if receiver.Name != nil && strings.EqualFold(*receiver.Name, id.EmailReceiverName) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (MonitorActionGroupEmailReceiverResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+
+  lifecycle {
+    ignore_changes = [email_receiver]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r MonitorActionGroupEmailReceiverResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_action_group_email_receiver" "test" {
+  name            = "sendtoadmin"
+  action_group_id = azurerm_monitor_action_group.test.id
+  email_address   = "admin@contoso.com"
+}
+`, r.template(data))
+}
+
+func (r MonitorActionGroupEmailReceiverResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_action_group_email_receiver" "import" {
+  name            = azurerm_monitor_action_group_email_receiver.test.name
+  action_group_id = azurerm_monitor_action_group_email_receiver.test.action_group_id
+  email_address   = azurerm_monitor_action_group_email_receiver.test.email_address
+}
+`, r.basic(data))
+}
+
+func (r MonitorActionGroupEmailReceiverResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_action_group_email_receiver" "test" {
+  name                    = "sendtoadmin"
+  action_group_id         = azurerm_monitor_action_group.test.id
+  email_address           = "devops@contoso.com"
+  use_common_alert_schema = true
+}
+`, r.template(data))
+}
+
+func (r MonitorActionGroupEmailReceiverResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_action_group_email_receiver" "test" {
+  name            = "sendtoadmin"
+  action_group_id = azurerm_monitor_action_group.test.id
+  email_address   = "admin@contoso.com"
+}
+
+resource "azurerm_monitor_action_group_email_receiver" "second" {
+  name            = "sendtodevops"
+  action_group_id = azurerm_monitor_action_group.test.id
+  email_address   = "devops@contoso.com"
+}
+
+resource "azurerm_monitor_action_group_email_receiver" "third" {
+  name                    = "sendtooncall"
+  action_group_id         = azurerm_monitor_action_group.test.id
+  email_address           = "oncall@contoso.com"
+  use_common_alert_schema = true
+}
+`, r.template(data))
+}
@@ -0,0 +1,104 @@
+package monitor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestMonitorActionGroupReceiverHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		first    map[string]interface{}
+		second   map[string]interface{}
+		expected bool
+	}{
+		{
+			name:     "Same Receiver",
+			first:    map[string]interface{}{"name": "devops", "email_address": "devops@contoso.com"},
+			second:   map[string]interface{}{"name": "devops", "email_address": "devops@contoso.com"},
+			expected: true,
+		},
+		{
+			name:     "Different Casing",
+			first:    map[string]interface{}{"name": "DevOps", "email_address": "devops@contoso.com"},
+			second:   map[string]interface{}{"name": "devops", "email_address": "devops@contoso.com"},
+			expected: true,
+		},
+		{
+			name:     "Updated Receiver",
+			first:    map[string]interface{}{"name": "devops", "email_address": "devops@contoso.com"},
+			second:   map[string]interface{}{"name": "devops", "email_address": "oncall@contoso.com"},
+			expected: true,
+		},
+		{
+			name:     "Different Receivers",
+			first:    map[string]interface{}{"name": "devops", "email_address": "devops@contoso.com"},
+			second:   map[string]interface{}{"name": "oncall", "email_address": "devops@contoso.com"},
+			expected: false,
+		},
+	}
+
+	for _, v := range tests {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := monitorActionGroupReceiverHash(v.first) == monitorActionGroupReceiverHash(v.second)
+		if actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestMonitorActionGroupCustomizeDiffKeyVaultSecretReceivers(t *testing.T) {
+	tests := []struct {
+		name          string
+		receiver      map[string]interface{}
+		expectedError string
+	}{
+		{
+			name:     "Service URI",
+			receiver: map[string]interface{}{"name": "devops", "service_uri": "https://example.com/alert"},
+		},
+		{
+			name:     "Key Vault Secret ID",
+			receiver: map[string]interface{}{"name": "devops", "service_uri_key_vault_secret_id": "https://vault1.vault.azure.net/secrets/secret1"},
+		},
+		{
+			name:          "Neither",
+			receiver:      map[string]interface{}{"name": "devops"},
+			expectedError: "one of `service_uri` or `service_uri_key_vault_secret_id` must be specified",
+		},
+		{
+			name:          "Both",
+			receiver:      map[string]interface{}{"name": "devops", "service_uri": "https://example.com/alert", "service_uri_key_vault_secret_id": "https://vault1.vault.azure.net/secrets/secret1"},
+			expectedError: "only one of `service_uri` and `service_uri_key_vault_secret_id` can be specified",
+		},
+	}
+
+	for _, v := range tests {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		r := resourceMonitorActionGroup()
+		r.CustomizeDiff = monitorActionGroupCustomizeDiff
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":                "acctestActionGroup",
+			"resource_group_name": "acctestRG",
+			"short_name":          "acctestag",
+			"webhook_receiver":    []interface{}{v.receiver},
+		})
+
+		_, err := r.Diff(context.Background(), nil, config, nil)
+		if v.expectedError == "" {
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), v.expectedError) {
+			t.Fatalf("expected an error containing %q but got: %+v", v.expectedError, err)
+		}
+	}
+}
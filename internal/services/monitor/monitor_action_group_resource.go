@@ -1,15 +1,25 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
+	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
 	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -17,14 +27,26 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+var monitorActionGroupResourceName = "azurerm_monitor_action_group"
+
+// monitorActionGroupMaxEmailReceivers is the maximum number of Email Receivers which can be defined within an Action Group
+const monitorActionGroupMaxEmailReceivers = 1000
+
 func resourceMonitorActionGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMonitorActionGroupCreateUpdate,
 		Read:   resourceMonitorActionGroupRead,
 		Update: resourceMonitorActionGroupCreateUpdate,
 		Delete: resourceMonitorActionGroupDelete,
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ActionGroupID(id)
+			return err
+		}),
+
+		SchemaVersion: 1,
+		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
+			0: migration.ActionGroupV0ToV1{},
+		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -33,6 +55,8 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(monitorActionGroupCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -43,6 +67,16 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
+			"location": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "global",
+				ValidateFunc:     validate.ActionGroupLocation,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+
 			"short_name": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
@@ -56,8 +90,10 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 			},
 
 			"email_receiver": {
-				Type:     pluginsdk.TypeList,
+				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Set:      monitorActionGroupReceiverHash,
+				MaxItems: monitorActionGroupMaxEmailReceivers,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -79,8 +115,10 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 			},
 
 			"itsm_receiver": {
-				Type:     pluginsdk.TypeList,
+				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Set:      monitorActionGroupReceiverHash,
+				MaxItems: 10,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -115,8 +153,10 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 			},
 
 			"azure_app_push_receiver": {
-				Type:     pluginsdk.TypeList,
+				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Set:      monitorActionGroupReceiverHash,
+				MaxItems: 10,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -134,8 +174,10 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 			},
 
 			"sms_receiver": {
-				Type:     pluginsdk.TypeList,
+				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Set:      monitorActionGroupReceiverHash,
+				MaxItems: 10,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -146,7 +188,7 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 						"country_code": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
+							ValidateFunc: validate.ActionGroupReceiverCountryCode,
 						},
 						"phone_number": {
 							Type:         pluginsdk.TypeString,
@@ -158,8 +200,10 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 			},
 
 			"webhook_receiver": {
-				Type:     pluginsdk.TypeList,
+				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Set:      monitorActionGroupReceiverHash,
+				MaxItems: 10,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -169,9 +213,14 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 						},
 						"service_uri": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
+							Optional:     true,
 							ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
 						},
+						"service_uri_key_vault_secret_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+						},
 						"use_common_alert_schema": {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
@@ -210,8 +259,10 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 			},
 
 			"automation_runbook_receiver": {
-				Type:     pluginsdk.TypeList,
+				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Set:      monitorActionGroupReceiverHash,
+				MaxItems: 10,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -253,8 +304,10 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 			},
 
 			"voice_receiver": {
-				Type:     pluginsdk.TypeList,
+				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Set:      monitorActionGroupReceiverHash,
+				MaxItems: 10,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -265,7 +318,7 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 						"country_code": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
+							ValidateFunc: validate.ActionGroupReceiverCountryCode,
 						},
 						"phone_number": {
 							Type:         pluginsdk.TypeString,
@@ -277,8 +330,10 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 			},
 
 			"logic_app_receiver": {
-				Type:     pluginsdk.TypeList,
+				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Set:      monitorActionGroupReceiverHash,
+				MaxItems: 10,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -293,9 +348,14 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 						},
 						"callback_url": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
+							Optional:     true,
 							ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
 						},
+						"callback_url_key_vault_secret_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+						},
 						"use_common_alert_schema": {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
@@ -305,8 +365,10 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 			},
 
 			"azure_function_receiver": {
-				Type:     pluginsdk.TypeList,
+				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Set:      monitorActionGroupReceiverHash,
+				MaxItems: 10,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -326,9 +388,14 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 						},
 						"http_trigger_url": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
+							Optional:     true,
 							ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
 						},
+						"http_trigger_url_key_vault_secret_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+						},
 						"use_common_alert_schema": {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
@@ -338,8 +405,9 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 			},
 
 			"arm_role_receiver": {
-				Type:     pluginsdk.TypeList,
+				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Set:      monitorActionGroupReceiverHash,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -364,8 +432,88 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 	}
 }
 
+// monitorActionGroupReceiverTypes are the blocks which define the Receivers of an Action Group
+var monitorActionGroupReceiverTypes = []string{
+	"arm_role_receiver",
+	"automation_runbook_receiver",
+	"azure_app_push_receiver",
+	"azure_function_receiver",
+	"email_receiver",
+	"itsm_receiver",
+	"logic_app_receiver",
+	"sms_receiver",
+	"voice_receiver",
+	"webhook_receiver",
+}
+
+// monitorActionGroupKeyVaultSecretReceiverTypes maps the Receiver types whose URI can be sourced from a Key Vault
+// Secret to the name of the URI field, the Key Vault Secret ID is specified in the field `{name}_key_vault_secret_id`
+var monitorActionGroupKeyVaultSecretReceiverTypes = map[string]string{
+	"azure_function_receiver": "http_trigger_url",
+	"logic_app_receiver":      "callback_url",
+	"webhook_receiver":        "service_uri",
+}
+
+// monitorActionGroupCustomizeDiff ensures that exactly one of the URI and the Key Vault Secret ID is specified for each
+// Receiver which supports sourcing its URI from a Key Vault Secret, and that the names of the Receivers are unique
+// (case-insensitively) across all of the Receiver types, since the API otherwise rejects the Action Group with an
+// opaque error during the apply
+func monitorActionGroupCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	for receiverType, uriField := range monitorActionGroupKeyVaultSecretReceiverTypes {
+		secretField := fmt.Sprintf("%s_key_vault_secret_id", uriField)
+		for _, raw := range d.Get(receiverType).(*pluginsdk.Set).List() {
+			receiver, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			// a Receiver containing values which aren't known until apply is keyed using a `~` prefixed hash
+			key := fmt.Sprintf("%s.~%d", receiverType, monitorActionGroupReceiverHash(receiver))
+			if !d.NewValueKnown(fmt.Sprintf("%s.%s", key, uriField)) || !d.NewValueKnown(fmt.Sprintf("%s.%s", key, secretField)) {
+				continue
+			}
+
+			uri := receiver[uriField].(string)
+			secretId := receiver[secretField].(string)
+			if uri != "" && secretId != "" {
+				return fmt.Errorf("only one of `%s` and `%s` can be specified for the `%s` %q", uriField, secretField, receiverType, receiver["name"].(string))
+			}
+			if uri == "" && secretId == "" {
+				return fmt.Errorf("one of `%s` or `%s` must be specified for the `%s` %q", uriField, secretField, receiverType, receiver["name"].(string))
+			}
+		}
+	}
+
+	names := make(map[string]string)
+	for _, receiverType := range monitorActionGroupReceiverTypes {
+		for _, raw := range d.Get(receiverType).(*pluginsdk.Set).List() {
+			receiver, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			// the name may not be known until apply (e.g. when interpolated from another resource)
+			name := receiver["name"].(string)
+			if name == "" {
+				continue
+			}
+
+			if existing, ok := names[strings.ToLower(name)]; ok {
+				if existing == receiverType {
+					return fmt.Errorf("the name %q is used by more than one `%s` - Receiver names must be unique across all Receivers within an Action Group", name, receiverType)
+				}
+				return fmt.Errorf("the name %q is used by both the `%s` and `%s` blocks - Receiver names must be unique across all Receivers within an Action Group", name, existing, receiverType)
+			}
+			names[strings.ToLower(name)] = receiverType
+		}
+	}
+
+	return nil
+}
+
 func resourceMonitorActionGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Monitor.ActionGroupsClient
+	keyVaultsClient := meta.(*clients.Client).KeyVault.ManagementClient
 	tenantId := meta.(*clients.Client).Account.TenantId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -373,6 +521,9 @@ func resourceMonitorActionGroupCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
+	locks.ByName(name, monitorActionGroupResourceName)
+	defer locks.UnlockByName(name, monitorActionGroupResourceName)
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
@@ -389,22 +540,31 @@ func resourceMonitorActionGroupCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	shortName := d.Get("short_name").(string)
 	enabled := d.Get("enabled").(bool)
 
-	emailReceiversRaw := d.Get("email_receiver").([]interface{})
-	itsmReceiversRaw := d.Get("itsm_receiver").([]interface{})
-	azureAppPushReceiversRaw := d.Get("azure_app_push_receiver").([]interface{})
-	smsReceiversRaw := d.Get("sms_receiver").([]interface{})
-	webhookReceiversRaw := d.Get("webhook_receiver").([]interface{})
-	automationRunbookReceiversRaw := d.Get("automation_runbook_receiver").([]interface{})
-	voiceReceiversRaw := d.Get("voice_receiver").([]interface{})
-	logicAppReceiversRaw := d.Get("logic_app_receiver").([]interface{})
-	azureFunctionReceiversRaw := d.Get("azure_function_receiver").([]interface{})
-	armRoleReceiversRaw := d.Get("arm_role_receiver").([]interface{})
+	emailReceiversRaw := d.Get("email_receiver").(*pluginsdk.Set).List()
+	itsmReceiversRaw := d.Get("itsm_receiver").(*pluginsdk.Set).List()
+	azureAppPushReceiversRaw := d.Get("azure_app_push_receiver").(*pluginsdk.Set).List()
+	smsReceiversRaw := d.Get("sms_receiver").(*pluginsdk.Set).List()
+	webhookReceiversRaw, err := resolveMonitorActionGroupReceiverKeyVaultSecrets(ctx, keyVaultsClient, "webhook_receiver", d.Get("webhook_receiver").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+	automationRunbookReceiversRaw := d.Get("automation_runbook_receiver").(*pluginsdk.Set).List()
+	voiceReceiversRaw := d.Get("voice_receiver").(*pluginsdk.Set).List()
+	logicAppReceiversRaw, err := resolveMonitorActionGroupReceiverKeyVaultSecrets(ctx, keyVaultsClient, "logic_app_receiver", d.Get("logic_app_receiver").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+	azureFunctionReceiversRaw, err := resolveMonitorActionGroupReceiverKeyVaultSecrets(ctx, keyVaultsClient, "azure_function_receiver", d.Get("azure_function_receiver").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+	armRoleReceiversRaw := d.Get("arm_role_receiver").(*pluginsdk.Set).List()
 
 	t := d.Get("tags").(map[string]interface{})
 	expandedTags := tags.Expand(t)
 
 	parameters := insights.ActionGroupResource{
-		Location: utils.String(azure.NormalizeLocation("Global")),
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		ActionGroup: &insights.ActionGroup{
 			GroupShortName:             utils.String(shortName),
 			Enabled:                    utils.Bool(enabled),
@@ -462,6 +622,7 @@ func resourceMonitorActionGroupRead(d *pluginsdk.ResourceData, meta interface{})
 
 	d.Set("name", name)
 	d.Set("resource_group_name", resGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
 
 	if group := resp.ActionGroup; group != nil {
 		d.Set("short_name", group.GroupShortName)
@@ -483,7 +644,11 @@ func resourceMonitorActionGroupRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `sms_receiver`: %+v", err)
 		}
 
-		if err = d.Set("webhook_receiver", flattenMonitorActionGroupWebHookReceiver(group.WebhookReceivers)); err != nil {
+		webhookReceivers, err := flattenMonitorActionGroupReceiverKeyVaultSecrets(ctx, meta, d, "webhook_receiver", flattenMonitorActionGroupWebHookReceiver(group.WebhookReceivers))
+		if err != nil {
+			return err
+		}
+		if err = d.Set("webhook_receiver", webhookReceivers); err != nil {
 			return fmt.Errorf("Error setting `webhook_receiver`: %+v", err)
 		}
 
@@ -495,11 +660,19 @@ func resourceMonitorActionGroupRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `voice_receiver`: %+v", err)
 		}
 
-		if err = d.Set("logic_app_receiver", flattenMonitorActionGroupLogicAppReceiver(group.LogicAppReceivers)); err != nil {
+		logicAppReceivers, err := flattenMonitorActionGroupReceiverKeyVaultSecrets(ctx, meta, d, "logic_app_receiver", flattenMonitorActionGroupLogicAppReceiver(group.LogicAppReceivers))
+		if err != nil {
+			return err
+		}
+		if err = d.Set("logic_app_receiver", logicAppReceivers); err != nil {
 			return fmt.Errorf("Error setting `logic_app_receiver`: %+v", err)
 		}
 
-		if err = d.Set("azure_function_receiver", flattenMonitorActionGroupAzureFunctionReceiver(group.AzureFunctionReceivers)); err != nil {
+		azureFunctionReceivers, err := flattenMonitorActionGroupReceiverKeyVaultSecrets(ctx, meta, d, "azure_function_receiver", flattenMonitorActionGroupAzureFunctionReceiver(group.AzureFunctionReceivers))
+		if err != nil {
+			return err
+		}
+		if err = d.Set("azure_function_receiver", azureFunctionReceivers); err != nil {
 			return fmt.Errorf("Error setting `azure_function_receiver`: %+v", err)
 		}
 		if err = d.Set("arm_role_receiver", flattenMonitorActionGroupRoleReceiver(group.ArmRoleReceivers)); err != nil {
@@ -946,3 +1119,115 @@ func flattenMonitorActionGroupRoleReceiver(receivers *[]insights.ArmRoleReceiver
 	}
 	return result
 }
+
+// resolveMonitorActionGroupReceiverKeyVaultSecrets populates the URI of each Receiver which references a Key Vault Secret
+// with the value of that Secret, so that the URI is only sent to the API (and isn't persisted into the state)
+func resolveMonitorActionGroupReceiverKeyVaultSecrets(ctx context.Context, client *keyvaultmgmt.BaseClient, receiverType string, input []interface{}) ([]interface{}, error) {
+	uriField := monitorActionGroupKeyVaultSecretReceiverTypes[receiverType]
+	secretField := fmt.Sprintf("%s_key_vault_secret_id", uriField)
+
+	for _, raw := range input {
+		receiver := raw.(map[string]interface{})
+		name := receiver["name"].(string)
+
+		secretId := receiver[secretField].(string)
+		if secretId == "" {
+			continue
+		}
+
+		uri, err := getMonitorActionGroupKeyVaultSecretValue(ctx, client, secretId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving `%s` for the `%s` %q: %+v", secretField, receiverType, name, err)
+		}
+		if _, errs := validation.IsURLWithScheme([]string{"http", "https"})(uri, secretField); len(errs) > 0 {
+			return nil, fmt.Errorf("the Key Vault Secret %q referenced by the `%s` %q doesn't contain a valid URI", secretId, receiverType, name)
+		}
+		receiver[uriField] = uri
+	}
+
+	return input, nil
+}
+
+// flattenMonitorActionGroupReceiverKeyVaultSecrets replaces the URI returned from the API with the Key Vault Secret ID for
+// each Receiver which was configured using a Key Vault Secret, so that the URI (which may embed a token) isn't persisted
+// into the state
+func flattenMonitorActionGroupReceiverKeyVaultSecrets(ctx context.Context, meta interface{}, d *pluginsdk.ResourceData, receiverType string, input []interface{}) ([]interface{}, error) {
+	uriField := monitorActionGroupKeyVaultSecretReceiverTypes[receiverType]
+	secretField := fmt.Sprintf("%s_key_vault_secret_id", uriField)
+
+	// the API has no knowledge of the Key Vault Secret, so this has to be sourced from the existing state
+	secretIds := make(map[string]string)
+	if existing, ok := d.GetOk(receiverType); ok {
+		for _, raw := range existing.(*pluginsdk.Set).List() {
+			receiver := raw.(map[string]interface{})
+			if v := receiver[secretField].(string); v != "" {
+				secretIds[strings.ToLower(receiver["name"].(string))] = v
+			}
+		}
+	}
+
+	detectRotation := meta.(*clients.Client).Features.MonitorActionGroup.DetectKeyVaultSecretRotation
+	keyVaultsClient := meta.(*clients.Client).KeyVault.ManagementClient
+
+	for _, raw := range input {
+		receiver := raw.(map[string]interface{})
+		name := monitorActionGroupReceiverName(receiver)
+
+		secretId, ok := secretIds[strings.ToLower(name)]
+		if !ok {
+			receiver[secretField] = ""
+			continue
+		}
+
+		if detectRotation {
+			uri, err := getMonitorActionGroupKeyVaultSecretValue(ctx, keyVaultsClient, secretId)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving `%s` for the `%s` %q: %+v", secretField, receiverType, name, err)
+			}
+
+			// removing the reference from the state means it'll be re-applied (using the current value of the Secret)
+			if existing, ok := receiver[uriField].(string); !ok || existing != uri {
+				log.Printf("[DEBUG] the `%s` of the `%s` %q doesn't match the Key Vault Secret %q - removing the reference from state", uriField, receiverType, name, secretId)
+				secretId = ""
+			}
+		}
+
+		receiver[uriField] = ""
+		receiver[secretField] = secretId
+	}
+
+	return input, nil
+}
+
+func getMonitorActionGroupKeyVaultSecretValue(ctx context.Context, client *keyvaultmgmt.BaseClient, secretId string) (string, error) {
+	id, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(secretId)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		return "", fmt.Errorf("retrieving Key Vault Secret %q: %+v", secretId, err)
+	}
+	if resp.Value == nil {
+		return "", fmt.Errorf("retrieving Key Vault Secret %q: `value` was nil", secretId)
+	}
+
+	return *resp.Value, nil
+}
+
+// monitorActionGroupReceiverHash hashes the Receivers using their name, which is unique (case-insensitively) across all
+// of the Receivers within an Action Group - such that the API returning the Receivers in a different order doesn't
+// produce a diff, and the other fields (which the API normalizes) are diffed in-place
+func monitorActionGroupReceiverHash(input interface{}) int {
+	return pluginsdk.HashString(strings.ToLower(monitorActionGroupReceiverName(input)))
+}
+
+func monitorActionGroupReceiverName(input interface{}) string {
+	if v, ok := input.(map[string]interface{}); ok && v != nil {
+		if name, ok := v["name"].(string); ok {
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,303 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/actiongroupsapis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const monitorActionGroupTestNotificationStateComplete = "Complete"
+
+func resourceMonitorActionGroupTestNotification() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorActionGroupTestNotificationCreate,
+		Read:   resourceMonitorActionGroupTestNotificationRead,
+		Delete: resourceMonitorActionGroupTestNotificationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"action_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ActionGroupID,
+			},
+
+			"alert_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"activitylog",
+					"budget",
+					"logalertv1metricmeasurement",
+					"logalertv1numresult",
+					"logalertv2",
+					"metricsdynamicthreshold",
+					"metricstaticthreshold",
+					"resourcehealth",
+					"servicehealth",
+					"smartalert",
+					"webtestalert",
+				}, false),
+			},
+
+			// changing any of the triggers sends a new set of test notifications
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"created_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"completed_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"action_detail": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mechanism_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"sub_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"send_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"detail": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMonitorActionGroupTestNotificationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	actionGroupsClient := meta.(*clients.Client).Monitor.ActionGroupsClient
+	client := meta.(*clients.Client).Monitor.ActionGroupsAPIsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	actionGroupId, err := parse.ActionGroupID(d.Get("action_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	actionGroup, err := actionGroupsClient.Get(ctx, actionGroupId.ResourceGroup, actionGroupId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *actionGroupId, err)
+	}
+	if actionGroup.ActionGroup == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *actionGroupId)
+	}
+
+	// the receivers are sent as they're defined within the Action Group - since the JSON representation of the
+	// receivers is the same in both API versions, they're converted by round-tripping them through JSON
+	serialized, err := json.Marshal(actionGroup.ActionGroup)
+	if err != nil {
+		return fmt.Errorf("serializing the receivers for %s: %+v", *actionGroupId, err)
+	}
+	var parameters actiongroupsapis.NotificationRequestBody
+	if err := json.Unmarshal(serialized, &parameters); err != nil {
+		return fmt.Errorf("deserializing the receivers for %s: %+v", *actionGroupId, err)
+	}
+	parameters.AlertType = d.Get("alert_type").(string)
+
+	sdkActionGroupId := actiongroupsapis.NewActionGroupID(actionGroupId.SubscriptionId, actionGroupId.ResourceGroup, actionGroupId.Name)
+	resp, err := client.CreateNotificationsAtActionGroupResourceLevel(ctx, sdkActionGroupId, parameters)
+	if err != nil {
+		return fmt.Errorf("sending Test Notifications for %s: %+v", *actionGroupId, err)
+	}
+
+	// the ID of the Test Notifications is only available from the Location header used to poll the operation
+	id, err := monitorActionGroupTestNotificationIdFromResponse(resp.HttpResponse)
+	if err != nil {
+		return fmt.Errorf("sending Test Notifications for %s: %+v", *actionGroupId, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for %s to complete", *id)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Pending"},
+		Target:     []string{monitorActionGroupTestNotificationStateComplete},
+		Refresh:    monitorActionGroupTestNotificationStateRefreshFunc(ctx, client, *id),
+		MinTimeout: 15 * time.Second,
+		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to complete: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorActionGroupTestNotificationRead(d, meta)
+}
+
+func resourceMonitorActionGroupTestNotificationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	actionGroupsClient := meta.(*clients.Client).Monitor.ActionGroupsClient
+	client := meta.(*clients.Client).Monitor.ActionGroupsAPIsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := actiongroupsapis.ParseNotificationStatusID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	actionGroupId := parse.NewActionGroupID(id.SubscriptionId, id.ResourceGroup, id.ActionGroupName)
+	actionGroup, err := actionGroupsClient.Get(ctx, actionGroupId.ResourceGroup, actionGroupId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(actionGroup.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", actionGroupId, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", actionGroupId, err)
+	}
+
+	resp, err := client.GetTestNotificationsAtActionGroupResourceLevel(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			// the results of Test Notifications are only retained for a limited time, after which the last known
+			// results are kept, rather than sending a new set of Test Notifications
+			log.Printf("[DEBUG] %s has expired - retaining the last known results", *id)
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("action_group_id", actionGroupId.ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("state", model.State)
+		d.Set("created_time", model.CreatedTime)
+		d.Set("completed_time", model.CompletedTime)
+
+		if err := d.Set("action_detail", flattenMonitorActionGroupTestNotificationActionDetails(model.ActionDetails)); err != nil {
+			return fmt.Errorf("setting `action_detail`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceMonitorActionGroupTestNotificationDelete(d *pluginsdk.ResourceData, _ interface{}) error {
+	// Test Notifications can't be deleted, they're removed by the service once they've expired
+	log.Printf("[DEBUG] Removing Test Notifications %q from state", d.Id())
+
+	return nil
+}
+
+func monitorActionGroupTestNotificationIdFromResponse(resp *http.Response) (*actiongroupsapis.NotificationStatusId, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("the response was nil")
+	}
+
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return nil, fmt.Errorf("the `Location` header was missing from the response")
+	}
+
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the `Location` header %q: %+v", location, err)
+	}
+
+	id, err := actiongroupsapis.ParseNotificationStatusIDInsensitively(u.Path)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the `Location` header %q: %+v", location, err)
+	}
+
+	return id, nil
+}
+
+func monitorActionGroupTestNotificationStateRefreshFunc(ctx context.Context, client *actiongroupsapis.ActionGroupsAPIsClient, id actiongroupsapis.NotificationStatusId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetTestNotificationsAtActionGroupResourceLevel(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.State != monitorActionGroupTestNotificationStateComplete {
+			return resp, "Pending", nil
+		}
+
+		return resp, monitorActionGroupTestNotificationStateComplete, nil
+	}
+}
+
+func flattenMonitorActionGroupTestNotificationActionDetails(input *[]actiongroupsapis.ActionDetail) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"mechanism_type": utils.NormalizeNilableString(item.MechanismType),
+			"name":           utils.NormalizeNilableString(item.Name),
+			"status":         utils.NormalizeNilableString(item.Status),
+			"sub_state":      utils.NormalizeNilableString(item.SubState),
+			"send_time":      utils.NormalizeNilableString(item.SendTime),
+			"detail":         utils.NormalizeNilableString(item.Detail),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,121 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/actiongroupsapis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorActionGroupTestNotificationResource struct {
+}
+
+func TestAccMonitorActionGroupTestNotification_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_action_group_test_notification", "test")
+	r := MonitorActionGroupTestNotificationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Complete"),
+				check.That(data.ResourceName).Key("action_detail.#").HasValue("1"),
+				check.That(data.ResourceName).Key("action_detail.0.mechanism_type").HasValue("Email"),
+				check.That(data.ResourceName).Key("action_detail.0.name").HasValue("sendtoadmin"),
+			),
+		},
+	})
+}
+
+func TestAccMonitorActionGroupTestNotification_triggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_action_group_test_notification", "test")
+	r := MonitorActionGroupTestNotificationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.triggers(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Complete"),
+			),
+		},
+		{
+			Config: r.triggers(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Complete"),
+			),
+		},
+	})
+}
+
+func (t MonitorActionGroupTestNotificationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := actiongroupsapis.ParseNotificationStatusID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Monitor.ActionGroupsAPIsClient.GetTestNotificationsAtActionGroupResourceLevel(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (MonitorActionGroupTestNotificationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+
+  email_receiver {
+    name          = "sendtoadmin"
+    email_address = "admin@contoso.com"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r MonitorActionGroupTestNotificationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_action_group_test_notification" "test" {
+  action_group_id = azurerm_monitor_action_group.test.id
+  alert_type      = "servicehealth"
+}
+`, r.template(data))
+}
+
+func (r MonitorActionGroupTestNotificationResource) triggers(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_action_group_test_notification" "test" {
+  action_group_id = azurerm_monitor_action_group.test.id
+  alert_type      = "metricstaticthreshold"
+
+  triggers = {
+    run = "%s"
+  }
+}
+`, r.template(data), trigger)
+}
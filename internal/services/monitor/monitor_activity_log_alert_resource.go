@@ -94,6 +94,22 @@ func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 								"Error",
 								"Critical",
 							}, false),
+							ConflictsWith: []string{"criteria.0.levels"},
+						},
+						"levels": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Verbose",
+									"Informational",
+									"Warning",
+									"Error",
+									"Critical",
+								}, false),
+							},
+							ConflictsWith: []string{"criteria.0.level"},
 						},
 						"resource_provider": {
 							Type:     pluginsdk.TypeString,
@@ -113,12 +129,32 @@ func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 							ValidateFunc: azure.ValidateResourceID,
 						},
 						"status": {
-							Type:     pluginsdk.TypeString,
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"criteria.0.statuses"},
+						},
+						"statuses": {
+							Type:     pluginsdk.TypeList,
 							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							ConflictsWith: []string{"criteria.0.status"},
 						},
 						"sub_status": {
-							Type:     pluginsdk.TypeString,
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"criteria.0.sub_statuses"},
+						},
+						"sub_statuses": {
+							Type:     pluginsdk.TypeList,
 							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							ConflictsWith: []string{"criteria.0.sub_status"},
 						},
 						"recommendation_category": {
 							Type:     pluginsdk.TypeString,
@@ -194,7 +230,7 @@ func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 									},
 								},
 							},
-							ConflictsWith: []string{"criteria.0.recommendation_category", "criteria.0.recommendation_impact", "criteria.0.status", "criteria.0.sub_status", "criteria.0.recommendation_impact", "criteria.0.resource_provider", "criteria.0.resource_type", "criteria.0.operation_name", "criteria.0.caller", "criteria.0.operation_name"},
+							ConflictsWith: []string{"criteria.0.recommendation_category", "criteria.0.recommendation_impact", "criteria.0.status", "criteria.0.statuses", "criteria.0.sub_status", "criteria.0.sub_statuses", "criteria.0.recommendation_impact", "criteria.0.resource_provider", "criteria.0.resource_type", "criteria.0.operation_name", "criteria.0.caller", "criteria.0.operation_name"},
 						},
 					},
 				},
@@ -384,6 +420,8 @@ func expandMonitorActivityLogAlertCriteria(input []interface{}) *insights.AlertR
 			Field:  utils.String("level"),
 			Equals: utils.String(level),
 		})
+	} else if levels := v["levels"].([]interface{}); len(levels) > 0 {
+		conditions = append(conditions, expandMonitorActivityLogAlertCriteriaAnyOf("level", levels))
 	}
 	if resourceProvider := v["resource_provider"].(string); resourceProvider != "" {
 		conditions = append(conditions, insights.AlertRuleAnyOfOrLeafCondition{
@@ -414,12 +452,16 @@ func expandMonitorActivityLogAlertCriteria(input []interface{}) *insights.AlertR
 			Field:  utils.String("status"),
 			Equals: utils.String(status),
 		})
+	} else if statuses := v["statuses"].([]interface{}); len(statuses) > 0 {
+		conditions = append(conditions, expandMonitorActivityLogAlertCriteriaAnyOf("status", statuses))
 	}
 	if subStatus := v["sub_status"].(string); subStatus != "" {
 		conditions = append(conditions, insights.AlertRuleAnyOfOrLeafCondition{
 			Field:  utils.String("subStatus"),
 			Equals: utils.String(subStatus),
 		})
+	} else if subStatuses := v["sub_statuses"].([]interface{}); len(subStatuses) > 0 {
+		conditions = append(conditions, expandMonitorActivityLogAlertCriteriaAnyOf("subStatus", subStatuses))
 	}
 	if recommendationType := v["recommendation_type"].(string); recommendationType != "" {
 		conditions = append(conditions, insights.AlertRuleAnyOfOrLeafCondition{
@@ -451,6 +493,21 @@ func expandMonitorActivityLogAlertCriteria(input []interface{}) *insights.AlertR
 	}
 }
 
+// expandMonitorActivityLogAlertCriteriaAnyOf returns a condition which matches when the field equals any of the values
+func expandMonitorActivityLogAlertCriteriaAnyOf(field string, values []interface{}) insights.AlertRuleAnyOfOrLeafCondition {
+	anyOf := make([]insights.AlertRuleLeafCondition, 0)
+	for _, value := range values {
+		anyOf = append(anyOf, insights.AlertRuleLeafCondition{
+			Field:  utils.String(field),
+			Equals: utils.String(value.(string)),
+		})
+	}
+
+	return insights.AlertRuleAnyOfOrLeafCondition{
+		AnyOf: &anyOf,
+	}
+}
+
 func expandServiceHealth(serviceHealth []interface{}, conditions []insights.AlertRuleAnyOfOrLeafCondition) []insights.AlertRuleAnyOfOrLeafCondition {
 	for _, serviceItem := range serviceHealth {
 		if serviceItem == nil {
@@ -544,6 +601,18 @@ func flattenMonitorActivityLogAlertCriteria(input *insights.AlertRuleAllOfCondit
 				result[*condition.Field] = *condition.Equals
 			}
 		}
+
+		if condition.Field == nil && condition.AnyOf != nil && len(*condition.AnyOf) > 0 {
+			field, values := flattenMonitorActivityLogAlertCriteriaAnyOf(*condition.AnyOf)
+			switch strings.ToLower(field) {
+			case "level":
+				result["levels"] = values
+			case "status":
+				result["statuses"] = values
+			case "substatus":
+				result["sub_statuses"] = values
+			}
+		}
 	}
 
 	if result["category"] == "ServiceHealth" {
@@ -564,20 +633,31 @@ func flattenMonitorActivityLogAlertServiceHealth(input *insights.AlertRuleAllOfC
 				shResult["services"] = *condition.ContainsAny
 			}
 		}
-		if condition.Field == nil && len(*condition.AnyOf) > 0 {
-			events := []string{}
-			for _, evCond := range *condition.AnyOf {
-				if evCond.Field != nil && evCond.Equals != nil {
-					events = append(events, *evCond.Equals)
-				}
+		if condition.Field == nil && condition.AnyOf != nil && len(*condition.AnyOf) > 0 {
+			if field, events := flattenMonitorActivityLogAlertCriteriaAnyOf(*condition.AnyOf); strings.EqualFold(field, "properties.incidentType") {
+				shResult["events"] = events
 			}
-			shResult["events"] = events
 		}
 	}
 
 	result["service_health"] = []interface{}{shResult}
 }
 
+// flattenMonitorActivityLogAlertCriteriaAnyOf returns the field which the conditions match on, along with the values
+func flattenMonitorActivityLogAlertCriteriaAnyOf(input []insights.AlertRuleLeafCondition) (field string, values []string) {
+	values = make([]string, 0)
+	for _, condition := range input {
+		if condition.Field == nil || condition.Equals == nil {
+			continue
+		}
+
+		field = *condition.Field
+		values = append(values, *condition.Equals)
+	}
+
+	return field, values
+}
+
 func flattenMonitorActivityLogAlertAction(input *insights.ActionList) (result []interface{}) {
 	result = make([]interface{}, 0)
 	if input == nil || input.ActionGroups == nil {
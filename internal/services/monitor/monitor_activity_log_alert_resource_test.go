@@ -159,6 +159,43 @@ func TestAccMonitorActivityLogAlert_basicAndCompleteUpdate(t *testing.T) {
 	})
 }
 
+func TestAccMonitorActivityLogAlert_multipleValues(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.singleValues(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("criteria.0.level").HasValue("Error"),
+				check.That(data.ResourceName).Key("criteria.0.status").HasValue("Failed"),
+				check.That(data.ResourceName).Key("criteria.0.sub_status").HasValue("BadRequest"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.multipleValues(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("criteria.0.level").HasValue(""),
+				check.That(data.ResourceName).Key("criteria.0.levels.#").HasValue("2"),
+				check.That(data.ResourceName).Key("criteria.0.statuses.#").HasValue("2"),
+				check.That(data.ResourceName).Key("criteria.0.sub_statuses.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.singleValues(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("criteria.0.levels.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMonitorActivityLogAlert_ServiceHealth_basicAndUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
 	r := MonitorActivityLogAlertResource{}
@@ -233,6 +270,58 @@ resource "azurerm_monitor_activity_log_alert" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (MonitorActivityLogAlertResource) singleValues(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+
+  criteria {
+    category   = "Administrative"
+    level      = "Error"
+    status     = "Failed"
+    sub_status = "BadRequest"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (MonitorActivityLogAlertResource) multipleValues(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+
+  criteria {
+    category     = "Administrative"
+    levels       = ["Error", "Critical"]
+    statuses     = ["Failed", "Succeeded"]
+    sub_statuses = ["BadRequest", "Conflict"]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (r MonitorActivityLogAlertResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
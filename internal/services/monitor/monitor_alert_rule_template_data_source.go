@@ -0,0 +1,141 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/alertrulerecommendations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceMonitorAlertRuleTemplate() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceMonitorAlertRuleTemplateRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+				ExactlyOneOf: []string{"resource_id", "target_type"},
+			},
+
+			"target_type": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"resource_id", "target_type"},
+			},
+
+			"templates": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"alert_rule_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"display_information": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"rule_arm_template_json": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMonitorAlertRuleTemplateRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.AlertRuleRecommendationsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	var model *alertrulerecommendations.AlertRuleRecommendationsListResponse
+	if resourceId := d.Get("resource_id").(string); resourceId != "" {
+		resp, err := client.ListByResource(ctx, resourceId)
+		if err != nil {
+			return fmt.Errorf("retrieving Alert Rule Templates for Resource %q: %+v", resourceId, err)
+		}
+		model = resp.Model
+	} else {
+		targetType := d.Get("target_type").(string)
+		id := alertrulerecommendations.NewSubscriptionID(subscriptionId)
+		resp, err := client.ListByTargetType(ctx, id, targetType)
+		if err != nil {
+			return fmt.Errorf("retrieving Alert Rule Templates for Target Type %q (%s): %+v", targetType, id, err)
+		}
+		model = resp.Model
+	}
+
+	templates, err := flattenMonitorAlertRuleTemplates(model)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("templates", templates); err != nil {
+		return fmt.Errorf("setting `templates`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenMonitorAlertRuleTemplates(input *alertrulerecommendations.AlertRuleRecommendationsListResponse) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, v := range input.Value {
+		name := ""
+		if v.Name != nil {
+			name = *v.Name
+		}
+
+		template, err := json.Marshal(v.Properties.RuleArmTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("serializing the ARM Template for Alert Rule Template %q: %+v", name, err)
+		}
+
+		displayInformation := make(map[string]interface{})
+		for key, value := range v.Properties.DisplayInformation {
+			displayInformation[key] = value
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":                   name,
+			"alert_rule_type":        v.Properties.AlertRuleType,
+			"display_information":    displayInformation,
+			"rule_arm_template_json": string(template),
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,78 @@
+package monitor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MonitorAlertRuleTemplateDataSource struct{}
+
+func TestAccDataSourceMonitorAlertRuleTemplate_targetType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_alert_rule_template", "test")
+	r := MonitorAlertRuleTemplateDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.targetType(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("templates.#").Exists(),
+				check.That(data.ResourceName).Key("templates.0.alert_rule_type").Exists(),
+				check.That(data.ResourceName).Key("templates.0.rule_arm_template_json").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceMonitorAlertRuleTemplate_resource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_alert_rule_template", "test")
+	r := MonitorAlertRuleTemplateDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.resource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("templates.#").Exists(),
+			),
+		},
+	})
+}
+
+func (MonitorAlertRuleTemplateDataSource) targetType() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_monitor_alert_rule_template" "test" {
+  target_type = "microsoft.compute/virtualmachines"
+}
+`
+}
+
+func (MonitorAlertRuleTemplateDataSource) resource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+data "azurerm_monitor_alert_rule_template" "test" {
+  resource_id = azurerm_storage_account.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
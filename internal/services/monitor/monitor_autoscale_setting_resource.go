@@ -259,10 +259,11 @@ func resourceMonitorAutoScaleSetting() *pluginsdk.Resource {
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"timezone": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										Default:      "UTC",
-										ValidateFunc: validateAutoScaleSettingsTimeZone(),
+										Type:             pluginsdk.TypeString,
+										Optional:         true,
+										Default:          "UTC",
+										ValidateFunc:     validateAutoScaleSettingsTimeZone(),
+										DiffSuppressFunc: suppress.AzureTimeZone,
 									},
 									"start": {
 										Type:         pluginsdk.TypeString,
@@ -284,10 +285,11 @@ func resourceMonitorAutoScaleSetting() *pluginsdk.Resource {
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"timezone": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										Default:      "UTC",
-										ValidateFunc: validateAutoScaleSettingsTimeZone(),
+										Type:             pluginsdk.TypeString,
+										Optional:         true,
+										Default:          "UTC",
+										ValidateFunc:     validateAutoScaleSettingsTimeZone(),
+										DiffSuppressFunc: suppress.AzureTimeZone,
 									},
 									"days": {
 										Type:     pluginsdk.TypeList,
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -33,6 +34,8 @@ func resourceMonitorMetricAlert() *pluginsdk.Resource {
 		// TODO: replace this with an importer which validates the ID during import
 		Importer: pluginsdk.DefaultImporter(),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(monitorMetricAlertCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -62,10 +65,11 @@ func resourceMonitorMetricAlert() *pluginsdk.Resource {
 			},
 
 			"target_resource_type": {
-				Type:        pluginsdk.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: `The resource type (e.g. Microsoft.Compute/virtualMachines) of the target pluginsdk. Required when using subscription, resource group scope or multiple scopes.`,
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				Description:      `The resource type (e.g. Microsoft.Compute/virtualMachines) of the target pluginsdk. Required when using subscription, resource group scope or multiple scopes.`,
 			},
 
 			"target_resource_location": {
@@ -374,6 +378,50 @@ func resourceMonitorMetricAlert() *pluginsdk.Resource {
 	}
 }
 
+// monitorMetricAlertCustomizeDiff validates the combinations of scopes and criteria which the API would otherwise reject
+// during the apply - such as scoping an alert to a whole Resource Group without specifying which resources it targets
+func monitorMetricAlertCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// the Web Test criteria is always scoped to both the Web Test and the Application Insights component
+	if d.NewValueKnown("scopes") && len(d.Get("application_insights_web_test_location_availability_criteria").([]interface{})) == 0 {
+		scopes := d.Get("scopes").(*pluginsdk.Set).List()
+		multiResource := len(scopes) > 1
+		for _, raw := range scopes {
+			scope, ok := raw.(string)
+			if !ok || scope == "" {
+				continue
+			}
+
+			// a Subscription or Resource Group scope covers every resource of the target type within it
+			if id, err := azure.ParseAzureResourceID(scope); err == nil && id.Provider == "" {
+				multiResource = true
+			}
+		}
+
+		if multiResource {
+			for _, field := range []string{"target_resource_type", "target_resource_location"} {
+				if d.NewValueKnown(field) && d.Get(field).(string) == "" {
+					return fmt.Errorf("`%s` must be specified when `scopes` contains a Subscription, a Resource Group or more than one Resource", field)
+				}
+			}
+		}
+	}
+
+	for _, raw := range d.Get("dynamic_criteria").(*pluginsdk.Set).List() {
+		criteria, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		totalCount := criteria["evaluation_total_count"].(int)
+		failureCount := criteria["evaluation_failure_count"].(int)
+		if totalCount > 0 && failureCount > totalCount {
+			return fmt.Errorf("`evaluation_failure_count` (%d) must be less than or equal to `evaluation_total_count` (%d) within the `dynamic_criteria` block", failureCount, totalCount)
+		}
+	}
+
+	return nil
+}
+
 func resourceMonitorMetricAlertCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Monitor.MetricAlertsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -119,6 +119,21 @@ func TestAccMonitorMetricAlert_multiScope(t *testing.T) {
 	})
 }
 
+func TestAccMonitorMetricAlert_resourceGroupScopeDynamicCriteria(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_metric_alert", "test")
+	r := MonitorMetricAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceGroupScopeDynamicCriteria(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMonitorMetricAlert_applicationInsightsWebTest(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_metric_alert", "test")
 	r := MonitorMetricAlertResource{}
@@ -371,6 +386,44 @@ resource "azurerm_monitor_metric_alert" "test" {
 `, r.multiVMTemplate(data, count), data.RandomInteger, data.Locations.Primary)
 }
 
+func (r MonitorMetricAlertResource) resourceGroupScopeDynamicCriteria(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_metric_alert" "test" {
+  name                = "acctestMetricAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+  dynamic_criteria {
+    metric_namespace = "Microsoft.Compute/virtualMachines"
+    metric_name      = "Percentage CPU"
+    aggregation      = "Average"
+
+    operator                 = "GreaterThan"
+    alert_sensitivity        = "Low"
+    evaluation_total_count   = 6
+    evaluation_failure_count = 3
+    ignore_data_before       = "2021-01-01T00:00:00Z"
+  }
+  action {
+    action_group_id = azurerm_monitor_action_group.test.id
+  }
+  window_size              = "PT15M"
+  frequency                = "PT5M"
+  target_resource_type     = "Microsoft.Compute/virtualMachines"
+  target_resource_location = "%s"
+
+  depends_on = [azurerm_linux_virtual_machine.test]
+}
+`, r.multiVMTemplate(data, 2), data.RandomInteger, data.RandomInteger, data.Locations.Primary)
+}
+
 func (MonitorMetricAlertResource) applicationInsightsWebTestTemplate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,201 @@
+package monitor
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceMonitorMetricDefinitions() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceMonitorMetricDefinitionsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"metric_namespace": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"metric_definitions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"namespace": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"unit": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"primary_aggregation_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"supported_aggregation_types": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"dimensions": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"dimension_required": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"time_grains": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMonitorMetricDefinitionsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.MetricDefinitionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	actualResourceId := d.Get("resource_id").(string)
+	// trim off the leading `/` since the List method doesn't expect it
+	resourceId := strings.TrimPrefix(actualResourceId, "/")
+	metricNamespace := d.Get("metric_namespace").(string)
+
+	resp, err := client.List(ctx, resourceId, metricNamespace)
+	if err != nil {
+		return fmt.Errorf("retrieving Metric Definitions for Resource %q: %+v", actualResourceId, err)
+	}
+
+	d.SetId(actualResourceId)
+
+	if err := d.Set("metric_definitions", flattenMonitorMetricDefinitions(resp.Value)); err != nil {
+		return fmt.Errorf("setting `metric_definitions`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenMonitorMetricDefinitions(input *[]insights.MetricDefinition) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	definitions := make([]insights.MetricDefinition, 0)
+	for _, v := range *input {
+		if v.Name == nil || v.Name.Value == nil {
+			continue
+		}
+		definitions = append(definitions, v)
+	}
+
+	// the API doesn't return these in a consistent order
+	sort.Slice(definitions, func(i, j int) bool {
+		return *definitions[i].Name.Value < *definitions[j].Name.Value
+	})
+
+	output := make([]interface{}, 0)
+	for _, v := range definitions {
+		displayName := ""
+		if v.Name.LocalizedValue != nil {
+			displayName = *v.Name.LocalizedValue
+		}
+
+		namespace := ""
+		if v.Namespace != nil {
+			namespace = *v.Namespace
+		}
+
+		aggregationTypes := make([]interface{}, 0)
+		if v.SupportedAggregationTypes != nil {
+			for _, aggregationType := range *v.SupportedAggregationTypes {
+				aggregationTypes = append(aggregationTypes, string(aggregationType))
+			}
+		}
+
+		dimensions := make([]interface{}, 0)
+		if v.Dimensions != nil {
+			for _, dimension := range *v.Dimensions {
+				if dimension.Value != nil {
+					dimensions = append(dimensions, *dimension.Value)
+				}
+			}
+		}
+
+		dimensionRequired := false
+		if v.IsDimensionRequired != nil {
+			dimensionRequired = *v.IsDimensionRequired
+		}
+
+		timeGrains := make([]interface{}, 0)
+		if v.MetricAvailabilities != nil {
+			for _, availability := range *v.MetricAvailabilities {
+				if availability.TimeGrain != nil {
+					timeGrains = append(timeGrains, *availability.TimeGrain)
+				}
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":                        *v.Name.Value,
+			"display_name":                displayName,
+			"namespace":                   namespace,
+			"unit":                        string(v.Unit),
+			"primary_aggregation_type":    string(v.PrimaryAggregationType),
+			"supported_aggregation_types": aggregationTypes,
+			"dimensions":                  dimensions,
+			"dimension_required":          dimensionRequired,
+			"time_grains":                 timeGrains,
+		})
+	}
+
+	return output
+}
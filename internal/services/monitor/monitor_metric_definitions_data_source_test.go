@@ -0,0 +1,73 @@
+package monitor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MonitorMetricDefinitionsDataSource struct {
+}
+
+func TestAccDataSourceMonitorMetricDefinitions_storageAccount(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_metric_definitions", "test")
+	r := MonitorMetricDefinitionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.storageAccount(data, "", ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("metric_definitions.#").Exists(),
+				check.That(data.ResourceName).Key("metric_definitions.0.supported_aggregation_types.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceMonitorMetricDefinitions_metricNamespace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_metric_definitions", "test")
+	r := MonitorMetricDefinitionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.storageAccount(data, "/blobServices/default", "Microsoft.Storage/storageAccounts/blobServices"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("metric_definitions.#").Exists(),
+				check.That(data.ResourceName).Key("metric_definitions.0.namespace").HasValue("Microsoft.Storage/storageAccounts/blobServices"),
+			),
+		},
+	})
+}
+
+func (MonitorMetricDefinitionsDataSource) storageAccount(data acceptance.TestData, resourceIdSuffix, metricNamespace string) string {
+	namespace := ""
+	if metricNamespace != "" {
+		namespace = fmt.Sprintf("metric_namespace = %q", metricNamespace)
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+data "azurerm_monitor_metric_definitions" "test" {
+  resource_id = "${azurerm_storage_account.test.id}%s"
+  %s
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, resourceIdSuffix, namespace)
+}
@@ -0,0 +1,97 @@
+package monitor
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceMonitorMetricNamespaces() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceMonitorMetricNamespacesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"namespaces": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"fully_qualified_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMonitorMetricNamespacesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.MetricNamespacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	actualResourceId := d.Get("resource_id").(string)
+	// trim off the leading `/` since the List method doesn't expect it
+	resourceId := strings.TrimPrefix(actualResourceId, "/")
+
+	resp, err := client.List(ctx, resourceId, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Metric Namespaces for Resource %q: %+v", actualResourceId, err)
+	}
+
+	namespaces := make([]interface{}, 0)
+	if resp.Value != nil {
+		for _, v := range *resp.Value {
+			name := ""
+			if v.Name != nil {
+				name = *v.Name
+			}
+
+			fullyQualifiedName := ""
+			if v.Properties != nil && v.Properties.MetricNamespaceName != nil {
+				fullyQualifiedName = *v.Properties.MetricNamespaceName
+			}
+
+			namespaces = append(namespaces, map[string]interface{}{
+				"name":                 name,
+				"fully_qualified_name": fullyQualifiedName,
+			})
+		}
+	}
+
+	// the API doesn't return these in a consistent order
+	sort.Slice(namespaces, func(i, j int) bool {
+		return namespaces[i].(map[string]interface{})["fully_qualified_name"].(string) < namespaces[j].(map[string]interface{})["fully_qualified_name"].(string)
+	})
+
+	d.SetId(actualResourceId)
+
+	if err := d.Set("namespaces", namespaces); err != nil {
+		return fmt.Errorf("setting `namespaces`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package monitor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MonitorMetricNamespacesDataSource struct {
+}
+
+func TestAccDataSourceMonitorMetricNamespaces_storageAccount(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_metric_namespaces", "test")
+	r := MonitorMetricNamespacesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.storageAccount(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("namespaces.#").Exists(),
+				check.That(data.ResourceName).Key("namespaces.0.fully_qualified_name").HasValue("Microsoft.Storage/storageAccounts"),
+			),
+		},
+	})
+}
+
+func (MonitorMetricNamespacesDataSource) storageAccount(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+data "azurerm_monitor_metric_namespaces" "test" {
+  resource_id = azurerm_storage_account.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -76,8 +76,9 @@ func resourceMonitorScheduledQueryRulesAlert() *pluginsdk.Resource {
 							Optional: true,
 							// TODO remove `Computed: true` in 3.0. This is a breaking change where the Default used to be "{}"
 							// We'll keep Computed: true for users who expect the same functionality but will remove it in 3.0
-							Computed:     true,
-							ValidateFunc: validation.StringIsJSON,
+							Computed:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 						},
 						"email_subject": {
 							Type:         pluginsdk.TypeString,
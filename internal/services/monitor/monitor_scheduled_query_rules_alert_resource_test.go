@@ -71,6 +71,35 @@ func TestAccMonitorScheduledQueryRules_AlertingActionComplete(t *testing.T) {
 	})
 }
 
+func TestAccMonitorScheduledQueryRules_AlertingActionCustomWebhookPayload(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_scheduled_query_rules_alert", "test")
+	r := MonitorScheduledQueryRulesResource{}
+	ts := time.Now().Format(time.RFC3339)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.AlertingActionCustomWebhookPayloadConfig(data, ts, `<<PAYLOAD
+{
+  "alertname": "#alertrulename",
+  "details": {
+    "severity": "#severity",
+    "results": "#searchresultcount"
+  }
+}
+PAYLOAD`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// reformatting the payload (whitespace and key order) shouldn't cause a diff
+			Config:   r.AlertingActionCustomWebhookPayloadConfig(data, ts, `jsonencode({ details = { results = "#searchresultcount", severity = "#severity" }, alertname = "#alertrulename" })`),
+			PlanOnly: true,
+		},
+	})
+}
+
 func TestAccMonitorScheduledQueryRules_AlertingActionCrossResource(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_scheduled_query_rules_alert", "test")
 	r := MonitorScheduledQueryRulesResource{}
@@ -183,6 +212,52 @@ QUERY
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, ts, ts)
 }
 
+func (MonitorScheduledQueryRulesResource) AlertingActionCustomWebhookPayloadConfig(data acceptance.TestData, ts string, payload string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-monitor-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestAppInsights-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  application_type    = "web"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_scheduled_query_rules_alert" "test" {
+  name                = "acctestsqr-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  description         = "test alerting action"
+  enabled             = true
+
+  data_source_id = azurerm_application_insights.test.id
+  query          = "let d=datatable(TimeGenerated: datetime, usage_percent: double) [  '%s', 25.4, '%s', 75.4 ]; d | summarize AggregatedValue=avg(usage_percent) by bin(TimeGenerated, 1h)"
+
+  frequency   = 60
+  time_window = 60
+
+  action {
+    action_group           = [azurerm_monitor_action_group.test.id]
+    custom_webhook_payload = %s
+  }
+
+  trigger {
+    operator  = "GreaterThan"
+    threshold = 5000
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, ts, ts, payload)
+}
+
 func (MonitorScheduledQueryRulesResource) AlertingActionConfigComplete(data acceptance.TestData) string {
 	ts := time.Now().Format(time.RFC3339)
 
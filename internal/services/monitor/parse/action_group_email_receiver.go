@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type ActionGroupEmailReceiverId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	ActionGroupName   string
+	EmailReceiverName string
+}
+
+func NewActionGroupEmailReceiverID(subscriptionId, resourceGroup, actionGroupName, emailReceiverName string) ActionGroupEmailReceiverId {
+	return ActionGroupEmailReceiverId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		ActionGroupName:   actionGroupName,
+		EmailReceiverName: emailReceiverName,
+	}
+}
+
+func (id ActionGroupEmailReceiverId) String() string {
+	segments := []string{
+		fmt.Sprintf("Email Receiver Name %q", id.EmailReceiverName),
+		fmt.Sprintf("Action Group Name %q", id.ActionGroupName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Action Group Email Receiver", segmentsStr)
+}
+
+func (id ActionGroupEmailReceiverId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/microsoft.insights/actionGroups/%s/emailReceivers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ActionGroupName, id.EmailReceiverName)
+}
+
+// ActionGroupEmailReceiverID parses a ActionGroupEmailReceiver ID into an ActionGroupEmailReceiverId struct
+func ActionGroupEmailReceiverID(input string) (*ActionGroupEmailReceiverId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ActionGroupEmailReceiverId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ActionGroupName, err = id.PopSegment("actionGroups"); err != nil {
+		return nil, err
+	}
+	if resourceId.EmailReceiverName, err = id.PopSegment("emailReceivers"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -22,8 +22,11 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_monitor_action_group":                dataSourceMonitorActionGroup(),
+		"azurerm_monitor_alert_rule_template":         dataSourceMonitorAlertRuleTemplate(),
 		"azurerm_monitor_diagnostic_categories":       dataSourceMonitorDiagnosticCategories(),
 		"azurerm_monitor_log_profile":                 dataSourceMonitorLogProfile(),
+		"azurerm_monitor_metric_definitions":          dataSourceMonitorMetricDefinitions(),
+		"azurerm_monitor_metric_namespaces":           dataSourceMonitorMetricNamespaces(),
 		"azurerm_monitor_scheduled_query_rules_alert": dataSourceMonitorScheduledQueryRulesAlert(),
 		"azurerm_monitor_scheduled_query_rules_log":   dataSourceMonitorScheduledQueryRulesLog(),
 	}
@@ -32,17 +35,19 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_monitor_aad_diagnostic_setting":      resourceMonitorAADDiagnosticSetting(),
-		"azurerm_monitor_autoscale_setting":           resourceMonitorAutoScaleSetting(),
-		"azurerm_monitor_action_group":                resourceMonitorActionGroup(),
-		"azurerm_monitor_action_rule_action_group":    resourceMonitorActionRuleActionGroup(),
-		"azurerm_monitor_action_rule_suppression":     resourceMonitorActionRuleSuppression(),
-		"azurerm_monitor_activity_log_alert":          resourceMonitorActivityLogAlert(),
-		"azurerm_monitor_diagnostic_setting":          resourceMonitorDiagnosticSetting(),
-		"azurerm_monitor_log_profile":                 resourceMonitorLogProfile(),
-		"azurerm_monitor_metric_alert":                resourceMonitorMetricAlert(),
-		"azurerm_monitor_scheduled_query_rules_alert": resourceMonitorScheduledQueryRulesAlert(),
-		"azurerm_monitor_scheduled_query_rules_log":   resourceMonitorScheduledQueryRulesLog(),
-		"azurerm_monitor_smart_detector_alert_rule":   resourceMonitorSmartDetectorAlertRule(),
+		"azurerm_monitor_aad_diagnostic_setting":         resourceMonitorAADDiagnosticSetting(),
+		"azurerm_monitor_autoscale_setting":              resourceMonitorAutoScaleSetting(),
+		"azurerm_monitor_action_group":                   resourceMonitorActionGroup(),
+		"azurerm_monitor_action_group_email_receiver":    resourceMonitorActionGroupEmailReceiver(),
+		"azurerm_monitor_action_group_test_notification": resourceMonitorActionGroupTestNotification(),
+		"azurerm_monitor_action_rule_action_group":       resourceMonitorActionRuleActionGroup(),
+		"azurerm_monitor_action_rule_suppression":        resourceMonitorActionRuleSuppression(),
+		"azurerm_monitor_activity_log_alert":             resourceMonitorActivityLogAlert(),
+		"azurerm_monitor_diagnostic_setting":             resourceMonitorDiagnosticSetting(),
+		"azurerm_monitor_log_profile":                    resourceMonitorLogProfile(),
+		"azurerm_monitor_metric_alert":                   resourceMonitorMetricAlert(),
+		"azurerm_monitor_scheduled_query_rules_alert":    resourceMonitorScheduledQueryRulesAlert(),
+		"azurerm_monitor_scheduled_query_rules_log":      resourceMonitorScheduledQueryRulesLog(),
+		"azurerm_monitor_smart_detector_alert_rule":      resourceMonitorSmartDetectorAlertRule(),
 	}
 }
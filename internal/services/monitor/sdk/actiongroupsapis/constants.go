@@ -0,0 +1,9 @@
+package actiongroupsapis
+
+type ReceiverStatus string
+
+const (
+	ReceiverStatusDisabled     ReceiverStatus = "Disabled"
+	ReceiverStatusEnabled      ReceiverStatus = "Enabled"
+	ReceiverStatusNotSpecified ReceiverStatus = "NotSpecified"
+)
@@ -0,0 +1,108 @@
+package actiongroupsapis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ActionGroupId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	ActionGroupName string
+}
+
+func NewActionGroupID(subscriptionId, resourceGroup, actionGroupName string) ActionGroupId {
+	return ActionGroupId{
+		SubscriptionId:  subscriptionId,
+		ResourceGroup:   resourceGroup,
+		ActionGroupName: actionGroupName,
+	}
+}
+
+func (id ActionGroupId) String() string {
+	segments := []string{
+		fmt.Sprintf("Action Group Name %q", id.ActionGroupName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Action Group", segmentsStr)
+}
+
+func (id ActionGroupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/actionGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ActionGroupName)
+}
+
+// ParseActionGroupID parses an ActionGroup ID into an ActionGroupId struct
+func ParseActionGroupID(input string) (*ActionGroupId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ActionGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ActionGroupName, err = id.PopSegment("actionGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseActionGroupIDInsensitively parses an ActionGroup ID into an ActionGroupId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseActionGroupID method should be used instead for validation etc.
+func ParseActionGroupIDInsensitively(input string) (*ActionGroupId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ActionGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'ActionGroup' segment
+	ActionGroupKey := "actionGroups"
+	for key := range id.Path {
+		if strings.EqualFold(key, ActionGroupKey) {
+			ActionGroupKey = key
+			break
+		}
+	}
+	if resourceId.ActionGroupName, err = id.PopSegment(ActionGroupKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,126 @@
+package actiongroupsapis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NotificationStatusId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	ActionGroupName string
+	Name            string
+}
+
+func NewNotificationStatusID(subscriptionId, resourceGroup, actionGroupName, name string) NotificationStatusId {
+	return NotificationStatusId{
+		SubscriptionId:  subscriptionId,
+		ResourceGroup:   resourceGroup,
+		ActionGroupName: actionGroupName,
+		Name:            name,
+	}
+}
+
+func (id NotificationStatusId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Action Group Name %q", id.ActionGroupName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Notification Status", segmentsStr)
+}
+
+func (id NotificationStatusId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/actionGroups/%s/notificationStatus/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ActionGroupName, id.Name)
+}
+
+// ParseNotificationStatusID parses a NotificationStatus ID into an NotificationStatusId struct
+func ParseNotificationStatusID(input string) (*NotificationStatusId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NotificationStatusId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ActionGroupName, err = id.PopSegment("actionGroups"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("notificationStatus"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseNotificationStatusIDInsensitively parses an NotificationStatus ID into an NotificationStatusId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseNotificationStatusID method should be used instead for validation etc.
+func ParseNotificationStatusIDInsensitively(input string) (*NotificationStatusId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NotificationStatusId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'ActionGroup' segment
+	ActionGroupKey := "actionGroups"
+	for key := range id.Path {
+		if strings.EqualFold(key, ActionGroupKey) {
+			ActionGroupKey = key
+			break
+		}
+	}
+	if resourceId.ActionGroupName, err = id.PopSegment(ActionGroupKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'notificationStatus' segment
+	notificationStatusKey := "notificationStatus"
+	for key := range id.Path {
+		if strings.EqualFold(key, notificationStatusKey) {
+			notificationStatusKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(notificationStatusKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,76 @@
+package actiongroupsapis
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type CreateNotificationsAtActionGroupResourceLevelResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateNotificationsAtActionGroupResourceLevel ...
+func (c ActionGroupsAPIsClient) CreateNotificationsAtActionGroupResourceLevel(ctx context.Context, id ActionGroupId, input NotificationRequestBody) (result CreateNotificationsAtActionGroupResourceLevelResponse, err error) {
+	req, err := c.preparerForCreateNotificationsAtActionGroupResourceLevel(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "actiongroupsapis.ActionGroupsAPIsClient", "CreateNotificationsAtActionGroupResourceLevel", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForCreateNotificationsAtActionGroupResourceLevel(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "actiongroupsapis.ActionGroupsAPIsClient", "CreateNotificationsAtActionGroupResourceLevel", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// CreateNotificationsAtActionGroupResourceLevelThenPoll performs CreateNotificationsAtActionGroupResourceLevel then polls until it's completed
+func (c ActionGroupsAPIsClient) CreateNotificationsAtActionGroupResourceLevelThenPoll(ctx context.Context, id ActionGroupId, input NotificationRequestBody) error {
+	result, err := c.CreateNotificationsAtActionGroupResourceLevel(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateNotificationsAtActionGroupResourceLevel: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateNotificationsAtActionGroupResourceLevel: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForCreateNotificationsAtActionGroupResourceLevel prepares the CreateNotificationsAtActionGroupResourceLevel request.
+func (c ActionGroupsAPIsClient) preparerForCreateNotificationsAtActionGroupResourceLevel(ctx context.Context, id ActionGroupId, input NotificationRequestBody) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/createNotifications", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForCreateNotificationsAtActionGroupResourceLevel sends the CreateNotificationsAtActionGroupResourceLevel request. The method will close the
+// http.Response Body if it receives an error.
+func (c ActionGroupsAPIsClient) senderForCreateNotificationsAtActionGroupResourceLevel(ctx context.Context, req *http.Request) (future CreateNotificationsAtActionGroupResourceLevelResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.HttpResponse = resp
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
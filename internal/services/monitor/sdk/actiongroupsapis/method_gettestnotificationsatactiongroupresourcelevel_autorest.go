@@ -0,0 +1,64 @@
+package actiongroupsapis
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetTestNotificationsAtActionGroupResourceLevelResponse struct {
+	HttpResponse *http.Response
+	Model        *TestNotificationDetailsResponse
+}
+
+// GetTestNotificationsAtActionGroupResourceLevel ...
+func (c ActionGroupsAPIsClient) GetTestNotificationsAtActionGroupResourceLevel(ctx context.Context, id NotificationStatusId) (result GetTestNotificationsAtActionGroupResourceLevelResponse, err error) {
+	req, err := c.preparerForGetTestNotificationsAtActionGroupResourceLevel(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "actiongroupsapis.ActionGroupsAPIsClient", "GetTestNotificationsAtActionGroupResourceLevel", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "actiongroupsapis.ActionGroupsAPIsClient", "GetTestNotificationsAtActionGroupResourceLevel", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetTestNotificationsAtActionGroupResourceLevel(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "actiongroupsapis.ActionGroupsAPIsClient", "GetTestNotificationsAtActionGroupResourceLevel", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetTestNotificationsAtActionGroupResourceLevel prepares the GetTestNotificationsAtActionGroupResourceLevel request.
+func (c ActionGroupsAPIsClient) preparerForGetTestNotificationsAtActionGroupResourceLevel(ctx context.Context, id NotificationStatusId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetTestNotificationsAtActionGroupResourceLevel handles the response to the GetTestNotificationsAtActionGroupResourceLevel request. The method always
+// closes the http.Response Body.
+func (c ActionGroupsAPIsClient) responderForGetTestNotificationsAtActionGroupResourceLevel(resp *http.Response) (result GetTestNotificationsAtActionGroupResourceLevelResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
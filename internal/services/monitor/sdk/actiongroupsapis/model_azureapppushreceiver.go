@@ -0,0 +1,6 @@
+package actiongroupsapis
+
+type AzureAppPushReceiver struct {
+	EmailAddress string `json:"emailAddress"`
+	Name         string `json:"name"`
+}
@@ -0,0 +1,9 @@
+package actiongroupsapis
+
+type TestNotificationDetailsResponse struct {
+	ActionDetails *[]ActionDetail `json:"actionDetails,omitempty"`
+	CompletedTime *string         `json:"completedTime,omitempty"`
+	Context       *Context        `json:"context,omitempty"`
+	CreatedTime   *string         `json:"createdTime,omitempty"`
+	State         string          `json:"state"`
+}
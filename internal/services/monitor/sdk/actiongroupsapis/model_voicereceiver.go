@@ -0,0 +1,7 @@
+package actiongroupsapis
+
+type VoiceReceiver struct {
+	CountryCode string `json:"countryCode"`
+	Name        string `json:"name"`
+	PhoneNumber string `json:"phoneNumber"`
+}
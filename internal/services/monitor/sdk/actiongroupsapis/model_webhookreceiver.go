@@ -0,0 +1,11 @@
+package actiongroupsapis
+
+type WebhookReceiver struct {
+	IdentifierUri        *string `json:"identifierUri,omitempty"`
+	Name                 string  `json:"name"`
+	ObjectId             *string `json:"objectId,omitempty"`
+	ServiceUri           string  `json:"serviceUri"`
+	TenantId             *string `json:"tenantId,omitempty"`
+	UseAadAuth           *bool   `json:"useAadAuth,omitempty"`
+	UseCommonAlertSchema *bool   `json:"useCommonAlertSchema,omitempty"`
+}
@@ -0,0 +1,15 @@
+package alertrulerecommendations
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AlertRuleRecommendationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAlertRuleRecommendationsClientWithBaseURI(endpoint string) AlertRuleRecommendationsClient {
+	return AlertRuleRecommendationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
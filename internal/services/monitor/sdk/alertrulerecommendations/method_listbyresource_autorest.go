@@ -0,0 +1,65 @@
+package alertrulerecommendations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListByResourceResponse struct {
+	HttpResponse *http.Response
+	Model        *AlertRuleRecommendationsListResponse
+}
+
+// ListByResource ...
+func (c AlertRuleRecommendationsClient) ListByResource(ctx context.Context, resourceUri string) (result ListByResourceResponse, err error) {
+	req, err := c.preparerForListByResource(ctx, resourceUri)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "alertrulerecommendations.AlertRuleRecommendationsClient", "ListByResource", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "alertrulerecommendations.AlertRuleRecommendationsClient", "ListByResource", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForListByResource(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "alertrulerecommendations.AlertRuleRecommendationsClient", "ListByResource", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForListByResource prepares the ListByResource request.
+func (c AlertRuleRecommendationsClient) preparerForListByResource(ctx context.Context, resourceUri string) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/providers/Microsoft.Insights/alertRuleRecommendations", resourceUri)),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListByResource handles the response to the ListByResource request. The method always
+// closes the http.Response Body.
+func (c AlertRuleRecommendationsClient) responderForListByResource(resp *http.Response) (result ListByResourceResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
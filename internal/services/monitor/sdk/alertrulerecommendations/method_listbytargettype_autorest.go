@@ -0,0 +1,66 @@
+package alertrulerecommendations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListByTargetTypeResponse struct {
+	HttpResponse *http.Response
+	Model        *AlertRuleRecommendationsListResponse
+}
+
+// ListByTargetType ...
+func (c AlertRuleRecommendationsClient) ListByTargetType(ctx context.Context, id SubscriptionId, targetType string) (result ListByTargetTypeResponse, err error) {
+	req, err := c.preparerForListByTargetType(ctx, id, targetType)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "alertrulerecommendations.AlertRuleRecommendationsClient", "ListByTargetType", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "alertrulerecommendations.AlertRuleRecommendationsClient", "ListByTargetType", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForListByTargetType(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "alertrulerecommendations.AlertRuleRecommendationsClient", "ListByTargetType", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForListByTargetType prepares the ListByTargetType request.
+func (c AlertRuleRecommendationsClient) preparerForListByTargetType(ctx context.Context, id SubscriptionId, targetType string) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"$filter":     autorest.Encode("query", fmt.Sprintf("targetType eq '%s'", targetType)),
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/providers/Microsoft.Insights/alertRuleRecommendations", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListByTargetType handles the response to the ListByTargetType request. The method always
+// closes the http.Response Body.
+func (c AlertRuleRecommendationsClient) responderForListByTargetType(resp *http.Response) (result ListByTargetTypeResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,7 @@
+package alertrulerecommendations
+
+type AlertRuleRecommendationProperties struct {
+	AlertRuleType      string            `json:"alertRuleType"`
+	DisplayInformation map[string]string `json:"displayInformation"`
+	RuleArmTemplate    RuleArmTemplate   `json:"ruleArmTemplate"`
+}
@@ -0,0 +1,8 @@
+package alertrulerecommendations
+
+type AlertRuleRecommendationResource struct {
+	Id         *string                           `json:"id,omitempty"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties AlertRuleRecommendationProperties `json:"properties"`
+	Type       *string                           `json:"type,omitempty"`
+}
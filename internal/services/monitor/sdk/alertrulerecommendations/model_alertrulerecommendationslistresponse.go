@@ -0,0 +1,6 @@
+package alertrulerecommendations
+
+type AlertRuleRecommendationsListResponse struct {
+	NextLink *string                           `json:"nextLink,omitempty"`
+	Value    []AlertRuleRecommendationResource `json:"value"`
+}
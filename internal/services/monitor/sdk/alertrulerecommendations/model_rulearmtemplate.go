@@ -0,0 +1,9 @@
+package alertrulerecommendations
+
+type RuleArmTemplate struct {
+	ContentVersion string        `json:"contentVersion"`
+	Parameters     interface{}   `json:"parameters"`
+	Resources      []interface{} `json:"resources"`
+	Schema         string        `json:"$schema"`
+	Variables      interface{}   `json:"variables"`
+}
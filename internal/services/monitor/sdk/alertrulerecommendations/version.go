@@ -0,0 +1,9 @@
+package alertrulerecommendations
+
+import "fmt"
+
+const defaultApiVersion = "2023-01-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/alertrulerecommendations/%s", defaultApiVersion)
+}
@@ -0,0 +1,23 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+)
+
+// ActionGroupLocation validates that the location of an Action Group is either `global` or an Azure Region,
+// since Action Groups can be regional (to meet data residency requirements)
+func ActionGroupLocation(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, append(errors, fmt.Errorf("expected type of %s to be string", k))
+	}
+
+	if strings.EqualFold(v, "global") {
+		return nil, nil
+	}
+
+	return location.EnhancedValidate(v, k)
+}
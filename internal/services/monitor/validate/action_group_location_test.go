@@ -0,0 +1,44 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestActionGroupLocation(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "global",
+			expected: true,
+		},
+		{
+			input:    "Global",
+			expected: true,
+		},
+		{
+			input:    "West Europe",
+			expected: true,
+		},
+		{
+			input:    "westeurope",
+			expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ActionGroupLocation(v.input, "location")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
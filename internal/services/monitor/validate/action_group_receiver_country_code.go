@@ -0,0 +1,94 @@
+package validate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// actionGroupReceiverCountryCodes are the country calling codes of the countries which support SMS and Voice
+// notifications from an Action Group: https://docs.microsoft.com/azure/azure-monitor/alerts/alerts-sms-behavior
+var actionGroupReceiverCountryCodes = map[string]struct{}{
+	"1":   {}, // United States, Canada & Puerto Rico
+	"7":   {}, // Russia
+	"27":  {}, // South Africa
+	"31":  {}, // Netherlands
+	"32":  {}, // Belgium
+	"33":  {}, // France
+	"34":  {}, // Spain
+	"36":  {}, // Hungary
+	"39":  {}, // Italy
+	"40":  {}, // Romania
+	"41":  {}, // Switzerland
+	"43":  {}, // Austria
+	"44":  {}, // United Kingdom
+	"45":  {}, // Denmark
+	"46":  {}, // Sweden
+	"47":  {}, // Norway
+	"48":  {}, // Poland
+	"49":  {}, // Germany
+	"51":  {}, // Peru
+	"52":  {}, // Mexico
+	"54":  {}, // Argentina
+	"55":  {}, // Brazil
+	"56":  {}, // Chile
+	"57":  {}, // Colombia
+	"60":  {}, // Malaysia
+	"61":  {}, // Australia
+	"62":  {}, // Indonesia
+	"63":  {}, // Philippines
+	"64":  {}, // New Zealand
+	"65":  {}, // Singapore
+	"66":  {}, // Thailand
+	"81":  {}, // Japan
+	"82":  {}, // South Korea
+	"84":  {}, // Vietnam
+	"86":  {}, // China
+	"90":  {}, // Turkey
+	"91":  {}, // India
+	"351": {}, // Portugal
+	"352": {}, // Luxembourg
+	"353": {}, // Ireland
+	"354": {}, // Iceland
+	"358": {}, // Finland
+	"359": {}, // Bulgaria
+	"370": {}, // Lithuania
+	"371": {}, // Latvia
+	"372": {}, // Estonia
+	"380": {}, // Ukraine
+	"385": {}, // Croatia
+	"386": {}, // Slovenia
+	"420": {}, // Czech Republic
+	"421": {}, // Slovakia
+	"852": {}, // Hong Kong
+	"886": {}, // Taiwan
+	"966": {}, // Saudi Arabia
+	"971": {}, // United Arab Emirates
+	"972": {}, // Israel
+}
+
+// ActionGroupReceiverCountryCode validates that the country code of an SMS or Voice Receiver is one which
+// supports notifications, since the API otherwise rejects the Action Group with an opaque error
+func ActionGroupReceiverCountryCode(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, append(errors, fmt.Errorf("expected type of %s to be string", k))
+	}
+
+	if _, ok := actionGroupReceiverCountryCodes[v]; ok {
+		return nil, nil
+	}
+
+	supported := make([]string, 0, len(actionGroupReceiverCountryCodes))
+	for code := range actionGroupReceiverCountryCodes {
+		supported = append(supported, code)
+	}
+	sort.Slice(supported, func(i, j int) bool {
+		if len(supported[i]) != len(supported[j]) {
+			return len(supported[i]) < len(supported[j])
+		}
+		return supported[i] < supported[j]
+	})
+
+	return nil, append(errors, fmt.Errorf("%s must be the country calling code (without a leading `+`) of a country which supports notifications, one of: %s - got %q", k, strings.Join(supported, ", "), v))
+}
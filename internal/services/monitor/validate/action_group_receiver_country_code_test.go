@@ -0,0 +1,58 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestActionGroupReceiverCountryCode(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// United States
+			input:    "1",
+			expected: true,
+		},
+		{
+			// China
+			input:    "86",
+			expected: true,
+		},
+		{
+			// Ireland
+			input:    "353",
+			expected: true,
+		},
+		{
+			// leading plus
+			input:    "+44",
+			expected: false,
+		},
+		{
+			// unsupported country
+			input:    "999",
+			expected: false,
+		},
+		{
+			// not a number
+			input:    "us",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ActionGroupReceiverCountryCode(v.input, "country_code")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/publicipaddresses"
 )
 
 type Client struct {
@@ -28,6 +29,7 @@ type Client struct {
 	ProfileClient                          *network.ProfilesClient
 	PacketCapturesClient                   *network.PacketCapturesClient
 	PrivateEndpointClient                  *network.PrivateEndpointsClient
+	PublicIPAddressesClient                *publicipaddresses.PublicIPAddressesClient
 	PublicIPsClient                        *network.PublicIPAddressesClient
 	PublicIPPrefixesClient                 *network.PublicIPPrefixesClient
 	RoutesClient                           *network.RoutesClient
@@ -137,6 +139,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	VnetPeeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VnetPeeringsClient.Client, o.ResourceManagerAuthorizer)
 
+	PublicIPAddressesClient := publicipaddresses.NewPublicIPAddressesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&PublicIPAddressesClient.Client, o.ResourceManagerAuthorizer)
+
 	PublicIPsClient := network.NewPublicIPAddressesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&PublicIPsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -244,6 +249,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ProfileClient:                          &ProfileClient,
 		PacketCapturesClient:                   &PacketCapturesClient,
 		PrivateEndpointClient:                  &PrivateEndpointClient,
+		PublicIPAddressesClient:                &PublicIPAddressesClient,
 		PublicIPsClient:                        &PublicIPsClient,
 		PublicIPPrefixesClient:                 &PublicIPPrefixesClient,
 		RoutesClient:                           &RoutesClient,
@@ -7,6 +7,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -138,6 +139,9 @@ func resourceExpressRouteConnectionCreate(d *pluginsdk.ResourceData, meta interf
 		return err
 	}
 
+	locks.ByName(expressRouteGatewayId.Name, expressRouteGatewayResourceName)
+	defer locks.UnlockByName(expressRouteGatewayId.Name, expressRouteGatewayResourceName)
+
 	id := parse.NewExpressRouteConnectionID(expressRouteGatewayId.SubscriptionId, expressRouteGatewayId.ResourceGroup, expressRouteGatewayId.Name, d.Get("name").(string))
 
 	existing, err := client.Get(ctx, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name)
@@ -240,6 +244,9 @@ func resourceExpressRouteConnectionUpdate(d *pluginsdk.ResourceData, meta interf
 		return err
 	}
 
+	locks.ByName(id.ExpressRouteGatewayName, expressRouteGatewayResourceName)
+	defer locks.UnlockByName(id.ExpressRouteGatewayName, expressRouteGatewayResourceName)
+
 	parameters := network.ExpressRouteConnection{
 		Name: utils.String(id.Name),
 		ExpressRouteConnectionProperties: &network.ExpressRouteConnectionProperties{
@@ -278,6 +285,9 @@ func resourceExpressRouteConnectionDelete(d *pluginsdk.ResourceData, meta interf
 		return err
 	}
 
+	locks.ByName(id.ExpressRouteGatewayName, expressRouteGatewayResourceName)
+	defer locks.UnlockByName(id.ExpressRouteGatewayName, expressRouteGatewayResourceName)
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.ExpressRouteGatewayName, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
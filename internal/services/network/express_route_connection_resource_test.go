@@ -64,9 +64,11 @@ func testAccExpressRouteConnection_complete(t *testing.T) {
 
 	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
 		{
-			Config: r.complete(data),
+			Config: r.complete(data, 1),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("routing_weight").HasValue("2"),
+				check.That(data.ResourceName).Key("enable_internet_security").HasValue("true"),
 			),
 		},
 		data.ImportStep(),
@@ -86,9 +88,20 @@ func testAccExpressRouteConnection_update(t *testing.T) {
 		},
 		data.ImportStep(),
 		{
-			Config: r.complete(data),
+			Config: r.complete(data, 1),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("routing_weight").HasValue("2"),
+				check.That(data.ResourceName).Key("enable_internet_security").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the scale units of the ExpressRoute Gateway can be changed without recreating the connection
+			Config: r.complete(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_express_route_gateway.test").Key("scale_units").HasValue("2"),
 			),
 		},
 		data.ImportStep(),
@@ -132,7 +145,7 @@ resource "azurerm_express_route_connection" "test" {
 
   depends_on = [azurerm_virtual_hub_route_table.test]
 }
-`, r.template(data), data.RandomInteger)
+`, r.template(data, 1), data.RandomInteger)
 }
 
 func (r ExpressRouteConnectionResource) requiresImport(data acceptance.TestData) string {
@@ -148,7 +161,7 @@ resource "azurerm_express_route_connection" "import" {
 `, config)
 }
 
-func (r ExpressRouteConnectionResource) complete(data acceptance.TestData) string {
+func (r ExpressRouteConnectionResource) complete(data acceptance.TestData, scaleUnits int) string {
 	return fmt.Sprintf(`
 %s
 
@@ -169,10 +182,10 @@ resource "azurerm_express_route_connection" "test" {
     }
   }
 }
-`, r.template(data), data.RandomInteger)
+`, r.template(data, scaleUnits), data.RandomInteger)
 }
 
-func (r ExpressRouteConnectionResource) template(data acceptance.TestData) string {
+func (r ExpressRouteConnectionResource) template(data acceptance.TestData, scaleUnits int) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -235,12 +248,12 @@ resource "azurerm_express_route_gateway" "test" {
   resource_group_name = azurerm_resource_group.test.name
   location            = azurerm_resource_group.test.location
   virtual_hub_id      = azurerm_virtual_hub.test.id
-  scale_units         = 1
+  scale_units         = %d
 }
 
 resource "azurerm_virtual_hub_route_table" "test" {
   name           = "acctest-vhubrt-%d"
   virtual_hub_id = azurerm_virtual_hub.test.id
 }
-`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, scaleUnits, data.RandomInteger)
 }
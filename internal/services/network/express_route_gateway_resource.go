@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -17,11 +18,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+var expressRouteGatewayResourceName = "azurerm_express_route_gateway"
+
 func resourceExpressRouteGateway() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceExpressRouteGatewayCreateUpdate,
+		Create: resourceExpressRouteGatewayCreate,
 		Read:   resourceExpressRouteGatewayRead,
-		Update: resourceExpressRouteGatewayCreateUpdate,
+		Update: resourceExpressRouteGatewayUpdate,
 		Delete: resourceExpressRouteGatewayDelete,
 		// TODO: replace this with an importer which validates the ID during import
 		Importer: pluginsdk.DefaultImporter(),
@@ -63,9 +66,9 @@ func resourceExpressRouteGateway() *pluginsdk.Resource {
 	}
 }
 
-func resourceExpressRouteGatewayCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+func resourceExpressRouteGatewayCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.ExpressRouteGatewaysClient
-	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	log.Println("[INFO] preparing arguments for ExpressRoute Gateway creation.")
@@ -73,17 +76,15 @@ func resourceExpressRouteGatewayCreateUpdate(d *pluginsdk.ResourceData, meta int
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if d.IsNewResource() {
-		resp, err := client.Get(ctx, resourceGroup, name)
-		if err != nil {
-			if !utils.ResponseWasNotFound(resp.Response) {
-				return fmt.Errorf("Error checking for present of existing ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
-			}
-		}
-		if resp.ID != nil && *resp.ID != "" {
-			return tf.ImportAsExistsError("azurerm_express_route_gateway", *resp.ID)
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error checking for present of existing ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
 	}
+	if resp.ID != nil && *resp.ID != "" {
+		return tf.ImportAsExistsError("azurerm_express_route_gateway", *resp.ID)
+	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	virtualHubId := d.Get("virtual_hub_id").(string)
@@ -113,7 +114,7 @@ func resourceExpressRouteGatewayCreateUpdate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("Error waiting for creation of ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	resp, err := client.Get(ctx, resourceGroup, name)
+	resp, err = client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error retrieving ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -125,6 +126,58 @@ func resourceExpressRouteGatewayCreateUpdate(d *pluginsdk.ResourceData, meta int
 	return resourceExpressRouteGatewayRead(d, meta)
 }
 
+func resourceExpressRouteGatewayUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRouteGatewaysClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["expressRouteGateways"]
+
+	// the ExpressRoute Connections are updated through the ExpressRoute Gateway, so these need to be serialized
+	locks.ByName(name, expressRouteGatewayResourceName)
+	defer locks.UnlockByName(name, expressRouteGatewayResourceName)
+
+	// the existing ExpressRoute Gateway is updated, rather than replaced, such that the scale units can be
+	// changed in-place without affecting the existing ExpressRoute Connections
+	existing, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if existing.ExpressRouteGatewayProperties == nil {
+		return fmt.Errorf("Error retrieving ExpressRoute Gateway %q (Resource Group %q): `properties` was nil", name, resourceGroup)
+	}
+
+	if d.HasChange("scale_units") {
+		minScaleUnits := int32(d.Get("scale_units").(int))
+		if existing.ExpressRouteGatewayProperties.AutoScaleConfiguration == nil {
+			existing.ExpressRouteGatewayProperties.AutoScaleConfiguration = &network.ExpressRouteGatewayPropertiesAutoScaleConfiguration{}
+		}
+		if existing.ExpressRouteGatewayProperties.AutoScaleConfiguration.Bounds == nil {
+			existing.ExpressRouteGatewayProperties.AutoScaleConfiguration.Bounds = &network.ExpressRouteGatewayPropertiesAutoScaleConfigurationBounds{}
+		}
+		existing.ExpressRouteGatewayProperties.AutoScaleConfiguration.Bounds.Min = &minScaleUnits
+	}
+
+	if d.HasChange("tags") {
+		existing.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, existing)
+	if err != nil {
+		return fmt.Errorf("Error updating ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of ExpressRoute Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceExpressRouteGatewayRead(d, meta)
+}
+
 func resourceExpressRouteGatewayRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.ExpressRouteGatewaysClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -0,0 +1,99 @@
+package network
+
+import "testing"
+
+func TestNormalizeIpGroupCIDR(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "10.0.0.1",
+			Expected: "10.0.0.1",
+		},
+		{
+			Input:    "10.0.0.1/32",
+			Expected: "10.0.0.1",
+		},
+		{
+			Input:    " 10.0.0.1/32 ",
+			Expected: "10.0.0.1",
+		},
+		{
+			Input:    "10.0.0.0/24",
+			Expected: "10.0.0.0/24",
+		},
+		{
+			Input:    "10.0.0.10-10.0.0.20",
+			Expected: "10.0.0.10-10.0.0.20",
+		},
+		{
+			Input:    "2001:db8::1",
+			Expected: "2001:db8::1",
+		},
+		{
+			Input:    "2001:0db8:0000::0001/128",
+			Expected: "2001:db8::1",
+		},
+		{
+			Input:    "2001:db8::/32",
+			Expected: "2001:db8::/32",
+		},
+		{
+			// invalid values are left as-is, for the API to reject
+			Input:    "10.0.0.1/33",
+			Expected: "10.0.0.1/33",
+		},
+		{
+			Input:    "not-an-ip",
+			Expected: "not-an-ip",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual := normalizeIpGroupCIDR(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestIpGroupCIDRHash(t *testing.T) {
+	testData := []struct {
+		First    string
+		Second   string
+		Expected bool
+	}{
+		{
+			First:    "10.0.0.1",
+			Second:   "10.0.0.1/32",
+			Expected: true,
+		},
+		{
+			First:    "2001:db8::1",
+			Second:   "2001:db8:0::1/128",
+			Expected: true,
+		},
+		{
+			First:    "10.0.0.1",
+			Second:   "10.0.0.1/31",
+			Expected: false,
+		},
+		{
+			First:    "10.0.0.1",
+			Second:   "10.0.0.2",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q", v.First, v.Second)
+
+		actual := ipGroupCIDRHash(v.First) == ipGroupCIDRHash(v.Second)
+		if actual != v.Expected {
+			t.Fatalf("Expected the hashes to match to be %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -37,6 +37,22 @@ func dataSourceIpGroup() *pluginsdk.Resource {
 				Set:      pluginsdk.HashString,
 			},
 
+			"firewall_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"firewall_policy_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"tags": tags.SchemaDataSource(),
 		},
 	}
@@ -76,6 +92,12 @@ func dataSourceIpGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		if err := d.Set("cidrs", props.IPAddresses); err != nil {
 			return fmt.Errorf("setting `cidrs`: %+v", err)
 		}
+		if err := d.Set("firewall_ids", flattenNetworkSubResourceID(props.Firewalls)); err != nil {
+			return fmt.Errorf("setting `firewall_ids`: %+v", err)
+		}
+		if err := d.Set("firewall_policy_ids", flattenNetworkSubResourceID(props.FirewallPolicies)); err != nil {
+			return fmt.Errorf("setting `firewall_policy_ids`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -21,6 +21,8 @@ func TestAccDataSourceIPGroup_basic(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("location").Exists(),
 				check.That(data.ResourceName).Key("cidrs.#").HasValue("0"),
+				check.That(data.ResourceName).Key("firewall_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("firewall_policy_ids.#").HasValue("0"),
 				check.That(data.ResourceName).Key("tags.%").HasValue("0"),
 			),
 		},
@@ -2,6 +2,8 @@ package network
 
 import (
 	"fmt"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
@@ -53,7 +55,23 @@ func resourceIpGroup() *pluginsdk.Resource {
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
-				Set: pluginsdk.HashString,
+				Set: ipGroupCIDRHash,
+			},
+
+			"firewall_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"firewall_policy_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
 			},
 
 			"tags": tags.Schema(),
@@ -149,6 +167,12 @@ func resourceIpGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		if err := d.Set("cidrs", props.IPAddresses); err != nil {
 			return fmt.Errorf("setting `cidrs`: %+v", err)
 		}
+		if err := d.Set("firewall_ids", flattenNetworkSubResourceID(props.Firewalls)); err != nil {
+			return fmt.Errorf("setting `firewall_ids`: %+v", err)
+		}
+		if err := d.Set("firewall_policy_ids", flattenNetworkSubResourceID(props.FirewallPolicies)); err != nil {
+			return fmt.Errorf("setting `firewall_policy_ids`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -175,3 +199,42 @@ func resourceIpGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	return err
 }
+
+// ipGroupCIDRHash hashes the normalized form of the CIDR, such that equivalent values
+// (e.g. `10.0.0.1` and `10.0.0.1/32`) are treated as the same item within the set
+func ipGroupCIDRHash(v interface{}) int {
+	return pluginsdk.HashString(normalizeIpGroupCIDR(v.(string)))
+}
+
+// normalizeIpGroupCIDR returns the canonical form of an IP Address, CIDR or IP Address Range
+// within an IP Group - where single host prefixes are collapsed to the IP Address itself
+func normalizeIpGroupCIDR(input string) string {
+	input = strings.TrimSpace(input)
+
+	if strings.Contains(input, "-") {
+		segments := strings.Split(input, "-")
+		for i, segment := range segments {
+			segments[i] = normalizeIpGroupCIDR(segment)
+		}
+		return strings.Join(segments, "-")
+	}
+
+	if !strings.Contains(input, "/") {
+		if ip := net.ParseIP(input); ip != nil {
+			return ip.String()
+		}
+		return input
+	}
+
+	ip, network, err := net.ParseCIDR(input)
+	if err != nil {
+		return input
+	}
+
+	ones, bits := network.Mask.Size()
+	if ones == bits {
+		return ip.String()
+	}
+
+	return fmt.Sprintf("%s/%d", ip.String(), ones)
+}
@@ -61,6 +61,28 @@ func TestAccIpGroup_complete(t *testing.T) {
 	})
 }
 
+func TestAccIpGroup_singleHostCIDR(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ip_group", "test")
+	r := IPGroupResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.singleHostCIDR(data, "10.0.0.1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("cidrs.#").HasValue("2"),
+				check.That(data.ResourceName).Key("firewall_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("firewall_policy_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the single host prefix is equivalent to the IP Address, so this shouldn't cause a diff
+			Config:   r.singleHostCIDR(data, "10.0.0.1/32"),
+			PlanOnly: true,
+		},
+	})
+}
+
 func TestAccIpGroup_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_ip_group", "test")
 	r := IPGroupResource{}
@@ -209,3 +231,24 @@ resource "azurerm_ip_group" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (IPGroupResource) singleHostCIDR(data acceptance.TestData, cidr string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-network-%d"
+  location = "%s"
+}
+
+resource "azurerm_ip_group" "test" {
+  name                = "acceptanceTestIpGroup1"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  cidrs = ["%s", "10.0.0.10-10.0.0.20"]
+}
+`, data.RandomInteger, data.Locations.Primary, cidr)
+}
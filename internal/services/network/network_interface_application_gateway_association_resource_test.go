@@ -3,7 +3,6 @@ package network_test
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
@@ -12,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	network2 "github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -92,39 +92,28 @@ func TestAccNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation_upda
 }
 
 func (NetworkInterfaceApplicationGatewayBackendAddressPoolAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	splitId := strings.Split(state.ID, "|")
-	if len(splitId) != 2 {
-		return nil, fmt.Errorf("expected ID to be in the format {networkInterfaceId}/ipConfigurations/{ipConfigurationName}|{backendAddressPoolId} but got %q", state.ID)
-	}
-
-	id, err := azure.ParseAzureResourceID(splitId[0])
+	id, err := parse.NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	ipConfigurationName := id.Path["ipConfigurations"]
-	networkInterfaceName := id.Path["networkInterfaces"]
-	resourceGroup := id.ResourceGroup
-	backendAddressPoolId := splitId[1]
-
-	read, err := clients.Network.InterfacesClient.Get(ctx, resourceGroup, networkInterfaceName, "")
+	read, err := clients.Network.InterfacesClient.Get(ctx, id.IpConfiguration.ResourceGroup, id.IpConfiguration.NetworkInterfaceName, "")
 	if err != nil {
-		return nil, fmt.Errorf("reading NetworkInterfaceApplicationGatewayBackendAddressPoolAssociation (%s): %+v", id, err)
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
 	nicProps := read.InterfacePropertiesFormat
 	if nicProps == nil {
-		return nil, fmt.Errorf("`properties` was nil for Network Interface (%s): %+v", id, err)
+		return nil, fmt.Errorf("retrieving %s: `properties` was nil", id)
 	}
 
-	ipConfigs := nicProps.IPConfigurations
-	if ipConfigs == nil {
-		return nil, fmt.Errorf("`properties.IPConfigurations` was nil for Network Interface (%s): %+v", id, err)
+	if nicProps.IPConfigurations == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.ipConfigurations` was nil", id)
 	}
 
-	c := network2.FindNetworkInterfaceIPConfiguration(nicProps.IPConfigurations, ipConfigurationName)
+	c := network2.FindNetworkInterfaceIPConfiguration(nicProps.IPConfigurations, id.IpConfiguration.IpConfigurationName)
 	if c == nil {
-		return nil, fmt.Errorf("IP configuration was nil for Network Interface (%s): %+v", id, err)
+		return nil, fmt.Errorf("%s was not found", id.IpConfiguration)
 	}
 	config := *c
 
@@ -136,7 +125,7 @@ func (NetworkInterfaceApplicationGatewayBackendAddressPoolAssociationResource) E
 					continue
 				}
 
-				if *pool.ID == backendAddressPoolId {
+				if *pool.ID == id.BackendAddressPoolId {
 					found = true
 					break
 				}
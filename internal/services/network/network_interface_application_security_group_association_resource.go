@@ -3,14 +3,14 @@ package network
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -21,8 +21,11 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociation() *pluginsdk.Re
 		Create: resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate,
 		Read:   resourceNetworkInterfaceApplicationSecurityGroupAssociationRead,
 		Delete: resourceNetworkInterfaceApplicationSecurityGroupAssociationDelete,
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkInterfaceApplicationSecurityGroupAssociationID(id)
+			return err
+		}),
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -41,14 +44,14 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociation() *pluginsdk.Re
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validate.NetworkInterfaceID,
 			},
 
 			"application_security_group_id": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validate.ApplicationSecurityGroupID,
 			},
 		},
 	}
@@ -61,59 +64,53 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *plugin
 
 	log.Printf("[INFO] preparing arguments for Network Interface <-> Application Security Group Association creation.")
 
-	networkInterfaceId := d.Get("network_interface_id").(string)
-	applicationSecurityGroupId := d.Get("application_security_group_id").(string)
-
-	id, err := azure.ParseAzureResourceID(networkInterfaceId)
+	nicId, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
 	if err != nil {
 		return err
 	}
 
-	networkInterfaceName := id.Path["networkInterfaces"]
-	resourceGroup := id.ResourceGroup
+	id := parse.NewNetworkInterfaceApplicationSecurityGroupAssociationID(*nicId, d.Get("application_security_group_id").(string))
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
+	locks.ByName(nicId.Name, networkInterfaceResourceName)
+	defer locks.UnlockByName(nicId.Name, networkInterfaceResourceName)
 
-	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	read, err := client.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
-			log.Printf("[INFO] Network Interface %q does not exist - removing from state", d.Id())
-			d.SetId("")
-			return nil
+			return fmt.Errorf("%s was not found", *nicId)
 		}
 
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", *nicId, err)
 	}
 
 	props := read.InterfacePropertiesFormat
 	if props == nil {
-		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+		return fmt.Errorf("retrieving %s: `properties` was nil", *nicId)
 	}
+
 	if props.IPConfigurations == nil {
-		return fmt.Errorf("Error: `properties.ipConfigurations` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+		return fmt.Errorf("retrieving %s: `properties.ipConfigurations` was nil", *nicId)
 	}
 
 	info := parseFieldsFromNetworkInterface(*props)
-	resourceId := fmt.Sprintf("%s|%s", networkInterfaceId, applicationSecurityGroupId)
-	if utils.SliceContainsValue(info.applicationSecurityGroupIDs, applicationSecurityGroupId) {
-		return tf.ImportAsExistsError("azurerm_network_interface_application_security_group_association", resourceId)
+	if utils.SliceContainsValue(info.applicationSecurityGroupIDs, id.ApplicationSecurityGroupId) {
+		return tf.ImportAsExistsError("azurerm_network_interface_application_security_group_association", id.ID())
 	}
 
-	info.applicationSecurityGroupIDs = append(info.applicationSecurityGroupIDs, applicationSecurityGroupId)
+	info.applicationSecurityGroupIDs = append(info.applicationSecurityGroupIDs, id.ApplicationSecurityGroupId)
 
 	read.InterfacePropertiesFormat.IPConfigurations = mapFieldsToNetworkInterface(props.IPConfigurations, info)
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+	future, err := client.CreateOrUpdate(ctx, nicId.ResourceGroup, nicId.Name, read)
 	if err != nil {
-		return fmt.Errorf("Error updating Application Security Group Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of Application Security Group Association for NIC %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
 	}
 
-	d.SetId(resourceId)
+	d.SetId(id.ID())
 
 	return resourceNetworkInterfaceApplicationSecurityGroupAssociationRead(d, meta)
 }
@@ -123,51 +120,35 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationRead(d *pluginsd
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	splitId := strings.Split(d.Id(), "|")
-	if len(splitId) != 2 {
-		return fmt.Errorf("Expected ID to be in the format {networkInterfaceId}|{applicationSecurityGroupId} but got %q", d.Id())
-	}
-
-	nicID, err := azure.ParseAzureResourceID(splitId[0])
+	id, err := parse.NetworkInterfaceApplicationSecurityGroupAssociationID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	networkInterfaceName := nicID.Path["networkInterfaces"]
-	resourceGroup := nicID.ResourceGroup
-	applicationSecurityGroupId := splitId[1]
-
-	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	read, err := client.Get(ctx, id.NetworkInterface.ResourceGroup, id.NetworkInterface.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
-			log.Printf("[DEBUG] Network Interface %q (Resource Group %q) was not found - removing from state!", networkInterfaceName, resourceGroup)
+			log.Printf("[DEBUG] %s was not found - removing from state!", id.NetworkInterface)
 			d.SetId("")
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", id.NetworkInterface, err)
 	}
 
 	nicProps := read.InterfacePropertiesFormat
 	if nicProps == nil {
-		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+		return fmt.Errorf("retrieving %s: `properties` was nil", id.NetworkInterface)
 	}
 
 	info := parseFieldsFromNetworkInterface(*nicProps)
-	exists := false
-	for _, groupId := range info.applicationSecurityGroupIDs {
-		if groupId == applicationSecurityGroupId {
-			exists = true
-		}
-	}
-
-	if !exists {
-		log.Printf("[DEBUG] Association between Network Interface %q (Resource Group %q) and Application Security Group %q was not found - removing from state!", networkInterfaceName, resourceGroup, applicationSecurityGroupId)
+	if !utils.SliceContainsValue(info.applicationSecurityGroupIDs, id.ApplicationSecurityGroupId) {
+		log.Printf("[DEBUG] %s was not found - removing from state!", id)
 		d.SetId("")
 		return nil
 	}
 
-	d.Set("application_security_group_id", applicationSecurityGroupId)
+	d.Set("application_security_group_id", id.ApplicationSecurityGroupId)
 	d.Set("network_interface_id", read.ID)
 
 	return nil
@@ -178,59 +159,50 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationDelete(d *plugin
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	splitId := strings.Split(d.Id(), "|")
-	if len(splitId) != 2 {
-		return fmt.Errorf("Expected ID to be in the format {networkInterfaceId}|{applicationSecurityGroupId} but got %q", d.Id())
-	}
-
-	nicID, err := azure.ParseAzureResourceID(splitId[0])
+	id, err := parse.NetworkInterfaceApplicationSecurityGroupAssociationID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	networkInterfaceName := nicID.Path["networkInterfaces"]
-	resourceGroup := nicID.ResourceGroup
-	applicationSecurityGroupId := splitId[1]
-
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
+	locks.ByName(id.NetworkInterface.Name, networkInterfaceResourceName)
+	defer locks.UnlockByName(id.NetworkInterface.Name, networkInterfaceResourceName)
 
-	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	read, err := client.Get(ctx, id.NetworkInterface.ResourceGroup, id.NetworkInterface.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
-			return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", networkInterfaceName, resourceGroup)
+			return fmt.Errorf("%s was not found", id.NetworkInterface)
 		}
 
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", id.NetworkInterface, err)
 	}
 
 	props := read.InterfacePropertiesFormat
 	if props == nil {
-		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+		return fmt.Errorf("retrieving %s: `properties` was nil", id.NetworkInterface)
 	}
 
 	if props.IPConfigurations == nil {
-		return fmt.Errorf("Error: `properties.ipConfigurations` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+		return fmt.Errorf("retrieving %s: `properties.ipConfigurations` was nil", id.NetworkInterface)
 	}
 
 	info := parseFieldsFromNetworkInterface(*props)
 
 	applicationSecurityGroupIds := make([]string, 0)
 	for _, v := range info.applicationSecurityGroupIDs {
-		if v != applicationSecurityGroupId {
+		if v != id.ApplicationSecurityGroupId {
 			applicationSecurityGroupIds = append(applicationSecurityGroupIds, v)
 		}
 	}
 	info.applicationSecurityGroupIDs = applicationSecurityGroupIds
 	read.InterfacePropertiesFormat.IPConfigurations = mapFieldsToNetworkInterface(props.IPConfigurations, info)
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+	future, err := client.CreateOrUpdate(ctx, id.NetworkInterface.ResourceGroup, id.NetworkInterface.Name, read)
 	if err != nil {
-		return fmt.Errorf("Error removing Application Security Group for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for removal of Application Security Group for NIC %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
 	}
 
 	return nil
@@ -3,14 +3,15 @@ package network
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	loadBalancerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -22,8 +23,11 @@ func resourceNetworkInterfaceBackendAddressPoolAssociation() *pluginsdk.Resource
 		Create: resourceNetworkInterfaceBackendAddressPoolAssociationCreate,
 		Read:   resourceNetworkInterfaceBackendAddressPoolAssociationRead,
 		Delete: resourceNetworkInterfaceBackendAddressPoolAssociationDelete,
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkInterfaceIpConfigurationBackendPoolAssociationID(id)
+			return err
+		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -37,7 +41,7 @@ func resourceNetworkInterfaceBackendAddressPoolAssociation() *pluginsdk.Resource
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validate.NetworkInterfaceID,
 			},
 
 			"ip_configuration_name": {
@@ -51,7 +55,7 @@ func resourceNetworkInterfaceBackendAddressPoolAssociation() *pluginsdk.Resource
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: loadBalancerValidate.LoadBalancerBackendAddressPoolID,
 			},
 		},
 	}
@@ -64,60 +68,62 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationCreate(d *pluginsdk.Re
 
 	log.Printf("[INFO] preparing arguments for Network Interface <-> Load Balancer Backend Address Pool Association creation.")
 
-	networkInterfaceId := d.Get("network_interface_id").(string)
-	ipConfigurationName := d.Get("ip_configuration_name").(string)
-	backendAddressPoolId := d.Get("backend_address_pool_id").(string)
-
-	id, err := azure.ParseAzureResourceID(networkInterfaceId)
+	nicId, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
 	if err != nil {
 		return err
 	}
 
-	networkInterfaceName := id.Path["networkInterfaces"]
-	resourceGroup := id.ResourceGroup
+	ipConfigurationId := parse.NewNetworkInterfaceIpConfigurationID(nicId.SubscriptionId, nicId.ResourceGroup, nicId.Name, d.Get("ip_configuration_name").(string))
+	id := parse.NewNetworkInterfaceIpConfigurationBackendPoolAssociationID(ipConfigurationId, d.Get("backend_address_pool_id").(string))
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
+	locks.ByName(nicId.Name, networkInterfaceResourceName)
+	defer locks.UnlockByName(nicId.Name, networkInterfaceResourceName)
 
-	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	read, err := client.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
-			return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", networkInterfaceName, resourceGroup)
+			return fmt.Errorf("%s was not found", *nicId)
 		}
 
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", *nicId, err)
 	}
 
 	props := read.InterfacePropertiesFormat
 	if props == nil {
-		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+		return fmt.Errorf("retrieving %s: `properties` was nil", *nicId)
 	}
 
-	ipConfigs := props.IPConfigurations
-	if ipConfigs == nil {
-		return fmt.Errorf("Error: `properties.IPConfigurations` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+	if props.IPConfigurations == nil {
+		return fmt.Errorf("retrieving %s: `properties.ipConfigurations` was nil", *nicId)
 	}
 
-	c := FindNetworkInterfaceIPConfiguration(props.IPConfigurations, ipConfigurationName)
+	c := FindNetworkInterfaceIPConfiguration(props.IPConfigurations, ipConfigurationId.IpConfigurationName)
 	if c == nil {
-		return fmt.Errorf("Error: IP Configuration %q was not found on Network Interface %q (Resource Group %q)", ipConfigurationName, networkInterfaceName, resourceGroup)
+		return fmt.Errorf("%s was not found", ipConfigurationId)
 	}
 
 	config := *c
 	p := config.InterfaceIPConfigurationPropertiesFormat
 	if p == nil {
-		return fmt.Errorf("Error: `IPConfiguration.properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+		return fmt.Errorf("retrieving %s: `properties` was nil", ipConfigurationId)
+	}
+
+	poolIPVersions, err := retrieveBackendAddressPoolIPVersions(ctx, meta.(*clients.Client).LoadBalancers.LoadBalancersClient, meta.(*clients.Client).Network.PublicIPsClient, id.BackendAddressPoolId)
+	if err != nil {
+		return err
+	}
+	if err := validateNetworkInterfaceIPConfigurationForBackendAddressPool(config, id.BackendAddressPoolId, poolIPVersions); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
 	pools := make([]network.BackendAddressPool, 0)
 
 	// first double-check it doesn't exist
-	resourceId := fmt.Sprintf("%s/ipConfigurations/%s|%s", networkInterfaceId, ipConfigurationName, backendAddressPoolId)
 	if p.LoadBalancerBackendAddressPools != nil {
 		for _, existingPool := range *p.LoadBalancerBackendAddressPools {
-			if id := existingPool.ID; id != nil {
-				if *id == backendAddressPoolId {
-					return tf.ImportAsExistsError("azurerm_network_interface_backend_address_pool_association", resourceId)
+			if existingPool.ID != nil {
+				if *existingPool.ID == id.BackendAddressPoolId {
+					return tf.ImportAsExistsError("azurerm_network_interface_backend_address_pool_association", id.ID())
 				}
 
 				pools = append(pools, existingPool)
@@ -126,23 +132,23 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationCreate(d *pluginsdk.Re
 	}
 
 	pool := network.BackendAddressPool{
-		ID: utils.String(backendAddressPoolId),
+		ID: utils.String(id.BackendAddressPoolId),
 	}
 	pools = append(pools, pool)
 	p.LoadBalancerBackendAddressPools = &pools
 
 	props.IPConfigurations = updateNetworkInterfaceIPConfiguration(config, props.IPConfigurations)
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+	future, err := client.CreateOrUpdate(ctx, nicId.ResourceGroup, nicId.Name, read)
 	if err != nil {
-		return fmt.Errorf("Error updating Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of Backend Address Pool Association for NIC %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
 	}
 
-	d.SetId(resourceId)
+	d.SetId(id.ID())
 
 	return resourceNetworkInterfaceBackendAddressPoolAssociationRead(d, meta)
 }
@@ -152,45 +158,36 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationRead(d *pluginsdk.Reso
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	splitId := strings.Split(d.Id(), "|")
-	if len(splitId) != 2 {
-		return fmt.Errorf("Expected ID to be in the format {networkInterfaceId}/ipConfigurations/{ipConfigurationName}|{backendAddressPoolId} but got %q", d.Id())
-	}
-
-	nicID, err := azure.ParseAzureResourceID(splitId[0])
+	id, err := parse.NetworkInterfaceIpConfigurationBackendPoolAssociationID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ipConfigurationName := nicID.Path["ipConfigurations"]
-	networkInterfaceName := nicID.Path["networkInterfaces"]
-	resourceGroup := nicID.ResourceGroup
-	backendAddressPoolId := splitId[1]
+	nicId := parse.NewNetworkInterfaceID(id.IpConfiguration.SubscriptionId, id.IpConfiguration.ResourceGroup, id.IpConfiguration.NetworkInterfaceName)
 
-	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	read, err := client.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
-			log.Printf("Network Interface %q (Resource Group %q) was not found - removing from state!", networkInterfaceName, resourceGroup)
+			log.Printf("[DEBUG] %s was not found - removing from state!", nicId)
 			d.SetId("")
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", nicId, err)
 	}
 
 	nicProps := read.InterfacePropertiesFormat
 	if nicProps == nil {
-		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+		return fmt.Errorf("retrieving %s: `properties` was nil", nicId)
 	}
 
-	ipConfigs := nicProps.IPConfigurations
-	if ipConfigs == nil {
-		return fmt.Errorf("Error: `properties.IPConfigurations` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+	if nicProps.IPConfigurations == nil {
+		return fmt.Errorf("retrieving %s: `properties.ipConfigurations` was nil", nicId)
 	}
 
-	c := FindNetworkInterfaceIPConfiguration(nicProps.IPConfigurations, ipConfigurationName)
+	c := FindNetworkInterfaceIPConfiguration(nicProps.IPConfigurations, id.IpConfiguration.IpConfigurationName)
 	if c == nil {
-		log.Printf("IP Configuration %q was not found in Network Interface %q (Resource Group %q) - removing from state!", ipConfigurationName, networkInterfaceName, resourceGroup)
+		log.Printf("[DEBUG] %s was not found - removing from state!", id.IpConfiguration)
 		d.SetId("")
 		return nil
 	}
@@ -204,7 +201,7 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationRead(d *pluginsdk.Reso
 					continue
 				}
 
-				if *pool.ID == backendAddressPoolId {
+				if *pool.ID == id.BackendAddressPoolId {
 					found = true
 					break
 				}
@@ -213,13 +210,13 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationRead(d *pluginsdk.Reso
 	}
 
 	if !found {
-		log.Printf("[DEBUG] Association between Network Interface %q (Resource Group %q) and Load Balancer Backend Pool %q was not found - removing from state!", networkInterfaceName, resourceGroup, backendAddressPoolId)
+		log.Printf("[DEBUG] %s was not found - removing from state!", id)
 		d.SetId("")
 		return nil
 	}
 
-	d.Set("backend_address_pool_id", backendAddressPoolId)
-	d.Set("ip_configuration_name", ipConfigurationName)
+	d.Set("backend_address_pool_id", id.BackendAddressPoolId)
+	d.Set("ip_configuration_name", id.IpConfiguration.IpConfigurationName)
 	d.Set("network_interface_id", read.ID)
 
 	return nil
@@ -230,52 +227,43 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationDelete(d *pluginsdk.Re
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	splitId := strings.Split(d.Id(), "|")
-	if len(splitId) != 2 {
-		return fmt.Errorf("Expected ID to be in the format {networkInterfaceId}/ipConfigurations/{ipConfigurationName}|{backendAddressPoolId} but got %q", d.Id())
-	}
-
-	nicID, err := azure.ParseAzureResourceID(splitId[0])
+	id, err := parse.NetworkInterfaceIpConfigurationBackendPoolAssociationID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	ipConfigurationName := nicID.Path["ipConfigurations"]
-	networkInterfaceName := nicID.Path["networkInterfaces"]
-	resourceGroup := nicID.ResourceGroup
-	backendAddressPoolId := splitId[1]
+	nicId := parse.NewNetworkInterfaceID(id.IpConfiguration.SubscriptionId, id.IpConfiguration.ResourceGroup, id.IpConfiguration.NetworkInterfaceName)
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
+	locks.ByName(nicId.Name, networkInterfaceResourceName)
+	defer locks.UnlockByName(nicId.Name, networkInterfaceResourceName)
 
-	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	read, err := client.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
-			return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", networkInterfaceName, resourceGroup)
+			return fmt.Errorf("%s was not found", nicId)
 		}
 
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", nicId, err)
 	}
 
 	nicProps := read.InterfacePropertiesFormat
 	if nicProps == nil {
-		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+		return fmt.Errorf("retrieving %s: `properties` was nil", nicId)
 	}
 
-	ipConfigs := nicProps.IPConfigurations
-	if ipConfigs == nil {
-		return fmt.Errorf("Error: `properties.IPConfigurations` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+	if nicProps.IPConfigurations == nil {
+		return fmt.Errorf("retrieving %s: `properties.ipConfigurations` was nil", nicId)
 	}
 
-	c := FindNetworkInterfaceIPConfiguration(nicProps.IPConfigurations, ipConfigurationName)
+	c := FindNetworkInterfaceIPConfiguration(nicProps.IPConfigurations, id.IpConfiguration.IpConfigurationName)
 	if c == nil {
-		return fmt.Errorf("Error: IP Configuration %q was not found on Network Interface %q (Resource Group %q)", ipConfigurationName, networkInterfaceName, resourceGroup)
+		return fmt.Errorf("%s was not found", id.IpConfiguration)
 	}
 	config := *c
 
 	props := config.InterfaceIPConfigurationPropertiesFormat
 	if props == nil {
-		return fmt.Errorf("Error: Properties for IPConfiguration %q was nil for Network Interface %q (Resource Group %q)", ipConfigurationName, networkInterfaceName, resourceGroup)
+		return fmt.Errorf("retrieving %s: `properties` was nil", id.IpConfiguration)
 	}
 
 	backendAddressPools := make([]network.BackendAddressPool, 0)
@@ -285,7 +273,7 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationDelete(d *pluginsdk.Re
 				continue
 			}
 
-			if *pool.ID != backendAddressPoolId {
+			if *pool.ID != id.BackendAddressPoolId {
 				backendAddressPools = append(backendAddressPools, pool)
 			}
 		}
@@ -293,13 +281,13 @@ func resourceNetworkInterfaceBackendAddressPoolAssociationDelete(d *pluginsdk.Re
 	props.LoadBalancerBackendAddressPools = &backendAddressPools
 	nicProps.IPConfigurations = updateNetworkInterfaceIPConfiguration(config, nicProps.IPConfigurations)
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+	future, err := client.CreateOrUpdate(ctx, nicId.ResourceGroup, nicId.Name, read)
 	if err != nil {
-		return fmt.Errorf("Error removing Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for removal of Backend Address Pool Association for NIC %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
 	}
 
 	return nil
@@ -0,0 +1,588 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// networkInterfaceBackendAddressPoolAssociationsParallelism is the maximum number of Network Interfaces
+// which are updated at the same time - each Network Interface is still locked individually
+const networkInterfaceBackendAddressPoolAssociationsParallelism = 10
+
+func resourceNetworkInterfaceBackendAddressPoolAssociations() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkInterfaceBackendAddressPoolAssociationsCreate,
+		Read:   resourceNetworkInterfaceBackendAddressPoolAssociationsRead,
+		Update: resourceNetworkInterfaceBackendAddressPoolAssociationsUpdate,
+		Delete: resourceNetworkInterfaceBackendAddressPoolAssociationsDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, _, err := parseNetworkInterfaceBackendAddressPoolAssociationsImportId(id)
+			return err
+		}, importNetworkInterfaceBackendAddressPoolAssociations),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"backend_address_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"network_interface": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"network_interface_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"ip_configuration_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkInterfaceBackendAddressPoolAssociationsCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	poolsClient := meta.(*clients.Client).LoadBalancers.LoadBalancerBackendAddressPoolsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Network Interface <-> Load Balancer Backend Address Pool Associations creation.")
+
+	backendAddressPoolId := d.Get("backend_address_pool_id").(string)
+	poolId, err := parseNetworkInterfaceBackendAddressPoolId(backendAddressPoolId)
+	if err != nil {
+		return err
+	}
+
+	toAdd := expandNetworkInterfaceBackendAddressPoolAssociations(d.Get("network_interface").(*pluginsdk.Set).List())
+
+	// an association which already exists is managed elsewhere (or by another instance of this resource)
+	associated, err := retrieveNetworkInterfaceBackendAddressPoolAssociations(ctx, poolsClient, *poolId)
+	if err != nil {
+		return err
+	}
+	if associated == nil {
+		return fmt.Errorf("Backend Address Pool %q (Load Balancer %q / Resource Group %q) was not found", poolId.name, poolId.loadBalancerName, poolId.resourceGroup)
+	}
+	existing := make([]networkInterfaceBackendAddressPoolAssociation, 0)
+	for _, association := range toAdd {
+		if _, ok := associated[association.key()]; ok {
+			existing = append(existing, association)
+		}
+	}
+	if len(existing) > 0 {
+		return tf.ImportAsExistsError("azurerm_network_interface_backend_address_pool_associations", networkInterfaceBackendAddressPoolAssociationsImportId(backendAddressPoolId, existing))
+	}
+
+	poolIPVersions, err := retrieveBackendAddressPoolIPVersions(ctx, meta.(*clients.Client).LoadBalancers.LoadBalancersClient, meta.(*clients.Client).Network.PublicIPsClient, backendAddressPoolId)
+	if err != nil {
+		return err
+	}
+
+	if err := updateNetworkInterfaceBackendAddressPoolAssociations(ctx, client, backendAddressPoolId, poolIPVersions, toAdd, nil); err != nil {
+		return err
+	}
+
+	d.SetId(networkInterfaceBackendAddressPoolAssociationsId(backendAddressPoolId, toAdd))
+
+	return resourceNetworkInterfaceBackendAddressPoolAssociationsRead(d, meta)
+}
+
+func resourceNetworkInterfaceBackendAddressPoolAssociationsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LoadBalancers.LoadBalancerBackendAddressPoolsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	backendAddressPoolId, err := parseNetworkInterfaceBackendAddressPoolAssociationsId(d.Id())
+	if err != nil {
+		return err
+	}
+	id, err := parseNetworkInterfaceBackendAddressPoolId(backendAddressPoolId)
+	if err != nil {
+		return err
+	}
+
+	associated, err := retrieveNetworkInterfaceBackendAddressPoolAssociations(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+	if associated == nil {
+		log.Printf("[DEBUG] Backend Address Pool %q (Load Balancer %q / Resource Group %q) was not found - removing from state!", id.name, id.loadBalancerName, id.resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	// other associations may exist for this Backend Address Pool which aren't managed by this resource,
+	// so only the associations present in the state are tracked
+	associations := make([]interface{}, 0)
+	for _, association := range expandNetworkInterfaceBackendAddressPoolAssociations(d.Get("network_interface").(*pluginsdk.Set).List()) {
+		if _, ok := associated[association.key()]; !ok {
+			log.Printf("[DEBUG] Association between IP Configuration %q of Network Interface %q and Backend Address Pool %q was not found - removing from state!", association.ipConfigurationName, association.networkInterfaceId, backendAddressPoolId)
+			continue
+		}
+		associations = append(associations, association.flatten())
+	}
+
+	if len(associations) == 0 {
+		log.Printf("[DEBUG] None of the associations with Backend Address Pool %q were found - removing from state!", backendAddressPoolId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend_address_pool_id", backendAddressPoolId)
+	if err := d.Set("network_interface", associations); err != nil {
+		return fmt.Errorf("setting `network_interface`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkInterfaceBackendAddressPoolAssociationsUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	if d.HasChange("network_interface") {
+		oldRaw, newRaw := d.GetChange("network_interface")
+		oldSet := oldRaw.(*pluginsdk.Set)
+		newSet := newRaw.(*pluginsdk.Set)
+
+		toAdd := expandNetworkInterfaceBackendAddressPoolAssociations(newSet.Difference(oldSet).List())
+		toRemove := expandNetworkInterfaceBackendAddressPoolAssociations(oldSet.Difference(newSet).List())
+
+		backendAddressPoolId := d.Get("backend_address_pool_id").(string)
+		var poolIPVersions map[network.IPVersion][]string
+		if len(toAdd) > 0 {
+			var err error
+			poolIPVersions, err = retrieveBackendAddressPoolIPVersions(ctx, meta.(*clients.Client).LoadBalancers.LoadBalancersClient, meta.(*clients.Client).Network.PublicIPsClient, backendAddressPoolId)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := updateNetworkInterfaceBackendAddressPoolAssociations(ctx, client, backendAddressPoolId, poolIPVersions, toAdd, toRemove); err != nil {
+			return err
+		}
+	}
+
+	return resourceNetworkInterfaceBackendAddressPoolAssociationsRead(d, meta)
+}
+
+func resourceNetworkInterfaceBackendAddressPoolAssociationsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	toRemove := expandNetworkInterfaceBackendAddressPoolAssociations(d.Get("network_interface").(*pluginsdk.Set).List())
+	return updateNetworkInterfaceBackendAddressPoolAssociations(ctx, client, d.Get("backend_address_pool_id").(string), nil, nil, toRemove)
+}
+
+func importNetworkInterfaceBackendAddressPoolAssociations(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).LoadBalancers.LoadBalancerBackendAddressPoolsClient
+
+	backendAddressPoolId, associations, err := parseNetworkInterfaceBackendAddressPoolAssociationsImportId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	id, err := parseNetworkInterfaceBackendAddressPoolId(backendAddressPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	associated, err := retrieveNetworkInterfaceBackendAddressPoolAssociations(ctx, client, *id)
+	if err != nil {
+		return nil, err
+	}
+	if associated == nil {
+		return nil, fmt.Errorf("Backend Address Pool %q (Load Balancer %q / Resource Group %q) was not found", id.name, id.loadBalancerName, id.resourceGroup)
+	}
+
+	flattened := make([]interface{}, 0)
+	for _, association := range associations {
+		if _, ok := associated[association.key()]; !ok {
+			return nil, fmt.Errorf("IP Configuration %q of Network Interface %q isn't associated with Backend Address Pool %q", association.ipConfigurationName, association.networkInterfaceId, backendAddressPoolId)
+		}
+		flattened = append(flattened, association.flatten())
+	}
+
+	d.SetId(networkInterfaceBackendAddressPoolAssociationsId(backendAddressPoolId, associations))
+	d.Set("backend_address_pool_id", backendAddressPoolId)
+	if err := d.Set("network_interface", flattened); err != nil {
+		return nil, fmt.Errorf("setting `network_interface`: %+v", err)
+	}
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+// retrieveNetworkInterfaceBackendAddressPoolAssociations returns the IP Configurations of Network Interfaces which
+// are associated with the Backend Address Pool (keyed by networkInterfaceBackendAddressPoolAssociation.key()), or
+// nil when the Backend Address Pool doesn't exist. The Backend Address Pool exposes each of the associated IP
+// Configurations, which means that the associations can be read in a single request rather than one per Network Interface
+func retrieveNetworkInterfaceBackendAddressPoolAssociations(ctx context.Context, client *network.LoadBalancerBackendAddressPoolsClient, id networkInterfaceBackendAddressPoolId) (map[string]networkInterfaceBackendAddressPoolAssociation, error) {
+	pool, err := client.Get(ctx, id.resourceGroup, id.loadBalancerName, id.name)
+	if err != nil {
+		if utils.ResponseWasNotFound(pool.Response) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("retrieving Backend Address Pool %q (Load Balancer %q / Resource Group %q): %+v", id.name, id.loadBalancerName, id.resourceGroup, err)
+	}
+
+	associated := make(map[string]networkInterfaceBackendAddressPoolAssociation)
+	if props := pool.BackendAddressPoolPropertiesFormat; props != nil && props.BackendIPConfigurations != nil {
+		for _, config := range *props.BackendIPConfigurations {
+			if config.ID == nil {
+				continue
+			}
+
+			// Virtual Machine Scale Sets are associated with the Backend Address Pool directly
+			configId, err := azure.ParseAzureResourceID(*config.ID)
+			if err != nil || configId.Path["virtualMachineScaleSets"] != "" || configId.Path["networkInterfaces"] == "" {
+				continue
+			}
+
+			association := networkInterfaceBackendAddressPoolAssociation{
+				networkInterfaceId:  strings.TrimSuffix(*config.ID, fmt.Sprintf("/ipConfigurations/%s", configId.Path["ipConfigurations"])),
+				ipConfigurationName: configId.Path["ipConfigurations"],
+			}
+			associated[association.key()] = association
+		}
+	}
+
+	return associated, nil
+}
+
+// networkInterfaceBackendAddressPoolAssociationsId returns the ID for this resource, which is the ID of the Backend
+// Address Pool combined with a hash of the associations present when it was created - since multiple instances of
+// this resource can manage (distinct) associations for the same Backend Address Pool
+func networkInterfaceBackendAddressPoolAssociationsId(backendAddressPoolId string, associations []networkInterfaceBackendAddressPoolAssociation) string {
+	keys := make([]string, 0)
+	for _, association := range associations {
+		keys = append(keys, association.key())
+	}
+	sort.Strings(keys)
+
+	return fmt.Sprintf("%s|%d", backendAddressPoolId, pluginsdk.HashString(strings.Join(keys, ";")))
+}
+
+func parseNetworkInterfaceBackendAddressPoolAssociationsId(input string) (string, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 || segments[1] == "" {
+		return "", fmt.Errorf("expected ID to be in the format {backendAddressPoolId}|{hash} but got %q", input)
+	}
+
+	if _, err := parseNetworkInterfaceBackendAddressPoolId(segments[0]); err != nil {
+		return "", err
+	}
+
+	return segments[0], nil
+}
+
+// networkInterfaceBackendAddressPoolAssociationsImportId returns the ID used to import the specified associations
+func networkInterfaceBackendAddressPoolAssociationsImportId(backendAddressPoolId string, associations []networkInterfaceBackendAddressPoolAssociation) string {
+	ipConfigurationIds := make([]string, 0)
+	for _, association := range associations {
+		ipConfigurationIds = append(ipConfigurationIds, fmt.Sprintf("%s/ipConfigurations/%s", association.networkInterfaceId, association.ipConfigurationName))
+	}
+
+	return fmt.Sprintf("%s|%s", backendAddressPoolId, strings.Join(ipConfigurationIds, ","))
+}
+
+func parseNetworkInterfaceBackendAddressPoolAssociationsImportId(input string) (string, []networkInterfaceBackendAddressPoolAssociation, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 || segments[1] == "" {
+		return "", nil, fmt.Errorf("expected ID to be in the format {backendAddressPoolId}|{networkInterfaceId}/ipConfigurations/{ipConfigurationName}[,...] but got %q", input)
+	}
+
+	if _, err := parseNetworkInterfaceBackendAddressPoolId(segments[0]); err != nil {
+		return "", nil, err
+	}
+
+	associations := make([]networkInterfaceBackendAddressPoolAssociation, 0)
+	for _, ipConfigurationId := range strings.Split(segments[1], ",") {
+		id, err := azure.ParseAzureResourceID(ipConfigurationId)
+		if err != nil {
+			return "", nil, err
+		}
+
+		ipConfigurationName := id.Path["ipConfigurations"]
+		if id.Path["networkInterfaces"] == "" || ipConfigurationName == "" {
+			return "", nil, fmt.Errorf("expected %q to be a Network Interface IP Configuration ID", ipConfigurationId)
+		}
+
+		associations = append(associations, networkInterfaceBackendAddressPoolAssociation{
+			networkInterfaceId:  strings.TrimSuffix(ipConfigurationId, fmt.Sprintf("/ipConfigurations/%s", ipConfigurationName)),
+			ipConfigurationName: ipConfigurationName,
+		})
+	}
+
+	return segments[0], associations, nil
+}
+
+type networkInterfaceBackendAddressPoolId struct {
+	resourceGroup    string
+	loadBalancerName string
+	name             string
+}
+
+func parseNetworkInterfaceBackendAddressPoolId(input string) (*networkInterfaceBackendAddressPoolId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	loadBalancerName := id.Path["loadBalancers"]
+	name := id.Path["backendAddressPools"]
+	if loadBalancerName == "" || name == "" {
+		return nil, fmt.Errorf("expected %q to be a Load Balancer Backend Address Pool ID", input)
+	}
+
+	return &networkInterfaceBackendAddressPoolId{
+		resourceGroup:    id.ResourceGroup,
+		loadBalancerName: loadBalancerName,
+		name:             name,
+	}, nil
+}
+
+type networkInterfaceBackendAddressPoolAssociation struct {
+	networkInterfaceId  string
+	ipConfigurationName string
+}
+
+// key returns a case-insensitive identifier for this association, since the casing of the
+// Resource IDs returned from the API doesn't necessarily match the casing in the configuration
+func (a networkInterfaceBackendAddressPoolAssociation) key() string {
+	return strings.ToLower(fmt.Sprintf("%s/ipConfigurations/%s", a.networkInterfaceId, a.ipConfigurationName))
+}
+
+func (a networkInterfaceBackendAddressPoolAssociation) flatten() map[string]interface{} {
+	return map[string]interface{}{
+		"network_interface_id":  a.networkInterfaceId,
+		"ip_configuration_name": a.ipConfigurationName,
+	}
+}
+
+func expandNetworkInterfaceBackendAddressPoolAssociations(input []interface{}) []networkInterfaceBackendAddressPoolAssociation {
+	output := make([]networkInterfaceBackendAddressPoolAssociation, 0)
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+		output = append(output, networkInterfaceBackendAddressPoolAssociation{
+			networkInterfaceId:  v["network_interface_id"].(string),
+			ipConfigurationName: v["ip_configuration_name"].(string),
+		})
+	}
+	return output
+}
+
+// networkInterfaceBackendAddressPoolChanges are the IP Configurations of a single Network Interface
+// to add to and remove from the Backend Address Pool
+type networkInterfaceBackendAddressPoolChanges struct {
+	networkInterfaceId string
+	add                []string
+	remove             []string
+}
+
+// updateNetworkInterfaceBackendAddressPoolAssociations applies the specified associations concurrently,
+// making a single update per Network Interface (which is locked for the duration of the update) - the IP
+// Configurations being added are validated against the IP Versions used by the Backend Address Pool
+func updateNetworkInterfaceBackendAddressPoolAssociations(ctx context.Context, client *network.InterfacesClient, backendAddressPoolId string, poolIPVersions map[network.IPVersion][]string, toAdd, toRemove []networkInterfaceBackendAddressPoolAssociation) error {
+	changesByNetworkInterface := make(map[string]*networkInterfaceBackendAddressPoolChanges)
+	changesFor := func(networkInterfaceId string) *networkInterfaceBackendAddressPoolChanges {
+		key := strings.ToLower(networkInterfaceId)
+		if _, ok := changesByNetworkInterface[key]; !ok {
+			changesByNetworkInterface[key] = &networkInterfaceBackendAddressPoolChanges{
+				networkInterfaceId: networkInterfaceId,
+			}
+		}
+		return changesByNetworkInterface[key]
+	}
+	for _, v := range toAdd {
+		changes := changesFor(v.networkInterfaceId)
+		changes.add = append(changes.add, v.ipConfigurationName)
+	}
+	for _, v := range toRemove {
+		changes := changesFor(v.networkInterfaceId)
+		changes.remove = append(changes.remove, v.ipConfigurationName)
+	}
+
+	// sort the keys so that the Network Interfaces are updated in a consistent order
+	keys := make([]string, 0)
+	for k := range changesByNetworkInterface {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var errors *multierror.Error
+	semaphore := make(chan struct{}, networkInterfaceBackendAddressPoolAssociationsParallelism)
+	for _, k := range keys {
+		changes := *changesByNetworkInterface[k]
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := updateNetworkInterfaceBackendAddressPool(ctx, client, backendAddressPoolId, poolIPVersions, changes); err != nil {
+				mutex.Lock()
+				errors = multierror.Append(errors, err)
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.ErrorOrNil()
+}
+
+func updateNetworkInterfaceBackendAddressPool(ctx context.Context, client *network.InterfacesClient, backendAddressPoolId string, poolIPVersions map[network.IPVersion][]string, changes networkInterfaceBackendAddressPoolChanges) error {
+	id, err := azure.ParseAzureResourceID(changes.networkInterfaceId)
+	if err != nil {
+		return err
+	}
+
+	networkInterfaceName := id.Path["networkInterfaces"]
+	resourceGroup := id.ResourceGroup
+
+	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
+	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
+
+	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) && len(changes.add) == 0 {
+			// nothing to remove from a Network Interface which no longer exists
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	props := read.InterfacePropertiesFormat
+	if props == nil || props.IPConfigurations == nil {
+		return fmt.Errorf("`properties.IPConfigurations` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+	}
+
+	changed := false
+	for _, ipConfigurationName := range changes.remove {
+		c := FindNetworkInterfaceIPConfiguration(props.IPConfigurations, ipConfigurationName)
+		if c == nil || c.InterfaceIPConfigurationPropertiesFormat == nil {
+			continue
+		}
+		config := *c
+
+		pools := make([]network.BackendAddressPool, 0)
+		if existing := config.LoadBalancerBackendAddressPools; existing != nil {
+			for _, pool := range *existing {
+				if pool.ID == nil {
+					continue
+				}
+
+				if strings.EqualFold(*pool.ID, backendAddressPoolId) {
+					changed = true
+					continue
+				}
+
+				pools = append(pools, pool)
+			}
+		}
+		config.LoadBalancerBackendAddressPools = &pools
+		props.IPConfigurations = updateNetworkInterfaceIPConfiguration(config, props.IPConfigurations)
+	}
+
+	for _, ipConfigurationName := range changes.add {
+		c := FindNetworkInterfaceIPConfiguration(props.IPConfigurations, ipConfigurationName)
+		if c == nil {
+			return fmt.Errorf("IP Configuration %q was not found on Network Interface %q (Resource Group %q)", ipConfigurationName, networkInterfaceName, resourceGroup)
+		}
+		config := *c
+		if config.InterfaceIPConfigurationPropertiesFormat == nil {
+			return fmt.Errorf("`properties` was nil for IP Configuration %q of Network Interface %q (Resource Group %q)", ipConfigurationName, networkInterfaceName, resourceGroup)
+		}
+		if err := validateNetworkInterfaceIPConfigurationForBackendAddressPool(config, backendAddressPoolId, poolIPVersions); err != nil {
+			return fmt.Errorf("associating Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		}
+
+		pools := make([]network.BackendAddressPool, 0)
+		exists := false
+		if existing := config.LoadBalancerBackendAddressPools; existing != nil {
+			for _, pool := range *existing {
+				if pool.ID == nil {
+					continue
+				}
+
+				if strings.EqualFold(*pool.ID, backendAddressPoolId) {
+					exists = true
+				}
+
+				pools = append(pools, pool)
+			}
+		}
+		if exists {
+			continue
+		}
+
+		pools = append(pools, network.BackendAddressPool{
+			ID: utils.String(backendAddressPoolId),
+		})
+		config.LoadBalancerBackendAddressPools = &pools
+		props.IPConfigurations = updateNetworkInterfaceIPConfiguration(config, props.IPConfigurations)
+		changed = true
+	}
+
+	if !changed {
+		log.Printf("[DEBUG] Backend Address Pool Associations for Network Interface %q (Resource Group %q) are up to date", networkInterfaceName, resourceGroup)
+		return nil
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+	if err != nil {
+		return fmt.Errorf("updating Backend Address Pool Associations for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of Backend Address Pool Associations for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	network2 "github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkInterfaceBackendAddressPoolAssociationsResource struct {
+}
+
+func TestAccNetworkInterfaceBackendAddressPoolAssociations_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_backend_address_pool_associations", "test")
+	r := NetworkInterfaceBackendAddressPoolAssociationsResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_interface.#").HasValue("2"),
+			),
+		},
+		r.importStep(data),
+	})
+}
+
+func TestAccNetworkInterfaceBackendAddressPoolAssociations_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_backend_address_pool_associations", "test")
+	r := NetworkInterfaceBackendAddressPoolAssociationsResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_interface.#").HasValue("2"),
+			),
+		},
+		r.importStep(data),
+		{
+			Config: r.basic(data, 5),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_interface.#").HasValue("5"),
+			),
+		},
+		r.importStep(data),
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_interface.#").HasValue("1"),
+			),
+		},
+		r.importStep(data),
+	})
+}
+
+func TestAccNetworkInterfaceBackendAddressPoolAssociations_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_backend_address_pool_associations", "test")
+	r := NetworkInterfaceBackendAddressPoolAssociationsResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkInterfaceBackendAddressPoolAssociations_multipleInstances(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_backend_address_pool_associations", "test")
+	r := NetworkInterfaceBackendAddressPoolAssociationsResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleInstances(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_interface.#").HasValue("2"),
+				check.That("azurerm_network_interface_backend_address_pool_associations.second").ExistsInAzure(r),
+				check.That("azurerm_network_interface_backend_address_pool_associations.second").Key("network_interface.#").HasValue("3"),
+			),
+		},
+		r.importStep(data),
+	})
+}
+
+// importStep imports the resource using the associations in the state, since the ID of this resource
+// can't be used to import it
+func (NetworkInterfaceBackendAddressPoolAssociationsResource) importStep(data acceptance.TestData) acceptance.TestStep {
+	step := data.ImportStep()
+	step.ImportStateIdFunc = func(state *acceptance.State) (string, error) {
+		rs, ok := state.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return "", fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+
+		ipConfigurationIds := make([]string, 0)
+		for key, networkInterfaceId := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "network_interface.") || !strings.HasSuffix(key, ".network_interface_id") {
+				continue
+			}
+			ipConfigurationName := rs.Primary.Attributes[strings.TrimSuffix(key, "network_interface_id")+"ip_configuration_name"]
+			ipConfigurationIds = append(ipConfigurationIds, fmt.Sprintf("%s/ipConfigurations/%s", networkInterfaceId, ipConfigurationName))
+		}
+
+		return fmt.Sprintf("%s|%s", rs.Primary.Attributes["backend_address_pool_id"], strings.Join(ipConfigurationIds, ",")), nil
+	}
+	return step
+}
+
+func (t NetworkInterfaceBackendAddressPoolAssociationsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	backendAddressPoolId := state.Attributes["backend_address_pool_id"]
+
+	for key, networkInterfaceId := range state.Attributes {
+		if !strings.HasPrefix(key, "network_interface.") || !strings.HasSuffix(key, ".network_interface_id") {
+			continue
+		}
+		ipConfigurationName := state.Attributes[strings.TrimSuffix(key, "network_interface_id")+"ip_configuration_name"]
+
+		id, err := azure.ParseAzureResourceID(networkInterfaceId)
+		if err != nil {
+			return nil, err
+		}
+
+		read, err := clients.Network.InterfacesClient.Get(ctx, id.ResourceGroup, id.Path["networkInterfaces"], "")
+		if err != nil {
+			return nil, fmt.Errorf("retrieving Network Interface %q: %+v", networkInterfaceId, err)
+		}
+
+		if read.InterfacePropertiesFormat == nil {
+			return nil, fmt.Errorf("`properties` was nil for Network Interface %q", networkInterfaceId)
+		}
+
+		c := network2.FindNetworkInterfaceIPConfiguration(read.InterfacePropertiesFormat.IPConfigurations, ipConfigurationName)
+		if c == nil {
+			return nil, fmt.Errorf("IP Configuration %q wasn't found for Network Interface %q", ipConfigurationName, networkInterfaceId)
+		}
+
+		found := false
+		if props := c.InterfaceIPConfigurationPropertiesFormat; props != nil && props.LoadBalancerBackendAddressPools != nil {
+			for _, pool := range *props.LoadBalancerBackendAddressPools {
+				if pool.ID != nil && strings.EqualFold(*pool.ID, backendAddressPoolId) {
+					found = true
+					break
+				}
+			}
+		}
+
+		if !found {
+			return utils.Bool(false), nil
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r NetworkInterfaceBackendAddressPoolAssociationsResource) basic(data acceptance.TestData, count int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "test" {
+  count               = 5
+  name                = "acctestni-%d-${count.index}"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_network_interface_backend_address_pool_associations" "test" {
+  backend_address_pool_id = azurerm_lb_backend_address_pool.test.id
+
+  dynamic "network_interface" {
+    for_each = slice(azurerm_network_interface.test, 0, %d)
+    content {
+      network_interface_id  = network_interface.value.id
+      ip_configuration_name = "testconfiguration1"
+    }
+  }
+}
+`, NetworkInterfaceBackendAddressPoolResource{}.template(data), data.RandomInteger, count)
+}
+
+func (r NetworkInterfaceBackendAddressPoolAssociationsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_backend_address_pool_associations" "import" {
+  backend_address_pool_id = azurerm_network_interface_backend_address_pool_associations.test.backend_address_pool_id
+
+  dynamic "network_interface" {
+    for_each = azurerm_network_interface_backend_address_pool_associations.test.network_interface
+    content {
+      network_interface_id  = network_interface.value.network_interface_id
+      ip_configuration_name = network_interface.value.ip_configuration_name
+    }
+  }
+}
+`, r.basic(data, 2))
+}
+
+func (r NetworkInterfaceBackendAddressPoolAssociationsResource) multipleInstances(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "test" {
+  count               = 5
+  name                = "acctestni-%d-${count.index}"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_network_interface_backend_address_pool_associations" "test" {
+  backend_address_pool_id = azurerm_lb_backend_address_pool.test.id
+
+  dynamic "network_interface" {
+    for_each = slice(azurerm_network_interface.test, 0, 2)
+    content {
+      network_interface_id  = network_interface.value.id
+      ip_configuration_name = "testconfiguration1"
+    }
+  }
+}
+
+resource "azurerm_network_interface_backend_address_pool_associations" "second" {
+  backend_address_pool_id = azurerm_lb_backend_address_pool.test.id
+
+  dynamic "network_interface" {
+    for_each = slice(azurerm_network_interface.test, 2, 5)
+    content {
+      network_interface_id  = network_interface.value.id
+      ip_configuration_name = "testconfiguration1"
+    }
+  }
+}
+`, NetworkInterfaceBackendAddressPoolResource{}.template(data), data.RandomInteger)
+}
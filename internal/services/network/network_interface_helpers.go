@@ -1,6 +1,14 @@
 package network
 
-import "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
 
 func FindNetworkInterfaceIPConfiguration(input *[]network.InterfaceIPConfiguration, name string) *network.InterfaceIPConfiguration {
 	if input == nil {
@@ -40,3 +48,129 @@ func updateNetworkInterfaceIPConfiguration(config network.InterfaceIPConfigurati
 
 	return &output
 }
+
+// orderNetworkInterfaceIPConfigurations orders the flattened IP Configurations to match the order they're defined
+// in, since the API returns the Primary IP Configuration first - any IP Configurations which aren't defined (e.g.
+// during an import) are returned in the order they were returned from the API
+func orderNetworkInterfaceIPConfigurations(flattened []interface{}, defined []interface{}) []interface{} {
+	positions := make(map[string]int)
+	for i, raw := range defined {
+		if v, ok := raw.(map[string]interface{}); ok {
+			positions[v["name"].(string)] = i
+		}
+	}
+
+	output := make([]interface{}, len(flattened))
+	copy(output, flattened)
+	sort.SliceStable(output, func(i, j int) bool {
+		iPosition, iDefined := positions[output[i].(map[string]interface{})["name"].(string)]
+		jPosition, jDefined := positions[output[j].(map[string]interface{})["name"].(string)]
+		if iDefined && jDefined {
+			return iPosition < jPosition
+		}
+		return iDefined && !jDefined
+	})
+
+	return output
+}
+
+// networkInterfaceIPConfigurationIPVersion returns the IP Version of the IP Configuration, which defaults to IPv4
+func networkInterfaceIPConfigurationIPVersion(input network.InterfaceIPConfiguration) network.IPVersion {
+	if props := input.InterfaceIPConfigurationPropertiesFormat; props != nil && props.PrivateIPAddressVersion != "" {
+		return props.PrivateIPAddressVersion
+	}
+
+	return network.IPVersionIPv4
+}
+
+// retrieveBackendAddressPoolIPVersions returns the IP Versions of the Frontend IP Configurations used by the Load
+// Balancing Rules which target the Backend Address Pool, mapped to the names of those Load Balancing Rules
+func retrieveBackendAddressPoolIPVersions(ctx context.Context, loadBalancersClient *network.LoadBalancersClient, publicIPsClient *network.PublicIPAddressesClient, backendAddressPoolId string) (map[network.IPVersion][]string, error) {
+	poolId, err := parseNetworkInterfaceBackendAddressPoolId(backendAddressPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	loadBalancer, err := loadBalancersClient.Get(ctx, poolId.resourceGroup, poolId.loadBalancerName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Load Balancer %q (Resource Group %q): %+v", poolId.loadBalancerName, poolId.resourceGroup, err)
+	}
+
+	output := make(map[network.IPVersion][]string)
+	props := loadBalancer.LoadBalancerPropertiesFormat
+	if props == nil || props.LoadBalancingRules == nil {
+		return output, nil
+	}
+
+	frontendIPVersions := make(map[string]network.IPVersion)
+	if props.FrontendIPConfigurations != nil {
+		for _, frontend := range *props.FrontendIPConfigurations {
+			if frontend.ID == nil || frontend.FrontendIPConfigurationPropertiesFormat == nil {
+				continue
+			}
+
+			version := frontend.PrivateIPAddressVersion
+			if publicIP := frontend.PublicIPAddress; publicIP != nil && publicIP.ID != nil {
+				publicIPId, err := azure.ParseAzureResourceID(*publicIP.ID)
+				if err != nil {
+					return nil, err
+				}
+				publicIPName := publicIPId.Path["publicIPAddresses"]
+
+				resp, err := publicIPsClient.Get(ctx, publicIPId.ResourceGroup, publicIPName, "")
+				if err != nil {
+					return nil, fmt.Errorf("retrieving Public IP Address %q (Resource Group %q): %+v", publicIPName, publicIPId.ResourceGroup, err)
+				}
+				version = ""
+				if resp.PublicIPAddressPropertiesFormat != nil {
+					version = resp.PublicIPAddressVersion
+				}
+			}
+
+			if version != "" {
+				frontendIPVersions[strings.ToLower(*frontend.ID)] = version
+			}
+		}
+	}
+
+	for _, rule := range *props.LoadBalancingRules {
+		ruleProps := rule.LoadBalancingRulePropertiesFormat
+		if rule.Name == nil || ruleProps == nil || ruleProps.FrontendIPConfiguration == nil || ruleProps.FrontendIPConfiguration.ID == nil {
+			continue
+		}
+
+		if ruleProps.BackendAddressPool == nil || ruleProps.BackendAddressPool.ID == nil || !strings.EqualFold(*ruleProps.BackendAddressPool.ID, backendAddressPoolId) {
+			continue
+		}
+
+		// the IP Version of a Frontend IP Configuration using a Public IP Prefix can't be determined, so is skipped
+		if version, ok := frontendIPVersions[strings.ToLower(*ruleProps.FrontendIPConfiguration.ID)]; ok {
+			output[version] = append(output[version], *rule.Name)
+		}
+	}
+
+	return output, nil
+}
+
+// validateNetworkInterfaceIPConfigurationForBackendAddressPool checks that the IP Version of the IP Configuration
+// matches the IP Version of the Load Balancing Rules which target the Backend Address Pool, since the API otherwise
+// returns a generic error
+func validateNetworkInterfaceIPConfigurationForBackendAddressPool(config network.InterfaceIPConfiguration, backendAddressPoolId string, poolIPVersions map[network.IPVersion][]string) error {
+	version := networkInterfaceIPConfigurationIPVersion(config)
+
+	for poolVersion, rules := range poolIPVersions {
+		if poolVersion == version {
+			continue
+		}
+
+		name := ""
+		if config.Name != nil {
+			name = *config.Name
+		}
+
+		sort.Strings(rules)
+		return fmt.Errorf("the IP Configuration %q uses %s but the Backend Address Pool %q is used by the Load Balancing Rule(s) %q which use an %s Frontend IP Configuration - IP Configurations can only be associated with a Backend Address Pool of the same IP Version", name, string(version), backendAddressPoolId, strings.Join(rules, ", "), string(poolVersion))
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package network
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestOrderNetworkInterfaceIPConfigurations(t *testing.T) {
+	config := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"name": name,
+		}
+	}
+
+	testData := []struct {
+		Name      string
+		Flattened []interface{}
+		Defined   []interface{}
+		Expected  []interface{}
+	}{
+		{
+			Name:      "Nothing Defined",
+			Flattened: []interface{}{config("primary"), config("ipv6")},
+			Defined:   []interface{}{},
+			Expected:  []interface{}{config("primary"), config("ipv6")},
+		},
+		{
+			Name:      "Same Order",
+			Flattened: []interface{}{config("primary"), config("ipv6")},
+			Defined:   []interface{}{config("primary"), config("ipv6")},
+			Expected:  []interface{}{config("primary"), config("ipv6")},
+		},
+		{
+			Name:      "Secondary Defined First",
+			Flattened: []interface{}{config("primary"), config("ipv6"), config("secondary")},
+			Defined:   []interface{}{config("secondary"), config("ipv6"), config("primary")},
+			Expected:  []interface{}{config("secondary"), config("ipv6"), config("primary")},
+		},
+		{
+			Name:      "Unknown IP Configurations Last",
+			Flattened: []interface{}{config("primary"), config("other"), config("ipv6")},
+			Defined:   []interface{}{config("ipv6"), config("primary")},
+			Expected:  []interface{}{config("ipv6"), config("primary"), config("other")},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := orderNetworkInterfaceIPConfigurations(v.Flattened, v.Defined)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestValidateNetworkInterfaceIPConfigurationForBackendAddressPool(t *testing.T) {
+	poolId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1"
+	config := func(version network.IPVersion) network.InterfaceIPConfiguration {
+		return network.InterfaceIPConfiguration{
+			Name: utils.String("config1"),
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+				PrivateIPAddressVersion: version,
+			},
+		}
+	}
+
+	testData := []struct {
+		Name           string
+		Config         network.InterfaceIPConfiguration
+		PoolIPVersions map[network.IPVersion][]string
+		ExpectError    bool
+	}{
+		{
+			Name:           "No Load Balancing Rules",
+			Config:         config(network.IPVersionIPv6),
+			PoolIPVersions: map[network.IPVersion][]string{},
+			ExpectError:    false,
+		},
+		{
+			Name:   "Default Version matches IPv4",
+			Config: config(""),
+			PoolIPVersions: map[network.IPVersion][]string{
+				network.IPVersionIPv4: {"rule1"},
+			},
+			ExpectError: false,
+		},
+		{
+			Name:   "IPv6 matches IPv6",
+			Config: config(network.IPVersionIPv6),
+			PoolIPVersions: map[network.IPVersion][]string{
+				network.IPVersionIPv6: {"rule1"},
+			},
+			ExpectError: false,
+		},
+		{
+			Name:   "IPv4 doesn't match IPv6",
+			Config: config(network.IPVersionIPv4),
+			PoolIPVersions: map[network.IPVersion][]string{
+				network.IPVersionIPv6: {"rule1"},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:   "IPv6 doesn't match IPv4",
+			Config: config(network.IPVersionIPv6),
+			PoolIPVersions: map[network.IPVersion][]string{
+				network.IPVersionIPv4: {"rule1", "rule2"},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := validateNetworkInterfaceIPConfigurationForBackendAddressPool(v.Config, poolId, v.PoolIPVersions)
+		if v.ExpectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
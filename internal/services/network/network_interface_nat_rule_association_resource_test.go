@@ -3,11 +3,9 @@ package network_test
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -93,36 +91,26 @@ func TestAccNetworkInterfaceNATRuleAssociation_updateNIC(t *testing.T) {
 }
 
 func (t NetworkInterfaceNATRuleAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	splitId := strings.Split(state.ID, "|")
-	if len(splitId) != 2 {
-		return nil, fmt.Errorf("expected ID to be in the format {networkInterfaceId}|{networkSecurityGroupId} but got %q", state.ID)
-	}
-
-	nicID, err := azure.ParseAzureResourceID(splitId[0])
+	id, err := parse.NetworkInterfaceIpConfigurationNatRuleAssociationID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	ipConfigurationName := nicID.Path["ipConfigurations"]
-	networkInterfaceName := nicID.Path["networkInterfaces"]
-	resourceGroup := nicID.ResourceGroup
-	natRuleId := splitId[1]
-
-	read, err := clients.Network.InterfacesClient.Get(ctx, resourceGroup, networkInterfaceName, "")
+	read, err := clients.Network.InterfacesClient.Get(ctx, id.IpConfiguration.ResourceGroup, id.IpConfiguration.NetworkInterfaceName, "")
 	if err != nil {
-		return nil, fmt.Errorf("retrieving Network Interface %q: %+v", nicID, err)
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	c := network2.FindNetworkInterfaceIPConfiguration(read.InterfacePropertiesFormat.IPConfigurations, ipConfigurationName)
+	c := network2.FindNetworkInterfaceIPConfiguration(read.InterfacePropertiesFormat.IPConfigurations, id.IpConfiguration.IpConfigurationName)
 	if c == nil {
-		return nil, fmt.Errorf("IP Configuration %q wasn't found for Network Interface %q (Resource Group %q)", ipConfigurationName, networkInterfaceName, resourceGroup)
+		return nil, fmt.Errorf("%s was not found", id.IpConfiguration)
 	}
 	config := *c
 
 	found := false
 	if config.InterfaceIPConfigurationPropertiesFormat.LoadBalancerInboundNatRules != nil {
 		for _, rule := range *config.InterfaceIPConfigurationPropertiesFormat.LoadBalancerInboundNatRules {
-			if *rule.ID == natRuleId {
+			if *rule.ID == id.InboundNatRuleId {
 				found = true
 				break
 			}
@@ -3,15 +3,15 @@ package network
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -22,8 +22,11 @@ func resourceNetworkInterfaceSecurityGroupAssociation() *pluginsdk.Resource {
 		Create: resourceNetworkInterfaceSecurityGroupAssociationCreate,
 		Read:   resourceNetworkInterfaceSecurityGroupAssociationRead,
 		Delete: resourceNetworkInterfaceSecurityGroupAssociationDelete,
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkInterfaceNetworkSecurityGroupAssociationID(id)
+			return err
+		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -37,14 +40,14 @@ func resourceNetworkInterfaceSecurityGroupAssociation() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validate.NetworkInterfaceID,
 			},
 
 			"network_security_group_id": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: validate.NetworkSecurityGroupID,
 			},
 		},
 	}
@@ -57,63 +60,57 @@ func resourceNetworkInterfaceSecurityGroupAssociationCreate(d *pluginsdk.Resourc
 
 	log.Printf("[INFO] preparing arguments for Network Interface <-> Network Security Group Association creation.")
 
-	networkInterfaceId := d.Get("network_interface_id").(string)
-	networkSecurityGroupId := d.Get("network_security_group_id").(string)
-
-	nicId, err := azure.ParseAzureResourceID(networkInterfaceId)
+	nicId, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
 	if err != nil {
 		return err
 	}
 
-	networkInterfaceName := nicId.Path["networkInterfaces"]
-	resourceGroup := nicId.ResourceGroup
-
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
-
-	nsgId, err := azure.ParseAzureResourceID(networkSecurityGroupId)
+	nsgId, err := parse.NetworkSecurityGroupID(d.Get("network_security_group_id").(string))
 	if err != nil {
 		return err
 	}
-	nsgName := nsgId.Path["networkSecurityGroups"]
 
-	locks.ByName(nsgName, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(nsgName, networkSecurityGroupResourceName)
+	id := parse.NewNetworkInterfaceNetworkSecurityGroupAssociationID(*nicId, nsgId.ID())
 
-	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	locks.ByName(nicId.Name, networkInterfaceResourceName)
+	defer locks.UnlockByName(nicId.Name, networkInterfaceResourceName)
+
+	locks.ByName(nsgId.Name, networkSecurityGroupResourceName)
+	defer locks.UnlockByName(nsgId.Name, networkSecurityGroupResourceName)
+
+	read, err := client.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
-			return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", networkInterfaceName, resourceGroup)
+			return fmt.Errorf("%s was not found", *nicId)
 		}
 
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", *nicId, err)
 	}
 
 	props := read.InterfacePropertiesFormat
 	if props == nil {
-		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+		return fmt.Errorf("retrieving %s: `properties` was nil", *nicId)
 	}
 
 	// first double-check it doesn't exist
-	resourceId := fmt.Sprintf("%s|%s", networkInterfaceId, networkSecurityGroupId)
 	if props.NetworkSecurityGroup != nil {
-		return tf.ImportAsExistsError("azurerm_network_interface_security_group_association", resourceId)
+		return tf.ImportAsExistsError("azurerm_network_interface_security_group_association", id.ID())
 	}
 
 	props.NetworkSecurityGroup = &network.SecurityGroup{
-		ID: utils.String(networkSecurityGroupId),
+		ID: utils.String(id.NetworkSecurityGroupId),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+	future, err := client.CreateOrUpdate(ctx, nicId.ResourceGroup, nicId.Name, read)
 	if err != nil {
-		return fmt.Errorf("Error updating Security Group Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of Security Group Association for NIC %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
 	}
 
-	d.SetId(resourceId)
+	d.SetId(id.ID())
 
 	return resourceNetworkInterfaceSecurityGroupAssociationRead(d, meta)
 }
@@ -123,37 +120,29 @@ func resourceNetworkInterfaceSecurityGroupAssociationRead(d *pluginsdk.ResourceD
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	splitId := strings.Split(d.Id(), "|")
-	if len(splitId) != 2 {
-		return fmt.Errorf("Expected ID to be in the format {networkInterfaceId}|{networkSecurityGroupId} but got %q", d.Id())
-	}
-
-	nicID, err := azure.ParseAzureResourceID(splitId[0])
+	id, err := parse.NetworkInterfaceNetworkSecurityGroupAssociationID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := nicID.Path["networkInterfaces"]
-	resourceGroup := nicID.ResourceGroup
-
-	read, err := client.Get(ctx, resourceGroup, name, "")
+	read, err := client.Get(ctx, id.NetworkInterface.ResourceGroup, id.NetworkInterface.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
-			log.Printf("Network Interface %q (Resource Group %q) was not found - removing from state!", name, resourceGroup)
+			log.Printf("[DEBUG] %s was not found - removing from state!", id.NetworkInterface)
 			d.SetId("")
 			return nil
 		}
 
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", id.NetworkInterface, err)
 	}
 
 	props := read.InterfacePropertiesFormat
 	if props == nil {
-		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", name, resourceGroup)
+		return fmt.Errorf("retrieving %s: `properties` was nil", id.NetworkInterface)
 	}
 
 	if props.NetworkSecurityGroup == nil || props.NetworkSecurityGroup.ID == nil {
-		log.Printf("Network Interface %q (Resource Group %q) doesn't have a Security Group attached - removing from state!", name, resourceGroup)
+		log.Printf("[DEBUG] %s doesn't have a Network Security Group attached - removing from state!", id.NetworkInterface)
 		d.SetId("")
 		return nil
 	}
@@ -171,45 +160,38 @@ func resourceNetworkInterfaceSecurityGroupAssociationDelete(d *pluginsdk.Resourc
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	splitId := strings.Split(d.Id(), "|")
-	if len(splitId) != 2 {
-		return fmt.Errorf("Expected ID to be in the format {networkInterfaceId}/{networkSecurityGroup} but got %q", d.Id())
-	}
-
-	nicID, err := azure.ParseAzureResourceID(splitId[0])
+	id, err := parse.NetworkInterfaceNetworkSecurityGroupAssociationID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	name := nicID.Path["networkInterfaces"]
-	resourceGroup := nicID.ResourceGroup
-
-	locks.ByName(name, networkInterfaceResourceName)
-	defer locks.UnlockByName(name, networkInterfaceResourceName)
+	locks.ByName(id.NetworkInterface.Name, networkInterfaceResourceName)
+	defer locks.UnlockByName(id.NetworkInterface.Name, networkInterfaceResourceName)
 
-	read, err := client.Get(ctx, resourceGroup, name, "")
+	read, err := client.Get(ctx, id.NetworkInterface.ResourceGroup, id.NetworkInterface.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
-			return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", name, resourceGroup)
+			return fmt.Errorf("%s was not found", id.NetworkInterface)
 		}
 
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", id.NetworkInterface, err)
 	}
 
 	props := read.InterfacePropertiesFormat
 	if props == nil {
-		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", name, resourceGroup)
+		return fmt.Errorf("retrieving %s: `properties` was nil", id.NetworkInterface)
 	}
 
 	props.NetworkSecurityGroup = nil
 	read.InterfacePropertiesFormat = props
 
-	future, err := azuresdkhacks.UpdateNetworkInterfaceAllowingRemovalOfNSG(ctx, client, resourceGroup, name, read)
+	future, err := azuresdkhacks.UpdateNetworkInterfaceAllowingRemovalOfNSG(ctx, client, id.NetworkInterface.ResourceGroup, id.NetworkInterface.Name, read)
 	if err != nil {
-		return fmt.Errorf("Error updating Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
+
 	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for update of Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
 	}
 
 	return nil
@@ -3,10 +3,8 @@ package network_test
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/azuresdkhacks"
@@ -92,28 +90,20 @@ func TestAccNetworkInterfaceSecurityGroupAssociation_updateNIC(t *testing.T) {
 }
 
 func (t NetworkInterfaceNetworkSecurityGroupAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	splitId := strings.Split(state.ID, "|")
-	if len(splitId) != 2 {
-		return nil, fmt.Errorf("expected ID to be in the format {networkInterfaceId}|{networkSecurityGroupId} but got %q", state.ID)
-	}
-
-	nicID, err := azure.ParseAzureResourceID(splitId[0])
+	id, err := parse.NetworkInterfaceNetworkSecurityGroupAssociationID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	name := nicID.Path["networkInterfaces"]
-	resourceGroup := nicID.ResourceGroup
-
-	read, err := clients.Network.InterfacesClient.Get(ctx, resourceGroup, name, "")
+	read, err := clients.Network.InterfacesClient.Get(ctx, id.NetworkInterface.ResourceGroup, id.NetworkInterface.Name, "")
 	if err != nil {
-		return nil, fmt.Errorf("retrieving Network Interface %q: %+v", nicID, err)
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
 	found := false
 	if read.InterfacePropertiesFormat != nil {
 		if read.InterfacePropertiesFormat.NetworkSecurityGroup != nil && read.InterfacePropertiesFormat.NetworkSecurityGroup.ID != nil {
-			found = *read.InterfacePropertiesFormat.NetworkSecurityGroup.ID == state.Attributes["network_security_group_id"]
+			found = *read.InterfacePropertiesFormat.NetworkSecurityGroup.ID == id.NetworkSecurityGroupId
 		}
 	}
 
@@ -454,7 +454,8 @@ func resourceNetworkInterfaceRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("private_ip_address", primaryPrivateIPAddress)
 		d.Set("virtual_machine_id", virtualMachineId)
 
-		if err := d.Set("ip_configuration", flattenNetworkInterfaceIPConfigurations(props.IPConfigurations)); err != nil {
+		ipConfigurations := orderNetworkInterfaceIPConfigurations(flattenNetworkInterfaceIPConfigurations(props.IPConfigurations), d.Get("ip_configuration").([]interface{}))
+		if err := d.Set("ip_configuration", ipConfigurations); err != nil {
 			return fmt.Errorf("Error setting `ip_configuration`: %+v", err)
 		}
 
@@ -577,6 +578,14 @@ func expandNetworkInterfaceIPConfigurations(input []interface{}) (*[]network.Int
 		}
 	}
 
+	// IPv6 IP Configurations are only supported as secondary IP Configurations alongside an IPv4 Primary
+	for _, config := range ipConfigs {
+		isPrimary := len(ipConfigs) == 1 || (config.Primary != nil && *config.Primary)
+		if isPrimary && config.PrivateIPAddressVersion == network.IPVersionIPv6 {
+			return nil, fmt.Errorf("the primary `ip_configuration` %q must use `IPv4` - IPv6 is only supported for secondary IP Configurations", *config.Name)
+		}
+	}
+
 	return &ipConfigs, nil
 }
 
@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -161,6 +162,34 @@ func TestAccNetworkInterface_ipv6(t *testing.T) {
 	})
 }
 
+func TestAccNetworkInterface_ipv6SecondaryDefinedFirst(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface", "test")
+	r := NetworkInterfaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ipv6SecondaryDefinedFirst(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ip_configuration.0.name").HasValue("ipv6"),
+				check.That(data.ResourceName).Key("ip_configuration.0.private_ip_address_version").HasValue("IPv6"),
+				check.That(data.ResourceName).Key("ip_configuration.1.name").HasValue("primary"),
+				check.That(data.ResourceName).Key("ip_configuration.1.private_ip_address_version").HasValue("IPv4"),
+			),
+		},
+	})
+}
+
+func TestAccNetworkInterface_ipv6Primary(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface", "test")
+	r := NetworkInterfaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.ipv6Primary(data),
+			ExpectError: regexp.MustCompile("the primary `ip_configuration` \"primary\" must use `IPv4`"),
+		},
+	})
+}
+
 func TestAccNetworkInterface_multipleIPConfigurations(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_network_interface", "test")
 	r := NetworkInterfaceResource{}
@@ -547,6 +576,57 @@ resource "azurerm_network_interface" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r NetworkInterfaceResource) ipv6SecondaryDefinedFirst(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "ipv6"
+    private_ip_address_allocation = "Dynamic"
+    private_ip_address_version    = "IPv6"
+  }
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+    primary                       = true
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkInterfaceResource) ipv6Primary(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+    private_ip_address_version    = "IPv6"
+    primary                       = true
+  }
+
+  ip_configuration {
+    name                          = "secondary"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r NetworkInterfaceResource) multipleIPConfigurations(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
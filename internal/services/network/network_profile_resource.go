@@ -50,7 +50,6 @@ func resourceNetworkProfile() *pluginsdk.Resource {
 			"container_network_interface": {
 				Type:     pluginsdk.TypeList,
 				Required: true,
-				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -119,7 +118,10 @@ func resourceNetworkProfileCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	subnetsToLock, vnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetNames(d)
+	// when updating, Subnets which are being removed from the Network Profile also need to be locked
+	oldCNIConfigs, newCNIConfigs := d.GetChange("container_network_interface")
+	cniConfigs := append(oldCNIConfigs.([]interface{}), newCNIConfigs.([]interface{})...)
+	subnetsToLock, vnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetNames(cniConfigs)
 	if err != nil {
 		return fmt.Errorf("Error extracting names of Subnet and Virtual Network: %+v", err)
 	}
@@ -226,7 +228,7 @@ func resourceNetworkProfileDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error retrieving Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	subnetsToLock, vnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetNames(d)
+	subnetsToLock, vnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetNames(d.Get("container_network_interface").([]interface{}))
 	if err != nil {
 		return fmt.Errorf("Error extracting names of Subnet and Virtual Network: %+v", err)
 	}
@@ -287,16 +289,21 @@ func expandNetworkProfileContainerNetworkInterface(d *pluginsdk.ResourceData) *[
 	return &retCNIConfigs
 }
 
-func expandNetworkProfileVirtualNetworkSubnetNames(d *pluginsdk.ResourceData) (*[]string, *[]string, error) {
-	cniConfigs := d.Get("container_network_interface").([]interface{})
+func expandNetworkProfileVirtualNetworkSubnetNames(cniConfigs []interface{}) (*[]string, *[]string, error) {
 	subnetNames := make([]string, 0)
 	vnetNames := make([]string, 0)
 
 	for _, cniConfig := range cniConfigs {
+		if cniConfig == nil {
+			continue
+		}
 		nciData := cniConfig.(map[string]interface{})
 		ipConfigs := nciData["ip_configuration"].([]interface{})
 
 		for _, ipConfig := range ipConfigs {
+			if ipConfig == nil {
+				continue
+			}
 			ipData := ipConfig.(map[string]interface{})
 			subnetID := ipData["subnet_id"].(string)
 
@@ -76,6 +76,38 @@ func TestAccNetworkProfile_withTags(t *testing.T) {
 	})
 }
 
+func TestAccNetworkProfile_multipleContainerNetworkInterfaces(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_profile", "test")
+	r := NetworkProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.multipleContainerNetworkInterfaces(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("container_network_interface.#").HasValue("2"),
+				check.That(data.ResourceName).Key("container_network_interface.1.ip_configuration.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("container_network_interface.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccNetworkProfile_disappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_network_profile", "test")
 	r := NetworkProfileResource{}
@@ -301,3 +333,84 @@ resource "azurerm_network_profile" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
+
+func (NetworkProfileResource) multipleContainerNetworkInterfaces(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.1.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.1.0.0/24"
+
+  delegation {
+    name = "acctestdelegation-%d"
+
+    service_delegation {
+      name    = "Microsoft.ContainerInstance/containerGroups"
+      actions = ["Microsoft.Network/virtualNetworks/subnets/action"]
+    }
+  }
+}
+
+resource "azurerm_subnet" "second" {
+  name                 = "acctestsubnet2-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.1.1.0/24"
+
+  delegation {
+    name = "acctestdelegation-%d"
+
+    service_delegation {
+      name    = "Microsoft.ContainerInstance/containerGroups"
+      actions = ["Microsoft.Network/virtualNetworks/subnets/action"]
+    }
+  }
+}
+
+resource "azurerm_network_profile" "test" {
+  name                = "acctestnetprofile-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  container_network_interface {
+    name = "acctesteth-%d"
+
+    ip_configuration {
+      name      = "acctestipconfig-%d"
+      subnet_id = azurerm_subnet.test.id
+    }
+  }
+
+  container_network_interface {
+    name = "acctesteth2-%d"
+
+    ip_configuration {
+      name      = "acctestipconfig2-%d"
+      subnet_id = azurerm_subnet.test.id
+    }
+
+    ip_configuration {
+      name      = "acctestipconfig3-%d"
+      subnet_id = azurerm_subnet.second.id
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,49 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+type NetworkInterfaceApplicationSecurityGroupAssociationId struct {
+	NetworkInterface           NetworkInterfaceId
+	ApplicationSecurityGroupId string
+}
+
+func NewNetworkInterfaceApplicationSecurityGroupAssociationID(networkInterfaceId NetworkInterfaceId, applicationSecurityGroupId string) NetworkInterfaceApplicationSecurityGroupAssociationId {
+	return NetworkInterfaceApplicationSecurityGroupAssociationId{
+		NetworkInterface:           networkInterfaceId,
+		ApplicationSecurityGroupId: applicationSecurityGroupId,
+	}
+}
+
+func (id NetworkInterfaceApplicationSecurityGroupAssociationId) String() string {
+	return fmt.Sprintf("Association between %s and Application Security Group %q", id.NetworkInterface, id.ApplicationSecurityGroupId)
+}
+
+func (id NetworkInterfaceApplicationSecurityGroupAssociationId) ID() string {
+	return fmt.Sprintf("%s|%s", id.NetworkInterface.ID(), id.ApplicationSecurityGroupId)
+}
+
+// NetworkInterfaceApplicationSecurityGroupAssociationID parses a Network Interface <-> Application Security Group Association ID into an NetworkInterfaceApplicationSecurityGroupAssociationId struct
+func NetworkInterfaceApplicationSecurityGroupAssociationID(input string) (*NetworkInterfaceApplicationSecurityGroupAssociationId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format `{networkInterfaceID}|{applicationSecurityGroupID}` but got %q", input)
+	}
+
+	networkInterfaceId, err := NetworkInterfaceID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Network Interface ID %q: %+v", segments[0], err)
+	}
+
+	// whilst we need the Resource ID, we may as well validate it
+	if _, err := ApplicationSecurityGroupID(segments[1]); err != nil {
+		return nil, fmt.Errorf("parsing Application Security Group ID %q: %+v", segments[1], err)
+	}
+
+	return &NetworkInterfaceApplicationSecurityGroupAssociationId{
+		NetworkInterface:           *networkInterfaceId,
+		ApplicationSecurityGroupId: segments[1],
+	}, nil
+}
@@ -0,0 +1,82 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestNetworkInterfaceApplicationSecurityGroupAssociationID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Error  bool
+		Expect *NetworkInterfaceApplicationSecurityGroupAssociationId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "One Segment",
+			Input: "hello",
+			Error: true,
+		},
+		{
+			Name:  "Two Segments Invalid ID's",
+			Input: "hello|world",
+			Error: true,
+		},
+		{
+			Name:  "Network Interface ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/networkInterface1",
+			Error: true,
+		},
+		{
+			Name:  "Application Security Group ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/networkInterface1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationSecurityGroups/securityGroup1/extra/segment",
+			Error: true,
+		},
+		{
+			Name:  "Association ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/networkInterface1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationSecurityGroups/securityGroup1",
+			Error: false,
+			Expect: &NetworkInterfaceApplicationSecurityGroupAssociationId{
+				NetworkInterface: NetworkInterfaceId{
+					SubscriptionId: "12345678-1234-9876-4563-123456789012",
+					ResourceGroup:  "resGroup1",
+					Name:           "networkInterface1",
+				},
+				ApplicationSecurityGroupId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationSecurityGroups/securityGroup1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := NetworkInterfaceApplicationSecurityGroupAssociationID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.NetworkInterface != v.Expect.NetworkInterface {
+			t.Fatalf("Expected %+v but got %+v", v.Expect.NetworkInterface, actual.NetworkInterface)
+		}
+
+		if actual.ApplicationSecurityGroupId != v.Expect.ApplicationSecurityGroupId {
+			t.Fatalf("Expected %q but got %q", v.Expect.ApplicationSecurityGroupId, actual.ApplicationSecurityGroupId)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+type NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationId struct {
+	IpConfiguration      NetworkInterfaceIpConfigurationId
+	BackendAddressPoolId string
+}
+
+func NewNetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationID(networkInterfaceIpConfigurationId NetworkInterfaceIpConfigurationId, backendAddressPoolId string) NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationId {
+	return NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationId{
+		IpConfiguration:      networkInterfaceIpConfigurationId,
+		BackendAddressPoolId: backendAddressPoolId,
+	}
+}
+
+func (id NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationId) String() string {
+	return fmt.Sprintf("Association between %s and Application Gateway Backend Address Pool %q", id.IpConfiguration, id.BackendAddressPoolId)
+}
+
+func (id NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationId) ID() string {
+	return fmt.Sprintf("%s|%s", id.IpConfiguration.ID(), id.BackendAddressPoolId)
+}
+
+// NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationID parses a Network Interface IP Configuration <-> Application Gateway Backend Address Pool Association ID into an NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationId struct
+func NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationID(input string) (*NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format `{networkInterfaceID}/ipConfigurations/{ipConfigurationName}|{applicationGatewayBackendAddressPoolID}` but got %q", input)
+	}
+
+	networkInterfaceIpConfigurationId, err := NetworkInterfaceIpConfigurationID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Network Interface IP Configuration ID %q: %+v", segments[0], err)
+	}
+
+	// whilst we need the Resource ID, we may as well validate it
+	if _, err := ApplicationGatewayBackendAddressPoolID(segments[1]); err != nil {
+		return nil, fmt.Errorf("parsing Application Gateway Backend Address Pool ID %q: %+v", segments[1], err)
+	}
+
+	return &NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationId{
+		IpConfiguration:      *networkInterfaceIpConfigurationId,
+		BackendAddressPoolId: segments[1],
+	}, nil
+}
@@ -0,0 +1,88 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestNetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Error  bool
+		Expect *NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "One Segment",
+			Input: "hello",
+			Error: true,
+		},
+		{
+			Name:  "Two Segments Invalid ID's",
+			Input: "hello|world",
+			Error: true,
+		},
+		{
+			Name:  "Network Interface IP Configuration ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/networkInterface1/ipConfigurations/ipConfiguration1",
+			Error: true,
+		},
+		{
+			Name:  "Network Interface ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/networkInterface1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationGateways/applicationGateway1/backendAddressPools/pool1",
+			Error: true,
+		},
+		{
+			Name:  "Application Gateway Backend Address Pool ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/networkInterface1/ipConfigurations/ipConfiguration1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationGateways/applicationGateway1/backendAddressPools/pool1/extra/segment",
+			Error: true,
+		},
+		{
+			Name:  "Association ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/networkInterface1/ipConfigurations/ipConfiguration1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationGateways/applicationGateway1/backendAddressPools/pool1",
+			Error: false,
+			Expect: &NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationId{
+				IpConfiguration: NetworkInterfaceIpConfigurationId{
+					SubscriptionId:       "12345678-1234-9876-4563-123456789012",
+					ResourceGroup:        "resGroup1",
+					NetworkInterfaceName: "networkInterface1",
+					IpConfigurationName:  "ipConfiguration1",
+				},
+				BackendAddressPoolId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/applicationGateways/applicationGateway1/backendAddressPools/pool1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := NetworkInterfaceIpConfigurationApplicationGatewayBackendPoolAssociationID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.IpConfiguration != v.Expect.IpConfiguration {
+			t.Fatalf("Expected %+v but got %+v", v.Expect.IpConfiguration, actual.IpConfiguration)
+		}
+
+		if actual.BackendAddressPoolId != v.Expect.BackendAddressPoolId {
+			t.Fatalf("Expected %q but got %q", v.Expect.BackendAddressPoolId, actual.BackendAddressPoolId)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
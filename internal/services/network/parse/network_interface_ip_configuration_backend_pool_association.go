@@ -0,0 +1,51 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	loadBalancerParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
+)
+
+type NetworkInterfaceIpConfigurationBackendPoolAssociationId struct {
+	IpConfiguration      NetworkInterfaceIpConfigurationId
+	BackendAddressPoolId string
+}
+
+func NewNetworkInterfaceIpConfigurationBackendPoolAssociationID(networkInterfaceIpConfigurationId NetworkInterfaceIpConfigurationId, backendAddressPoolId string) NetworkInterfaceIpConfigurationBackendPoolAssociationId {
+	return NetworkInterfaceIpConfigurationBackendPoolAssociationId{
+		IpConfiguration:      networkInterfaceIpConfigurationId,
+		BackendAddressPoolId: backendAddressPoolId,
+	}
+}
+
+func (id NetworkInterfaceIpConfigurationBackendPoolAssociationId) String() string {
+	return fmt.Sprintf("Association between %s and Load Balancer Backend Address Pool %q", id.IpConfiguration, id.BackendAddressPoolId)
+}
+
+func (id NetworkInterfaceIpConfigurationBackendPoolAssociationId) ID() string {
+	return fmt.Sprintf("%s|%s", id.IpConfiguration.ID(), id.BackendAddressPoolId)
+}
+
+// NetworkInterfaceIpConfigurationBackendPoolAssociationID parses a Network Interface IP Configuration <-> Load Balancer Backend Address Pool Association ID into an NetworkInterfaceIpConfigurationBackendPoolAssociationId struct
+func NetworkInterfaceIpConfigurationBackendPoolAssociationID(input string) (*NetworkInterfaceIpConfigurationBackendPoolAssociationId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format `{networkInterfaceID}/ipConfigurations/{ipConfigurationName}|{backendAddressPoolID}` but got %q", input)
+	}
+
+	networkInterfaceIpConfigurationId, err := NetworkInterfaceIpConfigurationID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Network Interface IP Configuration ID %q: %+v", segments[0], err)
+	}
+
+	// whilst we need the Resource ID, we may as well validate it
+	if _, err := loadBalancerParse.LoadBalancerBackendAddressPoolID(segments[1]); err != nil {
+		return nil, fmt.Errorf("parsing Load Balancer Backend Address Pool ID %q: %+v", segments[1], err)
+	}
+
+	return &NetworkInterfaceIpConfigurationBackendPoolAssociationId{
+		IpConfiguration:      *networkInterfaceIpConfigurationId,
+		BackendAddressPoolId: segments[1],
+	}, nil
+}
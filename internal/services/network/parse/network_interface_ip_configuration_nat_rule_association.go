@@ -0,0 +1,51 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	loadBalancerParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
+)
+
+type NetworkInterfaceIpConfigurationNatRuleAssociationId struct {
+	IpConfiguration  NetworkInterfaceIpConfigurationId
+	InboundNatRuleId string
+}
+
+func NewNetworkInterfaceIpConfigurationNatRuleAssociationID(networkInterfaceIpConfigurationId NetworkInterfaceIpConfigurationId, inboundNatRuleId string) NetworkInterfaceIpConfigurationNatRuleAssociationId {
+	return NetworkInterfaceIpConfigurationNatRuleAssociationId{
+		IpConfiguration:  networkInterfaceIpConfigurationId,
+		InboundNatRuleId: inboundNatRuleId,
+	}
+}
+
+func (id NetworkInterfaceIpConfigurationNatRuleAssociationId) String() string {
+	return fmt.Sprintf("Association between %s and Load Balancer Inbound NAT Rule %q", id.IpConfiguration, id.InboundNatRuleId)
+}
+
+func (id NetworkInterfaceIpConfigurationNatRuleAssociationId) ID() string {
+	return fmt.Sprintf("%s|%s", id.IpConfiguration.ID(), id.InboundNatRuleId)
+}
+
+// NetworkInterfaceIpConfigurationNatRuleAssociationID parses a Network Interface IP Configuration <-> Load Balancer Inbound NAT Rule Association ID into an NetworkInterfaceIpConfigurationNatRuleAssociationId struct
+func NetworkInterfaceIpConfigurationNatRuleAssociationID(input string) (*NetworkInterfaceIpConfigurationNatRuleAssociationId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format `{networkInterfaceID}/ipConfigurations/{ipConfigurationName}|{inboundNatRuleID}` but got %q", input)
+	}
+
+	networkInterfaceIpConfigurationId, err := NetworkInterfaceIpConfigurationID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Network Interface IP Configuration ID %q: %+v", segments[0], err)
+	}
+
+	// whilst we need the Resource ID, we may as well validate it
+	if _, err := loadBalancerParse.LoadBalancerInboundNatRuleID(segments[1]); err != nil {
+		return nil, fmt.Errorf("parsing Load Balancer Inbound NAT Rule ID %q: %+v", segments[1], err)
+	}
+
+	return &NetworkInterfaceIpConfigurationNatRuleAssociationId{
+		IpConfiguration:  *networkInterfaceIpConfigurationId,
+		InboundNatRuleId: segments[1],
+	}, nil
+}
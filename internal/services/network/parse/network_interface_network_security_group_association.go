@@ -0,0 +1,49 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+type NetworkInterfaceNetworkSecurityGroupAssociationId struct {
+	NetworkInterface       NetworkInterfaceId
+	NetworkSecurityGroupId string
+}
+
+func NewNetworkInterfaceNetworkSecurityGroupAssociationID(networkInterfaceId NetworkInterfaceId, networkSecurityGroupId string) NetworkInterfaceNetworkSecurityGroupAssociationId {
+	return NetworkInterfaceNetworkSecurityGroupAssociationId{
+		NetworkInterface:       networkInterfaceId,
+		NetworkSecurityGroupId: networkSecurityGroupId,
+	}
+}
+
+func (id NetworkInterfaceNetworkSecurityGroupAssociationId) String() string {
+	return fmt.Sprintf("Association between %s and Network Security Group %q", id.NetworkInterface, id.NetworkSecurityGroupId)
+}
+
+func (id NetworkInterfaceNetworkSecurityGroupAssociationId) ID() string {
+	return fmt.Sprintf("%s|%s", id.NetworkInterface.ID(), id.NetworkSecurityGroupId)
+}
+
+// NetworkInterfaceNetworkSecurityGroupAssociationID parses a Network Interface <-> Network Security Group Association ID into an NetworkInterfaceNetworkSecurityGroupAssociationId struct
+func NetworkInterfaceNetworkSecurityGroupAssociationID(input string) (*NetworkInterfaceNetworkSecurityGroupAssociationId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format `{networkInterfaceID}|{networkSecurityGroupID}` but got %q", input)
+	}
+
+	networkInterfaceId, err := NetworkInterfaceID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Network Interface ID %q: %+v", segments[0], err)
+	}
+
+	// whilst we need the Resource ID, we may as well validate it
+	if _, err := NetworkSecurityGroupID(segments[1]); err != nil {
+		return nil, fmt.Errorf("parsing Network Security Group ID %q: %+v", segments[1], err)
+	}
+
+	return &NetworkInterfaceNetworkSecurityGroupAssociationId{
+		NetworkInterface:       *networkInterfaceId,
+		NetworkSecurityGroupId: segments[1],
+	}, nil
+}
@@ -135,6 +135,12 @@ func resourcePointToSiteVPNGateway() *pluginsdk.Resource {
 								},
 							},
 						},
+
+						"internet_security_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -331,7 +337,8 @@ func expandPointToSiteVPNGatewayConnectionConfiguration(input []interface{}) *[]
 				VpnClientAddressPool: &network.AddressSpace{
 					AddressPrefixes: &addressPrefixes,
 				},
-				RoutingConfiguration: expandPointToSiteVPNGatewayConnectionRouteConfiguration(raw["route"].([]interface{})),
+				RoutingConfiguration:   expandPointToSiteVPNGatewayConnectionRouteConfiguration(raw["route"].([]interface{})),
+				EnableInternetSecurity: utils.Bool(raw["internet_security_enabled"].(bool)),
 			},
 		})
 	}
@@ -384,11 +391,16 @@ func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]network.P2SCon
 		}
 
 		addressPrefixes := make([]interface{}, 0)
+		internetSecurityEnabled := false
 		if props := v.P2SConnectionConfigurationProperties; props != nil {
 			if props.VpnClientAddressPool == nil {
 				continue
 			}
 
+			if props.EnableInternetSecurity != nil {
+				internetSecurityEnabled = *props.EnableInternetSecurity
+			}
+
 			if props.VpnClientAddressPool.AddressPrefixes != nil {
 				for _, prefix := range *props.VpnClientAddressPool.AddressPrefixes {
 					addressPrefixes = append(addressPrefixes, prefix)
@@ -403,7 +415,8 @@ func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]network.P2SCon
 					"address_prefixes": addressPrefixes,
 				},
 			},
-			"route": flattenPointToSiteVPNGatewayConnectionRouteConfiguration(v.RoutingConfiguration),
+			"route":                     flattenPointToSiteVPNGatewayConnectionRouteConfiguration(v.RoutingConfiguration),
+			"internet_security_enabled": internetSecurityEnabled,
 		})
 	}
 
@@ -146,6 +146,8 @@ resource "azurerm_point_to_site_vpn_gateway" "test" {
       address_prefixes = ["172.100.0.0/14", "10.100.0.0/14"]
     }
 
+    internet_security_enabled = true
+
     route {
       associated_route_table_id = azurerm_virtual_hub_route_table.test.id
 
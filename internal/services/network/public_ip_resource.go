@@ -7,11 +7,13 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/publicipaddresses"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -56,8 +58,8 @@ func resourcePublicIp() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.IPAllocationMethodStatic),
-					string(network.IPAllocationMethodDynamic),
+					string(publicipaddresses.IPAllocationMethodStatic),
+					string(publicipaddresses.IPAllocationMethodDynamic),
 				}, false),
 			},
 
@@ -82,12 +84,12 @@ func resourcePublicIp() *pluginsdk.Resource {
 			"ip_version": {
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
-				Default:          string(network.IPVersionIPv4),
+				Default:          string(publicipaddresses.IPVersionIPvFour),
 				ForceNew:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.IPVersionIPv4),
-					string(network.IPVersionIPv6),
+					string(publicipaddresses.IPVersionIPvFour),
+					string(publicipaddresses.IPVersionIPvSix),
 				}, true),
 			},
 
@@ -95,22 +97,22 @@ func resourcePublicIp() *pluginsdk.Resource {
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
 				ForceNew:         true,
-				Default:          string(network.PublicIPAddressSkuNameBasic),
+				Default:          string(publicipaddresses.PublicIPAddressSkuNameBasic),
 				DiffSuppressFunc: suppress.CaseDifference,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.PublicIPAddressSkuNameBasic),
-					string(network.PublicIPAddressSkuNameStandard),
+					string(publicipaddresses.PublicIPAddressSkuNameBasic),
+					string(publicipaddresses.PublicIPAddressSkuNameStandard),
 				}, true),
 			},
 
 			"sku_tier": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				Default:  string(network.PublicIPAddressSkuTierRegional),
+				Default:  string(publicipaddresses.PublicIPAddressSkuTierRegional),
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(network.PublicIPAddressSkuTierGlobal),
-					string(network.PublicIPAddressSkuTierRegional),
+					string(publicipaddresses.PublicIPAddressSkuTierGlobal),
+					string(publicipaddresses.PublicIPAddressSkuTierRegional),
 				}, false),
 			},
 
@@ -127,6 +129,14 @@ func resourcePublicIp() *pluginsdk.Resource {
 				ValidateFunc: validate.PublicIpDomainNameLabel,
 			},
 
+			"domain_name_label_scope": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"domain_name_label"},
+				ValidateFunc: validation.StringInSlice(publicipaddresses.PossibleValuesForPublicIPAddressDnsSettingsDomainNameLabelScope(), false),
+			},
+
 			"fqdn": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -149,6 +159,19 @@ func resourcePublicIp() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 
+			"ddos_protection_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(publicipaddresses.DdosSettingsProtectionModeVirtualNetworkInherited),
+				ValidateFunc: validation.StringInSlice(publicipaddresses.PossibleValuesForDdosSettingsProtectionMode(), false),
+			},
+
+			"ddos_protection_plan_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
 			"ip_tags": {
 				Type:     pluginsdk.TypeMap,
 				Optional: true,
@@ -181,23 +204,23 @@ func resourcePublicIp() *pluginsdk.Resource {
 }
 
 func resourcePublicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPsClient
+	client := meta.(*clients.Client).Network.PublicIPAddressesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Public IP creation.")
 
-	id := parse.NewPublicIpAddressID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := publicipaddresses.NewPublicIPAddressID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_public_ip", id.ID())
 		}
 	}
@@ -249,7 +272,7 @@ func resourcePublicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	idleTimeout := d.Get("idle_timeout_in_minutes").(int)
-	ipVersion := network.IPVersion(d.Get("ip_version").(string))
+	ipVersion := publicipaddresses.IPVersion(d.Get("ip_version").(string))
 	ipAllocationMethod := d.Get("allocation_method").(string)
 
 	if strings.EqualFold(sku, "standard") {
@@ -258,35 +281,38 @@ func resourcePublicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	publicIp := network.PublicIPAddress{
+	skuName := publicipaddresses.PublicIPAddressSkuName(sku)
+	skuTier := publicipaddresses.PublicIPAddressSkuTier(sku_tier)
+	allocationMethod := publicipaddresses.IPAllocationMethod(ipAllocationMethod)
+	publicIp := publicipaddresses.PublicIPAddress{
 		Name:     utils.String(id.Name),
 		Location: &location,
-		Sku: &network.PublicIPAddressSku{
-			Name: network.PublicIPAddressSkuName(sku),
-			Tier: network.PublicIPAddressSkuTier(sku_tier),
+		Sku: &publicipaddresses.PublicIPAddressSku{
+			Name: &skuName,
+			Tier: &skuTier,
 		},
-		PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
-			PublicIPAllocationMethod: network.IPAllocationMethod(ipAllocationMethod),
-			PublicIPAddressVersion:   ipVersion,
-			IdleTimeoutInMinutes:     utils.Int32(int32(idleTimeout)),
+		Properties: &publicipaddresses.PublicIPAddressPropertiesFormat{
+			PublicIPAllocationMethod: &allocationMethod,
+			PublicIPAddressVersion:   &ipVersion,
+			IdleTimeoutInMinutes:     utils.Int64(int64(idleTimeout)),
 		},
-		Tags:  tags.Expand(t),
+		Tags:  expandTags(t),
 		Zones: zones,
 	}
 
 	publicIpPrefixId, publicIpPrefixIdOk := d.GetOk("public_ip_prefix_id")
 
 	if publicIpPrefixIdOk {
-		publicIpPrefix := network.SubResource{}
-		publicIpPrefix.ID = utils.String(publicIpPrefixId.(string))
-		publicIp.PublicIPAddressPropertiesFormat.PublicIPPrefix = &publicIpPrefix
+		publicIp.Properties.PublicIPPrefix = &publicipaddresses.SubResource{
+			Id: utils.String(publicIpPrefixId.(string)),
+		}
 	}
 
 	dnl, dnlOk := d.GetOk("domain_name_label")
 	rfqdn, rfqdnOk := d.GetOk("reverse_fqdn")
 
 	if dnlOk || rfqdnOk {
-		dnsSettings := network.PublicIPAddressDNSSettings{}
+		dnsSettings := publicipaddresses.PublicIPAddressDnsSettings{}
 
 		if rfqdnOk {
 			dnsSettings.ReverseFqdn = utils.String(rfqdn.(string))
@@ -296,50 +322,72 @@ func resourcePublicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 			dnsSettings.DomainNameLabel = utils.String(dnl.(string))
 		}
 
-		publicIp.PublicIPAddressPropertiesFormat.DNSSettings = &dnsSettings
+		if v, ok := d.GetOk("domain_name_label_scope"); ok {
+			domainNameLabelScope := publicipaddresses.PublicIPAddressDnsSettingsDomainNameLabelScope(v.(string))
+			dnsSettings.DomainNameLabelScope = &domainNameLabelScope
+		}
+
+		publicIp.Properties.DnsSettings = &dnsSettings
+	}
+
+	ddosProtectionMode := publicipaddresses.DdosSettingsProtectionMode(d.Get("ddos_protection_mode").(string))
+	ddosProtectionPlanId := d.Get("ddos_protection_plan_id").(string)
+	if ddosProtectionPlanId != "" && ddosProtectionMode != publicipaddresses.DdosSettingsProtectionModeEnabled {
+		return fmt.Errorf("`ddos_protection_plan_id` can only be specified when `ddos_protection_mode` is set to `%s`", string(publicipaddresses.DdosSettingsProtectionModeEnabled))
+	}
+	// the DDoS Settings are only sent when they differ from the default (or are being changed back to it), since
+	// they can't be configured on a `Basic` SKU Public IP
+	if ddosProtectionMode != publicipaddresses.DdosSettingsProtectionModeVirtualNetworkInherited || (!d.IsNewResource() && d.HasChange("ddos_protection_mode")) {
+		if strings.EqualFold(sku, "Basic") && ddosProtectionMode != publicipaddresses.DdosSettingsProtectionModeVirtualNetworkInherited {
+			return fmt.Errorf("`ddos_protection_mode` can only be set to `%s` on the `Basic` SKU", string(publicipaddresses.DdosSettingsProtectionModeVirtualNetworkInherited))
+		}
+
+		publicIp.Properties.DdosSettings = &publicipaddresses.DdosSettings{
+			ProtectionMode: &ddosProtectionMode,
+		}
+		if ddosProtectionPlanId != "" {
+			publicIp.Properties.DdosSettings.DdosProtectionPlan = &publicipaddresses.SubResource{
+				Id: utils.String(ddosProtectionPlanId),
+			}
+		}
 	}
 
 	if v, ok := d.GetOk("ip_tags"); ok {
 		ipTags := v.(map[string]interface{})
-		newIpTags := []network.IPTag{}
+		newIpTags := []publicipaddresses.IPTag{}
 
 		for key, val := range ipTags {
-			ipTag := network.IPTag{
+			ipTag := publicipaddresses.IPTag{
 				IPTagType: utils.String(key),
 				Tag:       utils.String(val.(string)),
 			}
 			newIpTags = append(newIpTags, ipTag)
 		}
 
-		publicIp.PublicIPAddressPropertiesFormat.IPTags = &newIpTags
+		publicIp.Properties.IPTags = &newIpTags
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, publicIp)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, id, publicIp); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
-	}
-
 	d.SetId(id.ID())
 	return resourcePublicIpRead(d, meta)
 }
 
 func resourcePublicIpRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPsClient
+	client := meta.(*clients.Client).Network.PublicIPAddressesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.PublicIpAddressID(d.Id())
+	id, err := publicipaddresses.ParsePublicIPAddressID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
@@ -350,73 +398,113 @@ func resourcePublicIpRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
 
-	availabilityZones := "No-Zone"
-	zonesDeprecated := make([]string, 0)
-	if resp.Zones != nil {
-		if len(*resp.Zones) > 1 {
-			availabilityZones = "Zone-Redundant"
+	if model := resp.Model; model != nil {
+		availabilityZones := "No-Zone"
+		zonesDeprecated := make([]string, 0)
+		if model.Zones != nil {
+			if len(*model.Zones) > 1 {
+				availabilityZones = "Zone-Redundant"
+			}
+			if len(*model.Zones) == 1 {
+				zones := *model.Zones
+				availabilityZones = zones[0]
+				zonesDeprecated = zones
+			}
 		}
-		if len(*resp.Zones) == 1 {
-			zones := *resp.Zones
-			availabilityZones = zones[0]
-			zonesDeprecated = zones
+
+		d.Set("availability_zone", availabilityZones)
+		d.Set("zones", zonesDeprecated)
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if sku := model.Sku; sku != nil {
+			skuName := ""
+			if sku.Name != nil {
+				skuName = string(*sku.Name)
+			}
+			d.Set("sku", skuName)
+
+			skuTier := ""
+			if sku.Tier != nil {
+				skuTier = string(*sku.Tier)
+			}
+			d.Set("sku_tier", skuTier)
 		}
-	}
 
-	d.Set("availability_zone", availabilityZones)
-	d.Set("zones", zonesDeprecated)
-	d.Set("location", location.NormalizeNilable(resp.Location))
+		if props := model.Properties; props != nil {
+			allocationMethod := ""
+			if props.PublicIPAllocationMethod != nil {
+				allocationMethod = string(*props.PublicIPAllocationMethod)
+			}
+			d.Set("allocation_method", allocationMethod)
 
-	if sku := resp.Sku; sku != nil {
-		d.Set("sku", string(sku.Name))
-		d.Set("sku_tier", string(sku.Tier))
-	}
+			ipVersion := ""
+			if props.PublicIPAddressVersion != nil {
+				ipVersion = string(*props.PublicIPAddressVersion)
+			}
+			d.Set("ip_version", ipVersion)
 
-	if props := resp.PublicIPAddressPropertiesFormat; props != nil {
-		d.Set("allocation_method", string(props.PublicIPAllocationMethod))
-		d.Set("ip_version", string(props.PublicIPAddressVersion))
+			if publicIpPrefix := props.PublicIPPrefix; publicIpPrefix != nil {
+				d.Set("public_ip_prefix_id", publicIpPrefix.Id)
+			}
 
-		if publicIpPrefix := props.PublicIPPrefix; publicIpPrefix != nil {
-			d.Set("public_ip_prefix_id", publicIpPrefix.ID)
-		}
+			if settings := props.DnsSettings; settings != nil {
+				d.Set("fqdn", settings.Fqdn)
+				d.Set("reverse_fqdn", settings.ReverseFqdn)
+				d.Set("domain_name_label", settings.DomainNameLabel)
 
-		if settings := props.DNSSettings; settings != nil {
-			d.Set("fqdn", settings.Fqdn)
-			d.Set("reverse_fqdn", settings.ReverseFqdn)
-			d.Set("domain_name_label", settings.DomainNameLabel)
-		}
+				domainNameLabelScope := ""
+				if settings.DomainNameLabelScope != nil {
+					domainNameLabelScope = string(*settings.DomainNameLabelScope)
+				}
+				d.Set("domain_name_label_scope", domainNameLabelScope)
+			}
+
+			ddosProtectionMode := string(publicipaddresses.DdosSettingsProtectionModeVirtualNetworkInherited)
+			ddosProtectionPlanId := ""
+			if settings := props.DdosSettings; settings != nil {
+				if settings.ProtectionMode != nil {
+					ddosProtectionMode = string(*settings.ProtectionMode)
+				}
+				if settings.DdosProtectionPlan != nil && settings.DdosProtectionPlan.Id != nil {
+					ddosProtectionPlanId = *settings.DdosProtectionPlan.Id
+				}
+			}
+			d.Set("ddos_protection_mode", ddosProtectionMode)
+			d.Set("ddos_protection_plan_id", ddosProtectionPlanId)
+
+			if err := d.Set("ip_tags", flattenPublicIpIPTags(props.IPTags)); err != nil {
+				return fmt.Errorf("setting `ip_tags`: %+v", err)
+			}
+
+			d.Set("ip_address", props.IPAddress)
 
-		iptags := flattenPublicIpPropsIpTags(*props.IPTags)
-		if iptags != nil {
-			d.Set("ip_tags", iptags)
+			idleTimeoutInMinutes := 0
+			if props.IdleTimeoutInMinutes != nil {
+				idleTimeoutInMinutes = int(*props.IdleTimeoutInMinutes)
+			}
+			d.Set("idle_timeout_in_minutes", idleTimeoutInMinutes)
 		}
 
-		d.Set("ip_address", props.IPAddress)
-		d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
+		return tags.FlattenAndSet(d, flattenTags(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourcePublicIpDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.PublicIPsClient
+	client := meta.(*clients.Client).Network.PublicIPAddressesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.PublicIpAddressID(d.Id())
+	id, err := publicipaddresses.ParsePublicIPAddressID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
 
@@ -430,3 +518,21 @@ func flattenPublicIpPropsIpTags(ipTags []network.IPTag) map[string]interface{} {
 	}
 	return mapIpTags
 }
+
+func flattenPublicIpIPTags(input *[]publicipaddresses.IPTag) map[string]interface{} {
+	output := make(map[string]interface{})
+	if input == nil {
+		return output
+	}
+
+	for _, tag := range *input {
+		if tag.IPTagType != nil {
+			tagValue := ""
+			if tag.Tag != nil {
+				tagValue = *tag.Tag
+			}
+			output[*tag.IPTagType] = tagValue
+		}
+	}
+	return output
+}
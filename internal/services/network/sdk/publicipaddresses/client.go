@@ -0,0 +1,15 @@
+package publicipaddresses
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PublicIPAddressesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPublicIPAddressesClientWithBaseURI(endpoint string) PublicIPAddressesClient {
+	return PublicIPAddressesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
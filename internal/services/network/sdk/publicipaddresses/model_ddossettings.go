@@ -0,0 +1,6 @@
+package publicipaddresses
+
+type DdosSettings struct {
+	DdosProtectionPlan *SubResource                `json:"ddosProtectionPlan,omitempty"`
+	ProtectionMode     *DdosSettingsProtectionMode `json:"protectionMode,omitempty"`
+}
@@ -0,0 +1,8 @@
+package publicipaddresses
+
+type PublicIPAddressDnsSettings struct {
+	DomainNameLabel      *string                                         `json:"domainNameLabel,omitempty"`
+	DomainNameLabelScope *PublicIPAddressDnsSettingsDomainNameLabelScope `json:"domainNameLabelScope,omitempty"`
+	Fqdn                 *string                                         `json:"fqdn,omitempty"`
+	ReverseFqdn          *string                                         `json:"reverseFqdn,omitempty"`
+}
@@ -0,0 +1,14 @@
+package publicipaddresses
+
+type PublicIPAddressPropertiesFormat struct {
+	DdosSettings             *DdosSettings               `json:"ddosSettings,omitempty"`
+	DnsSettings              *PublicIPAddressDnsSettings `json:"dnsSettings,omitempty"`
+	IPAddress                *string                     `json:"ipAddress,omitempty"`
+	IPTags                   *[]IPTag                    `json:"ipTags,omitempty"`
+	IdleTimeoutInMinutes     *int64                      `json:"idleTimeoutInMinutes,omitempty"`
+	ProvisioningState        *ProvisioningState          `json:"provisioningState,omitempty"`
+	PublicIPAddressVersion   *IPVersion                  `json:"publicIPAddressVersion,omitempty"`
+	PublicIPAllocationMethod *IPAllocationMethod         `json:"publicIPAllocationMethod,omitempty"`
+	PublicIPPrefix           *SubResource                `json:"publicIPPrefix,omitempty"`
+	ResourceGuid             *string                     `json:"resourceGuid,omitempty"`
+}
@@ -0,0 +1,5 @@
+package publicipaddresses
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
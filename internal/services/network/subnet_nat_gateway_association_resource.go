@@ -74,40 +74,23 @@ func resourceSubnetNatGatewayAssociationCreate(d *pluginsdk.ResourceData, meta i
 
 	locks.ByName(gatewayName, natGatewayResourceName)
 	defer locks.UnlockByName(gatewayName, natGatewayResourceName)
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
 
-	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(subnet.Response) {
-			return fmt.Errorf("Subnet %q (Virtual Network %q / Resource Group %q) was not found!", subnetName, virtualNetworkName, resourceGroup)
-		}
-		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
-	}
-
-	if props := subnet.SubnetPropertiesFormat; props != nil {
+	err = updateSubnet(ctx, client, *parsedSubnetId, func(subnet *network.Subnet) (bool, error) {
 		// check if the resources are imported
-		if gateway := props.NatGateway; gateway != nil {
+		if gateway := subnet.SubnetPropertiesFormat.NatGateway; gateway != nil {
 			if gateway.ID != nil && subnet.ID != nil {
-				return tf.ImportAsExistsError("azurerm_subnet_nat_gateway_association", *subnet.ID)
+				return false, tf.ImportAsExistsError("azurerm_subnet_nat_gateway_association", *subnet.ID)
 			}
 		}
-		props.NatGateway = &network.SubResource{
+		subnet.SubnetPropertiesFormat.NatGateway = &network.SubResource{
 			ID: utils.String(natGatewayId),
 		}
-	}
-
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, subnet)
+		return true, nil
+	})
 	if err != nil {
 		return fmt.Errorf("Error updating NAT Gateway Association for Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of NAT Gateway Association for Subnet %q (VN %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
-	}
-
 	read, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
 		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
@@ -197,29 +180,17 @@ func resourceSubnetNatGatewayAssociationDelete(d *pluginsdk.ResourceData, meta i
 	gatewayName := parsedGatewayId.Path["natGateways"]
 	locks.ByName(gatewayName, natGatewayResourceName)
 	defer locks.UnlockByName(gatewayName, natGatewayResourceName)
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
 
-	// ensure we get the latest state
-	subnet, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(subnet.Response) {
-			log.Printf("[DEBUG] Subnet %q (Virtual Network %q / Resource Group %q) could not be found - removing from state!", subnetName, virtualNetworkName, resourceGroup)
-			return nil
+	err = removeFromSubnet(ctx, client, *id, func(subnet *network.Subnet) (bool, error) {
+		if subnet.SubnetPropertiesFormat.NatGateway == nil {
+			return false, nil
 		}
-		return fmt.Errorf("Error retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
-	}
-
-	subnet.SubnetPropertiesFormat.NatGateway = nil // remove the nat gateway from subnet
-
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualNetworkName, subnetName, subnet)
+		subnet.SubnetPropertiesFormat.NatGateway = nil // remove the nat gateway from subnet
+		return true, nil
+	})
 	if err != nil {
 		return fmt.Errorf("Error removing NAT Gateway Association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for removal of NAT Gateway Association from Subnet %q (Virtual Network %q / Resource Group %q): %+v", subnetName, virtualNetworkName, resourceGroup, err)
-	}
-
 	return nil
 }
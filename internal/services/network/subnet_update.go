@@ -0,0 +1,153 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// updateSubnet is used by each of the resources which update an existing Subnet (for example the association
+// resources), such that all updates to a Subnet are queued behind the same locks, taken in the same order.
+//
+// Since the Subnet can also be updated outside of Terraform (for example when a Service Endpoint or Delegation
+// is being provisioned) the update is retried when Azure reports that another operation is in progress - with
+// `update` being called against the latest version of the Subnet each time. `update` returns false when the
+// Subnet doesn't need to be updated.
+func updateSubnet(ctx context.Context, client *network.SubnetsClient, id parse.SubnetId, update func(subnet *network.Subnet) (bool, error)) error {
+	return updateSubnetWithRetry(ctx, client, id, false, update)
+}
+
+// removeFromSubnet is used by the Delete functions of the resources which update an existing Subnet - and behaves
+// the same as updateSubnet, other than treating a Subnet which no longer exists as having been updated.
+func removeFromSubnet(ctx context.Context, client *network.SubnetsClient, id parse.SubnetId, update func(subnet *network.Subnet) (bool, error)) error {
+	return updateSubnetWithRetry(ctx, client, id, true, update)
+}
+
+func updateSubnetWithRetry(ctx context.Context, client *network.SubnetsClient, id parse.SubnetId, ignoreNotFound bool, update func(subnet *network.Subnet) (bool, error)) error {
+	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+
+	locks.ByName(id.Name, SubnetResourceName)
+	defer locks.UnlockByName(id.Name, SubnetResourceName)
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	return pluginsdk.Retry(time.Until(deadline), func() *pluginsdk.RetryError {
+		subnet, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(subnet.Response) {
+				if ignoreNotFound {
+					log.Printf("[DEBUG] %s was not found - nothing to update", id)
+					return nil
+				}
+
+				return pluginsdk.NonRetryableError(fmt.Errorf("%s was not found", id))
+			}
+
+			return pluginsdk.NonRetryableError(fmt.Errorf("retrieving %s: %+v", id, err))
+		}
+
+		if subnet.SubnetPropertiesFormat == nil {
+			return pluginsdk.NonRetryableError(fmt.Errorf("retrieving %s: `properties` was nil", id))
+		}
+
+		changed, err := update(&subnet)
+		if err != nil {
+			return pluginsdk.NonRetryableError(err)
+		}
+		if !changed {
+			log.Printf("[DEBUG] %s doesn't need to be updated", id)
+			return nil
+		}
+
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, subnet)
+		if err != nil {
+			return subnetUpdateRetryError(id, ignoreNotFound, subnetUpdateFutureResponse(future), fmt.Errorf("updating %s: %+v", id, err), err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return subnetUpdateRetryError(id, ignoreNotFound, subnetUpdateFutureResponse(future), fmt.Errorf("waiting for update of %s: %+v", id, err), err)
+		}
+
+		return nil
+	})
+}
+
+// subnetUpdateFutureResponse returns the latest response for the update of a Subnet - the Future is only
+// populated once the request has been sent, so this can't be retrieved from the Future directly
+func subnetUpdateFutureResponse(future network.SubnetsCreateOrUpdateFuture) autorest.Response {
+	if future.FutureAPI == nil {
+		return autorest.Response{}
+	}
+
+	return autorest.Response{Response: future.Response()}
+}
+
+func subnetUpdateRetryError(id parse.SubnetId, ignoreNotFound bool, resp autorest.Response, wrapped error, err error) *pluginsdk.RetryError {
+	if ignoreNotFound && utils.ResponseWasNotFound(resp) {
+		log.Printf("[DEBUG] %s was not found - nothing to update", id)
+		return nil
+	}
+
+	if subnetUpdateIsRetryable(resp, err) {
+		log.Printf("[DEBUG] another operation is in progress on %s - retrying: %+v", id, err)
+		return pluginsdk.RetryableError(wrapped)
+	}
+
+	return pluginsdk.NonRetryableError(wrapped)
+}
+
+// subnetUpdateIsRetryable determines whether the failed update of a Subnet was caused by a conflicting
+// operation (or throttling) and so can be retried
+func subnetUpdateIsRetryable(resp autorest.Response, err error) bool {
+	if utils.ResponseWasStatusCode(resp, http.StatusTooManyRequests) {
+		return true
+	}
+
+	if utils.ResponseErrorIsRetryable(err) {
+		return true
+	}
+
+	if detailed, ok := err.(autorest.DetailedError); ok {
+		if statusCode, ok := detailed.StatusCode.(int); ok && statusCode == http.StatusTooManyRequests {
+			return true
+		}
+		err = detailed.Original
+	}
+
+	var serviceError *azure.ServiceError
+	switch e := err.(type) {
+	case *azure.RequestError:
+		serviceError = e.ServiceError
+	case azure.RequestError:
+		serviceError = e.ServiceError
+	case *azure.ServiceError:
+		serviceError = e
+	}
+
+	if serviceError == nil {
+		return false
+	}
+
+	for _, code := range []string{"AnotherOperationInProgress", "RetryableError"} {
+		if strings.EqualFold(serviceError.Code, code) {
+			return true
+		}
+	}
+
+	return false
+}
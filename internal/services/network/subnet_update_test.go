@@ -0,0 +1,76 @@
+package network
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestSubnetUpdateIsRetryable(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Response autorest.Response
+		Error    error
+		Expected bool
+	}{
+		{
+			Name:     "Throttled Response",
+			Response: autorest.Response{Response: &http.Response{StatusCode: http.StatusTooManyRequests}},
+			Error:    fmt.Errorf("throttled"),
+			Expected: true,
+		},
+		{
+			Name:     "Throttled Detailed Error",
+			Error:    autorest.DetailedError{StatusCode: http.StatusTooManyRequests, Original: fmt.Errorf("throttled")},
+			Expected: true,
+		},
+		{
+			Name: "Another Operation In Progress",
+			Error: autorest.DetailedError{
+				StatusCode: http.StatusConflict,
+				Original: &azure.RequestError{
+					ServiceError: &azure.ServiceError{Code: "AnotherOperationInProgress"},
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name:     "Retryable Service Error from the Long Running Operation",
+			Error:    &azure.ServiceError{Code: "RetryableError"},
+			Expected: true,
+		},
+		{
+			Name:     "Other Service Error",
+			Response: autorest.Response{Response: &http.Response{StatusCode: http.StatusBadRequest}},
+			Error: autorest.DetailedError{
+				StatusCode: http.StatusBadRequest,
+				Original: &azure.RequestError{
+					ServiceError: &azure.ServiceError{Code: "InvalidRequestFormat"},
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name:     "Error Message mentioning a Retryable Code",
+			Error:    fmt.Errorf("the subnet RetryableError is in use"),
+			Expected: false,
+		},
+		{
+			Name:     "No Response",
+			Error:    fmt.Errorf("boom"),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := subnetUpdateIsRetryable(v.Response, v.Error)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 )
 
 var ValidateWebApplicationFirewallPolicyRuleGroupName = validation.StringInSlice([]string{
+	"BadBots",
 	"crs_20_protocol_violations",
 	"crs_21_protocol_anomalies",
 	"crs_23_request_limits",
@@ -16,6 +17,7 @@ var ValidateWebApplicationFirewallPolicyRuleGroupName = validation.StringInSlice
 	"crs_42_tight_security",
 	"crs_45_trojans",
 	"General",
+	"GoodBots",
 	"REQUEST-911-METHOD-ENFORCEMENT",
 	"REQUEST-913-SCANNER-DETECTION",
 	"REQUEST-920-PROTOCOL-ENFORCEMENT",
@@ -28,6 +30,7 @@ var ValidateWebApplicationFirewallPolicyRuleGroupName = validation.StringInSlice
 	"REQUEST-942-APPLICATION-ATTACK-SQLI",
 	"REQUEST-943-APPLICATION-ATTACK-SESSION-FIXATION",
 	"REQUEST-944-APPLICATION-ATTACK-JAVA",
+	"UnknownBots",
 }, false)
 
 var ValidateWebApplicationFirewallPolicyRuleSetVersion = validation.StringInSlice([]string{
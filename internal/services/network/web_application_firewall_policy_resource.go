@@ -1,8 +1,10 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
@@ -28,6 +30,8 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 		// TODO: replace this with an importer which validates the ID during import
 		Importer: pluginsdk.DefaultImporter(),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(webApplicationFirewallPolicyCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -298,6 +302,71 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 	}
 }
 
+// webApplicationFirewallPolicyRuleSetVersions is the list of versions supported by each Managed Rule Set type
+var webApplicationFirewallPolicyRuleSetVersions = map[string][]string{
+	"Microsoft_BotManagerRuleSet": {"0.1", "1.0"},
+	"OWASP":                       {"2.2.9", "3.0", "3.1", "3.2"},
+}
+
+// webApplicationFirewallPolicyBotManagerRuleGroups is the list of Rule Groups within the Microsoft_BotManagerRuleSet,
+// all other Rule Groups belong to the OWASP (Core Rule Set) Managed Rule Set
+var webApplicationFirewallPolicyBotManagerRuleGroups = []string{
+	"BadBots",
+	"GoodBots",
+	"UnknownBots",
+}
+
+// webApplicationFirewallPolicyCustomizeDiff ensures that each Managed Rule Set is specified once, with a version and
+// Rule Group Overrides which are valid for that Rule Set type - since the API otherwise rejects these during the apply
+func webApplicationFirewallPolicyCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	managedRules := d.Get("managed_rules").([]interface{})
+	if len(managedRules) == 0 || managedRules[0] == nil {
+		return nil
+	}
+
+	ruleSetTypes := make(map[string]struct{})
+	for _, raw := range managedRules[0].(map[string]interface{})["managed_rule_set"].([]interface{}) {
+		ruleSet, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		ruleSetType := ruleSet["type"].(string)
+		ruleSetVersion := ruleSet["version"].(string)
+		if ruleSetType == "" {
+			continue
+		}
+
+		if _, exists := ruleSetTypes[ruleSetType]; exists {
+			return fmt.Errorf("the Managed Rule Set %q can only be specified once within `managed_rules`", ruleSetType)
+		}
+		ruleSetTypes[ruleSetType] = struct{}{}
+
+		if versions, ok := webApplicationFirewallPolicyRuleSetVersions[ruleSetType]; ok && ruleSetVersion != "" && !utils.SliceContainsValue(versions, ruleSetVersion) {
+			return fmt.Errorf("version %q is not supported for the Managed Rule Set %q - supported versions are: %s", ruleSetVersion, ruleSetType, strings.Join(versions, ", "))
+		}
+
+		isBotManagerRuleSet := ruleSetType == "Microsoft_BotManagerRuleSet"
+		for _, rawOverride := range ruleSet["rule_group_override"].([]interface{}) {
+			override, ok := rawOverride.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			ruleGroupName := override["rule_group_name"].(string)
+			if ruleGroupName == "" {
+				continue
+			}
+
+			if isBotManagerRuleSet != utils.SliceContainsValue(webApplicationFirewallPolicyBotManagerRuleGroups, ruleGroupName) {
+				return fmt.Errorf("the Rule Group %q is not part of the Managed Rule Set %q", ruleGroupName, ruleSetType)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceWebApplicationFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.WebApplicationFirewallPoliciesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
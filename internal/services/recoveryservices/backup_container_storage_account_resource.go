@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -85,38 +88,16 @@ func resourceBackupProtectionContainerStorageAccountCreate(d *pluginsdk.Resource
 		}
 	}
 
-	parameters := backup.ProtectionContainerResource{
-		Properties: &backup.AzureStorageContainer{
-			SourceResourceID:     &storageAccountID,
-			FriendlyName:         &accountName,
-			BackupManagementType: backup.ManagementTypeAzureStorage,
-			ContainerType:        backup.ContainerTypeStorageContainer1,
-		},
-	}
-
-	resp, err := client.Register(ctx, vaultName, resGroup, "Azure", containerName, parameters)
-	if err != nil {
-		return fmt.Errorf("Error registering backup protection container %s (Vault %s): %+v", containerName, vaultName, err)
-	}
-
-	locationURL, err := resp.Response.Location() // Operation ID found in the Location header
-	if locationURL == nil || err != nil {
-		return fmt.Errorf("Unable to determine operation URL for protection container registration status for %s. (Vault %s): Location header missing or empty", containerName, vaultName)
-	}
-
-	opResourceID := handleAzureSdkForGoBug2824(locationURL.Path)
-
-	parsedLocation, err := azure.ParseAzureResourceID(opResourceID)
-	if err != nil {
+	// Storage Accounts created after the last discovery of the vault can't be registered until the vault is refreshed
+	if err := resourceBackupProtectionContainerRefresh(ctx, client, opStatusClient, d, vaultName, resGroup, "Azure"); err != nil {
 		return err
 	}
 
-	operationID := parsedLocation.Path["operationResults"]
-	if _, err = resourceBackupProtectionContainerStorageAccountWaitForOperation(ctx, opStatusClient, vaultName, resGroup, operationID, d); err != nil {
+	if err := resourceBackupProtectionContainerStorageAccountRegister(ctx, client, opStatusClient, d, vaultName, resGroup, storageAccountID, accountName, containerName); err != nil {
 		return err
 	}
 
-	resp, err = client.Get(ctx, vaultName, resGroup, "Azure", containerName)
+	resp, err := client.Get(ctx, vaultName, resGroup, "Azure", containerName)
 	if err != nil {
 		return fmt.Errorf("Error retrieving site recovery protection container %s (Vault %s): %+v", containerName, vaultName, err)
 	}
@@ -171,11 +152,79 @@ func resourceBackupProtectionContainerStorageAccountDelete(d *pluginsdk.Resource
 	fabricName := id.Path["backupFabrics"]
 	containerName := id.Path["protectionContainers"]
 
-	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
-	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
+	return resourceBackupProtectionContainerStorageAccountUnregister(ctx, meta.(*clients.Client), d, vaultName, resGroup, fabricName, containerName)
+}
+
+// resourceBackupProtectionContainerStorageAccountRegister registers the Storage Account as a backup protection container
+// within the vault, and then discovers the File Shares within it so that they can be protected straight away
+func resourceBackupProtectionContainerStorageAccountRegister(ctx context.Context, client *backup.ProtectionContainersClient, opClient *backup.OperationStatusesClient, d *pluginsdk.ResourceData, vaultName, resGroup, storageAccountID, accountName, containerName string) error {
+	parameters := backup.ProtectionContainerResource{
+		Properties: &backup.AzureStorageContainer{
+			SourceResourceID:     &storageAccountID,
+			FriendlyName:         &accountName,
+			BackupManagementType: backup.ManagementTypeAzureStorage,
+			ContainerType:        backup.ContainerTypeStorageContainer1,
+		},
+	}
+
+	resp, err := client.Register(ctx, vaultName, resGroup, "Azure", containerName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error registering backup protection container %s (Vault %s): %+v", containerName, vaultName, err)
+	}
+
+	locationURL, err := resp.Response.Location() // Operation ID found in the Location header
+	if locationURL == nil || err != nil {
+		return fmt.Errorf("Unable to determine operation URL for protection container registration status for %s. (Vault %s): Location header missing or empty", containerName, vaultName)
+	}
+
+	opResourceID := handleAzureSdkForGoBug2824(locationURL.Path)
+
+	parsedLocation, err := azure.ParseAzureResourceID(opResourceID)
+	if err != nil {
+		return err
+	}
+
+	operationID := parsedLocation.Path["operationResults"]
+	if _, err = resourceBackupProtectionContainerWaitForOperation(ctx, opClient, vaultName, resGroup, operationID, d); err != nil {
+		return err
+	}
+
+	// ensure the fileshares within the Storage Account are discovered, so that they can be protected straight away
+	return resourceBackupProtectionContainerInquire(ctx, client, opClient, d, vaultName, resGroup, "Azure", containerName)
+}
+
+// resourceBackupProtectionContainerStorageAccountUnregister unregisters the backup protection container from the vault,
+// purging any soft-deleted protected items within it first (when opted into)
+func resourceBackupProtectionContainerStorageAccountUnregister(ctx context.Context, meta *clients.Client, d *pluginsdk.ResourceData, vaultName, resGroup, fabricName, containerName string) error {
+	client := meta.RecoveryServices.BackupProtectionContainersClient
+	opClient := meta.RecoveryServices.BackupOperationStatusesClient
+	protectedItemsGroupClient := meta.RecoveryServices.ProtectedItemsGroupClient
+
+	// a container can't be unregistered whilst it contains soft-deleted protected items, which are otherwise only
+	// removed once the retention period of the vault has passed - so these need to be purged first (when opted into)
+	softDeletedItems, err := resourceBackupProtectionContainerStorageAccountSoftDeletedItems(ctx, protectedItemsGroupClient, vaultName, resGroup, containerName)
+	if err != nil {
+		return err
+	}
+	if len(softDeletedItems) > 0 {
+		names := make([]string, 0)
+		for name := range softDeletedItems {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		if !meta.Features.RecoveryServicesVault.PurgeSoftDeletedBackupItemsOnDestroy {
+			return fmt.Errorf("unregistering backup protection container %s (Vault %s): the container contains the soft-deleted protected items %q which must be removed first. These can either be undeleted and deleted with soft delete disabled on the vault, or Terraform can do this by setting `purge_soft_deleted_backup_items_on_destroy` to `true` within the `recovery_services_vault` block of the `features` block", containerName, vaultName, strings.Join(names, ", "))
+		}
+
+		if err := resourceBackupProtectionContainerStorageAccountPurgeSoftDeletedItems(ctx, meta, d, vaultName, resGroup, fabricName, containerName, softDeletedItems); err != nil {
+			return err
+		}
+	}
+
 	resp, err := client.Unregister(ctx, vaultName, resGroup, fabricName, containerName)
 	if err != nil {
 		return fmt.Errorf("Error deregistering backup protection container %s (Vault %s): %+v", containerName, vaultName, err)
@@ -194,53 +243,112 @@ func resourceBackupProtectionContainerStorageAccountDelete(d *pluginsdk.Resource
 	}
 	operationID := parsedLocation.Path["backupOperationResults"]
 
-	if _, err = resourceBackupProtectionContainerStorageAccountWaitForOperation(ctx, opClient, vaultName, resGroup, operationID, d); err != nil {
+	if _, err = resourceBackupProtectionContainerWaitForOperation(ctx, opClient, vaultName, resGroup, operationID, d); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// nolint unused - linter mistakenly things this function isn't used?
-func resourceBackupProtectionContainerStorageAccountWaitForOperation(ctx context.Context, client *backup.OperationStatusesClient, vaultName, resourceGroup, operationID string, d *pluginsdk.ResourceData) (backup.OperationStatus, error) {
-	state := &pluginsdk.StateChangeConf{
-		MinTimeout:                10 * time.Second,
-		Delay:                     10 * time.Second,
-		Pending:                   []string{"InProgress"},
-		Target:                    []string{"Succeeded"},
-		Refresh:                   resourceBackupProtectionContainerStorageAccountCheckOperation(ctx, client, vaultName, resourceGroup, operationID),
-		ContinuousTargetOccurence: 5, // Without this buffer, file share backups and storage account deletions may fail if performed immediately after creating/destroying the container
+// resourceBackupProtectionContainerStorageAccountSoftDeletedItems returns the soft-deleted protected items within the
+// container, keyed by the name of the protected item
+func resourceBackupProtectionContainerStorageAccountSoftDeletedItems(ctx context.Context, client *backup.ProtectedItemsGroupClient, vaultName, resourceGroup, containerName string) (map[string]backup.AzureFileshareProtectedItem, error) {
+	softDeletedItems := make(map[string]backup.AzureFileshareProtectedItem)
+
+	filter := "backupManagementType eq 'AzureStorage'"
+	iterator, err := client.ListComplete(ctx, vaultName, resourceGroup, filter, "")
+	if err != nil {
+		return nil, fmt.Errorf("listing protected items in Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
 	}
 
-	if d.IsNewResource() {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
-	} else {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing protected items in Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+		}
+
+		if item.ID == nil || item.Properties == nil {
+			continue
+		}
+
+		fileShareItem, ok := item.Properties.AsAzureFileshareProtectedItem()
+		if !ok || fileShareItem.IsScheduledForDeferredDelete == nil || !*fileShareItem.IsScheduledForDeferredDelete {
+			continue
+		}
+
+		itemId, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(*item.ID))
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(itemId.Path["protectionContainers"], containerName) {
+			continue
+		}
+
+		softDeletedItems[itemId.Path["protectedItems"]] = *fileShareItem
 	}
 
-	log.Printf("[DEBUG] Waiting for backup container operation %q (Vault %q) to complete", operationID, vaultName)
-	resp, err := state.WaitForStateContext(ctx)
+	return softDeletedItems, nil
+}
+
+// resourceBackupProtectionContainerStorageAccountPurgeSoftDeletedItems undeletes each of the soft-deleted protected
+// items and then deletes them again, which permanently deletes them provided soft delete is disabled on the vault
+func resourceBackupProtectionContainerStorageAccountPurgeSoftDeletedItems(ctx context.Context, clients *clients.Client, d *pluginsdk.ResourceData, vaultName, resourceGroup, fabricName, containerName string, items map[string]backup.AzureFileshareProtectedItem) error {
+	client := clients.RecoveryServices.ProtectedItemsClient
+	opClient := clients.RecoveryServices.BackupOperationStatusesClient
+	vaultConfigsClient := clients.RecoveryServices.VaultsConfigsClient
+
+	cfg, err := vaultConfigsClient.Get(ctx, vaultName, resourceGroup)
 	if err != nil {
-		return resp.(backup.OperationStatus), err
+		return fmt.Errorf("retrieving Config for Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+	if props := cfg.Properties; props == nil || props.SoftDeleteFeatureState != backup.SoftDeleteFeatureStateDisabled {
+		return fmt.Errorf("purging the soft-deleted protected items in backup protection container %s (Vault %s): soft delete must be disabled on the vault (`soft_delete_enabled` set to `false`) for the items to be permanently deleted", containerName, vaultName)
 	}
-	return resp.(backup.OperationStatus), nil
-}
 
-func resourceBackupProtectionContainerStorageAccountCheckOperation(ctx context.Context, client *backup.OperationStatusesClient, vaultName, resourceGroup, operationID string) pluginsdk.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		resp, err := client.Get(ctx, vaultName, resourceGroup, operationID)
+	for name, item := range items {
+		log.Printf("[DEBUG] Undeleting soft-deleted Recovery Service Protected Item %q (Container %q / Vault %q)", name, containerName, vaultName)
+		undelete := backup.ProtectedItemResource{
+			Properties: &backup.AzureFileshareProtectedItem{
+				ProtectedItemType: backup.ProtectedItemTypeAzureFileShareProtectedItem,
+				WorkloadType:      backup.DataSourceTypeAzureFileShare,
+				SourceResourceID:  item.SourceResourceID,
+				FriendlyName:      item.FriendlyName,
+				IsRehydrate:       utils.Bool(true),
+				ProtectionState:   backup.ProtectionStateProtectionStopped,
+			},
+		}
+		resp, err := client.CreateOrUpdate(ctx, vaultName, resourceGroup, fabricName, containerName, name, undelete)
 		if err != nil {
-			return resp, "Error", fmt.Errorf("Error making Read request on Recovery Service Protection Container operation %q (Vault %q in Resource Group %q): %+v", operationID, vaultName, resourceGroup, err)
+			return fmt.Errorf("undeleting soft-deleted Recovery Service Protected Item %q (Container %q / Vault %q): %+v", name, containerName, vaultName, err)
+		}
+		if err := resourceBackupProtectionContainerStorageAccountWaitForItemOperation(ctx, opClient, d, vaultName, resourceGroup, resp.Response, "operationResults"); err != nil {
+			return fmt.Errorf("waiting for soft-deleted Recovery Service Protected Item %q (Container %q / Vault %q) to be undeleted: %+v", name, containerName, vaultName, err)
 		}
 
-		if opErr := resp.Error; opErr != nil {
-			errMsg := "No upstream error message"
-			if opErr.Message != nil {
-				errMsg = *opErr.Message
-			}
-			err = fmt.Errorf("Recovery Service Protection Container operation status failed with status %q (Vault %q Resource Group %q Operation ID %q): %+v", resp.Status, vaultName, resourceGroup, operationID, errMsg)
+		log.Printf("[DEBUG] Deleting Recovery Service Protected Item %q (Container %q / Vault %q)", name, containerName, vaultName)
+		deleteResp, err := client.Delete(ctx, vaultName, resourceGroup, fabricName, containerName, name)
+		if err != nil {
+			return fmt.Errorf("deleting Recovery Service Protected Item %q (Container %q / Vault %q): %+v", name, containerName, vaultName, err)
+		}
+		if err := resourceBackupProtectionContainerStorageAccountWaitForItemOperation(ctx, opClient, d, vaultName, resourceGroup, deleteResp, "backupOperationResults"); err != nil {
+			return fmt.Errorf("waiting for Recovery Service Protected Item %q (Container %q / Vault %q) to be deleted: %+v", name, containerName, vaultName, err)
 		}
+	}
 
-		return resp, string(resp.Status), err
+	return nil
+}
+
+func resourceBackupProtectionContainerStorageAccountWaitForItemOperation(ctx context.Context, client *backup.OperationStatusesClient, d *pluginsdk.ResourceData, vaultName, resourceGroup string, resp autorest.Response, operationSegment string) error {
+	locationURL, err := resp.Response.Location()
+	if err != nil || locationURL == nil {
+		return fmt.Errorf("Location header missing or empty")
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
 	}
+
+	_, err = resourceBackupProtectionContainerWaitForOperation(ctx, client, vaultName, resourceGroup, parsedLocation.Path[operationSegment], d)
+	return err
 }
@@ -73,6 +73,7 @@ func resourceBackupProtectedFileShareCreateUpdate(d *pluginsdk.ResourceData, met
 	protectedClient := meta.(*clients.Client).RecoveryServices.ProtectedItemsGroupClient
 	protectableClient := meta.(*clients.Client).RecoveryServices.ProtectableItemsClient
 	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	containerClient := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
 	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -94,55 +95,27 @@ func resourceBackupProtectedFileShareCreateUpdate(d *pluginsdk.ResourceData, met
 		return fmt.Errorf("[ERROR] parsed source_storage_account_id '%s' doesn't contain 'storageAccounts'", storageAccountID)
 	}
 
+	containerName := fmt.Sprintf("StorageContainer;storage;%s;%s", parsedStorageAccountID.ResourceGroup, accountName)
+
 	// the fileshare has a user defined name, but its system name (fileShareSystemName) is only known to Azure Backup
-	fileShareSystemName := ""
-	// @aristosvo: preferred filter would be like below but the 'and' expression seems to fail
-	//   filter := fmt.Sprintf("backupManagementType eq 'AzureStorage' and friendlyName eq '%s'", fileShareName)
-	// this means which means we have to do it client side and loop over backupProtectedItems en backupProtectableItems until share is found
-	filter := "backupManagementType eq 'AzureStorage'"
-	backupProtectableItemsResponse, err := protectableClient.List(ctx, vaultName, resourceGroup, filter, "")
+	fileShareSystemName, err := resourceBackupProtectedFileShareFindSystemName(ctx, protectableClient, protectedClient, vaultName, resourceGroup, storageAccountID, accountName, fileShareName)
 	if err != nil {
-		return fmt.Errorf("Error checking for protectable fileshares in Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
-	}
-
-	for _, protectableItem := range backupProtectableItemsResponse.Values() {
-		if *protectableItem.Name == "" || protectableItem.Properties == nil {
-			continue
-		}
-		azureFileShareProtectableItem, check := protectableItem.Properties.AsAzureFileShareProtectableItem()
-
-		// check if protected item has the same fileshare name and is from the same storage account
-		if check && *azureFileShareProtectableItem.FriendlyName == fileShareName && *azureFileShareProtectableItem.ParentContainerFriendlyName == accountName {
-			fileShareSystemName = *protectableItem.Name
-			break
-		}
+		return err
 	}
 
-	// fileShareSystemName not found? Check if already protected by this vault!
+	// fileShareSystemName not found? The fileshare may have been created after the last inquiry of the container, in
+	// which case it only becomes protectable once the container has been inquired again
 	if fileShareSystemName == "" {
-		backupProtectedItemsResponse, err := protectedClient.List(ctx, vaultName, resourceGroup, filter, "")
-		if err != nil {
-			return fmt.Errorf("Error checking for protected fileshares in Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+		if err := resourceBackupProtectionContainerInquire(ctx, containerClient, opClient, d, vaultName, resourceGroup, "Azure", containerName); err != nil {
+			return err
 		}
 
-		for _, protectedItem := range backupProtectedItemsResponse.Values() {
-			if *protectedItem.Name == "" || protectedItem.Properties == nil {
-				continue
-			}
-			azureFileShareProtectedItem, check := protectedItem.Properties.AsAzureFileshareProtectedItem()
-
-			// check if protected item has the same fileshare name and is from the same storage account
-			if check && *azureFileShareProtectedItem.FriendlyName == fileShareName && strings.EqualFold(*azureFileShareProtectedItem.SourceResourceID, storageAccountID) {
-				fileShareSystemName = *protectedItem.Name
-				break
-			}
+		fileShareSystemName, err = resourceBackupProtectedFileShareWaitForProtectableItem(ctx, protectableClient, protectedClient, d, vaultName, resourceGroup, storageAccountID, accountName, fileShareName)
+		if err != nil {
+			return err
 		}
 	}
-	if fileShareSystemName == "" {
-		return fmt.Errorf("[ERROR] fileshare '%s' not found in protectable or protected fileshares, make sure Storage Account %q is registered with Recovery Service Vault %q (Resource Group %q)", fileShareName, accountName, vaultName, resourceGroup)
-	}
 
-	containerName := fmt.Sprintf("StorageContainer;storage;%s;%s", parsedStorageAccountID.ResourceGroup, accountName)
 	log.Printf("[DEBUG] creating/updating Recovery Service Protected File Share %q (Container Name %q)", fileShareName, containerName)
 
 	if d.IsNewResource() {
@@ -335,3 +308,88 @@ func resourceBackupProtectedFileShareCheckOperation(ctx context.Context, client
 		return resp, string(resp.Status), err
 	}
 }
+
+// resourceBackupProtectedFileShareFindSystemName returns the system name of the fileshare within the vault, or an
+// empty string when the fileshare is neither protectable nor protected by the vault
+func resourceBackupProtectedFileShareFindSystemName(ctx context.Context, protectableClient *backup.ProtectableItemsClient, protectedClient *backup.ProtectedItemsGroupClient, vaultName, resourceGroup, storageAccountID, accountName, fileShareName string) (string, error) {
+	fileShareSystemName := ""
+	// @aristosvo: preferred filter would be like below but the 'and' expression seems to fail
+	//   filter := fmt.Sprintf("backupManagementType eq 'AzureStorage' and friendlyName eq '%s'", fileShareName)
+	// this means which means we have to do it client side and loop over backupProtectedItems en backupProtectableItems until share is found
+	filter := "backupManagementType eq 'AzureStorage'"
+	backupProtectableItemsResponse, err := protectableClient.List(ctx, vaultName, resourceGroup, filter, "")
+	if err != nil {
+		return "", fmt.Errorf("Error checking for protectable fileshares in Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+
+	for _, protectableItem := range backupProtectableItemsResponse.Values() {
+		if *protectableItem.Name == "" || protectableItem.Properties == nil {
+			continue
+		}
+		azureFileShareProtectableItem, check := protectableItem.Properties.AsAzureFileShareProtectableItem()
+
+		// check if protected item has the same fileshare name and is from the same storage account
+		if check && *azureFileShareProtectableItem.FriendlyName == fileShareName && *azureFileShareProtectableItem.ParentContainerFriendlyName == accountName {
+			fileShareSystemName = *protectableItem.Name
+			break
+		}
+	}
+
+	// fileShareSystemName not found? Check if already protected by this vault!
+	if fileShareSystemName == "" {
+		backupProtectedItemsResponse, err := protectedClient.List(ctx, vaultName, resourceGroup, filter, "")
+		if err != nil {
+			return "", fmt.Errorf("Error checking for protected fileshares in Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+		}
+
+		for _, protectedItem := range backupProtectedItemsResponse.Values() {
+			if *protectedItem.Name == "" || protectedItem.Properties == nil {
+				continue
+			}
+			azureFileShareProtectedItem, check := protectedItem.Properties.AsAzureFileshareProtectedItem()
+
+			// check if protected item has the same fileshare name and is from the same storage account
+			if check && *azureFileShareProtectedItem.FriendlyName == fileShareName && strings.EqualFold(*azureFileShareProtectedItem.SourceResourceID, storageAccountID) {
+				fileShareSystemName = *protectedItem.Name
+				break
+			}
+		}
+	}
+
+	return fileShareSystemName, nil
+}
+
+// resourceBackupProtectedFileShareWaitForProtectableItem waits for a fileshare to become protectable once its container
+// has been inquired, since the inquiry completing doesn't mean the fileshare is immediately listed
+func resourceBackupProtectedFileShareWaitForProtectableItem(ctx context.Context, protectableClient *backup.ProtectableItemsClient, protectedClient *backup.ProtectedItemsGroupClient, d *pluginsdk.ResourceData, vaultName, resourceGroup, storageAccountID, accountName, fileShareName string) (string, error) {
+	state := &pluginsdk.StateChangeConf{
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+		Pending:    []string{"NotFound"},
+		Target:     []string{"Found"},
+		Refresh: func() (interface{}, string, error) {
+			name, err := resourceBackupProtectedFileShareFindSystemName(ctx, protectableClient, protectedClient, vaultName, resourceGroup, storageAccountID, accountName, fileShareName)
+			if err != nil {
+				return nil, "Error", err
+			}
+			if name == "" {
+				return name, "NotFound", nil
+			}
+			return name, "Found", nil
+		},
+	}
+
+	if d.IsNewResource() {
+		state.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+	} else {
+		state.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+	}
+
+	log.Printf("[DEBUG] Waiting for fileshare %q (Storage Account %q) to become protectable by Recovery Service Vault %q", fileShareName, accountName, vaultName)
+	resp, err := state.WaitForStateContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("waiting for fileshare %q to become protectable, make sure Storage Account %q is registered with Recovery Service Vault %q (Resource Group %q): %+v", fileShareName, accountName, vaultName, resourceGroup, err)
+	}
+
+	return resp.(string), nil
+}
@@ -59,6 +59,12 @@ func resourceRecoveryServicesBackupProtectedVM() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 
+			"undelete_on_conflict": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -100,7 +106,18 @@ func resourceRecoveryServicesBackupProtectedVMCreateUpdate(d *pluginsdk.Resource
 		}
 
 		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_backup_protected_vm", *existing.ID)
+			if !isBackupProtectedVMSoftDeleted(existing) {
+				return tf.ImportAsExistsError("azurerm_backup_protected_vm", *existing.ID)
+			}
+
+			if !d.Get("undelete_on_conflict").(bool) {
+				return fmt.Errorf("Azure Backup Protected VM %q (Resource Group %q) exists in a soft-deleted state - either set `undelete_on_conflict` to `true` to undelete and resume protection for this item, or wait until it's been permanently deleted", protectedItemName, resourceGroup)
+			}
+
+			log.Printf("[DEBUG] Undeleting soft-deleted Azure Backup Protected VM %q (Resource Group %q)", protectedItemName, resourceGroup)
+			if err := resourceRecoveryServicesBackupProtectedVMUndelete(ctx, client, vaultName, resourceGroup, containerName, protectedItemName, vmId, d); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -158,6 +175,12 @@ func resourceRecoveryServicesBackupProtectedVMRead(d *pluginsdk.ResourceData, me
 		return fmt.Errorf("Error making Read request on Azure Backup Protected VM %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
 	}
 
+	if isBackupProtectedVMSoftDeleted(resp) {
+		log.Printf("[DEBUG] Azure Backup Protected VM %q (Resource Group %q) is soft-deleted - removing from state", protectedItemName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("resource_group_name", resourceGroup)
 	d.Set("recovery_vault_name", vaultName)
 
@@ -258,6 +281,9 @@ func resourceRecoveryServicesBackupProtectedVMRefreshFunc(ctx context.Context, c
 			}
 
 			return resp, "Error", fmt.Errorf("Error making Read request on Azure Backup Protected VM %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
+		} else if !newResource && isBackupProtectedVMSoftDeleted(resp) {
+			// when Soft Delete is enabled on the Vault the item is retained in a soft-deleted state
+			return resp, "NotFound", nil
 		} else if !newResource && policyId != "" {
 			if properties := resp.Properties; properties != nil {
 				if vm, ok := properties.AsAzureIaaSComputeVMProtectedItem(); ok {
@@ -278,3 +304,59 @@ func resourceRecoveryServicesBackupProtectedVMRefreshFunc(ctx context.Context, c
 		return resp, "Found", nil
 	}
 }
+
+// resourceRecoveryServicesBackupProtectedVMUndelete undeletes (rehydrates) a soft-deleted Protected VM, which moves it into
+// a ProtectionStopped state - protection is then resumed by updating the item with the Backup Policy
+func resourceRecoveryServicesBackupProtectedVMUndelete(ctx context.Context, client *backup.ProtectedItemsClient, vaultName, resourceGroup, containerName, protectedItemName, vmId string, d *pluginsdk.ResourceData) error {
+	item := backup.ProtectedItemResource{
+		Properties: &backup.AzureIaaSComputeVMProtectedItem{
+			ProtectedItemType: backup.ProtectedItemTypeMicrosoftClassicComputevirtualMachines,
+			WorkloadType:      backup.DataSourceTypeVM,
+			SourceResourceID:  utils.String(vmId),
+			ProtectionState:   backup.ProtectionStateProtectionStopped,
+			IsRehydrate:       utils.Bool(true),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, item); err != nil {
+		return fmt.Errorf("undeleting Azure Backup Protected VM %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
+	}
+
+	state := &pluginsdk.StateChangeConf{
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Pending:    []string{"SoftDeleted"},
+		Target:     []string{"Undeleted"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+			if err != nil {
+				return resp, "Error", fmt.Errorf("retrieving Azure Backup Protected VM %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
+			}
+
+			if isBackupProtectedVMSoftDeleted(resp) {
+				return resp, "SoftDeleted", nil
+			}
+			return resp, "Undeleted", nil
+		},
+		Timeout: d.Timeout(pluginsdk.TimeoutCreate),
+	}
+
+	if _, err := state.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for Azure Backup Protected VM %q (Resource Group %q) to be undeleted: %+v", protectedItemName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func isBackupProtectedVMSoftDeleted(input backup.ProtectedItemResource) bool {
+	if input.Properties == nil {
+		return false
+	}
+
+	vm, ok := input.Properties.AsAzureIaaSComputeVMProtectedItem()
+	if !ok || vm == nil {
+		return false
+	}
+
+	return vm.IsScheduledForDeferredDelete != nil && *vm.IsScheduledForDeferredDelete
+}
@@ -0,0 +1,210 @@
+package recoveryservices
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	computeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceBackupProtectionContainerSQLWorkload() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceBackupProtectionContainerSQLWorkloadCreate,
+		Read:   resourceBackupProtectionContainerSQLWorkloadRead,
+		Delete: resourceBackupProtectionContainerSQLWorkloadDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			parsed, err := azure.ParseAzureResourceID(id)
+			if err != nil {
+				return err
+			}
+			if !strings.HasPrefix(strings.ToLower(parsed.Path["protectionContainers"]), "vmappcontainer;") {
+				return fmt.Errorf("expected the Protection Container in %q to be a VMAppContainer", id)
+			}
+			return nil
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: computeValidate.VirtualMachineID,
+			},
+		},
+	}
+}
+
+func resourceBackupProtectionContainerSQLWorkloadCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	opStatusClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+
+	vmId, err := computeParse.VirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	containerName := fmt.Sprintf("VMAppContainer;compute;%s;%s", vmId.ResourceGroup, vmId.Name)
+
+	existing, err := client.Get(ctx, vaultName, resGroup, "Azure", containerName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing backup protection container %s (Vault %s): %+v", containerName, vaultName, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_backup_protection_container_sql_workload", handleAzureSdkForGoBug2824(*existing.ID))
+	}
+
+	parameters := backup.ProtectionContainerResource{
+		Properties: &backup.AzureVMAppContainerProtectionContainer{
+			SourceResourceID:     utils.String(vmId.ID()),
+			FriendlyName:         utils.String(vmId.Name),
+			WorkloadType:         backup.WorkloadTypeSQLDataBase,
+			OperationType:        backup.OperationTypeRegister,
+			BackupManagementType: backup.ManagementTypeAzureWorkload,
+			ContainerType:        backup.ContainerTypeVMAppContainer1,
+		},
+	}
+
+	resp, err := client.Register(ctx, vaultName, resGroup, "Azure", containerName, parameters)
+	if err != nil {
+		return fmt.Errorf("registering backup protection container %s (Vault %s): %+v", containerName, vaultName, err)
+	}
+
+	locationURL, err := resp.Response.Location() // Operation ID found in the Location header
+	if locationURL == nil || err != nil {
+		return fmt.Errorf("determining operation URL for protection container registration status for %s (Vault %s): Location header missing or empty", containerName, vaultName)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+
+	operationID := parsedLocation.Path["operationResults"]
+	if _, err = resourceBackupProtectionContainerWaitForOperation(ctx, opStatusClient, vaultName, resGroup, operationID, d); err != nil {
+		return err
+	}
+
+	resp, err = client.Get(ctx, vaultName, resGroup, "Azure", containerName)
+	if err != nil {
+		return fmt.Errorf("retrieving backup protection container %s (Vault %s): %+v", containerName, vaultName, err)
+	}
+
+	d.SetId(handleAzureSdkForGoBug2824(*resp.ID))
+
+	return resourceBackupProtectionContainerSQLWorkloadRead(d, meta)
+}
+
+func resourceBackupProtectionContainerSQLWorkloadRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	fabricName := id.Path["backupFabrics"]
+	containerName := id.Path["protectionContainers"]
+
+	resp, err := client.Get(ctx, vaultName, resGroup, fabricName, containerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving backup protection container %s (Vault %s): %+v", containerName, vaultName, err)
+	}
+
+	d.Set("resource_group_name", resGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if properties, ok := resp.Properties.AsAzureVMAppContainerProtectionContainer(); ok && properties != nil {
+		virtualMachineId := ""
+		if properties.SourceResourceID != nil {
+			vmId, err := computeParse.VirtualMachineID(*properties.SourceResourceID)
+			if err != nil {
+				return err
+			}
+			virtualMachineId = vmId.ID()
+		}
+		d.Set("virtual_machine_id", virtualMachineId)
+	}
+
+	return nil
+}
+
+func resourceBackupProtectionContainerSQLWorkloadDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	fabricName := id.Path["backupFabrics"]
+	containerName := id.Path["protectionContainers"]
+
+	resp, err := client.Unregister(ctx, vaultName, resGroup, fabricName, containerName)
+	if err != nil {
+		return fmt.Errorf("unregistering backup protection container %s (Vault %s): %+v", containerName, vaultName, err)
+	}
+
+	locationURL, err := resp.Response.Location()
+	if err != nil || locationURL == nil {
+		return fmt.Errorf("unregistering backup protection container %s (Vault %s): Location header missing or empty", containerName, vaultName)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+
+	operationID := parsedLocation.Path["backupOperationResults"]
+	if _, err = resourceBackupProtectionContainerWaitForOperation(ctx, opClient, vaultName, resGroup, operationID, d); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,330 @@
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/sdk/backupresourcestorageconfigsnoncrr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	resourceGroupParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// backupProtectionContainerStorageAccountAutoParallelism is the maximum number of Storage Accounts which are
+// registered (or unregistered) with the vault at the same time
+const backupProtectionContainerStorageAccountAutoParallelism = 10
+
+func resourceBackupProtectionContainerStorageAccountAuto() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceBackupProtectionContainerStorageAccountAutoCreate,
+		Read:   resourceBackupProtectionContainerStorageAccountAutoRead,
+		Delete: resourceBackupProtectionContainerStorageAccountAutoDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"storage_account_resource_group_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceGroupName,
+			},
+
+			"file_share_name_regex": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"storage_account_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceBackupProtectionContainerStorageAccountAutoCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	opStatusClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	vaultsClient := meta.(*clients.Client).RecoveryServices.VaultsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	vaultId := backupresourcestorageconfigsnoncrr.NewVaultID(subscriptionId, d.Get("resource_group_name").(string), d.Get("recovery_vault_name").(string))
+	storageAccountResourceGroupId := resourceGroupParse.NewResourceGroupID(subscriptionId, d.Get("storage_account_resource_group_name").(string))
+
+	vault, err := vaultsClient.Get(ctx, vaultId.ResourceGroup, vaultId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", vaultId, err)
+	}
+	if vault.Location == nil {
+		return fmt.Errorf("retrieving %s: `location` was nil", vaultId)
+	}
+
+	var fileShareNameRegex *regexp.Regexp
+	if v := d.Get("file_share_name_regex").(string); v != "" {
+		fileShareNameRegex = regexp.MustCompile(v)
+	}
+
+	storageAccounts, err := resourceBackupProtectionContainerStorageAccountAutoDiscover(ctx, meta.(*clients.Client), storageAccountResourceGroupId.ResourceGroup, *vault.Location, fileShareNameRegex)
+	if err != nil {
+		return err
+	}
+
+	// Storage Accounts created after the last discovery of the vault can't be registered until the vault is refreshed
+	if err := resourceBackupProtectionContainerRefresh(ctx, client, opStatusClient, d, vaultId.Name, vaultId.ResourceGroup, "Azure"); err != nil {
+		return err
+	}
+
+	// Storage Accounts which are already registered are managed elsewhere, so are skipped rather than taken over
+	toRegister := make(map[string]string)
+	for storageAccountId, accountName := range storageAccounts {
+		containerName := fmt.Sprintf("StorageContainer;storage;%s;%s", storageAccountResourceGroupId.ResourceGroup, accountName)
+		existing, err := client.Get(ctx, vaultId.Name, vaultId.ResourceGroup, "Azure", containerName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing backup protection container %s (Vault %s): %+v", containerName, vaultId.Name, err)
+			}
+		}
+		if existing.ID != nil && *existing.ID != "" {
+			log.Printf("[DEBUG] Skipping Storage Account %q since it's already registered with %s", storageAccountId, vaultId)
+			continue
+		}
+
+		toRegister[storageAccountId] = accountName
+	}
+
+	storageAccountIds := make([]string, 0)
+	for storageAccountId := range toRegister {
+		storageAccountIds = append(storageAccountIds, storageAccountId)
+	}
+	sort.Strings(storageAccountIds)
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var errors *multierror.Error
+	registered := make([]string, 0)
+	semaphore := make(chan struct{}, backupProtectionContainerStorageAccountAutoParallelism)
+	for _, storageAccountId := range storageAccountIds {
+		storageAccountId := storageAccountId
+		accountName := toRegister[storageAccountId]
+		containerName := fmt.Sprintf("StorageContainer;storage;%s;%s", storageAccountResourceGroupId.ResourceGroup, accountName)
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			err := resourceBackupProtectionContainerStorageAccountRegister(ctx, client, opStatusClient, d, vaultId.Name, vaultId.ResourceGroup, storageAccountId, accountName, containerName)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				errors = multierror.Append(errors, err)
+				return
+			}
+			registered = append(registered, storageAccountId)
+		}()
+	}
+	wg.Wait()
+
+	// the Storage Accounts which were registered are tracked even when others failed, so that they're cleaned up
+	sort.Strings(registered)
+	d.SetId(fmt.Sprintf("%s|%s", vaultId.ID(), storageAccountResourceGroupId.ID()))
+	d.Set("storage_account_ids", registered)
+
+	if err := errors.ErrorOrNil(); err != nil {
+		return err
+	}
+
+	return resourceBackupProtectionContainerStorageAccountAutoRead(d, meta)
+}
+
+func resourceBackupProtectionContainerStorageAccountAutoRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	vaultsClient := meta.(*clients.Client).RecoveryServices.VaultsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	vaultId, storageAccountResourceGroupId, err := parseBackupProtectionContainerStorageAccountAutoId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	vault, err := vaultsClient.Get(ctx, vaultId.ResourceGroup, vaultId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(vault.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *vaultId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *vaultId, err)
+	}
+
+	// only the Storage Accounts which are still registered are tracked
+	storageAccountIds := make([]string, 0)
+	for _, v := range d.Get("storage_account_ids").([]interface{}) {
+		storageAccountId := v.(string)
+		id, err := azure.ParseAzureResourceID(storageAccountId)
+		if err != nil {
+			return err
+		}
+
+		containerName := fmt.Sprintf("StorageContainer;storage;%s;%s", id.ResourceGroup, id.Path["storageAccounts"])
+		resp, err := client.Get(ctx, vaultId.Name, vaultId.ResourceGroup, "Azure", containerName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] Backup protection container %s (Vault %s) was not found", containerName, vaultId.Name)
+				continue
+			}
+			return fmt.Errorf("retrieving backup protection container %s (Vault %s): %+v", containerName, vaultId.Name, err)
+		}
+
+		storageAccountIds = append(storageAccountIds, storageAccountId)
+	}
+
+	d.Set("resource_group_name", vaultId.ResourceGroup)
+	d.Set("recovery_vault_name", vaultId.Name)
+	d.Set("storage_account_resource_group_name", storageAccountResourceGroupId.ResourceGroup)
+	d.Set("storage_account_ids", storageAccountIds)
+
+	return nil
+}
+
+func resourceBackupProtectionContainerStorageAccountAutoDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	vaultId, _, err := parseBackupProtectionContainerStorageAccountAutoId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var errors *multierror.Error
+	semaphore := make(chan struct{}, backupProtectionContainerStorageAccountAutoParallelism)
+	for _, v := range d.Get("storage_account_ids").([]interface{}) {
+		id, err := azure.ParseAzureResourceID(v.(string))
+		if err != nil {
+			return err
+		}
+		containerName := fmt.Sprintf("StorageContainer;storage;%s;%s", id.ResourceGroup, id.Path["storageAccounts"])
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := resourceBackupProtectionContainerStorageAccountUnregister(ctx, meta.(*clients.Client), d, vaultId.Name, vaultId.ResourceGroup, "Azure", containerName); err != nil {
+				mutex.Lock()
+				errors = multierror.Append(errors, err)
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.ErrorOrNil()
+}
+
+// resourceBackupProtectionContainerStorageAccountAutoDiscover returns the Storage Accounts within the Resource Group
+// (and the same location as the vault) which contain a File Share matching the regex, keyed by the Storage Account ID
+func resourceBackupProtectionContainerStorageAccountAutoDiscover(ctx context.Context, meta *clients.Client, resourceGroup, location string, fileShareNameRegex *regexp.Regexp) (map[string]string, error) {
+	accountsClient := meta.Storage.AccountsClient
+	fileSharesClient := meta.Storage.FileSharesManagementClient
+
+	storageAccounts := make(map[string]string)
+
+	accounts, err := accountsClient.ListByResourceGroupComplete(ctx, resourceGroup)
+	if err != nil {
+		return nil, fmt.Errorf("listing Storage Accounts in Resource Group %q: %+v", resourceGroup, err)
+	}
+	for accounts.NotDone() {
+		account := accounts.Value()
+		if err := accounts.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Storage Accounts in Resource Group %q: %+v", resourceGroup, err)
+		}
+
+		if account.ID == nil || account.Name == nil || account.Location == nil {
+			continue
+		}
+		// Storage Accounts can only be protected by a vault within the same location
+		if azure.NormalizeLocation(*account.Location) != azure.NormalizeLocation(location) {
+			log.Printf("[DEBUG] Skipping Storage Account %q since it's not in the same location as the vault (%q)", *account.ID, location)
+			continue
+		}
+
+		shares, err := fileSharesClient.ListComplete(ctx, resourceGroup, *account.Name, "", "", "")
+		if err != nil {
+			return nil, fmt.Errorf("listing File Shares in Storage Account %q (Resource Group %q): %+v", *account.Name, resourceGroup, err)
+		}
+		for shares.NotDone() {
+			share := shares.Value()
+			if err := shares.NextWithContext(ctx); err != nil {
+				return nil, fmt.Errorf("listing File Shares in Storage Account %q (Resource Group %q): %+v", *account.Name, resourceGroup, err)
+			}
+
+			if share.Name == nil || (fileShareNameRegex != nil && !fileShareNameRegex.MatchString(*share.Name)) {
+				continue
+			}
+
+			storageAccounts[*account.ID] = *account.Name
+			break
+		}
+	}
+
+	return storageAccounts, nil
+}
+
+func parseBackupProtectionContainerStorageAccountAutoId(input string) (*backupresourcestorageconfigsnoncrr.VaultId, *resourceGroupParse.ResourceGroupId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, nil, fmt.Errorf("expected ID to be in the format {recoveryServicesVaultId}|{resourceGroupId} but got %q", input)
+	}
+
+	vaultId, err := backupresourcestorageconfigsnoncrr.ParseVaultID(segments[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resourceGroupId, err := resourceGroupParse.ResourceGroupID(segments[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vaultId, resourceGroupId, nil
+}
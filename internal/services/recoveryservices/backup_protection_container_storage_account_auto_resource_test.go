@@ -0,0 +1,123 @@
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/sdk/backupresourcestorageconfigsnoncrr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type BackupProtectionContainerStorageAccountAutoResource struct {
+}
+
+func TestAccBackupProtectionContainerStorageAccountAuto_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_protection_container_storage_account_auto", "test")
+	r := BackupProtectionContainerStorageAccountAutoResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage_account_ids.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (t BackupProtectionContainerStorageAccountAutoResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	segments := strings.Split(state.ID, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected ID to be in the format {recoveryServicesVaultId}|{resourceGroupId} but got %q", state.ID)
+	}
+
+	vaultId, err := backupresourcestorageconfigsnoncrr.ParseVaultID(segments[0])
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range state.Attributes {
+		if !strings.HasPrefix(k, "storage_account_ids.") || k == "storage_account_ids.#" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(v)
+		if err != nil {
+			return nil, err
+		}
+
+		containerName := fmt.Sprintf("StorageContainer;storage;%s;%s", id.ResourceGroup, id.Path["storageAccounts"])
+		resp, err := clients.RecoveryServices.BackupProtectionContainersClient.Get(ctx, vaultId.Name, vaultId.ResourceGroup, "Azure", containerName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving backup protection container %s (%s): %+v", containerName, *vaultId, err)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (BackupProtectionContainerStorageAccountAutoResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-backup-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+
+  soft_delete_enabled = false
+}
+
+resource "azurerm_resource_group" "storage" {
+  name     = "acctestRG-backup-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  count = 3
+
+  name                     = "acctestsa%[3]s${count.index}"
+  resource_group_name      = azurerm_resource_group.storage.name
+  location                 = azurerm_resource_group.storage.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  count = 3
+
+  # only the File Shares in the first two Storage Accounts match the regex
+  name                 = count.index < 2 ? "backup-${count.index}" : "other-${count.index}"
+  storage_account_name = azurerm_storage_account.test[count.index].name
+  quota                = 1
+}
+
+resource "azurerm_backup_protection_container_storage_account_auto" "test" {
+  resource_group_name                 = azurerm_resource_group.test.name
+  recovery_vault_name                 = azurerm_recovery_services_vault.test.name
+  storage_account_resource_group_name = azurerm_resource_group.storage.name
+  file_share_name_regex               = "^backup-"
+
+  depends_on = [azurerm_storage_share.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
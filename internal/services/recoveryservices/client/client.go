@@ -5,6 +5,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-07-10/siterecovery"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/sdk/backupresourcestorageconfigsnoncrr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/sdk/resourceguardproxy"
 )
 
 type Client struct {
@@ -15,7 +17,9 @@ type Client struct {
 	BackupProtectionContainersClient *backup.ProtectionContainersClient
 	BackupOperationStatusesClient    *backup.OperationStatusesClient
 	VaultsClient                     *recoveryservices.VaultsClient
+	ResourceGuardProxyClient         *resourceguardproxy.ResourceGuardProxyClient
 	VaultsConfigsClient              *backup.ResourceVaultConfigsClient // Not sure why this is in backup, but https://github.com/Azure/azure-sdk-for-go/issues/7279
+	StorageConfigsClient             *backupresourcestorageconfigsnoncrr.BackupResourceStorageConfigsNonCRRClient
 	FabricClient                     func(resourceGroupName string, vaultName string) siterecovery.ReplicationFabricsClient
 	ProtectionContainerClient        func(resourceGroupName string, vaultName string) siterecovery.ReplicationProtectionContainersClient
 	ReplicationPoliciesClient        func(resourceGroupName string, vaultName string) siterecovery.ReplicationPoliciesClient
@@ -28,6 +32,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	vaultConfigsClient := backup.NewResourceVaultConfigsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vaultConfigsClient.Client, o.ResourceManagerAuthorizer)
 
+	storageConfigsClient := backupresourcestorageconfigsnoncrr.NewBackupResourceStorageConfigsNonCRRClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&storageConfigsClient.Client, o.ResourceManagerAuthorizer)
+
 	vaultsClient := recoveryservices.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -49,6 +56,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	backupOperationStatusesClient := backup.NewOperationStatusesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&backupOperationStatusesClient.Client, o.ResourceManagerAuthorizer)
 
+	resourceGuardProxyClient := resourceguardproxy.NewResourceGuardProxyClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&resourceGuardProxyClient.Client, o.ResourceManagerAuthorizer)
+
 	fabricClient := func(resourceGroupName string, vaultName string) siterecovery.ReplicationFabricsClient {
 		client := siterecovery.NewReplicationFabricsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId, resourceGroupName, vaultName)
 		o.ConfigureClient(&client.Client, o.ResourceManagerAuthorizer)
@@ -93,7 +103,9 @@ func NewClient(o *common.ClientOptions) *Client {
 		BackupProtectionContainersClient: &backupProtectionContainersClient,
 		BackupOperationStatusesClient:    &backupOperationStatusesClient,
 		VaultsClient:                     &vaultsClient,
+		ResourceGuardProxyClient:         &resourceGuardProxyClient,
 		VaultsConfigsClient:              &vaultConfigsClient,
+		StorageConfigsClient:             &storageConfigsClient,
 		FabricClient:                     fabricClient,
 		ProtectionContainerClient:        protectionContainerClient,
 		ReplicationPoliciesClient:        replicationPoliciesClient,
@@ -1,10 +1,123 @@
 package recoveryservices
 
 import (
+	"context"
+	"fmt"
+	"log"
 	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 // This code is a workaround for this bug https://github.com/Azure/azure-sdk-for-go/issues/2824
 func handleAzureSdkForGoBug2824(id string) string {
 	return strings.Replace(id, "/Subscriptions/", "/subscriptions/", 1)
 }
+
+// nolint unused - linter mistakenly things this function isn't used?
+func resourceBackupProtectionContainerWaitForOperation(ctx context.Context, client *backup.OperationStatusesClient, vaultName, resourceGroup, operationID string, d *pluginsdk.ResourceData) (backup.OperationStatus, error) {
+	state := &pluginsdk.StateChangeConf{
+		MinTimeout:                10 * time.Second,
+		Delay:                     10 * time.Second,
+		Pending:                   []string{"InProgress"},
+		Target:                    []string{"Succeeded"},
+		Refresh:                   resourceBackupProtectionContainerCheckOperation(ctx, client, vaultName, resourceGroup, operationID),
+		ContinuousTargetOccurence: 5, // Without this buffer, file share backups and storage account deletions may fail if performed immediately after creating/destroying the container
+	}
+
+	if d.IsNewResource() {
+		state.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+	} else {
+		state.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+	}
+
+	log.Printf("[DEBUG] Waiting for backup container operation %q (Vault %q) to complete", operationID, vaultName)
+	resp, err := state.WaitForStateContext(ctx)
+	if err != nil {
+		return resp.(backup.OperationStatus), err
+	}
+	return resp.(backup.OperationStatus), nil
+}
+
+func resourceBackupProtectionContainerCheckOperation(ctx context.Context, client *backup.OperationStatusesClient, vaultName, resourceGroup, operationID string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, vaultName, resourceGroup, operationID)
+		if err != nil {
+			return resp, "Error", fmt.Errorf("Error making Read request on Recovery Service Protection Container operation %q (Vault %q in Resource Group %q): %+v", operationID, vaultName, resourceGroup, err)
+		}
+
+		if opErr := resp.Error; opErr != nil {
+			errMsg := "No upstream error message"
+			if opErr.Message != nil {
+				errMsg = *opErr.Message
+			}
+			err = fmt.Errorf("Recovery Service Protection Container operation status failed with status %q (Vault %q Resource Group %q Operation ID %q): %+v", resp.Status, vaultName, resourceGroup, operationID, errMsg)
+		}
+
+		return resp, string(resp.Status), err
+	}
+}
+
+// resourceBackupProtectionContainerRefresh triggers a discovery of the containers (e.g. Storage Accounts) which can be
+// protected by the vault - containers created after the last discovery can't be registered until this has completed
+func resourceBackupProtectionContainerRefresh(ctx context.Context, client *backup.ProtectionContainersClient, opClient *backup.OperationStatusesClient, d *pluginsdk.ResourceData, vaultName, resourceGroup, fabricName string) error {
+	log.Printf("[DEBUG] Refreshing the protectable containers in Recovery Service Vault %q (Resource Group %q)", vaultName, resourceGroup)
+	filter := fmt.Sprintf("backupManagementType eq '%s'", backup.ManagementTypeAzureStorage)
+	resp, err := client.Refresh(ctx, vaultName, resourceGroup, fabricName, filter)
+	if err != nil {
+		return fmt.Errorf("refreshing the protectable containers in Recovery Service Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+
+	if err := resourceBackupProtectionContainerWaitForLocationOperation(ctx, opClient, d, vaultName, resourceGroup, resp); err != nil {
+		return fmt.Errorf("waiting for the protectable containers in Recovery Service Vault %q (Resource Group %q) to be refreshed: %+v", vaultName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// resourceBackupProtectionContainerInquire triggers a discovery of the items (e.g. File Shares) within a registered
+// container which can be protected - items created after the last inquiry can't be protected until this has completed
+func resourceBackupProtectionContainerInquire(ctx context.Context, client *backup.ProtectionContainersClient, opClient *backup.OperationStatusesClient, d *pluginsdk.ResourceData, vaultName, resourceGroup, fabricName, containerName string) error {
+	log.Printf("[DEBUG] Inquiring the protectable items in backup protection container %q (Vault %q / Resource Group %q)", containerName, vaultName, resourceGroup)
+	filter := fmt.Sprintf("workloadType eq '%s'", backup.WorkloadTypeAzureFileShare)
+	resp, err := client.Inquire(ctx, vaultName, resourceGroup, fabricName, containerName, filter)
+	if err != nil {
+		return fmt.Errorf("inquiring the protectable items in backup protection container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+	}
+
+	if err := resourceBackupProtectionContainerWaitForLocationOperation(ctx, opClient, d, vaultName, resourceGroup, resp); err != nil {
+		return fmt.Errorf("waiting for the protectable items in backup protection container %q (Vault %q / Resource Group %q) to be inquired: %+v", containerName, vaultName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// resourceBackupProtectionContainerWaitForLocationOperation waits for the operation referenced by the `Location` header
+// of the response to complete - the operation completes synchronously when no `Location` header is returned
+func resourceBackupProtectionContainerWaitForLocationOperation(ctx context.Context, client *backup.OperationStatusesClient, d *pluginsdk.ResourceData, vaultName, resourceGroup string, resp autorest.Response) error {
+	if resp.Response == nil {
+		return nil
+	}
+
+	locationURL, err := resp.Response.Location()
+	if err != nil || locationURL == nil {
+		return nil
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+
+	operationID := parsedLocation.Path["operationResults"]
+	if operationID == "" {
+		return nil
+	}
+
+	_, err = resourceBackupProtectionContainerWaitForOperation(ctx, client, vaultName, resourceGroup, operationID, d)
+	return err
+}
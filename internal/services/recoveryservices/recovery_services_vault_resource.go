@@ -1,6 +1,7 @@
 package recoveryservices
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/sdk/backupresourcestorageconfigsnoncrr"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -37,6 +39,14 @@ func resourceRecoveryServicesVault() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// Cross Region Restore cannot be disabled once it's been enabled
+			pluginsdk.ForceNewIfChange("cross_region_restore_enabled", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+			resourceRecoveryServicesVaultStorageCustomizeDiff,
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -93,6 +103,24 @@ func resourceRecoveryServicesVault() *pluginsdk.Resource {
 				Optional: true,
 				Default:  true,
 			},
+
+			// these are Computed since existing Vaults may have been configured outside of Terraform
+			"storage_mode_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(backupresourcestorageconfigsnoncrr.StorageTypeGeoRedundant),
+					string(backupresourcestorageconfigsnoncrr.StorageTypeLocallyRedundant),
+					string(backupresourcestorageconfigsnoncrr.StorageTypeZoneRedundant),
+				}, false),
+			},
+
+			"cross_region_restore_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -100,6 +128,8 @@ func resourceRecoveryServicesVault() *pluginsdk.Resource {
 func resourceRecoveryServicesVaultCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).RecoveryServices.VaultsClient
 	cfgsClient := meta.(*clients.Client).RecoveryServices.VaultsConfigsClient
+	storageCfgsClient := meta.(*clients.Client).RecoveryServices.StorageConfigsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -177,6 +207,35 @@ func resourceRecoveryServicesVaultCreateUpdate(d *pluginsdk.ResourceData, meta i
 		return fmt.Errorf("Error waiting for on update for Recovery Service Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	vaultId := backupresourcestorageconfigsnoncrr.NewVaultID(subscriptionId, resourceGroup, name)
+
+	// the Storage Configuration is only updated when it's specified in the config - the Storage Type has to be updated
+	// before Cross Region Restore can be enabled, since it requires Geo-Redundant Storage
+	if v, ok := d.GetOk("storage_mode_type"); ok && (d.IsNewResource() || d.HasChange("storage_mode_type")) {
+		storageType := backupresourcestorageconfigsnoncrr.StorageType(v.(string))
+		storageCfg := backupresourcestorageconfigsnoncrr.BackupResourceConfigResource{
+			Properties: &backupresourcestorageconfigsnoncrr.BackupResourceConfig{
+				StorageModelType: &storageType,
+				StorageType:      &storageType,
+			},
+		}
+		if _, err := storageCfgsClient.Patch(ctx, vaultId, storageCfg); err != nil {
+			return fmt.Errorf("updating the Storage Type for Recovery Service Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	// nolint staticcheck
+	if v, ok := d.GetOkExists("cross_region_restore_enabled"); ok && (d.IsNewResource() || d.HasChange("cross_region_restore_enabled")) {
+		storageCfg := backupresourcestorageconfigsnoncrr.BackupResourceConfigResource{
+			Properties: &backupresourcestorageconfigsnoncrr.BackupResourceConfig{
+				CrossRegionRestoreFlag: utils.Bool(v.(bool)),
+			},
+		}
+		if _, err := storageCfgsClient.Patch(ctx, vaultId, storageCfg); err != nil {
+			return fmt.Errorf("updating Cross Region Restore for Recovery Service Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	read, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error issuing read request for Recovery Service Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -193,6 +252,7 @@ func resourceRecoveryServicesVaultCreateUpdate(d *pluginsdk.ResourceData, meta i
 func resourceRecoveryServicesVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).RecoveryServices.VaultsClient
 	cfgsClient := meta.(*clients.Client).RecoveryServices.VaultsConfigsClient
+	storageCfgsClient := meta.(*clients.Client).RecoveryServices.StorageConfigsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -235,6 +295,27 @@ func resourceRecoveryServicesVaultRead(d *pluginsdk.ResourceData, meta interface
 		d.Set("soft_delete_enabled", props.SoftDeleteFeatureState == backup.SoftDeleteFeatureStateEnabled)
 	}
 
+	storageCfg, err := storageCfgsClient.Get(ctx, backupresourcestorageconfigsnoncrr.NewVaultID(id.SubscriptionID, resourceGroup, name))
+	if err != nil {
+		return fmt.Errorf("reading the Storage Configuration for Recovery Service Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	storageModeType := ""
+	crossRegionRestoreEnabled := false
+	if model := storageCfg.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+		if props.StorageModelType != nil {
+			storageModeType = string(*props.StorageModelType)
+		} else if props.StorageType != nil {
+			storageModeType = string(*props.StorageType)
+		}
+		if props.CrossRegionRestoreFlag != nil {
+			crossRegionRestoreEnabled = *props.CrossRegionRestoreFlag
+		}
+	}
+	d.Set("storage_mode_type", storageModeType)
+	d.Set("cross_region_restore_enabled", crossRegionRestoreEnabled)
+
 	if err := d.Set("identity", flattenVaultIdentity(resp.Identity)); err != nil {
 		return fmt.Errorf("Error setting `identity`: %+v", err)
 	}
@@ -267,6 +348,40 @@ func resourceRecoveryServicesVaultDelete(d *pluginsdk.ResourceData, meta interfa
 	return nil
 }
 
+func resourceRecoveryServicesVaultStorageCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	// when `storage_mode_type` isn't specified for a new Vault it'll be Geo-Redundant, which is the API default
+	storageModeType := d.Get("storage_mode_type").(string)
+	if d.Get("cross_region_restore_enabled").(bool) && storageModeType != "" && storageModeType != string(backupresourcestorageconfigsnoncrr.StorageTypeGeoRedundant) {
+		return fmt.Errorf("`cross_region_restore_enabled` can only be set when `storage_mode_type` is `%s`", string(backupresourcestorageconfigsnoncrr.StorageTypeGeoRedundant))
+	}
+
+	if d.Id() == "" || !d.HasChange("storage_mode_type") {
+		return nil
+	}
+
+	// the Storage Type is locked once an item has been protected by the Vault - rather than attempting (and failing)
+	// the update after a number of minutes, we check this during the plan instead
+	client := meta.(*clients.Client).RecoveryServices.StorageConfigsClient
+	id, err := backupresourcestorageconfigsnoncrr.ParseVaultIDInsensitively(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving the Storage Configuration for %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.StorageTypeState != nil {
+		if *model.Properties.StorageTypeState == backupresourcestorageconfigsnoncrr.StorageTypeStateLocked {
+			old, _ := d.GetChange("storage_mode_type")
+			return fmt.Errorf("`storage_mode_type` cannot be changed from %q since items are already protected by %s - the protected items must be removed before the Storage Type can be changed", old.(string), *id)
+		}
+	}
+
+	return nil
+}
+
 func expandValutIdentity(input []interface{}) *recoveryservices.IdentityData {
 	if len(input) == 0 {
 		return nil
@@ -0,0 +1,145 @@
+package recoveryservices
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/sdk/resourceguards"
+	dataProtectionValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/sdk/resourceguardproxy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Resource Guard Proxy of a Recovery Services Vault is a singleton, which must use this name
+const recoveryServicesVaultResourceGuardProxyName = "VaultProxy"
+
+func resourceRecoveryServicesVaultResourceGuardAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceRecoveryServicesVaultResourceGuardAssociationCreate,
+		Read:   resourceRecoveryServicesVaultResourceGuardAssociationRead,
+		Delete: resourceRecoveryServicesVaultResourceGuardAssociationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := resourceguardproxy.ParseBackupResourceGuardProxyID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"vault_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultID,
+			},
+
+			"resource_guard_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: dataProtectionValidate.ResourceGuardID,
+			},
+		},
+	}
+}
+
+func resourceRecoveryServicesVaultResourceGuardAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ResourceGuardProxyClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	vaultId, err := resourceguardproxy.ParseVaultID(d.Get("vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := resourceguardproxy.NewBackupResourceGuardProxyID(vaultId.SubscriptionId, vaultId.ResourceGroup, vaultId.Name, recoveryServicesVaultResourceGuardProxyName)
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_recovery_services_vault_resource_guard_association", id.ID())
+	}
+
+	parameters := resourceguardproxy.ResourceGuardProxyBaseResource{
+		Properties: &resourceguardproxy.ResourceGuardProxyBase{
+			ResourceGuardResourceId: utils.String(d.Get("resource_guard_id").(string)),
+		},
+	}
+
+	if _, err := client.Put(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceRecoveryServicesVaultResourceGuardAssociationRead(d, meta)
+}
+
+func resourceRecoveryServicesVaultResourceGuardAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ResourceGuardProxyClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := resourceguardproxy.ParseBackupResourceGuardProxyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("vault_id", resourceguardproxy.NewVaultID(id.SubscriptionId, id.ResourceGroup, id.VaultName).ID())
+
+	resourceGuardId := ""
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ResourceGuardResourceId != nil {
+		// the API returns the Resource Guard ID with inconsistent casing
+		parsed, err := resourceguards.ParseResourceGuardIDInsensitively(*model.Properties.ResourceGuardResourceId)
+		if err != nil {
+			return err
+		}
+		resourceGuardId = parsed.ID()
+	}
+	d.Set("resource_guard_id", resourceGuardId)
+
+	return nil
+}
+
+func resourceRecoveryServicesVaultResourceGuardAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ResourceGuardProxyClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := resourceguardproxy.ParseBackupResourceGuardProxyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -110,6 +110,43 @@ func TestAccRecoveryServicesVault_basicWithIdentity(t *testing.T) {
 	})
 }
 
+func TestAccRecoveryServicesVault_storageModeType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_recovery_services_vault", "test")
+	r := RecoveryServicesVaultResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.storageModeType(data, "LocallyRedundant", false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage_mode_type").HasValue("LocallyRedundant"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.storageModeType(data, "GeoRedundant", false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage_mode_type").HasValue("GeoRedundant"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.storageModeType(data, "GeoRedundant", true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("cross_region_restore_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// omitting the Storage Configuration retains the existing settings rather than recreating the Vault
+			Config:   r.basic(data),
+			PlanOnly: true,
+		},
+	})
+}
+
 func (t RecoveryServicesVaultResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -197,6 +234,31 @@ resource "azurerm_recovery_services_vault" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (RecoveryServicesVaultResource) storageModeType(data acceptance.TestData, storageModeType string, crossRegionRestoreEnabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-recovery-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-Vault-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+
+  storage_mode_type            = "%s"
+  cross_region_restore_enabled = %t
+
+  soft_delete_enabled = false
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, storageModeType, crossRegionRestoreEnabled)
+}
+
 func (r RecoveryServicesVaultResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -21,8 +21,10 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_recovery_services_vault": dataSourceRecoveryServicesVault(),
-		"azurerm_backup_policy_vm":        dataSourceBackupPolicyVm(),
+		"azurerm_recovery_services_vault":            dataSourceRecoveryServicesVault(),
+		"azurerm_backup_policy_vm":                   dataSourceBackupPolicyVm(),
+		"azurerm_site_recovery_fabric":               dataSourceSiteRecoveryFabric(),
+		"azurerm_site_recovery_protection_container": dataSourceSiteRecoveryProtectionContainer(),
 	}
 }
 
@@ -30,17 +32,20 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_backup_container_storage_account":           resourceBackupProtectionContainerStorageAccount(),
-		"azurerm_backup_policy_file_share":                   resourceBackupProtectionPolicyFileShare(),
-		"azurerm_backup_protected_file_share":                resourceBackupProtectedFileShare(),
-		"azurerm_backup_protected_vm":                        resourceRecoveryServicesBackupProtectedVM(),
-		"azurerm_backup_policy_vm":                           resourceBackupProtectionPolicyVM(),
-		"azurerm_recovery_services_vault":                    resourceRecoveryServicesVault(),
-		"azurerm_site_recovery_fabric":                       resourceSiteRecoveryFabric(),
-		"azurerm_site_recovery_network_mapping":              resourceSiteRecoveryNetworkMapping(),
-		"azurerm_site_recovery_protection_container":         resourceSiteRecoveryProtectionContainer(),
-		"azurerm_site_recovery_protection_container_mapping": resourceSiteRecoveryProtectionContainerMapping(),
-		"azurerm_site_recovery_replicated_vm":                resourceSiteRecoveryReplicatedVM(),
-		"azurerm_site_recovery_replication_policy":           resourceSiteRecoveryReplicationPolicy(),
+		"azurerm_backup_container_storage_account":                   resourceBackupProtectionContainerStorageAccount(),
+		"azurerm_backup_policy_file_share":                           resourceBackupProtectionPolicyFileShare(),
+		"azurerm_backup_protected_file_share":                        resourceBackupProtectedFileShare(),
+		"azurerm_backup_protected_vm":                                resourceRecoveryServicesBackupProtectedVM(),
+		"azurerm_backup_protection_container_sql_workload":           resourceBackupProtectionContainerSQLWorkload(),
+		"azurerm_backup_protection_container_storage_account_auto":   resourceBackupProtectionContainerStorageAccountAuto(),
+		"azurerm_backup_policy_vm":                                   resourceBackupProtectionPolicyVM(),
+		"azurerm_recovery_services_vault":                            resourceRecoveryServicesVault(),
+		"azurerm_recovery_services_vault_resource_guard_association": resourceRecoveryServicesVaultResourceGuardAssociation(),
+		"azurerm_site_recovery_fabric":                               resourceSiteRecoveryFabric(),
+		"azurerm_site_recovery_network_mapping":                      resourceSiteRecoveryNetworkMapping(),
+		"azurerm_site_recovery_protection_container":                 resourceSiteRecoveryProtectionContainer(),
+		"azurerm_site_recovery_protection_container_mapping":         resourceSiteRecoveryProtectionContainerMapping(),
+		"azurerm_site_recovery_replicated_vm":                        resourceSiteRecoveryReplicatedVM(),
+		"azurerm_site_recovery_replication_policy":                   resourceSiteRecoveryReplicationPolicy(),
 	}
 }
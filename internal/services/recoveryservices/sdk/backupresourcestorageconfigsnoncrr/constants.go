@@ -0,0 +1,35 @@
+package backupresourcestorageconfigsnoncrr
+
+type DedupState string
+
+const (
+	DedupStateDisabled DedupState = "Disabled"
+	DedupStateEnabled  DedupState = "Enabled"
+	DedupStateInvalid  DedupState = "Invalid"
+)
+
+type StorageType string
+
+const (
+	StorageTypeGeoRedundant               StorageType = "GeoRedundant"
+	StorageTypeInvalid                    StorageType = "Invalid"
+	StorageTypeLocallyRedundant           StorageType = "LocallyRedundant"
+	StorageTypeReadAccessGeoZoneRedundant StorageType = "ReadAccessGeoZoneRedundant"
+	StorageTypeZoneRedundant              StorageType = "ZoneRedundant"
+)
+
+type StorageTypeState string
+
+const (
+	StorageTypeStateInvalid  StorageTypeState = "Invalid"
+	StorageTypeStateLocked   StorageTypeState = "Locked"
+	StorageTypeStateUnlocked StorageTypeState = "Unlocked"
+)
+
+type XcoolState string
+
+const (
+	XcoolStateDisabled XcoolState = "Disabled"
+	XcoolStateEnabled  XcoolState = "Enabled"
+	XcoolStateInvalid  XcoolState = "Invalid"
+)
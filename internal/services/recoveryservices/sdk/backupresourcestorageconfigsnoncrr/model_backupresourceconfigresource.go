@@ -0,0 +1,11 @@
+package backupresourcestorageconfigsnoncrr
+
+type BackupResourceConfigResource struct {
+	ETag       *string               `json:"eTag,omitempty"`
+	Id         *string               `json:"id,omitempty"`
+	Location   *string               `json:"location,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *BackupResourceConfig `json:"properties,omitempty"`
+	Tags       *map[string]string    `json:"tags,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package backupresourcestorageconfigsnoncrr
+
+import "fmt"
+
+const defaultApiVersion = "2021-12-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/backupresourcestorageconfigsnoncrr/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package resourceguardproxy
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ResourceGuardProxyClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewResourceGuardProxyClientWithBaseURI(endpoint string) ResourceGuardProxyClient {
+	return ResourceGuardProxyClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
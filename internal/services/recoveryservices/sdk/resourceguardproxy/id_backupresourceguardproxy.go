@@ -0,0 +1,126 @@
+package resourceguardproxy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type BackupResourceGuardProxyId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	VaultName      string
+	Name           string
+}
+
+func NewBackupResourceGuardProxyID(subscriptionId, resourceGroup, vaultName, name string) BackupResourceGuardProxyId {
+	return BackupResourceGuardProxyId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VaultName:      vaultName,
+		Name:           name,
+	}
+}
+
+func (id BackupResourceGuardProxyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Vault Name %q", id.VaultName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Backup Resource Guard Proxy", segmentsStr)
+}
+
+func (id BackupResourceGuardProxyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.RecoveryServices/vaults/%s/backupResourceGuardProxies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VaultName, id.Name)
+}
+
+// ParseBackupResourceGuardProxyID parses a BackupResourceGuardProxy ID into an BackupResourceGuardProxyId struct
+func ParseBackupResourceGuardProxyID(input string) (*BackupResourceGuardProxyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := BackupResourceGuardProxyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VaultName, err = id.PopSegment("vaults"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("backupResourceGuardProxies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseBackupResourceGuardProxyIDInsensitively parses an BackupResourceGuardProxy ID into an BackupResourceGuardProxyId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseBackupResourceGuardProxyID method should be used instead for validation etc.
+func ParseBackupResourceGuardProxyIDInsensitively(input string) (*BackupResourceGuardProxyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := BackupResourceGuardProxyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'vaults' segment
+	vaultsKey := "vaults"
+	for key := range id.Path {
+		if strings.EqualFold(key, vaultsKey) {
+			vaultsKey = key
+			break
+		}
+	}
+	if resourceId.VaultName, err = id.PopSegment(vaultsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'backupResourceGuardProxies' segment
+	backupResourceGuardProxiesKey := "backupResourceGuardProxies"
+	for key := range id.Path {
+		if strings.EqualFold(key, backupResourceGuardProxiesKey) {
+			backupResourceGuardProxiesKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(backupResourceGuardProxiesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,6 @@
+package resourceguardproxy
+
+type ResourceGuardOperationDetail struct {
+	DefaultResourceRequest *string `json:"defaultResourceRequest,omitempty"`
+	VaultCriticalOperation *string `json:"vaultCriticalOperation,omitempty"`
+}
@@ -0,0 +1,8 @@
+package resourceguardproxy
+
+type ResourceGuardProxyBase struct {
+	Description                   *string                         `json:"description,omitempty"`
+	LastUpdatedTime               *string                         `json:"lastUpdatedTime,omitempty"`
+	ResourceGuardOperationDetails *[]ResourceGuardOperationDetail `json:"resourceGuardOperationDetails,omitempty"`
+	ResourceGuardResourceId       *string                         `json:"resourceGuardResourceId,omitempty"`
+}
@@ -0,0 +1,11 @@
+package resourceguardproxy
+
+type ResourceGuardProxyBaseResource struct {
+	ETag       *string                 `json:"eTag,omitempty"`
+	Id         *string                 `json:"id,omitempty"`
+	Location   *string                 `json:"location,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *ResourceGuardProxyBase `json:"properties,omitempty"`
+	Tags       *map[string]string      `json:"tags,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
@@ -0,0 +1,96 @@
+package recoveryservices
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceSiteRecoveryFabric() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceSiteRecoveryFabricRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"location": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     location.EnhancedValidate,
+				StateFunc:        azure.NormalizeLocation,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSiteRecoveryFabricRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	resGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	fabricLocation := azure.NormalizeLocation(d.Get("location").(string))
+
+	client := meta.(*clients.Client).RecoveryServices.FabricClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	// the Azure-to-Azure fabrics are created automatically when replication is enabled using a generated name,
+	// as such the fabric for the region is looked up rather than by its name
+	iter, err := client.ListComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("listing site recovery fabrics (vault %s): %+v", vaultName, err)
+	}
+
+	var fabricId, fabricName string
+	for iter.NotDone() {
+		fabric := iter.Value()
+		if props := fabric.Properties; props != nil && fabric.ID != nil && fabric.Name != nil {
+			if azureDetails, isAzureDetails := props.CustomDetails.AsAzureFabricSpecificDetails(); isAzureDetails && azureDetails.Location != nil {
+				if strings.EqualFold(azure.NormalizeLocation(*azureDetails.Location), fabricLocation) {
+					if fabricId != "" {
+						return fmt.Errorf("found multiple site recovery fabrics in %q (vault %s): %q and %q", fabricLocation, vaultName, fabricName, *fabric.Name)
+					}
+					fabricId = *fabric.ID
+					fabricName = *fabric.Name
+				}
+			}
+		}
+
+		if err := iter.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing site recovery fabrics (vault %s): %+v", vaultName, err)
+		}
+	}
+
+	if fabricId == "" {
+		return fmt.Errorf("no site recovery fabric was found in %q (vault %s)", fabricLocation, vaultName)
+	}
+
+	d.SetId(handleAzureSdkForGoBug2824(fabricId))
+	d.Set("name", fabricName)
+	d.Set("resource_group_name", resGroup)
+	d.Set("recovery_vault_name", vaultName)
+	d.Set("location", fabricLocation)
+
+	return nil
+}
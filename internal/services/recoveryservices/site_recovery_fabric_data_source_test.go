@@ -0,0 +1,39 @@
+package recoveryservices_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SiteRecoveryFabricDataSource struct {
+}
+
+func TestAccDataSourceSiteRecoveryFabric_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_site_recovery_fabric", "test")
+	r := SiteRecoveryFabricDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctest-fabric-%d", data.RandomInteger)),
+			),
+		},
+	})
+}
+
+func (SiteRecoveryFabricDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_site_recovery_fabric" "test" {
+  resource_group_name = azurerm_site_recovery_fabric.test.resource_group_name
+  recovery_vault_name = azurerm_site_recovery_fabric.test.recovery_vault_name
+  location            = azurerm_site_recovery_fabric.test.location
+}
+`, SiteRecoveryFabricResource{}.basic(data))
+}
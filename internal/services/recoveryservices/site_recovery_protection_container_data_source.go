@@ -0,0 +1,89 @@
+package recoveryservices
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceSiteRecoveryProtectionContainer() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceSiteRecoveryProtectionContainerRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"recovery_fabric_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSiteRecoveryProtectionContainerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	resGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	fabricName := d.Get("recovery_fabric_name").(string)
+
+	client := meta.(*clients.Client).RecoveryServices.ProtectionContainerClient(resGroup, vaultName)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	// the Azure-to-Azure protection containers are created automatically alongside the fabric using a generated
+	// name, as such the only protection container within the fabric is looked up rather than by its name
+	iter, err := client.ListByReplicationFabricsComplete(ctx, fabricName)
+	if err != nil {
+		return fmt.Errorf("listing site recovery protection containers (fabric %s / vault %s): %+v", fabricName, vaultName, err)
+	}
+
+	var containerId, containerName string
+	for iter.NotDone() {
+		container := iter.Value()
+		if container.ID != nil && container.Name != nil {
+			if containerId != "" {
+				return fmt.Errorf("found multiple site recovery protection containers in fabric %s (vault %s): %q and %q", fabricName, vaultName, containerName, *container.Name)
+			}
+			containerId = *container.ID
+			containerName = *container.Name
+		}
+
+		if err := iter.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing site recovery protection containers (fabric %s / vault %s): %+v", fabricName, vaultName, err)
+		}
+	}
+
+	if containerId == "" {
+		return fmt.Errorf("no site recovery protection container was found in fabric %s (vault %s)", fabricName, vaultName)
+	}
+
+	d.SetId(handleAzureSdkForGoBug2824(containerId))
+	d.Set("name", containerName)
+	d.Set("resource_group_name", resGroup)
+	d.Set("recovery_vault_name", vaultName)
+	d.Set("recovery_fabric_name", fabricName)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package recoveryservices_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SiteRecoveryProtectionContainerDataSource struct {
+}
+
+func TestAccDataSourceSiteRecoveryProtectionContainer_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_site_recovery_protection_container", "test")
+	r := SiteRecoveryProtectionContainerDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctest-protection-cont-%d", data.RandomInteger)),
+			),
+		},
+	})
+}
+
+func (SiteRecoveryProtectionContainerDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_site_recovery_fabric" "test" {
+  resource_group_name = azurerm_site_recovery_protection_container.test.resource_group_name
+  recovery_vault_name = azurerm_site_recovery_protection_container.test.recovery_vault_name
+  location            = azurerm_site_recovery_fabric.test.location
+}
+
+data "azurerm_site_recovery_protection_container" "test" {
+  resource_group_name  = azurerm_site_recovery_protection_container.test.resource_group_name
+  recovery_vault_name  = azurerm_site_recovery_protection_container.test.recovery_vault_name
+  recovery_fabric_name = data.azurerm_site_recovery_fabric.test.name
+}
+`, SiteRecoveryProtectionContainerResource{}.basic(data))
+}
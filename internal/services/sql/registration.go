@@ -29,12 +29,13 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_sql_active_directory_administrator": resourceSqlAdministrator(),
-		"azurerm_sql_database":                       resourceSqlDatabase(),
-		"azurerm_sql_elasticpool":                    resourceSqlElasticPool(),
-		"azurerm_sql_failover_group":                 resourceSqlFailoverGroup(),
-		"azurerm_sql_firewall_rule":                  resourceSqlFirewallRule(),
-		"azurerm_sql_server":                         resourceSqlServer(),
-		"azurerm_sql_virtual_network_rule":           resourceSqlVirtualNetworkRule(),
+		"azurerm_sql_active_directory_administrator":   resourceSqlAdministrator(),
+		"azurerm_sql_database":                         resourceSqlDatabase(),
+		"azurerm_sql_elasticpool":                      resourceSqlElasticPool(),
+		"azurerm_sql_elasticpool_database_association": resourceSqlElasticPoolDatabaseAssociation(),
+		"azurerm_sql_failover_group":                   resourceSqlFailoverGroup(),
+		"azurerm_sql_firewall_rule":                    resourceSqlFirewallRule(),
+		"azurerm_sql_server":                           resourceSqlServer(),
+		"azurerm_sql_virtual_network_rule":             resourceSqlVirtualNetworkRule(),
 	}
 }
@@ -0,0 +1,213 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-03-01-preview/sql"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const sqlDatabaseResourceName = "azurerm_sql_database"
+
+func resourceSqlElasticPoolDatabaseAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSqlElasticPoolDatabaseAssociationCreate,
+		Read:   resourceSqlElasticPoolDatabaseAssociationRead,
+		Update: resourceSqlElasticPoolDatabaseAssociationUpdate,
+		Delete: resourceSqlElasticPoolDatabaseAssociationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DatabaseID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"database_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"elastic_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			// the Database needs a Service Objective of its own once it's moved out of the Elastic Pool
+			"removal_service_objective_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(sql.ServiceObjectiveNameS0),
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceSqlElasticPoolDatabaseAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DatabasesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	databaseId, err := parse.DatabaseID(d.Get("database_id").(string))
+	if err != nil {
+		return err
+	}
+
+	elasticPoolId, err := parse.ElasticPoolID(d.Get("elastic_pool_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if databaseId.SubscriptionId != elasticPoolId.SubscriptionId || !strings.EqualFold(databaseId.ResourceGroup, elasticPoolId.ResourceGroup) || !strings.EqualFold(databaseId.ServerName, elasticPoolId.ServerName) {
+		return fmt.Errorf("the %s and the %s must be within the same SQL Server", *databaseId, *elasticPoolId)
+	}
+
+	locks.ByName(databaseId.Name, sqlDatabaseResourceName)
+	defer locks.UnlockByName(databaseId.Name, sqlDatabaseResourceName)
+
+	existing, err := client.Get(ctx, databaseId.ResourceGroup, databaseId.ServerName, databaseId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("%s was not found", *databaseId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *databaseId, err)
+	}
+	if props := existing.DatabaseProperties; props != nil && props.ElasticPoolName != nil && strings.EqualFold(*props.ElasticPoolName, elasticPoolId.Name) {
+		return tf.ImportAsExistsError("azurerm_sql_elasticpool_database_association", databaseId.ID())
+	}
+
+	parameters := sql.DatabaseUpdate{
+		DatabaseProperties: &sql.DatabaseProperties{
+			ElasticPoolName: utils.String(elasticPoolId.Name),
+		},
+	}
+	if err := resourceSqlElasticPoolDatabaseAssociationUpdateDatabase(ctx, client, *databaseId, parameters); err != nil {
+		return fmt.Errorf("moving %s into %s: %+v", *databaseId, *elasticPoolId, err)
+	}
+
+	d.SetId(databaseId.ID())
+
+	return resourceSqlElasticPoolDatabaseAssociationRead(d, meta)
+}
+
+func resourceSqlElasticPoolDatabaseAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DatabasesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	elasticPoolName := ""
+	if props := resp.DatabaseProperties; props != nil && props.ElasticPoolName != nil {
+		elasticPoolName = *props.ElasticPoolName
+	}
+	if elasticPoolName == "" {
+		log.Printf("[DEBUG] %s isn't within an Elastic Pool - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("database_id", id.ID())
+	d.Set("elastic_pool_id", parse.NewElasticPoolID(id.SubscriptionId, id.ResourceGroup, id.ServerName, elasticPoolName).ID())
+
+	if _, ok := d.GetOk("removal_service_objective_name"); !ok {
+		d.Set("removal_service_objective_name", string(sql.ServiceObjectiveNameS0))
+	}
+
+	return nil
+}
+
+func resourceSqlElasticPoolDatabaseAssociationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	// `removal_service_objective_name` is only used when the Database is moved out of the Elastic Pool
+	return resourceSqlElasticPoolDatabaseAssociationRead(d, meta)
+}
+
+func resourceSqlElasticPoolDatabaseAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DatabasesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	elasticPoolId, err := parse.ElasticPoolID(d.Get("elastic_pool_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, sqlDatabaseResourceName)
+	defer locks.UnlockByName(id.Name, sqlDatabaseResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// the Database may have been moved into another Elastic Pool (or out of it) since, in which case there's nothing to do
+	if props := existing.DatabaseProperties; props == nil || props.ElasticPoolName == nil || !strings.EqualFold(*props.ElasticPoolName, elasticPoolId.Name) {
+		return nil
+	}
+
+	parameters := sql.DatabaseUpdate{
+		DatabaseProperties: &sql.DatabaseProperties{
+			RequestedServiceObjectiveName: sql.ServiceObjectiveName(d.Get("removal_service_objective_name").(string)),
+		},
+	}
+	if err := resourceSqlElasticPoolDatabaseAssociationUpdateDatabase(ctx, client, *id, parameters); err != nil {
+		return fmt.Errorf("moving %s out of %s: %+v", *id, *elasticPoolId, err)
+	}
+
+	return nil
+}
+
+// resourceSqlElasticPoolDatabaseAssociationUpdateDatabase updates the Database and waits for the (asynchronous) move
+// into or out of the Elastic Pool to complete
+func resourceSqlElasticPoolDatabaseAssociationUpdateDatabase(ctx context.Context, client *sql.DatabasesClient, id parse.DatabaseId, parameters sql.DatabaseUpdate) error {
+	future, err := client.Update(ctx, id.ResourceGroup, id.ServerName, id.Name, parameters)
+	if err != nil {
+		return err
+	}
+
+	return future.WaitForCompletionRef(ctx, client.Client)
+}
@@ -0,0 +1,121 @@
+package sql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SqlElasticPoolDatabaseAssociationResource struct{}
+
+func TestAccSqlElasticPoolDatabaseAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sql_elasticpool_database_association", "test")
+	r := SqlElasticPoolDatabaseAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSqlElasticPoolDatabaseAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sql_elasticpool_database_association", "test")
+	r := SqlElasticPoolDatabaseAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SqlElasticPoolDatabaseAssociationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DatabaseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Sql.DatabasesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	props := resp.DatabaseProperties
+	return utils.Bool(props != nil && props.ElasticPoolName != nil && *props.ElasticPoolName != ""), nil
+}
+
+func (r SqlElasticPoolDatabaseAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_sql_elasticpool" "test" {
+  name                = "acctest-pool-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  server_name         = azurerm_sql_server.test.name
+  edition             = "Basic"
+  dtu                 = 50
+  pool_size           = 5000
+}
+
+resource "azurerm_sql_database" "test" {
+  name                = "acctestdb%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  server_name         = azurerm_sql_server.test.name
+  location            = azurerm_resource_group.test.location
+  edition             = "Basic"
+}
+
+resource "azurerm_sql_elasticpool_database_association" "test" {
+  database_id                    = azurerm_sql_database.test.id
+  elastic_pool_id                = azurerm_sql_elasticpool.test.id
+  removal_service_objective_name = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r SqlElasticPoolDatabaseAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_elasticpool_database_association" "import" {
+  database_id     = azurerm_sql_elasticpool_database_association.test.database_id
+  elastic_pool_id = azurerm_sql_elasticpool_database_association.test.elastic_pool_id
+}
+`, r.basic(data))
+}
@@ -87,6 +87,12 @@ func resourceSqlElasticPool() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"zone_redundant": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"creation_date": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -123,6 +129,10 @@ func resourceSqlElasticPoolCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
+	if d.Get("zone_redundant").(bool) && sql.ElasticPoolEdition(d.Get("edition").(string)) != sql.ElasticPoolEditionPremium {
+		return fmt.Errorf("`zone_redundant` can only be enabled when `edition` is `%s`", string(sql.ElasticPoolEditionPremium))
+	}
+
 	elasticPool := sql.ElasticPool{
 		Name:                  &name,
 		Location:              &location,
@@ -212,6 +222,12 @@ func resourceSqlElasticPoolRead(d *pluginsdk.ResourceData, meta interface{}) err
 			storageMb = int(*props.StorageMB)
 		}
 		d.Set("pool_size", storageMb)
+
+		zoneRedundant := false
+		if props.ZoneRedundant != nil {
+			zoneRedundant = *props.ZoneRedundant
+		}
+		d.Set("zone_redundant", zoneRedundant)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -239,8 +255,9 @@ func getArmSqlElasticPoolProperties(d *pluginsdk.ResourceData) *sql.ElasticPoolP
 	dtu := int32(d.Get("dtu").(int))
 
 	props := &sql.ElasticPoolProperties{
-		Edition: edition,
-		Dtu:     &dtu,
+		Edition:       edition,
+		Dtu:           &dtu,
+		ZoneRedundant: utils.Bool(d.Get("zone_redundant").(bool)),
 	}
 
 	if databaseDtuMin, ok := d.GetOk("db_dtu_min"); ok {
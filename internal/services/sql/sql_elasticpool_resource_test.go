@@ -81,6 +81,30 @@ func TestAccSqlElasticPool_resizeDtu(t *testing.T) {
 	})
 }
 
+func TestAccSqlElasticPool_zoneRedundant(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sql_elasticpool", "test")
+	r := SqlElasticPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.premium(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zone_redundant").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.premium(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zone_redundant").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r SqlElasticPoolResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ElasticPoolID(state.ID)
 	if err != nil {
@@ -186,3 +210,36 @@ resource "azurerm_sql_elasticpool" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (r SqlElasticPoolResource) premium(data acceptance.TestData, zoneRedundant bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_sql_elasticpool" "test" {
+  name                = "acctest-pool-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  server_name         = azurerm_sql_server.test.name
+  edition             = "Premium"
+  dtu                 = 125
+  pool_size           = 256000
+  zone_redundant      = %[3]t
+}
+`, data.RandomInteger, data.Locations.Primary, zoneRedundant)
+}
@@ -0,0 +1,73 @@
+package streamanalytics
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/streamanalytics/mgmt/2020-03-01-preview/streamanalytics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func schemaStreamAnalyticsAuthenticationMode() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Default:  string(streamanalytics.ConnectionString),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(streamanalytics.ConnectionString),
+			string(streamanalytics.Msi),
+		}, false),
+	}
+}
+
+// flattenStreamAnalyticsAuthenticationMode returns the Authentication Mode of an Input/Output - those created prior to
+// the Authentication Mode being available don't return one, and use a Connection String
+func flattenStreamAnalyticsAuthenticationMode(input streamanalytics.AuthenticationMode) string {
+	if input == "" {
+		return string(streamanalytics.ConnectionString)
+	}
+
+	return string(input)
+}
+
+// validateStreamAnalyticsAuthenticationMode checks that the credentials required by the Authentication Mode of an
+// Input/Output are available - when using a Connection String all of the `credentials` (a map of field name to value)
+// must be specified, and when using a Managed Identity the parent Stream Analytics Job must have one assigned, since
+// the API otherwise accepts the Input/Output and the Job fails when it's started.
+func validateStreamAnalyticsAuthenticationMode(ctx context.Context, client *streamanalytics.StreamingJobsClient, resourceGroup, jobName, authenticationMode string, credentials map[string]string) error {
+	if authenticationMode == string(streamanalytics.ConnectionString) {
+		missing := make([]string, 0)
+		for field, value := range credentials {
+			if value == "" {
+				missing = append(missing, fmt.Sprintf("`%s`", field))
+			}
+		}
+		sort.Strings(missing)
+
+		if len(missing) > 0 {
+			return fmt.Errorf("%s must be specified when `authentication_mode` is `%s`", strings.Join(missing, " and "), string(streamanalytics.ConnectionString))
+		}
+
+		return nil
+	}
+
+	if authenticationMode == string(streamanalytics.Msi) {
+		job, err := client.Get(ctx, resourceGroup, jobName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(job.Response) {
+				return fmt.Errorf("Stream Analytics Job %q (Resource Group %q) was not found", jobName, resourceGroup)
+			}
+			return fmt.Errorf("retrieving Stream Analytics Job %q (Resource Group %q): %+v", jobName, resourceGroup, err)
+		}
+
+		if job.Identity == nil || job.Identity.Type == nil || !strings.EqualFold(*job.Identity.Type, "SystemAssigned") {
+			return fmt.Errorf("`authentication_mode` can only be `%s` when the Stream Analytics Job %q (Resource Group %q) has a `SystemAssigned` identity", string(streamanalytics.Msi), jobName, resourceGroup)
+		}
+	}
+
+	return nil
+}
@@ -1,9 +1,14 @@
 package streamanalytics
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/services/iothub/mgmt/2020-03-01/devices"
 	"github.com/Azure/azure-sdk-for-go/services/preview/streamanalytics/mgmt/2020-03-01-preview/streamanalytics"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -132,3 +137,62 @@ func flattenStreamAnalyticsStreamInputSerialization(input streamanalytics.BasicS
 		},
 	}
 }
+
+// validateStreamAnalyticsIoTHubSharedAccessPolicy checks that the Shared Access Policy used by an IoTHub Stream Input
+// has the `ServiceConnect` right, since otherwise the Stream Analytics Job gets stuck when starting. The IoTHub is
+// looked up by name within the current Subscription - when it can't be found (e.g. as it's in another Subscription)
+// or the keys can't be retrieved the check is skipped.
+func validateStreamAnalyticsIoTHubSharedAccessPolicy(ctx context.Context, client *devices.IotHubResourceClient, iotHubName, sharedAccessPolicyName string) error {
+	iter, err := client.ListBySubscriptionComplete(ctx)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to list IoTHubs to validate Shared Access Policy %q: %+v - skipping", sharedAccessPolicyName, err)
+		return nil
+	}
+
+	resourceGroup := ""
+	for iter.NotDone() {
+		if v := iter.Value(); v.Name != nil && strings.EqualFold(*v.Name, iotHubName) && v.ID != nil {
+			id, err := azure.ParseAzureResourceID(*v.ID)
+			if err != nil {
+				return err
+			}
+			resourceGroup = id.ResourceGroup
+			break
+		}
+
+		if err := iter.NextWithContext(ctx); err != nil {
+			log.Printf("[DEBUG] Unable to list IoTHubs to validate Shared Access Policy %q: %+v - skipping", sharedAccessPolicyName, err)
+			return nil
+		}
+	}
+	if resourceGroup == "" {
+		log.Printf("[DEBUG] IoTHub %q was not found within the Subscription - skipping validation of the Shared Access Policy %q", iotHubName, sharedAccessPolicyName)
+		return nil
+	}
+
+	policy, err := client.GetKeysForKeyName(ctx, resourceGroup, iotHubName, sharedAccessPolicyName)
+	if err != nil {
+		if utils.ResponseWasNotFound(policy.Response) {
+			return fmt.Errorf("the Shared Access Policy %q was not found for IoTHub %q (Resource Group %q)", sharedAccessPolicyName, iotHubName, resourceGroup)
+		}
+
+		log.Printf("[DEBUG] Unable to retrieve Shared Access Policy %q for IoTHub %q (Resource Group %q): %+v - skipping", sharedAccessPolicyName, iotHubName, resourceGroup, err)
+		return nil
+	}
+
+	if !iotHubAccessRightsIncludeServiceConnect(policy.Rights) {
+		return fmt.Errorf("the Shared Access Policy %q for IoTHub %q (Resource Group %q) has the rights %q but must have the `ServiceConnect` right to be used by a Stream Analytics Job", sharedAccessPolicyName, iotHubName, resourceGroup, string(policy.Rights))
+	}
+
+	return nil
+}
+
+func iotHubAccessRightsIncludeServiceConnect(rights devices.AccessRights) bool {
+	for _, v := range strings.Split(string(rights), ",") {
+		if strings.EqualFold(strings.TrimSpace(v), string(devices.ServiceConnect)) {
+			return true
+		}
+	}
+
+	return false
+}
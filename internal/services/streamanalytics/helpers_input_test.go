@@ -0,0 +1,55 @@
+package streamanalytics
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/iothub/mgmt/2020-03-01/devices"
+)
+
+func TestIoTHubAccessRightsIncludeServiceConnect(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Rights   devices.AccessRights
+		Expected bool
+	}{
+		{
+			Name:     "Empty",
+			Rights:   "",
+			Expected: false,
+		},
+		{
+			Name:     "Service Connect",
+			Rights:   devices.ServiceConnect,
+			Expected: true,
+		},
+		{
+			Name:     "Registry Read",
+			Rights:   devices.RegistryRead,
+			Expected: false,
+		},
+		{
+			Name:     "Registry Read and Device Connect",
+			Rights:   devices.RegistryReadDeviceConnect,
+			Expected: false,
+		},
+		{
+			Name:     "Registry Read and Service Connect",
+			Rights:   devices.RegistryReadServiceConnect,
+			Expected: true,
+		},
+		{
+			Name:     "All",
+			Rights:   devices.RegistryReadRegistryWriteServiceConnectDeviceConnect,
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := iotHubAccessRightsIncludeServiceConnect(v.Rights)
+		if actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package streamanalytics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/streamanalytics/mgmt/2020-03-01-preview/streamanalytics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/parse"
+)
+
+// streamAnalyticsJobIsRunning returns whether the Job is running (or transitioning to/from running) - in which
+// case it has to be stopped before the Job, its Inputs or its Outputs can be modified
+func streamAnalyticsJobIsRunning(job streamanalytics.StreamingJob) bool {
+	if job.StreamingJobProperties == nil || job.StreamingJobProperties.JobState == nil {
+		return false
+	}
+
+	switch strings.ToLower(*job.StreamingJobProperties.JobState) {
+	case "running", "starting", "degraded", "restarting", "scaling":
+		return true
+	}
+
+	return false
+}
+
+// startStreamAnalyticsJob starts the Job, emitting output events from the point in time specified by the parameters
+func startStreamAnalyticsJob(ctx context.Context, client *streamanalytics.StreamingJobsClient, id parse.StreamingJobId, parameters streamanalytics.StartStreamingJobParameters) error {
+	log.Printf("[DEBUG] Starting %s with Output Start Mode %q..", id, string(parameters.OutputStartMode))
+	future, err := client.Start(ctx, id.ResourceGroup, id.Name, &parameters)
+	if err != nil {
+		return fmt.Errorf("starting %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to start: %+v", id, err)
+	}
+
+	return nil
+}
+
+// stopStreamAnalyticsJob stops the Job if it's running, returning whether the Job was stopped
+func stopStreamAnalyticsJob(ctx context.Context, client *streamanalytics.StreamingJobsClient, id parse.StreamingJobId) (bool, error) {
+	job, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return false, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if !streamAnalyticsJobIsRunning(job) {
+		return false, nil
+	}
+
+	log.Printf("[DEBUG] Stopping %s..", id)
+	future, err := client.Stop(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return false, fmt.Errorf("stopping %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return false, fmt.Errorf("waiting for %s to stop: %+v", id, err)
+	}
+
+	return true, nil
+}
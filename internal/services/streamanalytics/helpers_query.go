@@ -0,0 +1,125 @@
+package streamanalytics
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const streamAnalyticsQueryIdentifier = `(\[[^\]]+\]|[A-Za-z_]\w*)`
+
+var (
+	// string literals and comments are removed prior to parsing, so that keywords within these are ignored
+	streamAnalyticsQueryLiteralsAndComments = regexp.MustCompile(`(?s)'(?:[^']|'')*'|/\*.*?\*/|--[^\n]*`)
+
+	streamAnalyticsQueryStepNames  = regexp.MustCompile(`(?i)(?:\bWITH|,)\s*` + streamAnalyticsQueryIdentifier + `\s+AS\s*\(`)
+	streamAnalyticsQuerySources    = regexp.MustCompile(`(?i)\b(?:FROM|JOIN)\s+` + streamAnalyticsQueryIdentifier + `(\s*\()?`)
+	streamAnalyticsQueryOutputRefs = regexp.MustCompile(`(?i)\bINTO\s+` + streamAnalyticsQueryIdentifier)
+)
+
+// parseStreamAnalyticsQueryReferences returns the names of the Inputs which are read from (via FROM/JOIN) and the
+// Outputs which are written to (via INTO) within the Query - sources which are query steps defined using a WITH
+// clause are excluded, since these aren't Inputs.
+func parseStreamAnalyticsQueryReferences(query string) (inputs []string, outputs []string) {
+	query = streamAnalyticsQueryLiteralsAndComments.ReplaceAllString(query, " ")
+
+	steps := make(map[string]struct{})
+	for _, match := range streamAnalyticsQueryStepNames.FindAllStringSubmatch(query, -1) {
+		steps[strings.ToLower(normalizeStreamAnalyticsQueryIdentifier(match[1]))] = struct{}{}
+	}
+
+	inputs = make([]string, 0)
+	seenInputs := make(map[string]struct{})
+	for _, match := range streamAnalyticsQuerySources.FindAllStringSubmatch(query, -1) {
+		// a subquery or function call rather than an Input
+		if match[2] != "" {
+			continue
+		}
+
+		name := normalizeStreamAnalyticsQueryIdentifier(match[1])
+		key := strings.ToLower(name)
+		if _, isStep := steps[key]; isStep {
+			continue
+		}
+		if _, seen := seenInputs[key]; seen {
+			continue
+		}
+		seenInputs[key] = struct{}{}
+		inputs = append(inputs, name)
+	}
+
+	outputs = make([]string, 0)
+	seenOutputs := make(map[string]struct{})
+	for _, match := range streamAnalyticsQueryOutputRefs.FindAllStringSubmatch(query, -1) {
+		name := normalizeStreamAnalyticsQueryIdentifier(match[1])
+		key := strings.ToLower(name)
+		if _, seen := seenOutputs[key]; seen {
+			continue
+		}
+		seenOutputs[key] = struct{}{}
+		outputs = append(outputs, name)
+	}
+
+	return inputs, outputs
+}
+
+// validateStreamAnalyticsQueryReferences ensures that each of the Inputs and Outputs referenced within the Query
+// is defined on the Job, and that these names unambiguously refer to either an Input or an Output - names are
+// compared case-insensitively, as they are by Stream Analytics.
+func validateStreamAnalyticsQueryReferences(query string, inputNames []string, outputNames []string) error {
+	referencedInputs, referencedOutputs := parseStreamAnalyticsQueryReferences(query)
+
+	undefinedInputs := undefinedStreamAnalyticsQueryReferences(referencedInputs, inputNames)
+	undefinedOutputs := undefinedStreamAnalyticsQueryReferences(referencedOutputs, outputNames)
+
+	ambiguous := make([]string, 0)
+	outputKeys := make(map[string]struct{})
+	for _, name := range outputNames {
+		outputKeys[strings.ToLower(name)] = struct{}{}
+	}
+	for _, name := range inputNames {
+		if _, ok := outputKeys[strings.ToLower(name)]; ok {
+			ambiguous = append(ambiguous, name)
+		}
+	}
+	sort.Strings(ambiguous)
+
+	errors := make([]string, 0)
+	if len(ambiguous) > 0 {
+		errors = append(errors, fmt.Sprintf("the names %q are used by both an Input and an Output", ambiguous))
+	}
+	if len(undefinedInputs) > 0 {
+		errors = append(errors, fmt.Sprintf("the Inputs %q are referenced (via FROM/JOIN) but aren't defined on the Job", undefinedInputs))
+	}
+	if len(undefinedOutputs) > 0 {
+		errors = append(errors, fmt.Sprintf("the Outputs %q are referenced (via INTO) but aren't defined on the Job", undefinedOutputs))
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("`transformation_query` is invalid: %s", strings.Join(errors, " and "))
+	}
+
+	return nil
+}
+
+func undefinedStreamAnalyticsQueryReferences(referenced []string, defined []string) []string {
+	definedNames := make(map[string]struct{})
+	for _, name := range defined {
+		definedNames[strings.ToLower(name)] = struct{}{}
+	}
+
+	undefined := make([]string, 0)
+	for _, name := range referenced {
+		if _, ok := definedNames[strings.ToLower(name)]; !ok {
+			undefined = append(undefined, name)
+		}
+	}
+	sort.Strings(undefined)
+
+	return undefined
+}
+
+func normalizeStreamAnalyticsQueryIdentifier(input string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(input, "["), "]")
+}
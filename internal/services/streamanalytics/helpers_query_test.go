@@ -0,0 +1,136 @@
+package streamanalytics
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseStreamAnalyticsQueryReferences(t *testing.T) {
+	testData := []struct {
+		Name            string
+		Query           string
+		ExpectedInputs  []string
+		ExpectedOutputs []string
+	}{
+		{
+			Name:            "Simple",
+			Query:           "SELECT * INTO [YourOutputAlias] FROM [YourInputAlias]",
+			ExpectedInputs:  []string{"YourInputAlias"},
+			ExpectedOutputs: []string{"YourOutputAlias"},
+		},
+		{
+			Name: "Unbracketed With Join",
+			Query: `SELECT i1.id
+INTO output1
+FROM input1 i1 TIMESTAMP BY i1.time
+JOIN input2 i2 ON DATEDIFF(minute, i1, i2) BETWEEN 0 AND 5`,
+			ExpectedInputs:  []string{"input1", "input2"},
+			ExpectedOutputs: []string{"output1"},
+		},
+		{
+			Name: "Query Steps",
+			Query: `WITH Step1 AS (
+    SELECT * FROM [input]
+), [Step2] AS (
+    SELECT * FROM Step1
+)
+SELECT * INTO [output1] FROM [Step2]
+SELECT * INTO [output2] FROM step1`,
+			ExpectedInputs:  []string{"input"},
+			ExpectedOutputs: []string{"output1", "output2"},
+		},
+		{
+			Name: "Comments, Literals and Subqueries",
+			Query: `-- SELECT * INTO commented FROM commented
+/* SELECT * INTO blockcomment FROM blockcomment */
+SELECT 'INTO literal FROM literal' AS value
+INTO [output]
+FROM (SELECT * FROM [input]) AS sub`,
+			ExpectedInputs:  []string{"input"},
+			ExpectedOutputs: []string{"output"},
+		},
+		{
+			Name:            "Duplicate References",
+			Query:           "SELECT * INTO [output] FROM [input] SELECT * INTO [OUTPUT] FROM [Input]",
+			ExpectedInputs:  []string{"input"},
+			ExpectedOutputs: []string{"output"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		inputs, outputs := parseStreamAnalyticsQueryReferences(v.Query)
+		if !reflect.DeepEqual(inputs, v.ExpectedInputs) {
+			t.Fatalf("Expected the Inputs to be %+v but got %+v", v.ExpectedInputs, inputs)
+		}
+		if !reflect.DeepEqual(outputs, v.ExpectedOutputs) {
+			t.Fatalf("Expected the Outputs to be %+v but got %+v", v.ExpectedOutputs, outputs)
+		}
+	}
+}
+
+func TestValidateStreamAnalyticsQueryReferences(t *testing.T) {
+	testData := []struct {
+		Name    string
+		Query   string
+		Inputs  []string
+		Outputs []string
+		Error   bool
+	}{
+		{
+			Name:    "All Defined",
+			Query:   "SELECT * INTO [output] FROM [input]",
+			Inputs:  []string{"input"},
+			Outputs: []string{"output"},
+			Error:   false,
+		},
+		{
+			Name:    "Different Casing",
+			Query:   "SELECT * INTO [Output] FROM [Input]",
+			Inputs:  []string{"input"},
+			Outputs: []string{"output"},
+			Error:   false,
+		},
+		{
+			Name:    "Undefined Input",
+			Query:   "SELECT * INTO [output] FROM [input]",
+			Inputs:  []string{"other"},
+			Outputs: []string{"output"},
+			Error:   true,
+		},
+		{
+			Name:    "Undefined Output",
+			Query:   "SELECT * INTO [output] FROM [input]",
+			Inputs:  []string{"input"},
+			Outputs: []string{},
+			Error:   true,
+		},
+		{
+			Name:    "Input And Output Share A Name",
+			Query:   "SELECT * INTO [output] FROM [input]",
+			Inputs:  []string{"input", "shared"},
+			Outputs: []string{"output", "Shared"},
+			Error:   true,
+		},
+		{
+			Name:    "Output Used As Input",
+			Query:   "SELECT * INTO [output] FROM [output]",
+			Inputs:  []string{"input"},
+			Outputs: []string{"output"},
+			Error:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateStreamAnalyticsQueryReferences(v.Query, v.Inputs, v.Outputs)
+		if v.Error && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.Error && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
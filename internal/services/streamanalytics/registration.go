@@ -29,13 +29,17 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_stream_analytics_job":                     resourceStreamAnalyticsJob(),
+		"azurerm_stream_analytics_job_schedule":            resourceStreamAnalyticsJobSchedule(),
 		"azurerm_stream_analytics_function_javascript_udf": resourceStreamAnalyticsFunctionUDF(),
 		"azurerm_stream_analytics_output_blob":             resourceStreamAnalyticsOutputBlob(),
+		"azurerm_stream_analytics_output_cosmosdb":         resourceStreamAnalyticsOutputCosmosDB(),
 		"azurerm_stream_analytics_output_mssql":            resourceStreamAnalyticsOutputSql(),
 		"azurerm_stream_analytics_output_eventhub":         resourceStreamAnalyticsOutputEventHub(),
 		"azurerm_stream_analytics_output_servicebus_queue": resourceStreamAnalyticsOutputServiceBusQueue(),
 		"azurerm_stream_analytics_output_servicebus_topic": resourceStreamAnalyticsOutputServiceBusTopic(),
+		"azurerm_stream_analytics_output_table":            resourceStreamAnalyticsOutputTable(),
 		"azurerm_stream_analytics_reference_input_blob":    resourceStreamAnalyticsReferenceInputBlob(),
+		"azurerm_stream_analytics_reference_input_mssql":   resourceStreamAnalyticsReferenceInputMsSql(),
 		"azurerm_stream_analytics_stream_input_blob":       resourceStreamAnalyticsStreamInputBlob(),
 		"azurerm_stream_analytics_stream_input_eventhub":   resourceStreamAnalyticsStreamInputEventHub(),
 		"azurerm_stream_analytics_stream_input_iothub":     resourceStreamAnalyticsStreamInputIoTHub(),
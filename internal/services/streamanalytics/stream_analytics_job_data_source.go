@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/preview/streamanalytics/mgmt/2020-03-01-preview/streamanalytics"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -85,6 +86,16 @@ func dataSourceArmStreamAnalyticsJob() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"job_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"streaming_units": {
 				Type:     pluginsdk.TypeInt,
 				Computed: true,
@@ -139,6 +150,18 @@ func dataSourceArmStreamAnalyticsJobRead(d *pluginsdk.ResourceData, meta interfa
 		d.Set("job_id", props.JobID)
 		d.Set("output_error_policy", string(props.OutputErrorPolicy))
 
+		skuName := string(streamanalytics.Standard)
+		if props.Sku != nil && props.Sku.Name != "" {
+			skuName = string(props.Sku.Name)
+		}
+		d.Set("sku_name", skuName)
+
+		jobType := string(streamanalytics.Cloud)
+		if props.JobType != "" {
+			jobType = string(props.JobType)
+		}
+		d.Set("job_type", jobType)
+
 		if props.Transformation != nil && props.Transformation.TransformationProperties != nil {
 			d.Set("streaming_units", props.Transformation.TransformationProperties.StreamingUnits)
 			d.Set("transformation_query", props.Transformation.TransformationProperties.Query)
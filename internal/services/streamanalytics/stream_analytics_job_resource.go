@@ -1,6 +1,7 @@
 package streamanalytics
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -105,10 +106,32 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 				Default: string(streamanalytics.OutputErrorPolicyDrop),
 			},
 
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(streamanalytics.Standard),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(streamanalytics.Standard),
+					streamAnalyticsJobSkuNameStandardV2,
+				}, false),
+			},
+
+			"job_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(streamanalytics.Cloud),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(streamanalytics.Cloud),
+					string(streamanalytics.Edge),
+				}, false),
+			},
+
+			// the valid values depend on the `sku_name` - as such this is validated in the CustomizeDiff
 			"streaming_units": {
 				Type:         pluginsdk.TypeInt,
-				Required:     true,
-				ValidateFunc: validate.StreamAnalyticsJobStreamingUnits,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 660),
 			},
 
 			"transformation_query": {
@@ -149,7 +172,93 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			resourceStreamAnalyticsJobSkuCustomizeDiff,
+			resourceStreamAnalyticsJobCustomizeDiff,
+		),
+	}
+}
+
+// StandardV2 isn't defined in the SDK
+const streamAnalyticsJobSkuNameStandardV2 = "StandardV2"
+
+func resourceStreamAnalyticsJobSkuCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	skuName := d.Get("sku_name").(string)
+	streamingUnits, hasStreamingUnits := d.GetOk("streaming_units")
+
+	// Edge Jobs run on the IoT Edge Device rather than in Azure, so the Streaming Units aren't applicable
+	if d.Get("job_type").(string) == string(streamanalytics.Edge) {
+		if hasStreamingUnits {
+			return fmt.Errorf("`streaming_units` cannot be set when `job_type` is `%s`", string(streamanalytics.Edge))
+		}
+		if skuName != string(streamanalytics.Standard) {
+			return fmt.Errorf("`sku_name` must be `%s` when `job_type` is `%s`", string(streamanalytics.Standard), string(streamanalytics.Edge))
+		}
+		return nil
+	}
+
+	if !hasStreamingUnits {
+		return fmt.Errorf("`streaming_units` must be set when `job_type` is `%s`", string(streamanalytics.Cloud))
+	}
+
+	validateFunc := validate.StreamAnalyticsJobStreamingUnits
+	if skuName == streamAnalyticsJobSkuNameStandardV2 {
+		validateFunc = validate.StreamAnalyticsJobStreamingUnitsV2
+	}
+	if _, errs := validateFunc(streamingUnits.(int), "streaming_units"); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+func resourceStreamAnalyticsJobCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client)
+	if !client.Features.StreamAnalyticsJob.ValidateTransformationQueryReferences {
+		return nil
+	}
+
+	// the Inputs and Outputs are separate resources which depend on the Job, as such the query can only be
+	// cross-checked against those which already exist once the Job has been created
+	if d.Id() == "" || !d.HasChange("transformation_query") {
+		return nil
+	}
+
+	id, err := parse.StreamingJobID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	inputNames := make([]string, 0)
+	inputs, err := client.StreamAnalytics.InputsClient.ListByStreamingJobComplete(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("listing Inputs for %s: %+v", *id, err)
+	}
+	for inputs.NotDone() {
+		if name := inputs.Value().Name; name != nil {
+			inputNames = append(inputNames, *name)
+		}
+		if err := inputs.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Inputs for %s: %+v", *id, err)
+		}
+	}
+
+	outputNames := make([]string, 0)
+	outputs, err := client.StreamAnalytics.OutputsClient.ListByStreamingJobComplete(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("listing Outputs for %s: %+v", *id, err)
+	}
+	for outputs.NotDone() {
+		if name := outputs.Value().Name; name != nil {
+			outputNames = append(outputNames, *name)
+		}
+		if err := outputs.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Outputs for %s: %+v", *id, err)
+		}
 	}
+
+	return validateStreamAnalyticsQueryReferences(d.Get("transformation_query").(string), inputNames, outputNames)
 }
 
 func resourceStreamAnalyticsJobCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -182,6 +291,7 @@ func resourceStreamAnalyticsJobCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	eventsOutOfOrderPolicy := d.Get("events_out_of_order_policy").(string)
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	outputErrorPolicy := d.Get("output_error_policy").(string)
+	jobType := d.Get("job_type").(string)
 	streamingUnits := d.Get("streaming_units").(int)
 	transformationQuery := d.Get("transformation_query").(string)
 	t := d.Get("tags").(map[string]interface{})
@@ -190,18 +300,21 @@ func resourceStreamAnalyticsJobCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	transformation := streamanalytics.Transformation{
 		Name: utils.String("main"),
 		TransformationProperties: &streamanalytics.TransformationProperties{
-			StreamingUnits: utils.Int32(int32(streamingUnits)),
-			Query:          utils.String(transformationQuery),
+			Query: utils.String(transformationQuery),
 		},
 	}
+	if jobType == string(streamanalytics.Cloud) {
+		transformation.TransformationProperties.StreamingUnits = utils.Int32(int32(streamingUnits))
+	}
 
 	props := streamanalytics.StreamingJob{
 		Name:     utils.String(name),
 		Location: utils.String(location),
 		StreamingJobProperties: &streamanalytics.StreamingJobProperties{
 			Sku: &streamanalytics.StreamingJobSku{
-				Name: streamanalytics.Standard,
+				Name: streamanalytics.StreamingJobSkuName(d.Get("sku_name").(string)),
 			},
+			JobType:                            streamanalytics.JobType(jobType),
 			CompatibilityLevel:                 streamanalytics.CompatibilityLevel(compatibilityLevel),
 			EventsLateArrivalMaxDelayInSeconds: utils.Int32(int32(eventsLateArrivalMaxDelayInSeconds)),
 			EventsOutOfOrderMaxDelayInSeconds:  utils.Int32(int32(eventsOutOfOrderMaxDelayInSeconds)),
@@ -241,6 +354,17 @@ func resourceStreamAnalyticsJobCreateUpdate(d *pluginsdk.ResourceData, meta inte
 
 		d.SetId(*read.ID)
 	} else {
+		id, err := parse.StreamingJobID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		// a running Job can't be modified, so it's stopped for the update and then resumed from the last output event
+		wasRunning, err := stopStreamAnalyticsJob(ctx, client, *id)
+		if err != nil {
+			return err
+		}
+
 		if _, err := client.Update(ctx, props, resourceGroup, name, ""); err != nil {
 			return fmt.Errorf("Error Updating Stream Analytics Job %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
@@ -255,6 +379,15 @@ func resourceStreamAnalyticsJobCreateUpdate(d *pluginsdk.ResourceData, meta inte
 				return fmt.Errorf("Error Updating Transformation for Stream Analytics Job %q (Resource Group %q): %+v", name, resourceGroup, err)
 			}
 		}
+
+		if wasRunning {
+			parameters := streamanalytics.StartStreamingJobParameters{
+				OutputStartMode: streamanalytics.LastOutputEventTime,
+			}
+			if err := startStreamAnalyticsJob(ctx, client, *id, parameters); err != nil {
+				return err
+			}
+		}
 	}
 
 	return resourceStreamAnalyticsJobRead(d, meta)
@@ -304,11 +437,24 @@ func resourceStreamAnalyticsJobRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("events_out_of_order_policy", string(props.EventsOutOfOrderPolicy))
 		d.Set("output_error_policy", string(props.OutputErrorPolicy))
 
+		skuName := string(streamanalytics.Standard)
+		if props.Sku != nil && props.Sku.Name != "" {
+			skuName = string(props.Sku.Name)
+		}
+		d.Set("sku_name", skuName)
+
+		jobType := string(streamanalytics.Cloud)
+		if props.JobType != "" {
+			jobType = string(props.JobType)
+		}
+		d.Set("job_type", jobType)
+
 		// Computed
 		d.Set("job_id", props.JobID)
 
 		if transformation := props.Transformation; transformation != nil {
-			if units := transformation.StreamingUnits; units != nil {
+			// the Streaming Units aren't applicable for Edge Jobs
+			if units := transformation.StreamingUnits; units != nil && jobType == string(streamanalytics.Cloud) {
 				d.Set("streaming_units", int(*units))
 			}
 			d.Set("transformation_query", transformation.Query)
@@ -0,0 +1,229 @@
+package streamanalytics
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/streamanalytics/mgmt/2020-03-01-preview/streamanalytics"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStreamAnalyticsJobSchedule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStreamAnalyticsJobScheduleCreate,
+		Read:   resourceStreamAnalyticsJobScheduleRead,
+		Update: resourceStreamAnalyticsJobScheduleUpdate,
+		Delete: resourceStreamAnalyticsJobScheduleDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StreamingJobScheduleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"stream_analytics_job_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StreamingJobID,
+			},
+
+			"start_mode": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(streamanalytics.JobStartTime),
+					string(streamanalytics.CustomTime),
+					string(streamanalytics.LastOutputEventTime),
+				}, false),
+			},
+
+			"start_time": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"last_output_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStreamAnalyticsJobScheduleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.JobsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	jobId, err := parse.StreamingJobID(d.Get("stream_analytics_job_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStreamingJobScheduleID(jobId.SubscriptionId, jobId.ResourceGroup, jobId.Name, "default")
+
+	existing, err := client.Get(ctx, jobId.ResourceGroup, jobId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *jobId, err)
+	}
+
+	if streamAnalyticsJobIsRunning(existing) {
+		return tf.ImportAsExistsError("azurerm_stream_analytics_job_schedule", id.ID())
+	}
+
+	parameters, err := expandStreamAnalyticsJobScheduleStartParameters(d)
+	if err != nil {
+		return err
+	}
+
+	if err := startStreamAnalyticsJob(ctx, client, *jobId, *parameters); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStreamAnalyticsJobScheduleRead(d, meta)
+}
+
+func resourceStreamAnalyticsJobScheduleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.JobsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StreamingJobScheduleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	jobId := parse.NewStreamingJobID(id.SubscriptionId, id.ResourceGroup, id.StreamingjobName)
+
+	resp, err := client.Get(ctx, jobId.ResourceGroup, jobId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", jobId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", jobId, err)
+	}
+
+	d.Set("stream_analytics_job_id", jobId.ID())
+
+	if props := resp.StreamingJobProperties; props != nil {
+		startTime := ""
+		if props.OutputStartTime != nil {
+			startTime = props.OutputStartTime.String()
+		}
+		d.Set("start_time", startTime)
+
+		lastOutputTime := ""
+		if props.LastOutputEventTime != nil {
+			lastOutputTime = props.LastOutputEventTime.String()
+		}
+		d.Set("last_output_time", lastOutputTime)
+
+		if props.OutputStartMode != "" {
+			d.Set("start_mode", string(props.OutputStartMode))
+		}
+	}
+
+	return nil
+}
+
+func resourceStreamAnalyticsJobScheduleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.JobsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StreamingJobScheduleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	jobId := parse.NewStreamingJobID(id.SubscriptionId, id.ResourceGroup, id.StreamingjobName)
+
+	parameters, err := expandStreamAnalyticsJobScheduleStartParameters(d)
+	if err != nil {
+		return err
+	}
+
+	// the start parameters can only be changed by stopping and then starting the Job again
+	if _, err := stopStreamAnalyticsJob(ctx, client, jobId); err != nil {
+		return err
+	}
+
+	if err := startStreamAnalyticsJob(ctx, client, jobId, *parameters); err != nil {
+		return err
+	}
+
+	return resourceStreamAnalyticsJobScheduleRead(d, meta)
+}
+
+func resourceStreamAnalyticsJobScheduleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.JobsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StreamingJobScheduleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	jobId := parse.NewStreamingJobID(id.SubscriptionId, id.ResourceGroup, id.StreamingjobName)
+
+	if _, err := stopStreamAnalyticsJob(ctx, client, jobId); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func expandStreamAnalyticsJobScheduleStartParameters(d *pluginsdk.ResourceData) (*streamanalytics.StartStreamingJobParameters, error) {
+	startMode := streamanalytics.OutputStartMode(d.Get("start_mode").(string))
+	parameters := streamanalytics.StartStreamingJobParameters{
+		OutputStartMode: startMode,
+	}
+
+	if startMode != streamanalytics.CustomTime {
+		// `start_time` is Computed, so only a value specified in the configuration is an error here
+		if d.HasChange("start_time") && d.Get("start_time").(string) != "" {
+			return nil, fmt.Errorf("`start_time` can only be specified when `start_mode` is `%s`", string(streamanalytics.CustomTime))
+		}
+
+		return &parameters, nil
+	}
+
+	v := d.Get("start_time").(string)
+	if v == "" {
+		return nil, fmt.Errorf("`start_time` must be specified when `start_mode` is `%s`", string(streamanalytics.CustomTime))
+	}
+
+	startTime, err := date.ParseTime(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `start_time`: %+v", err)
+	}
+	parameters.OutputStartTime = &date.Time{
+		Time: startTime,
+	}
+
+	return &parameters, nil
+}
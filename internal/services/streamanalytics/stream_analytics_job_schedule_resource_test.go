@@ -0,0 +1,246 @@
+package streamanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StreamAnalyticsJobScheduleResource struct{}
+
+func TestAccStreamAnalyticsJobSchedule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_job_schedule", "test")
+	r := StreamAnalyticsJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("start_mode").HasValue("JobStartTime"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStreamAnalyticsJobSchedule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_job_schedule", "test")
+	r := StreamAnalyticsJobScheduleResource{}
+	startTime := time.Now().UTC().Add(-time.Hour).Format(time.RFC3339)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.customTime(data, startTime),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("start_mode").HasValue("CustomTime"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.lastOutputEventTime(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("start_mode").HasValue("LastOutputEventTime"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStreamAnalyticsJobSchedule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_job_schedule", "test")
+	r := StreamAnalyticsJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StreamAnalyticsJobScheduleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StreamingJobScheduleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.StreamAnalytics.JobsClient.Get(ctx, id.ResourceGroup, id.StreamingjobName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.StreamingJobProperties; props != nil && props.JobState != nil {
+		return utils.Bool(*props.JobState == "Running"), nil
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r StreamAnalyticsJobScheduleResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_job_schedule" "test" {
+  stream_analytics_job_id = azurerm_stream_analytics_job.test.id
+  start_mode              = "JobStartTime"
+
+  depends_on = [
+    azurerm_stream_analytics_stream_input_blob.test,
+    azurerm_stream_analytics_output_blob.test,
+  ]
+}
+`, template)
+}
+
+func (r StreamAnalyticsJobScheduleResource) customTime(data acceptance.TestData, startTime string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_job_schedule" "test" {
+  stream_analytics_job_id = azurerm_stream_analytics_job.test.id
+  start_mode              = "CustomTime"
+  start_time              = "%s"
+
+  depends_on = [
+    azurerm_stream_analytics_stream_input_blob.test,
+    azurerm_stream_analytics_output_blob.test,
+  ]
+}
+`, template, startTime)
+}
+
+func (r StreamAnalyticsJobScheduleResource) lastOutputEventTime(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_job_schedule" "test" {
+  stream_analytics_job_id = azurerm_stream_analytics_job.test.id
+  start_mode              = "LastOutputEventTime"
+
+  depends_on = [
+    azurerm_stream_analytics_stream_input_blob.test,
+    azurerm_stream_analytics_output_blob.test,
+  ]
+}
+`, template)
+}
+
+func (r StreamAnalyticsJobScheduleResource) requiresImport(data acceptance.TestData) string {
+	template := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_job_schedule" "import" {
+  stream_analytics_job_id = azurerm_stream_analytics_job_schedule.test.stream_analytics_job_id
+  start_mode              = azurerm_stream_analytics_job_schedule.test.start_mode
+}
+`, template)
+}
+
+func (r StreamAnalyticsJobScheduleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "example"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_stream_analytics_job" "test" {
+  name                                     = "acctestjob-%[1]d"
+  resource_group_name                      = azurerm_resource_group.test.name
+  location                                 = azurerm_resource_group.test.location
+  compatibility_level                      = "1.0"
+  data_locale                              = "en-GB"
+  events_late_arrival_max_delay_in_seconds = 60
+  events_out_of_order_max_delay_in_seconds = 50
+  events_out_of_order_policy               = "Adjust"
+  output_error_policy                      = "Drop"
+  streaming_units                          = 3
+
+  transformation_query = <<QUERY
+    SELECT *
+    INTO [acctestoutput-%[1]d]
+    FROM [acctestinput-%[1]d]
+QUERY
+}
+
+resource "azurerm_stream_analytics_stream_input_blob" "test" {
+  name                      = "acctestinput-%[1]d"
+  stream_analytics_job_name = azurerm_stream_analytics_job.test.name
+  resource_group_name       = azurerm_stream_analytics_job.test.resource_group_name
+  storage_account_name      = azurerm_storage_account.test.name
+  storage_account_key       = azurerm_storage_account.test.primary_access_key
+  storage_container_name    = azurerm_storage_container.test.name
+  path_pattern              = "some-random-pattern"
+  date_format               = "yyyy/MM/dd"
+  time_format               = "HH"
+
+  serialization {
+    type     = "Json"
+    encoding = "UTF8"
+  }
+}
+
+resource "azurerm_stream_analytics_output_blob" "test" {
+  name                      = "acctestoutput-%[1]d"
+  stream_analytics_job_name = azurerm_stream_analytics_job.test.name
+  resource_group_name       = azurerm_stream_analytics_job.test.resource_group_name
+  storage_account_name      = azurerm_storage_account.test.name
+  storage_account_key       = azurerm_storage_account.test.primary_access_key
+  storage_container_name    = azurerm_storage_container.test.name
+  path_pattern              = "some-other-pattern"
+  date_format               = "yyyy-MM-dd"
+  time_format               = "HH"
+
+  serialization {
+    type     = "Json"
+    encoding = "UTF8"
+    format   = "LineSeparated"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
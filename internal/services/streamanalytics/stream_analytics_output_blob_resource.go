@@ -65,7 +65,7 @@ func resourceStreamAnalyticsOutputBlob() *pluginsdk.Resource {
 
 			"storage_account_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
@@ -89,12 +89,15 @@ func resourceStreamAnalyticsOutputBlob() *pluginsdk.Resource {
 			},
 
 			"serialization": schemaStreamAnalyticsOutputSerialization(),
+
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
 		},
 	}
 }
 
 func resourceStreamAnalyticsOutputBlobCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	jobsClient := meta.(*clients.Client).StreamAnalytics.JobsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -122,6 +125,14 @@ func resourceStreamAnalyticsOutputBlobCreateUpdate(d *pluginsdk.ResourceData, me
 	storageAccountKey := d.Get("storage_account_key").(string)
 	storageAccountName := d.Get("storage_account_name").(string)
 	timeFormat := d.Get("time_format").(string)
+	authenticationMode := d.Get("authentication_mode").(string)
+
+	credentials := map[string]string{
+		"storage_account_key": storageAccountKey,
+	}
+	if err := validateStreamAnalyticsAuthenticationMode(ctx, jobsClient, resourceGroup, jobName, authenticationMode, credentials); err != nil {
+		return err
+	}
 
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
@@ -129,22 +140,25 @@ func resourceStreamAnalyticsOutputBlobCreateUpdate(d *pluginsdk.ResourceData, me
 		return fmt.Errorf("Error expanding `serialization`: %+v", err)
 	}
 
+	storageAccount := streamanalytics.StorageAccount{
+		AccountName: utils.String(storageAccountName),
+	}
+	if authenticationMode == string(streamanalytics.ConnectionString) {
+		storageAccount.AccountKey = utils.String(storageAccountKey)
+	}
+
 	props := streamanalytics.Output{
 		Name: utils.String(name),
 		OutputProperties: &streamanalytics.OutputProperties{
 			Datasource: &streamanalytics.BlobOutputDataSource{
 				Type: streamanalytics.TypeMicrosoftStorageBlob,
 				BlobOutputDataSourceProperties: &streamanalytics.BlobOutputDataSourceProperties{
-					StorageAccounts: &[]streamanalytics.StorageAccount{
-						{
-							AccountKey:  utils.String(storageAccountKey),
-							AccountName: utils.String(storageAccountName),
-						},
-					},
-					Container:   utils.String(containerName),
-					DateFormat:  utils.String(dateFormat),
-					PathPattern: utils.String(pathPattern),
-					TimeFormat:  utils.String(timeFormat),
+					StorageAccounts:    &[]streamanalytics.StorageAccount{storageAccount},
+					Container:          utils.String(containerName),
+					DateFormat:         utils.String(dateFormat),
+					PathPattern:        utils.String(pathPattern),
+					TimeFormat:         utils.String(timeFormat),
+					AuthenticationMode: streamanalytics.AuthenticationMode(authenticationMode),
 				},
 			},
 			Serialization: serialization,
@@ -207,6 +221,7 @@ func resourceStreamAnalyticsOutputBlobRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("path_pattern", v.PathPattern)
 		d.Set("storage_container_name", v.Container)
 		d.Set("time_format", v.TimeFormat)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 
 		if accounts := v.StorageAccounts; accounts != nil && len(*accounts) > 0 {
 			account := (*accounts)[0]
@@ -0,0 +1,238 @@
+package streamanalytics
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/streamanalytics/mgmt/2020-03-01-preview/streamanalytics"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	cosmosParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	cosmosValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStreamAnalyticsOutputCosmosDB() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStreamAnalyticsOutputCosmosDBCreateUpdate,
+		Read:   resourceStreamAnalyticsOutputCosmosDBRead,
+		Update: resourceStreamAnalyticsOutputCosmosDBCreateUpdate,
+		Delete: resourceStreamAnalyticsOutputCosmosDBDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.OutputID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"stream_analytics_job_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"cosmosdb_account_key": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cosmosdb_sql_database_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: cosmosValidate.SqlDatabaseID,
+			},
+
+			"container_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"document_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"partition_key": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceStreamAnalyticsOutputCosmosDBCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Output CosmosDB creation.")
+	name := d.Get("name").(string)
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q): %s", name, jobName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_stream_analytics_output_cosmosdb", *existing.ID)
+		}
+	}
+
+	databaseId, err := cosmosParse.SqlDatabaseID(d.Get("cosmosdb_sql_database_id").(string))
+	if err != nil {
+		return err
+	}
+
+	dataSource := &streamanalytics.DocumentDbOutputDataSourceProperties{
+		AccountID:             utils.String(databaseId.DatabaseAccountName),
+		AccountKey:            utils.String(d.Get("cosmosdb_account_key").(string)),
+		Database:              utils.String(databaseId.Name),
+		CollectionNamePattern: utils.String(d.Get("container_name").(string)),
+	}
+	if v, ok := d.GetOk("document_id"); ok {
+		dataSource.DocumentID = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("partition_key"); ok {
+		dataSource.PartitionKey = utils.String(v.(string))
+	}
+
+	props := streamanalytics.Output{
+		Name: utils.String(name),
+		OutputProperties: &streamanalytics.OutputProperties{
+			Datasource: &streamanalytics.DocumentDbOutputDataSource{
+				Type:                                 streamanalytics.TypeMicrosoftStorageDocumentDB,
+				DocumentDbOutputDataSourceProperties: dataSource,
+			},
+		},
+	}
+
+	if d.IsNewResource() {
+		if _, err := client.CreateOrReplace(ctx, props, resourceGroup, jobName, name, "", ""); err != nil {
+			return fmt.Errorf("creating Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+
+		read, err := client.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			return fmt.Errorf("retrieving Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+		if read.ID == nil {
+			return fmt.Errorf("cannot read ID of Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q)", name, jobName, resourceGroup)
+		}
+
+		d.SetId(*read.ID)
+	} else if _, err := client.Update(ctx, props, resourceGroup, jobName, name, ""); err != nil {
+		return fmt.Errorf("updating Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	return resourceStreamAnalyticsOutputCosmosDBRead(d, meta)
+}
+
+func resourceStreamAnalyticsOutputCosmosDBRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.OutputID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StreamingjobName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("stream_analytics_job_name", id.StreamingjobName)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := resp.OutputProperties; props != nil {
+		v, ok := props.Datasource.AsDocumentDbOutputDataSource()
+		if !ok {
+			return fmt.Errorf("converting Output Data Source to a CosmosDB Output")
+		}
+
+		if v.DocumentDbOutputDataSourceProperties != nil {
+			accountName := ""
+			if v.AccountID != nil {
+				accountName = *v.AccountID
+			}
+			databaseName := ""
+			if v.Database != nil {
+				databaseName = *v.Database
+			}
+			// the API only returns the Account and Database Name, so the Resource Group of the CosmosDB Account is
+			// taken from the existing Database ID when available (e.g. not during import)
+			databaseId := cosmosParse.NewSqlDatabaseID(id.SubscriptionId, id.ResourceGroup, accountName, databaseName)
+			if existing, err := cosmosParse.SqlDatabaseID(d.Get("cosmosdb_sql_database_id").(string)); err == nil {
+				databaseId.SubscriptionId = existing.SubscriptionId
+				databaseId.ResourceGroup = existing.ResourceGroup
+			}
+			d.Set("cosmosdb_sql_database_id", databaseId.ID())
+
+			d.Set("container_name", v.CollectionNamePattern)
+			d.Set("document_id", v.DocumentID)
+			d.Set("partition_key", v.PartitionKey)
+		}
+	}
+
+	return nil
+}
+
+func resourceStreamAnalyticsOutputCosmosDBDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.OutputID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ResourceGroup, id.StreamingjobName, id.Name); err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
@@ -64,16 +64,18 @@ func resourceStreamAnalyticsOutputEventHub() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
+
 			"shared_access_policy_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"shared_access_policy_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
@@ -84,6 +86,7 @@ func resourceStreamAnalyticsOutputEventHub() *pluginsdk.Resource {
 
 func resourceStreamAnalyticsOutputEventHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	jobsClient := meta.(*clients.Client).StreamAnalytics.JobsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -107,8 +110,16 @@ func resourceStreamAnalyticsOutputEventHubCreateUpdate(d *pluginsdk.ResourceData
 
 	eventHubName := d.Get("eventhub_name").(string)
 	serviceBusNamespace := d.Get("servicebus_namespace").(string)
+	authenticationMode := d.Get("authentication_mode").(string)
 	sharedAccessPolicyKey := d.Get("shared_access_policy_key").(string)
 	sharedAccessPolicyName := d.Get("shared_access_policy_name").(string)
+	credentials := map[string]string{
+		"shared_access_policy_key":  sharedAccessPolicyKey,
+		"shared_access_policy_name": sharedAccessPolicyName,
+	}
+	if err := validateStreamAnalyticsAuthenticationMode(ctx, jobsClient, resourceGroup, jobName, authenticationMode, credentials); err != nil {
+		return err
+	}
 
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
@@ -116,17 +127,22 @@ func resourceStreamAnalyticsOutputEventHubCreateUpdate(d *pluginsdk.ResourceData
 		return fmt.Errorf("Error expanding `serialization`: %+v", err)
 	}
 
+	dataSource := &streamanalytics.EventHubOutputDataSourceProperties{
+		EventHubName:        utils.String(eventHubName),
+		ServiceBusNamespace: utils.String(serviceBusNamespace),
+		AuthenticationMode:  streamanalytics.AuthenticationMode(authenticationMode),
+	}
+	if authenticationMode == string(streamanalytics.ConnectionString) {
+		dataSource.SharedAccessPolicyKey = utils.String(sharedAccessPolicyKey)
+		dataSource.SharedAccessPolicyName = utils.String(sharedAccessPolicyName)
+	}
+
 	props := streamanalytics.Output{
 		Name: utils.String(name),
 		OutputProperties: &streamanalytics.OutputProperties{
 			Datasource: &streamanalytics.EventHubOutputDataSource{
-				Type: streamanalytics.TypeMicrosoftServiceBusEventHub,
-				EventHubOutputDataSourceProperties: &streamanalytics.EventHubOutputDataSourceProperties{
-					EventHubName:           utils.String(eventHubName),
-					ServiceBusNamespace:    utils.String(serviceBusNamespace),
-					SharedAccessPolicyKey:  utils.String(sharedAccessPolicyKey),
-					SharedAccessPolicyName: utils.String(sharedAccessPolicyName),
-				},
+				Type:                               streamanalytics.TypeMicrosoftServiceBusEventHub,
+				EventHubOutputDataSourceProperties: dataSource,
 			},
 			Serialization: serialization,
 		},
@@ -187,6 +203,7 @@ func resourceStreamAnalyticsOutputEventHubRead(d *pluginsdk.ResourceData, meta i
 		d.Set("eventhub_name", v.EventHubName)
 		d.Set("servicebus_namespace", v.ServiceBusNamespace)
 		d.Set("shared_access_policy_name", v.SharedAccessPolicyName)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 
 		if err := d.Set("serialization", flattenStreamAnalyticsOutputSerialization(props.Serialization)); err != nil {
 			return fmt.Errorf("setting `serialization`: %+v", err)
@@ -82,6 +82,30 @@ func TestAccStreamAnalyticsOutputEventHub_jsonArrayFormat(t *testing.T) {
 	})
 }
 
+func TestAccStreamAnalyticsOutputEventHub_authenticationModeMsi(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_output_eventhub", "test")
+	r := StreamAnalyticsOutputEventhubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.authenticationModeMsi(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authentication_mode").HasValue("Msi"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.json(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authentication_mode").HasValue("ConnectionString"),
+			),
+		},
+		data.ImportStep("shared_access_policy_key"),
+	})
+}
+
 func TestAccStreamAnalyticsOutputEventHub_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_output_eventhub", "test")
 	r := StreamAnalyticsOutputEventhubResource{}
@@ -201,6 +225,36 @@ resource "azurerm_stream_analytics_output_eventhub" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r StreamAnalyticsOutputEventhubResource) authenticationModeMsi(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_eventhub_namespace.test.id
+  role_definition_name = "Azure Event Hubs Data Sender"
+  principal_id         = azurerm_stream_analytics_job.test.identity.0.principal_id
+}
+
+resource "azurerm_stream_analytics_output_eventhub" "test" {
+  name                      = "acctestinput-%d"
+  stream_analytics_job_name = azurerm_stream_analytics_job.test.name
+  resource_group_name       = azurerm_stream_analytics_job.test.resource_group_name
+  eventhub_name             = azurerm_eventhub.test.name
+  servicebus_namespace      = azurerm_eventhub_namespace.test.name
+  authentication_mode       = "Msi"
+
+  serialization {
+    type     = "Json"
+    encoding = "UTF8"
+    format   = "LineSeparated"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, template, data.RandomInteger)
+}
+
 func (r StreamAnalyticsOutputEventhubResource) jsonArrayFormat(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -323,6 +377,10 @@ resource "azurerm_stream_analytics_job" "test" {
   output_error_policy                      = "Drop"
   streaming_units                          = 3
 
+  identity {
+    type = "SystemAssigned"
+  }
+
   transformation_query = <<QUERY
     SELECT *
     INTO [YourOutputAlias]
@@ -79,23 +79,26 @@ func resourceStreamAnalyticsOutputSql() *pluginsdk.Resource {
 
 			"user": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"password": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
 		},
 	}
 }
 
 func resourceStreamAnalyticsOutputSqlCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	jobsClient := meta.(*clients.Client).StreamAnalytics.JobsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -120,19 +123,33 @@ func resourceStreamAnalyticsOutputSqlCreateUpdate(d *pluginsdk.ResourceData, met
 	tableName := d.Get("table").(string)
 	sqlUser := d.Get("user").(string)
 	sqlUserPassword := d.Get("password").(string)
+	authenticationMode := d.Get("authentication_mode").(string)
+
+	credentials := map[string]string{
+		"user":     sqlUser,
+		"password": sqlUserPassword,
+	}
+	if err := validateStreamAnalyticsAuthenticationMode(ctx, jobsClient, resourceGroup, jobName, authenticationMode, credentials); err != nil {
+		return err
+	}
+
+	dataSource := &streamanalytics.AzureSQLDatabaseOutputDataSourceProperties{
+		Server:             utils.String(server),
+		Database:           utils.String(databaseName),
+		Table:              utils.String(tableName),
+		AuthenticationMode: streamanalytics.AuthenticationMode(authenticationMode),
+	}
+	if authenticationMode == string(streamanalytics.ConnectionString) {
+		dataSource.User = utils.String(sqlUser)
+		dataSource.Password = utils.String(sqlUserPassword)
+	}
 
 	props := streamanalytics.Output{
 		Name: utils.String(name),
 		OutputProperties: &streamanalytics.OutputProperties{
 			Datasource: &streamanalytics.AzureSQLDatabaseOutputDataSource{
 				Type: streamanalytics.TypeMicrosoftSQLServerDatabase,
-				AzureSQLDatabaseOutputDataSourceProperties: &streamanalytics.AzureSQLDatabaseOutputDataSourceProperties{
-					Server:   utils.String(server),
-					Database: utils.String(databaseName),
-					User:     utils.String(sqlUser),
-					Password: utils.String(sqlUserPassword),
-					Table:    utils.String(tableName),
-				},
+				AzureSQLDatabaseOutputDataSourceProperties: dataSource,
 			},
 		},
 	}
@@ -192,6 +209,7 @@ func resourceStreamAnalyticsOutputSqlRead(d *pluginsdk.ResourceData, meta interf
 		d.Set("database", v.Database)
 		d.Set("table", v.Table)
 		d.Set("user", v.User)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 	}
 
 	return nil
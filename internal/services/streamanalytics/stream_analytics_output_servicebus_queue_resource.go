@@ -64,16 +64,18 @@ func resourceStreamAnalyticsOutputServiceBusQueue() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
+
 			"shared_access_policy_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"shared_access_policy_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
@@ -84,6 +86,7 @@ func resourceStreamAnalyticsOutputServiceBusQueue() *pluginsdk.Resource {
 
 func resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	jobsClient := meta.(*clients.Client).StreamAnalytics.JobsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -107,8 +110,16 @@ func resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate(d *pluginsdk.Resou
 
 	queueName := d.Get("queue_name").(string)
 	serviceBusNamespace := d.Get("servicebus_namespace").(string)
+	authenticationMode := d.Get("authentication_mode").(string)
 	sharedAccessPolicyKey := d.Get("shared_access_policy_key").(string)
 	sharedAccessPolicyName := d.Get("shared_access_policy_name").(string)
+	credentials := map[string]string{
+		"shared_access_policy_key":  sharedAccessPolicyKey,
+		"shared_access_policy_name": sharedAccessPolicyName,
+	}
+	if err := validateStreamAnalyticsAuthenticationMode(ctx, jobsClient, resourceGroup, jobName, authenticationMode, credentials); err != nil {
+		return err
+	}
 
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
@@ -116,17 +127,22 @@ func resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate(d *pluginsdk.Resou
 		return fmt.Errorf("Error expanding `serialization`: %+v", err)
 	}
 
+	dataSource := &streamanalytics.ServiceBusQueueOutputDataSourceProperties{
+		QueueName:           utils.String(queueName),
+		ServiceBusNamespace: utils.String(serviceBusNamespace),
+		AuthenticationMode:  streamanalytics.AuthenticationMode(authenticationMode),
+	}
+	if authenticationMode == string(streamanalytics.ConnectionString) {
+		dataSource.SharedAccessPolicyKey = utils.String(sharedAccessPolicyKey)
+		dataSource.SharedAccessPolicyName = utils.String(sharedAccessPolicyName)
+	}
+
 	props := streamanalytics.Output{
 		Name: utils.String(name),
 		OutputProperties: &streamanalytics.OutputProperties{
 			Datasource: &streamanalytics.ServiceBusQueueOutputDataSource{
 				Type: streamanalytics.TypeMicrosoftServiceBusQueue,
-				ServiceBusQueueOutputDataSourceProperties: &streamanalytics.ServiceBusQueueOutputDataSourceProperties{
-					QueueName:              utils.String(queueName),
-					ServiceBusNamespace:    utils.String(serviceBusNamespace),
-					SharedAccessPolicyKey:  utils.String(sharedAccessPolicyKey),
-					SharedAccessPolicyName: utils.String(sharedAccessPolicyName),
-				},
+				ServiceBusQueueOutputDataSourceProperties: dataSource,
 			},
 			Serialization: serialization,
 		},
@@ -187,6 +203,7 @@ func resourceStreamAnalyticsOutputServiceBusQueueRead(d *pluginsdk.ResourceData,
 		d.Set("queue_name", v.QueueName)
 		d.Set("servicebus_namespace", v.ServiceBusNamespace)
 		d.Set("shared_access_policy_name", v.SharedAccessPolicyName)
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 
 		if err := d.Set("serialization", flattenStreamAnalyticsOutputSerialization(props.Serialization)); err != nil {
 			return fmt.Errorf("setting `serialization`: %+v", err)
@@ -64,19 +64,39 @@ func resourceStreamAnalyticsOutputServiceBusTopic() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
+
 			"shared_access_policy_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"shared_access_policy_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"property_columns": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"system_property_columns": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
 			"serialization": schemaStreamAnalyticsOutputSerialization(),
 		},
 	}
@@ -84,6 +104,7 @@ func resourceStreamAnalyticsOutputServiceBusTopic() *pluginsdk.Resource {
 
 func resourceStreamAnalyticsOutputServiceBusTopicCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	jobsClient := meta.(*clients.Client).StreamAnalytics.JobsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -107,8 +128,16 @@ func resourceStreamAnalyticsOutputServiceBusTopicCreateUpdate(d *pluginsdk.Resou
 
 	topicName := d.Get("topic_name").(string)
 	serviceBusNamespace := d.Get("servicebus_namespace").(string)
+	authenticationMode := d.Get("authentication_mode").(string)
 	sharedAccessPolicyKey := d.Get("shared_access_policy_key").(string)
 	sharedAccessPolicyName := d.Get("shared_access_policy_name").(string)
+	credentials := map[string]string{
+		"shared_access_policy_key":  sharedAccessPolicyKey,
+		"shared_access_policy_name": sharedAccessPolicyName,
+	}
+	if err := validateStreamAnalyticsAuthenticationMode(ctx, jobsClient, resourceGroup, jobName, authenticationMode, credentials); err != nil {
+		return err
+	}
 
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
@@ -116,17 +145,25 @@ func resourceStreamAnalyticsOutputServiceBusTopicCreateUpdate(d *pluginsdk.Resou
 		return fmt.Errorf("Error expanding `serialization`: %+v", err)
 	}
 
+	dataSourceProperties := &streamanalytics.ServiceBusTopicOutputDataSourceProperties{
+		TopicName:             utils.String(topicName),
+		ServiceBusNamespace:   utils.String(serviceBusNamespace),
+		AuthenticationMode:    streamanalytics.AuthenticationMode(authenticationMode),
+		PropertyColumns:       utils.ExpandStringSlice(d.Get("property_columns").([]interface{})),
+		SystemPropertyColumns: utils.ExpandMapStringPtrString(d.Get("system_property_columns").(map[string]interface{})),
+	}
+
+	if authenticationMode == string(streamanalytics.ConnectionString) {
+		dataSourceProperties.SharedAccessPolicyKey = utils.String(sharedAccessPolicyKey)
+		dataSourceProperties.SharedAccessPolicyName = utils.String(sharedAccessPolicyName)
+	}
+
 	props := streamanalytics.Output{
 		Name: utils.String(name),
 		OutputProperties: &streamanalytics.OutputProperties{
 			Datasource: &streamanalytics.ServiceBusTopicOutputDataSource{
 				Type: streamanalytics.TypeMicrosoftServiceBusTopic,
-				ServiceBusTopicOutputDataSourceProperties: &streamanalytics.ServiceBusTopicOutputDataSourceProperties{
-					TopicName:              utils.String(topicName),
-					ServiceBusNamespace:    utils.String(serviceBusNamespace),
-					SharedAccessPolicyKey:  utils.String(sharedAccessPolicyKey),
-					SharedAccessPolicyName: utils.String(sharedAccessPolicyName),
-				},
+				ServiceBusTopicOutputDataSourceProperties: dataSourceProperties,
 			},
 			Serialization: serialization,
 		},
@@ -187,6 +224,10 @@ func resourceStreamAnalyticsOutputServiceBusTopicRead(d *pluginsdk.ResourceData,
 		d.Set("topic_name", v.TopicName)
 		d.Set("servicebus_namespace", v.ServiceBusNamespace)
 		d.Set("shared_access_policy_name", v.SharedAccessPolicyName)
+		d.Set("property_columns", utils.FlattenStringSlice(v.PropertyColumns))
+		d.Set("system_property_columns", utils.FlattenMapStringPtrString(v.SystemPropertyColumns))
+
+		d.Set("authentication_mode", flattenStreamAnalyticsAuthenticationMode(v.AuthenticationMode))
 
 		if err := d.Set("serialization", flattenStreamAnalyticsOutputSerialization(props.Serialization)); err != nil {
 			return fmt.Errorf("setting `serialization`: %+v", err)
@@ -0,0 +1,235 @@
+package streamanalytics
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/streamanalytics/mgmt/2020-03-01-preview/streamanalytics"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStreamAnalyticsOutputTable() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStreamAnalyticsOutputTableCreateUpdate,
+		Read:   resourceStreamAnalyticsOutputTableRead,
+		Update: resourceStreamAnalyticsOutputTableCreateUpdate,
+		Delete: resourceStreamAnalyticsOutputTableDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.OutputID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"stream_analytics_job_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"storage_account_key": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"table": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"partition_key": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"row_key": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"batch_size": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"columns_to_remove": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceStreamAnalyticsOutputTableCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Output Table creation.")
+	name := d.Get("name").(string)
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Stream Analytics Output Table %q (Job %q / Resource Group %q): %s", name, jobName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_stream_analytics_output_table", *existing.ID)
+		}
+	}
+
+	props := streamanalytics.Output{
+		Name: utils.String(name),
+		OutputProperties: &streamanalytics.OutputProperties{
+			Datasource: &streamanalytics.AzureTableOutputDataSource{
+				Type: streamanalytics.TypeMicrosoftStorageTable,
+				AzureTableOutputDataSourceProperties: &streamanalytics.AzureTableOutputDataSourceProperties{
+					AccountName:     utils.String(d.Get("storage_account_name").(string)),
+					AccountKey:      utils.String(d.Get("storage_account_key").(string)),
+					Table:           utils.String(d.Get("table").(string)),
+					PartitionKey:    utils.String(d.Get("partition_key").(string)),
+					RowKey:          utils.String(d.Get("row_key").(string)),
+					BatchSize:       utils.Int32(int32(d.Get("batch_size").(int))),
+					ColumnsToRemove: utils.ExpandStringSlice(d.Get("columns_to_remove").([]interface{})),
+				},
+			},
+		},
+	}
+
+	if d.IsNewResource() {
+		if _, err := client.CreateOrReplace(ctx, props, resourceGroup, jobName, name, "", ""); err != nil {
+			return fmt.Errorf("creating Stream Analytics Output Table %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+
+		read, err := client.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			return fmt.Errorf("retrieving Stream Analytics Output Table %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+		if read.ID == nil {
+			return fmt.Errorf("cannot read ID of Stream Analytics Output Table %q (Job %q / Resource Group %q)", name, jobName, resourceGroup)
+		}
+
+		d.SetId(*read.ID)
+	} else if _, err := client.Update(ctx, props, resourceGroup, jobName, name, ""); err != nil {
+		return fmt.Errorf("updating Stream Analytics Output Table %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	return resourceStreamAnalyticsOutputTableRead(d, meta)
+}
+
+func resourceStreamAnalyticsOutputTableRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.OutputID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StreamingjobName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("stream_analytics_job_name", id.StreamingjobName)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := resp.OutputProperties; props != nil {
+		v, ok := props.Datasource.AsAzureTableOutputDataSource()
+		if !ok {
+			return fmt.Errorf("converting Output Data Source to a Table Output")
+		}
+
+		if v.AzureTableOutputDataSourceProperties != nil {
+			d.Set("storage_account_name", v.AccountName)
+			d.Set("table", v.Table)
+			d.Set("partition_key", v.PartitionKey)
+			d.Set("row_key", v.RowKey)
+
+			batchSize := 0
+			if v.BatchSize != nil {
+				batchSize = int(*v.BatchSize)
+			}
+			d.Set("batch_size", batchSize)
+
+			if err := d.Set("columns_to_remove", utils.FlattenStringSlice(v.ColumnsToRemove)); err != nil {
+				return fmt.Errorf("setting `columns_to_remove`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceStreamAnalyticsOutputTableDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.OutputsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.OutputID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ResourceGroup, id.StreamingjobName, id.Name); err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,307 @@
+package streamanalytics
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/streamanalytics/mgmt/2020-03-01-preview/streamanalytics"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	streamAnalyticsReferenceInputMsSqlRefreshTypeStatic                       = "Static"
+	streamAnalyticsReferenceInputMsSqlRefreshTypeRefreshPeriodicallyWithFull  = "RefreshPeriodicallyWithFull"
+	streamAnalyticsReferenceInputMsSqlRefreshTypeRefreshPeriodicallyWithDelta = "RefreshPeriodicallyWithDelta"
+)
+
+func resourceStreamAnalyticsReferenceInputMsSql() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStreamAnalyticsReferenceInputMsSqlCreate,
+		Read:   resourceStreamAnalyticsReferenceInputMsSqlRead,
+		Update: resourceStreamAnalyticsReferenceInputMsSqlUpdate,
+		Delete: resourceStreamAnalyticsReferenceInputMsSqlDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StreamInputID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"stream_analytics_job_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"server": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"database": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"user": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"password": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"refresh_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					streamAnalyticsReferenceInputMsSqlRefreshTypeStatic,
+					streamAnalyticsReferenceInputMsSqlRefreshTypeRefreshPeriodicallyWithFull,
+					streamAnalyticsReferenceInputMsSqlRefreshTypeRefreshPeriodicallyWithDelta,
+				}, false),
+			},
+
+			"refresh_interval_duration": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^\d{2}:[0-5]\d:[0-5]\d$`),
+					"`refresh_interval_duration` must be in the format `hh:mm:ss`",
+				),
+			},
+
+			"full_snapshot_query": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"delta_snapshot_query": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"table": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceStreamAnalyticsReferenceInputMsSqlCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Reference Input MsSql creation.")
+	resourceId := parse.NewStreamInputID(subscriptionId, d.Get("resource_group_name").(string), d.Get("stream_analytics_job_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, resourceId.ResourceGroup, resourceId.StreamingjobName, resourceId.InputName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", resourceId, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_stream_analytics_reference_input_mssql", resourceId.ID())
+	}
+
+	props, err := expandStreamAnalyticsReferenceInputMsSql(d)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.CreateOrReplace(ctx, props, resourceId.ResourceGroup, resourceId.StreamingjobName, resourceId.InputName, "", ""); err != nil {
+		return fmt.Errorf("creating %s: %+v", resourceId, err)
+	}
+
+	d.SetId(resourceId.ID())
+	return resourceStreamAnalyticsReferenceInputMsSqlRead(d, meta)
+}
+
+func resourceStreamAnalyticsReferenceInputMsSqlUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Reference Input MsSql update.")
+	id, err := parse.StreamInputID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	props, err := expandStreamAnalyticsReferenceInputMsSql(d)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Update(ctx, props, id.ResourceGroup, id.StreamingjobName, id.InputName, ""); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return resourceStreamAnalyticsReferenceInputMsSqlRead(d, meta)
+}
+
+func resourceStreamAnalyticsReferenceInputMsSqlRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StreamInputID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.StreamingjobName, id.InputName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.InputName)
+	d.Set("stream_analytics_job_name", id.StreamingjobName)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := resp.Properties; props != nil {
+		v, ok := props.AsReferenceInputProperties()
+		if !ok {
+			return fmt.Errorf("converting %s to a Reference Input", id)
+		}
+
+		inputDataSource, ok := v.Datasource.AsAzureSQLReferenceInputDataSource()
+		if !ok {
+			return fmt.Errorf("converting %s to an MsSql Reference Input", id)
+		}
+
+		if dataSourceProps := inputDataSource.Properties; dataSourceProps != nil {
+			d.Set("server", dataSourceProps.Server)
+			d.Set("database", dataSourceProps.Database)
+			d.Set("user", dataSourceProps.User)
+			d.Set("refresh_type", dataSourceProps.RefreshType)
+			d.Set("full_snapshot_query", dataSourceProps.FullSnapshotQuery)
+			d.Set("delta_snapshot_query", dataSourceProps.DeltaSnapshotQuery)
+			d.Set("table", dataSourceProps.Table)
+
+			// the API returns a default refresh rate for a Static refresh, which can't be configured
+			refreshIntervalDuration := ""
+			if dataSourceProps.RefreshType != nil && *dataSourceProps.RefreshType != streamAnalyticsReferenceInputMsSqlRefreshTypeStatic && dataSourceProps.RefreshRate != nil {
+				refreshIntervalDuration = *dataSourceProps.RefreshRate
+			}
+			d.Set("refresh_interval_duration", refreshIntervalDuration)
+		}
+	}
+
+	return nil
+}
+
+func resourceStreamAnalyticsReferenceInputMsSqlDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StreamInputID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ResourceGroup, id.StreamingjobName, id.InputName); err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandStreamAnalyticsReferenceInputMsSql(d *pluginsdk.ResourceData) (streamanalytics.Input, error) {
+	refreshType := d.Get("refresh_type").(string)
+	refreshIntervalDuration := d.Get("refresh_interval_duration").(string)
+	deltaSnapshotQuery := d.Get("delta_snapshot_query").(string)
+
+	if refreshType == streamAnalyticsReferenceInputMsSqlRefreshTypeStatic && refreshIntervalDuration != "" {
+		return streamanalytics.Input{}, fmt.Errorf("`refresh_interval_duration` cannot be specified when `refresh_type` is `%s`", streamAnalyticsReferenceInputMsSqlRefreshTypeStatic)
+	}
+	if refreshType != streamAnalyticsReferenceInputMsSqlRefreshTypeStatic && refreshIntervalDuration == "" {
+		return streamanalytics.Input{}, fmt.Errorf("`refresh_interval_duration` must be specified when `refresh_type` is `%s`", refreshType)
+	}
+	if refreshType != streamAnalyticsReferenceInputMsSqlRefreshTypeRefreshPeriodicallyWithDelta && deltaSnapshotQuery != "" {
+		return streamanalytics.Input{}, fmt.Errorf("`delta_snapshot_query` can only be specified when `refresh_type` is `%s`", streamAnalyticsReferenceInputMsSqlRefreshTypeRefreshPeriodicallyWithDelta)
+	}
+	if refreshType == streamAnalyticsReferenceInputMsSqlRefreshTypeRefreshPeriodicallyWithDelta && deltaSnapshotQuery == "" {
+		return streamanalytics.Input{}, fmt.Errorf("`delta_snapshot_query` must be specified when `refresh_type` is `%s`", streamAnalyticsReferenceInputMsSqlRefreshTypeRefreshPeriodicallyWithDelta)
+	}
+
+	dataSourceProps := &streamanalytics.AzureSQLReferenceInputDataSourceProperties{
+		Server:            utils.String(d.Get("server").(string)),
+		Database:          utils.String(d.Get("database").(string)),
+		User:              utils.String(d.Get("user").(string)),
+		Password:          utils.String(d.Get("password").(string)),
+		RefreshType:       utils.String(refreshType),
+		FullSnapshotQuery: utils.String(d.Get("full_snapshot_query").(string)),
+	}
+
+	if refreshIntervalDuration != "" {
+		dataSourceProps.RefreshRate = utils.String(refreshIntervalDuration)
+	}
+
+	if deltaSnapshotQuery != "" {
+		dataSourceProps.DeltaSnapshotQuery = utils.String(deltaSnapshotQuery)
+	}
+
+	if table := d.Get("table").(string); table != "" {
+		dataSourceProps.Table = utils.String(table)
+	}
+
+	return streamanalytics.Input{
+		Name: utils.String(d.Get("name").(string)),
+		Properties: &streamanalytics.ReferenceInputProperties{
+			Type: streamanalytics.TypeReference,
+			Datasource: &streamanalytics.AzureSQLReferenceInputDataSource{
+				Type:       streamanalytics.TypeBasicReferenceInputDataSourceTypeMicrosoftSQLServerDatabase,
+				Properties: dataSourceProps,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,232 @@
+package streamanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StreamAnalyticsReferenceInputMsSqlResource struct{}
+
+func TestAccStreamAnalyticsReferenceInputMsSql_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_reference_input_mssql", "test")
+	r := StreamAnalyticsReferenceInputMsSqlResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccStreamAnalyticsReferenceInputMsSql_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_reference_input_mssql", "test")
+	r := StreamAnalyticsReferenceInputMsSqlResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.refreshPeriodicallyWithFull(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("refresh_interval_duration").HasValue("00:10:00"),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.refreshPeriodicallyWithDelta(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("refresh_interval_duration").HasValue(""),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccStreamAnalyticsReferenceInputMsSql_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_reference_input_mssql", "test")
+	r := StreamAnalyticsReferenceInputMsSqlResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StreamAnalyticsReferenceInputMsSqlResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StreamInputID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.StreamAnalytics.InputsClient.Get(ctx, id.ResourceGroup, id.StreamingjobName, id.InputName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(true), nil
+}
+
+func (r StreamAnalyticsReferenceInputMsSqlResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_reference_input_mssql" "test" {
+  name                      = "acctestinput-%d"
+  stream_analytics_job_name = azurerm_stream_analytics_job.test.name
+  resource_group_name       = azurerm_stream_analytics_job.test.resource_group_name
+  server                    = azurerm_sql_server.test.fully_qualified_domain_name
+  database                  = azurerm_sql_database.test.name
+  user                      = azurerm_sql_server.test.administrator_login
+  password                  = azurerm_sql_server.test.administrator_login_password
+  refresh_type              = "Static"
+  full_snapshot_query       = "SELECT * FROM [dbo].[Lookup]"
+}
+`, template, data.RandomInteger)
+}
+
+func (r StreamAnalyticsReferenceInputMsSqlResource) refreshPeriodicallyWithFull(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_reference_input_mssql" "test" {
+  name                      = "acctestinput-%d"
+  stream_analytics_job_name = azurerm_stream_analytics_job.test.name
+  resource_group_name       = azurerm_stream_analytics_job.test.resource_group_name
+  server                    = azurerm_sql_server.test.fully_qualified_domain_name
+  database                  = azurerm_sql_database.test.name
+  user                      = azurerm_sql_server.test.administrator_login
+  password                  = azurerm_sql_server.test.administrator_login_password
+  refresh_type              = "RefreshPeriodicallyWithFull"
+  refresh_interval_duration = "00:10:00"
+  table                     = "Lookup"
+  full_snapshot_query       = "SELECT * FROM [dbo].[Lookup]"
+}
+`, template, data.RandomInteger)
+}
+
+func (r StreamAnalyticsReferenceInputMsSqlResource) refreshPeriodicallyWithDelta(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_reference_input_mssql" "test" {
+  name                      = "acctestinput-%d"
+  stream_analytics_job_name = azurerm_stream_analytics_job.test.name
+  resource_group_name       = azurerm_stream_analytics_job.test.resource_group_name
+  server                    = azurerm_sql_server.test.fully_qualified_domain_name
+  database                  = azurerm_sql_database.test.name
+  user                      = azurerm_sql_server.test.administrator_login
+  password                  = azurerm_sql_server.test.administrator_login_password
+  refresh_type              = "RefreshPeriodicallyWithDelta"
+  refresh_interval_duration = "00:20:00"
+  full_snapshot_query       = "SELECT * FROM [dbo].[Lookup]"
+  delta_snapshot_query      = "SELECT * FROM [dbo].[Lookup] WHERE [ModifiedAt] > @deltaStartTime AND [ModifiedAt] <= @deltaEndTime"
+}
+`, template, data.RandomInteger)
+}
+
+func (r StreamAnalyticsReferenceInputMsSqlResource) requiresImport(data acceptance.TestData) string {
+	template := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_reference_input_mssql" "import" {
+  name                      = azurerm_stream_analytics_reference_input_mssql.test.name
+  stream_analytics_job_name = azurerm_stream_analytics_reference_input_mssql.test.stream_analytics_job_name
+  resource_group_name       = azurerm_stream_analytics_reference_input_mssql.test.resource_group_name
+  server                    = azurerm_stream_analytics_reference_input_mssql.test.server
+  database                  = azurerm_stream_analytics_reference_input_mssql.test.database
+  user                      = azurerm_stream_analytics_reference_input_mssql.test.user
+  password                  = azurerm_stream_analytics_reference_input_mssql.test.password
+  refresh_type              = azurerm_stream_analytics_reference_input_mssql.test.refresh_type
+  full_snapshot_query       = azurerm_stream_analytics_reference_input_mssql.test.full_snapshot_query
+}
+`, template)
+}
+
+func (r StreamAnalyticsReferenceInputMsSqlResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestserver-%s"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "acctestadmin"
+  administrator_login_password = "t2RX8A76GrnE4EKC"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb"
+  resource_group_name              = azurerm_resource_group.test.name
+  location                         = azurerm_resource_group.test.location
+  server_name                      = azurerm_sql_server.test.name
+  requested_service_objective_name = "S0"
+  collation                        = "SQL_LATIN1_GENERAL_CP1_CI_AS"
+  max_size_bytes                   = "268435456000"
+  create_mode                      = "Default"
+}
+
+resource "azurerm_stream_analytics_job" "test" {
+  name                                     = "acctestjob-%d"
+  resource_group_name                      = azurerm_resource_group.test.name
+  location                                 = azurerm_resource_group.test.location
+  compatibility_level                      = "1.0"
+  data_locale                              = "en-GB"
+  events_late_arrival_max_delay_in_seconds = 60
+  events_out_of_order_max_delay_in_seconds = 50
+  events_out_of_order_policy               = "Adjust"
+  output_error_policy                      = "Drop"
+  streaming_units                          = 3
+
+  transformation_query = <<QUERY
+    SELECT *
+    INTO [YourOutputAlias]
+    FROM [YourInputAlias]
+QUERY
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger)
+}
@@ -1,11 +1,17 @@
 package streamanalytics
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/streamanalytics/mgmt/2020-03-01-preview/streamanalytics"
+	"github.com/Azure/go-autorest/autorest"
+	autorestAzure "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -17,6 +23,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+// The `authenticationMode` of a Blob Stream Input isn't available in the version of the Azure SDK for Go currently
+// in use - as such the Input is sent (and the Authentication Mode retrieved) using API Version `2020-03-01` directly.
+// TODO: remove this once the Stream Analytics SDK has been upgraded
+const streamAnalyticsStreamInputBlobAPIVersion = "2020-03-01"
+
 func resourceStreamAnalyticsStreamInputBlob() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceStreamAnalyticsStreamInputBlobCreateUpdate,
@@ -63,9 +74,11 @@ func resourceStreamAnalyticsStreamInputBlob() *pluginsdk.Resource {
 				Required: true,
 			},
 
+			"authentication_mode": schemaStreamAnalyticsAuthenticationMode(),
+
 			"storage_account_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
@@ -89,12 +102,30 @@ func resourceStreamAnalyticsStreamInputBlob() *pluginsdk.Resource {
 			},
 
 			"serialization": schemaStreamAnalyticsStreamInputSerialization(),
+
+			"partition_key": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(streamanalytics.TypeStream),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(streamanalytics.TypeReference),
+					string(streamanalytics.TypeStream),
+				}, false),
+			},
 		},
 	}
 }
 
 func resourceStreamAnalyticsStreamInputBlobCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).StreamAnalytics.InputsClient
+	jobsClient := meta.(*clients.Client).StreamAnalytics.JobsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -114,10 +145,18 @@ func resourceStreamAnalyticsStreamInputBlobCreateUpdate(d *pluginsdk.ResourceDat
 		}
 	}
 
+	authenticationMode := d.Get("authentication_mode").(string)
+	storageAccountKey := d.Get("storage_account_key").(string)
+	credentials := map[string]string{
+		"storage_account_key": storageAccountKey,
+	}
+	if err := validateStreamAnalyticsAuthenticationMode(ctx, jobsClient, resourceId.ResourceGroup, resourceId.StreamingjobName, authenticationMode, credentials); err != nil {
+		return err
+	}
+
 	containerName := d.Get("storage_container_name").(string)
 	dateFormat := d.Get("date_format").(string)
 	pathPattern := d.Get("path_pattern").(string)
-	storageAccountKey := d.Get("storage_account_key").(string)
 	storageAccountName := d.Get("storage_account_name").(string)
 	timeFormat := d.Get("time_format").(string)
 
@@ -127,9 +166,41 @@ func resourceStreamAnalyticsStreamInputBlobCreateUpdate(d *pluginsdk.ResourceDat
 		return fmt.Errorf("Error expanding `serialization`: %+v", err)
 	}
 
-	props := streamanalytics.Input{
-		Name: utils.String(resourceId.InputName),
-		Properties: &streamanalytics.StreamInputProperties{
+	storageAccount := streamanalytics.StorageAccount{
+		AccountName: utils.String(storageAccountName),
+	}
+	if storageAccountKey != "" {
+		storageAccount.AccountKey = utils.String(storageAccountKey)
+	}
+
+	var partitionKey *string
+	if v := d.Get("partition_key").(string); v != "" {
+		partitionKey = utils.String(v)
+	}
+
+	// the same Blob data source can be used for both Stream and Reference data, where the latter is typically
+	// refreshed by using the {date} and {time} tokens within the `path_pattern`
+	var properties streamanalytics.BasicInputProperties
+	if d.Get("type").(string) == string(streamanalytics.TypeReference) {
+		properties = &streamanalytics.ReferenceInputProperties{
+			Type: streamanalytics.TypeReference,
+			Datasource: &streamanalytics.BlobReferenceInputDataSource{
+				Type: streamanalytics.TypeBasicReferenceInputDataSourceTypeMicrosoftStorageBlob,
+				BlobReferenceInputDataSourceProperties: &streamanalytics.BlobReferenceInputDataSourceProperties{
+					Container:   utils.String(containerName),
+					DateFormat:  utils.String(dateFormat),
+					PathPattern: utils.String(pathPattern),
+					TimeFormat:  utils.String(timeFormat),
+					StorageAccounts: &[]streamanalytics.StorageAccount{
+						storageAccount,
+					},
+				},
+			},
+			Serialization: serialization,
+			PartitionKey:  partitionKey,
+		}
+	} else {
+		properties = &streamanalytics.StreamInputProperties{
 			Type: streamanalytics.TypeStream,
 			Datasource: &streamanalytics.BlobStreamInputDataSource{
 				Type: streamanalytics.TypeBasicStreamInputDataSourceTypeMicrosoftStorageBlob,
@@ -139,24 +210,27 @@ func resourceStreamAnalyticsStreamInputBlobCreateUpdate(d *pluginsdk.ResourceDat
 					PathPattern: utils.String(pathPattern),
 					TimeFormat:  utils.String(timeFormat),
 					StorageAccounts: &[]streamanalytics.StorageAccount{
-						{
-							AccountName: utils.String(storageAccountName),
-							AccountKey:  utils.String(storageAccountKey),
-						},
+						storageAccount,
 					},
 				},
 			},
 			Serialization: serialization,
-		},
+			PartitionKey:  partitionKey,
+		}
+	}
+
+	props := streamanalytics.Input{
+		Name:       utils.String(resourceId.InputName),
+		Properties: properties,
 	}
 
 	if d.IsNewResource() {
-		if _, err := client.CreateOrReplace(ctx, props, resourceId.ResourceGroup, resourceId.StreamingjobName, resourceId.InputName, "", ""); err != nil {
+		if err := sendStreamAnalyticsStreamInputBlob(ctx, client, http.MethodPut, resourceId, props, authenticationMode); err != nil {
 			return fmt.Errorf("creating %s: %+v", resourceId, err)
 		}
 
 		d.SetId(resourceId.ID())
-	} else if _, err := client.Update(ctx, props, resourceId.ResourceGroup, resourceId.StreamingjobName, resourceId.InputName, ""); err != nil {
+	} else if err := sendStreamAnalyticsStreamInputBlob(ctx, client, http.MethodPatch, resourceId, props, authenticationMode); err != nil {
 		return fmt.Errorf("updating %s: %+v", resourceId, err)
 	}
 
@@ -189,31 +263,71 @@ func resourceStreamAnalyticsStreamInputBlobRead(d *pluginsdk.ResourceData, meta
 	d.Set("resource_group_name", id.ResourceGroup)
 
 	if props := resp.Properties; props != nil {
-		v, ok := props.AsStreamInputProperties()
-		if !ok {
-			return fmt.Errorf("converting Stream Input Blob to an Stream Input: %+v", err)
-		}
+		var dataSource *streamanalytics.BlobStreamInputDataSourceProperties
+		var serialization streamanalytics.BasicSerialization
+		var partitionKey *string
+		inputType := string(streamanalytics.TypeStream)
+
+		if v, ok := props.AsReferenceInputProperties(); ok {
+			blobInput, ok := v.Datasource.AsBlobReferenceInputDataSource()
+			if !ok {
+				return fmt.Errorf("converting Reference Input Blob to a Blob Reference Input")
+			}
 
-		eventHub, ok := v.Datasource.AsBlobStreamInputDataSource()
-		if !ok {
-			return fmt.Errorf("converting Stream Input Blob to an Blob Stream Input: %+v", err)
+			inputType = string(streamanalytics.TypeReference)
+			serialization = v.Serialization
+			partitionKey = v.PartitionKey
+			if blobProps := blobInput.BlobReferenceInputDataSourceProperties; blobProps != nil {
+				dataSource = &streamanalytics.BlobStreamInputDataSourceProperties{
+					Container:       blobProps.Container,
+					DateFormat:      blobProps.DateFormat,
+					PathPattern:     blobProps.PathPattern,
+					StorageAccounts: blobProps.StorageAccounts,
+					TimeFormat:      blobProps.TimeFormat,
+				}
+			}
+		} else {
+			v, ok := props.AsStreamInputProperties()
+			if !ok {
+				return fmt.Errorf("converting Stream Input Blob to an Stream Input")
+			}
+
+			blobInput, ok := v.Datasource.AsBlobStreamInputDataSource()
+			if !ok {
+				return fmt.Errorf("converting Stream Input Blob to an Blob Stream Input")
+			}
+
+			serialization = v.Serialization
+			partitionKey = v.PartitionKey
+			dataSource = blobInput.BlobStreamInputDataSourceProperties
 		}
 
-		d.Set("date_format", eventHub.DateFormat)
-		d.Set("path_pattern", eventHub.PathPattern)
-		d.Set("storage_container_name", eventHub.Container)
-		d.Set("time_format", eventHub.TimeFormat)
+		d.Set("type", inputType)
+		d.Set("partition_key", partitionKey)
+
+		if dataSource != nil {
+			d.Set("date_format", dataSource.DateFormat)
+			d.Set("path_pattern", dataSource.PathPattern)
+			d.Set("storage_container_name", dataSource.Container)
+			d.Set("time_format", dataSource.TimeFormat)
 
-		if accounts := eventHub.StorageAccounts; accounts != nil && len(*accounts) > 0 {
-			account := (*accounts)[0]
-			d.Set("storage_account_name", account.AccountName)
+			if accounts := dataSource.StorageAccounts; accounts != nil && len(*accounts) > 0 {
+				account := (*accounts)[0]
+				d.Set("storage_account_name", account.AccountName)
+			}
 		}
 
-		if err := d.Set("serialization", flattenStreamAnalyticsStreamInputSerialization(v.Serialization)); err != nil {
+		if err := d.Set("serialization", flattenStreamAnalyticsStreamInputSerialization(serialization)); err != nil {
 			return fmt.Errorf("setting `serialization`: %+v", err)
 		}
 	}
 
+	authenticationMode, err := getStreamAnalyticsStreamInputBlobAuthenticationMode(ctx, client, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving Authentication Mode for %s: %+v", id, err)
+	}
+	d.Set("authentication_mode", authenticationMode)
+
 	return nil
 }
 
@@ -235,3 +349,118 @@ func resourceStreamAnalyticsStreamInputBlobDelete(d *pluginsdk.ResourceData, met
 
 	return nil
 }
+
+func streamAnalyticsStreamInputBlobPathParameters(client *streamanalytics.InputsClient, id parse.StreamInputId) map[string]interface{} {
+	return map[string]interface{}{
+		"inputName":         autorest.Encode("path", id.InputName),
+		"jobName":           autorest.Encode("path", id.StreamingjobName),
+		"resourceGroupName": autorest.Encode("path", id.ResourceGroup),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+}
+
+// sendStreamAnalyticsStreamInputBlob creates (PUT) or updates (PATCH) the Blob Stream Input, including the
+// `authenticationMode` of the data source which isn't available in the Azure SDK for Go
+func sendStreamAnalyticsStreamInputBlob(ctx context.Context, client *streamanalytics.InputsClient, method string, id parse.StreamInputId, input streamanalytics.Input, authenticationMode string) error {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("serializing Input: %+v", err)
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return fmt.Errorf("deserializing Input: %+v", err)
+	}
+	if props, ok := body["properties"].(map[string]interface{}); ok {
+		if datasource, ok := props["datasource"].(map[string]interface{}); ok {
+			if datasourceProps, ok := datasource["properties"].(map[string]interface{}); ok {
+				datasourceProps["authenticationMode"] = authenticationMode
+			}
+		}
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithMethod(method),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/Microsoft.StreamAnalytics/streamingjobs/{jobName}/inputs/{inputName}", streamAnalyticsStreamInputBlobPathParameters(client, id)),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": streamAnalyticsStreamInputBlobAPIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "streamanalytics.InputsClient", method, nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, autorestAzure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "streamanalytics.InputsClient", method, resp, "Failure sending request")
+	}
+
+	return autorest.Respond(
+		resp,
+		autorestAzure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByClosing())
+}
+
+// getStreamAnalyticsStreamInputBlobAuthenticationMode returns the `authenticationMode` of the data source of the
+// Blob Stream Input, which isn't available in the Azure SDK for Go
+func getStreamAnalyticsStreamInputBlobAuthenticationMode(ctx context.Context, client *streamanalytics.InputsClient, id parse.StreamInputId) (string, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/Microsoft.StreamAnalytics/streamingjobs/{jobName}/inputs/{inputName}", streamAnalyticsStreamInputBlobPathParameters(client, id)),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": streamAnalyticsStreamInputBlobAPIVersion,
+		}))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return "", autorest.NewErrorWithError(err, "streamanalytics.InputsClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, autorestAzure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return "", autorest.NewErrorWithError(err, "streamanalytics.InputsClient", "Get", resp, "Failure sending request")
+	}
+
+	var raw []byte
+	err = autorest.Respond(
+		resp,
+		autorestAzure.WithErrorUnlessStatusCode(http.StatusOK),
+		func(r autorest.Responder) autorest.Responder {
+			return autorest.ResponderFunc(func(resp *http.Response) error {
+				b, err := ioutil.ReadAll(resp.Body)
+				if err != nil {
+					return err
+				}
+				raw = b
+				return r.Respond(resp)
+			})
+		},
+		autorest.ByClosing())
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		Properties *struct {
+			Datasource *struct {
+				Properties *struct {
+					AuthenticationMode string `json:"authenticationMode"`
+				} `json:"properties"`
+			} `json:"datasource"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return "", fmt.Errorf("deserializing response: %+v", err)
+	}
+
+	// Inputs created prior to the Authentication Mode being available use a Connection String
+	authenticationMode := string(streamanalytics.ConnectionString)
+	if props := result.Properties; props != nil && props.Datasource != nil && props.Datasource.Properties != nil && props.Datasource.Properties.AuthenticationMode != "" {
+		authenticationMode = props.Datasource.Properties.AuthenticationMode
+	}
+
+	return authenticationMode, nil
+}
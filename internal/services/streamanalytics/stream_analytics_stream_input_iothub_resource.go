@@ -52,9 +52,12 @@ func resourceStreamAnalyticsStreamInputIoTHub() *pluginsdk.Resource {
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
 			"endpoint": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"messages/events",
+					"messages/operationsMonitoringEvents",
+				}, false),
 			},
 
 			"iothub_namespace": {
@@ -116,6 +119,10 @@ func resourceStreamAnalyticsStreamInputIoTHubCreateUpdate(d *pluginsdk.ResourceD
 	sharedAccessPolicyKey := d.Get("shared_access_policy_key").(string)
 	sharedAccessPolicyName := d.Get("shared_access_policy_name").(string)
 
+	if err := validateStreamAnalyticsIoTHubSharedAccessPolicy(ctx, meta.(*clients.Client).IoTHub.ResourceClient, iotHubNamespace, sharedAccessPolicyName); err != nil {
+		return fmt.Errorf("validating `shared_access_policy_name` for %s: %+v", resourceId, err)
+	}
+
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsStreamInputSerialization(serializationRaw)
 	if err != nil {
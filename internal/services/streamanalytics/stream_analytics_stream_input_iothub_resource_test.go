@@ -3,6 +3,7 @@ package streamanalytics_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -95,6 +96,18 @@ func TestAccStreamAnalyticsStreamInputIoTHub_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccStreamAnalyticsStreamInputIoTHub_sharedAccessPolicyWithoutServiceConnect(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_stream_input_iothub", "test")
+	r := StreamAnalyticsStreamInputIoTHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.sharedAccessPolicyWithoutServiceConnect(data),
+			ExpectError: regexp.MustCompile("must have the `ServiceConnect` right"),
+		},
+	})
+}
+
 func (r StreamAnalyticsStreamInputIoTHubResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	name := state.Attributes["name"]
 	jobName := state.Attributes["stream_analytics_job_name"]
@@ -235,6 +248,36 @@ resource "azurerm_stream_analytics_stream_input_iothub" "import" {
 `, template)
 }
 
+func (r StreamAnalyticsStreamInputIoTHubResource) sharedAccessPolicyWithoutServiceConnect(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_shared_access_policy" "test" {
+  name                = "acctest"
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+
+  registry_read = true
+}
+
+resource "azurerm_stream_analytics_stream_input_iothub" "test" {
+  name                         = "acctestinput-%d"
+  stream_analytics_job_name    = azurerm_stream_analytics_job.test.name
+  resource_group_name          = azurerm_stream_analytics_job.test.resource_group_name
+  endpoint                     = "messages/events"
+  iothub_namespace             = azurerm_iothub.test.name
+  eventhub_consumer_group_name = "$Default"
+  shared_access_policy_key     = azurerm_iothub_shared_access_policy.test.primary_key
+  shared_access_policy_name    = azurerm_iothub_shared_access_policy.test.name
+
+  serialization {
+    type = "Avro"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r StreamAnalyticsStreamInputIoTHubResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
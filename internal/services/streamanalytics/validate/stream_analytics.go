@@ -26,3 +26,29 @@ func StreamAnalyticsJobStreamingUnits(i interface{}, k string) (w []string, es [
 
 	return
 }
+
+func StreamAnalyticsJobStreamingUnitsV2(i interface{}, k string) (w []string, es []error) {
+	v, ok := i.(int)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be int", k))
+		return
+	}
+
+	// the V2 Streaming Units are exposed as `1/3`, `2/3` and `1`, with further increments of `1` - which map to
+	// the values `3`, `7` and `10`, with further increments of `10` in the API
+	if v == 3 || v == 7 {
+		return
+	}
+
+	if v < 10 || v > 660 {
+		es = append(es, fmt.Errorf("expected %s to be `3`, `7` or in the range (10 - 660), got %d", k, v))
+		return
+	}
+
+	if v%10 != 0 {
+		es = append(es, fmt.Errorf("expected %s to be divisible by 10, got %d", k, v))
+		return
+	}
+
+	return
+}
@@ -32,3 +32,28 @@ func TestStreamAnalyticsJobStreamingUnits(t *testing.T) {
 		}
 	}
 }
+
+func TestStreamAnalyticsJobStreamingUnitsV2(t *testing.T) {
+	cases := map[int]bool{
+		0:   false,
+		1:   false,
+		3:   true,
+		6:   false,
+		7:   true,
+		9:   false,
+		10:  true,
+		15:  false,
+		20:  true,
+		30:  true,
+		660: true,
+		670: false,
+	}
+	for i, shouldBeValid := range cases {
+		_, errors := StreamAnalyticsJobStreamingUnitsV2(i, "streaming_units")
+
+		isValid := len(errors) == 0
+		if shouldBeValid != isValid {
+			t.Fatalf("Expected %d to be %t but got %t", i, shouldBeValid, isValid)
+		}
+	}
+}
@@ -3,16 +3,17 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-08-01/trafficmanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/endpoints"
 )
 
 type Client struct {
 	GeographialHierarchiesClient *trafficmanager.GeographicHierarchiesClient
-	EndpointsClient              *trafficmanager.EndpointsClient
+	EndpointsClient              *endpoints.EndpointsClient
 	ProfilesClient               *trafficmanager.ProfilesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
-	endpointsClient := trafficmanager.NewEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	endpointsClient := endpoints.NewEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&endpointsClient.Client, o.ResourceManagerAuthorizer)
 
 	geographialHierarchiesClient := trafficmanager.NewGeographicHierarchiesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
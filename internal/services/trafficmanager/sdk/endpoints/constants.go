@@ -0,0 +1,35 @@
+package endpoints
+
+type AlwaysServe string
+
+const (
+	AlwaysServeDisabled AlwaysServe = "Disabled"
+	AlwaysServeEnabled  AlwaysServe = "Enabled"
+)
+
+type EndpointMonitorStatus string
+
+const (
+	EndpointMonitorStatusCheckingEndpoint EndpointMonitorStatus = "CheckingEndpoint"
+	EndpointMonitorStatusDegraded         EndpointMonitorStatus = "Degraded"
+	EndpointMonitorStatusDisabled         EndpointMonitorStatus = "Disabled"
+	EndpointMonitorStatusInactive         EndpointMonitorStatus = "Inactive"
+	EndpointMonitorStatusOnline           EndpointMonitorStatus = "Online"
+	EndpointMonitorStatusStopped          EndpointMonitorStatus = "Stopped"
+	EndpointMonitorStatusUnmonitored      EndpointMonitorStatus = "Unmonitored"
+)
+
+type EndpointStatus string
+
+const (
+	EndpointStatusDisabled EndpointStatus = "Disabled"
+	EndpointStatusEnabled  EndpointStatus = "Enabled"
+)
+
+type EndpointType string
+
+const (
+	EndpointTypeAzureEndpoints    EndpointType = "AzureEndpoints"
+	EndpointTypeExternalEndpoints EndpointType = "ExternalEndpoints"
+	EndpointTypeNestedEndpoints   EndpointType = "NestedEndpoints"
+)
@@ -0,0 +1,113 @@
+package endpoints
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type EndpointTypeId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ProfileName    string
+	EndpointType   EndpointType
+	Name           string
+}
+
+func NewEndpointTypeID(subscriptionId, resourceGroup, profileName string, endpointType EndpointType, name string) EndpointTypeId {
+	return EndpointTypeId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ProfileName:    profileName,
+		EndpointType:   endpointType,
+		Name:           name,
+	}
+}
+
+func (id EndpointTypeId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Endpoint Type %q", string(id.EndpointType)),
+		fmt.Sprintf("Profile Name %q", id.ProfileName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Endpoint Type", segmentsStr)
+}
+
+func (id EndpointTypeId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/trafficManagerProfiles/%s/%s/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, string(id.EndpointType), id.Name)
+}
+
+// ParseEndpointTypeIDInsensitively parses an Endpoint Type ID into an EndpointTypeId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseEndpointTypeID method should be used instead for validation etc.
+func ParseEndpointTypeIDInsensitively(input string) (*EndpointTypeId, error) {
+	return parseEndpointTypeID(input, true)
+}
+
+// ParseEndpointTypeID parses an Endpoint Type ID into an EndpointTypeId struct
+func ParseEndpointTypeID(input string) (*EndpointTypeId, error) {
+	return parseEndpointTypeID(input, false)
+}
+
+func parseEndpointTypeID(input string, insensitively bool) (*EndpointTypeId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := EndpointTypeId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'trafficManagerProfiles' segment
+	profilesKey := "trafficManagerProfiles"
+	if insensitively {
+		for key := range id.Path {
+			if strings.EqualFold(key, profilesKey) {
+				profilesKey = key
+				break
+			}
+		}
+	}
+	if resourceId.ProfileName, err = id.PopSegment(profilesKey); err != nil {
+		return nil, err
+	}
+
+	// the endpoint type is itself a segment of the ID, so there should only be one remaining key
+	if len(id.Path) != 1 {
+		return nil, fmt.Errorf("ID was missing the endpoint type element")
+	}
+	for key := range id.Path {
+		for _, endpointType := range []EndpointType{EndpointTypeAzureEndpoints, EndpointTypeExternalEndpoints, EndpointTypeNestedEndpoints} {
+			if key == string(endpointType) || (insensitively && strings.EqualFold(key, string(endpointType))) {
+				resourceId.EndpointType = endpointType
+				resourceId.Name, err = id.PopSegment(key)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if resourceId.EndpointType == "" {
+		return nil, fmt.Errorf("ID contained an unsupported endpoint type")
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,6 @@
+package endpoints
+
+type EndpointPropertiesCustomHeadersInlined struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
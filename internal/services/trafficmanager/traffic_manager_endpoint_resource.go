@@ -5,13 +5,14 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-08-01/trafficmanager"
+	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/endpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -82,8 +83,8 @@ func resourceArmTrafficManagerEndpoint() *pluginsdk.Resource {
 				Optional: true,
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(trafficmanager.EndpointStatusDisabled),
-					string(trafficmanager.EndpointStatusEnabled),
+					string(endpoints.EndpointStatusDisabled),
+					string(endpoints.EndpointStatusEnabled),
 				}, true),
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
@@ -139,9 +140,14 @@ func resourceArmTrafficManagerEndpoint() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"always_serve_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"custom_header": {
 				Type:     pluginsdk.TypeList,
-				ForceNew: true,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
@@ -161,7 +167,6 @@ func resourceArmTrafficManagerEndpoint() *pluginsdk.Resource {
 
 			"subnet": {
 				Type:     pluginsdk.TypeList,
-				ForceNew: true,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
@@ -206,26 +211,27 @@ func resourceArmTrafficManagerEndpointCreateUpdate(d *pluginsdk.ResourceData, me
 		return err
 	}
 
+	endpointId := endpoints.NewEndpointTypeID(subscriptionId, resourceGroup, profileName, endpoints.EndpointType(resourceId.EndpointType()), name)
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resourceGroup, profileName, endpointType, name)
+		existing, err := client.Get(ctx, endpointId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("Error checking for presence of existing Traffic Manager Endpoint %q (Resource Group %q): %v", name, resourceGroup, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_traffic_manager_endpoint", resourceId.ID())
 		}
 	}
 
-	params := trafficmanager.Endpoint{
-		Name:               &name,
-		Type:               &fullEndpointType,
-		EndpointProperties: getArmTrafficManagerEndpointProperties(d),
+	params := endpoints.Endpoint{
+		Name:       &name,
+		Type:       &fullEndpointType,
+		Properties: getArmTrafficManagerEndpointProperties(d),
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, resourceGroup, profileName, endpointType, name, params); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, endpointId, params); err != nil {
 		return fmt.Errorf("creating/updating %s Endpoint %q (Traffic Manager Profile %q / Resource Group %q): %+v", resourceId.EndpointType(), resourceId.Name, resourceId.TrafficManagerProfileName, resourceId.ResourceGroup, err)
 	}
 
@@ -243,9 +249,9 @@ func resourceArmTrafficManagerEndpointRead(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.TrafficManagerProfileName, id.EndpointType(), id.Name)
+	resp, err := client.Get(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, endpoints.EndpointType(id.EndpointType()), id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
@@ -257,14 +263,26 @@ func resourceArmTrafficManagerEndpointRead(d *pluginsdk.ResourceData, meta inter
 	d.Set("type", id.EndpointType())
 	d.Set("profile_name", id.TrafficManagerProfileName)
 
-	if props := resp.EndpointProperties; props != nil {
-		d.Set("endpoint_status", string(props.EndpointStatus))
-		d.Set("target_resource_id", props.TargetResourceID)
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+
+		endpointStatus := ""
+		if props.EndpointStatus != nil {
+			endpointStatus = string(*props.EndpointStatus)
+		}
+		d.Set("endpoint_status", endpointStatus)
+		d.Set("target_resource_id", props.TargetResourceId)
 		d.Set("target", props.Target)
 		d.Set("weight", props.Weight)
 		d.Set("priority", props.Priority)
 		d.Set("endpoint_location", props.EndpointLocation)
-		d.Set("endpoint_monitor_status", props.EndpointMonitorStatus)
+
+		endpointMonitorStatus := ""
+		if props.EndpointMonitorStatus != nil {
+			endpointMonitorStatus = string(*props.EndpointMonitorStatus)
+		}
+		d.Set("endpoint_monitor_status", endpointMonitorStatus)
+		d.Set("always_serve_enabled", props.AlwaysServe != nil && *props.AlwaysServe == endpoints.AlwaysServeEnabled)
 
 		d.Set("min_child_endpoints", props.MinChildEndpoints)
 		d.Set("minimum_required_child_endpoints_ipv4", props.MinChildEndpointsIPv4)
@@ -290,9 +308,9 @@ func resourceArmTrafficManagerEndpointDelete(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.TrafficManagerProfileName, id.EndpointType(), id.Name)
+	resp, err := client.Delete(ctx, endpoints.NewEndpointTypeID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, endpoints.EndpointType(id.EndpointType()), id.Name))
 	if err != nil {
-		if !utils.ResponseWasNotFound(resp.Response) {
+		if !response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("deleting Endpoint %q (Traffic Manager Profile %q / Resource Group %q): %+v", id.Name, id.TrafficManagerProfileName, id.ResourceGroup, err)
 		}
 	}
@@ -300,17 +318,26 @@ func resourceArmTrafficManagerEndpointDelete(d *pluginsdk.ResourceData, meta int
 	return nil
 }
 
-func getArmTrafficManagerEndpointProperties(d *pluginsdk.ResourceData) *trafficmanager.EndpointProperties {
+func getArmTrafficManagerEndpointProperties(d *pluginsdk.ResourceData) *endpoints.EndpointProperties {
 	target := d.Get("target").(string)
-	status := d.Get("endpoint_status").(string)
+	status := endpoints.EndpointStatus(d.Get("endpoint_status").(string))
+
+	alwaysServe := endpoints.AlwaysServeDisabled
+	if d.Get("always_serve_enabled").(bool) {
+		alwaysServe = endpoints.AlwaysServeEnabled
+	}
+
+	endpointProps := endpoints.EndpointProperties{
+		Target:      &target,
+		AlwaysServe: &alwaysServe,
+	}
 
-	endpointProps := trafficmanager.EndpointProperties{
-		Target:         &target,
-		EndpointStatus: trafficmanager.EndpointStatus(status),
+	if status != "" {
+		endpointProps.EndpointStatus = &status
 	}
 
 	if resourceId := d.Get("target_resource_id").(string); resourceId != "" {
-		endpointProps.TargetResourceID = utils.String(resourceId)
+		endpointProps.TargetResourceId = utils.String(resourceId)
 		// NOTE: Workaround for upstream behaviour: if the target is blank instead of nil, the REST API will throw a 500 error
 		if target == "" {
 			endpointProps.Target = nil
@@ -353,18 +380,18 @@ func getArmTrafficManagerEndpointProperties(d *pluginsdk.ResourceData) *trafficm
 		endpointProps.MinChildEndpointsIPv6 = utils.Int64(int64(minChildEndpointsIPv6))
 	}
 
-	subnetSlice := make([]trafficmanager.EndpointPropertiesSubnetsItem, 0)
+	subnetSlice := make([]endpoints.EndpointPropertiesSubnetsInlined, 0)
 	for _, subnet := range d.Get("subnet").([]interface{}) {
 		subnetBlock := subnet.(map[string]interface{})
 		if subnetBlock["scope"].(int) == 0 && subnetBlock["first"].(string) != "0.0.0.0" {
-			subnetSlice = append(subnetSlice, trafficmanager.EndpointPropertiesSubnetsItem{
+			subnetSlice = append(subnetSlice, endpoints.EndpointPropertiesSubnetsInlined{
 				First: utils.String(subnetBlock["first"].(string)),
 				Last:  utils.String(subnetBlock["last"].(string)),
 			})
 		} else {
-			subnetSlice = append(subnetSlice, trafficmanager.EndpointPropertiesSubnetsItem{
+			subnetSlice = append(subnetSlice, endpoints.EndpointPropertiesSubnetsInlined{
 				First: utils.String(subnetBlock["first"].(string)),
-				Scope: utils.Int32(int32(subnetBlock["scope"].(int))),
+				Scope: utils.Int64(int64(subnetBlock["scope"].(int))),
 			})
 		}
 	}
@@ -372,10 +399,10 @@ func getArmTrafficManagerEndpointProperties(d *pluginsdk.ResourceData) *trafficm
 		endpointProps.Subnets = &subnetSlice
 	}
 
-	headerSlice := make([]trafficmanager.EndpointPropertiesCustomHeadersItem, 0)
+	headerSlice := make([]endpoints.EndpointPropertiesCustomHeadersInlined, 0)
 	for _, header := range d.Get("custom_header").([]interface{}) {
 		headerBlock := header.(map[string]interface{})
-		headerSlice = append(headerSlice, trafficmanager.EndpointPropertiesCustomHeadersItem{
+		headerSlice = append(headerSlice, endpoints.EndpointPropertiesCustomHeadersInlined{
 			Name:  utils.String(headerBlock["name"].(string)),
 			Value: utils.String(headerBlock["value"].(string)),
 		})
@@ -387,7 +414,7 @@ func getArmTrafficManagerEndpointProperties(d *pluginsdk.ResourceData) *trafficm
 	return &endpointProps
 }
 
-func flattenAzureRMTrafficManagerEndpointSubnetConfig(input *[]trafficmanager.EndpointPropertiesSubnetsItem) []interface{} {
+func flattenAzureRMTrafficManagerEndpointSubnetConfig(input *[]endpoints.EndpointPropertiesSubnetsInlined) []interface{} {
 	result := make([]interface{}, 0)
 	if input == nil {
 		return result
@@ -408,7 +435,7 @@ func flattenAzureRMTrafficManagerEndpointSubnetConfig(input *[]trafficmanager.En
 	return result
 }
 
-func flattenAzureRMTrafficManagerEndpointCustomHeaderConfig(input *[]trafficmanager.EndpointPropertiesCustomHeadersItem) []interface{} {
+func flattenAzureRMTrafficManagerEndpointCustomHeaderConfig(input *[]endpoints.EndpointPropertiesCustomHeadersInlined) []interface{} {
 	result := make([]interface{}, 0)
 	if input == nil {
 		return result
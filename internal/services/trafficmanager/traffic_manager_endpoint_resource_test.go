@@ -6,9 +6,11 @@ import (
 	"path"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/endpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -186,6 +188,30 @@ func TestAccAzureRMTrafficManagerEndpoint_updateCustomeHeaders(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMTrafficManagerEndpoint_alwaysServe(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_endpoint", "testExternal")
+	r := TrafficManagerEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.alwaysServe(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("always_serve_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.alwaysServe(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("always_serve_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 // Altering priority might be used to switch failover/active roles
 func TestAccAzureRMTrafficManagerEndpoint_updatePriority(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_endpoint", "testExternal")
@@ -295,9 +321,10 @@ func (r TrafficManagerEndpointResource) Exists(ctx context.Context, client *clie
 	profileName := state.Attributes["profile_name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	resp, err := client.TrafficManager.EndpointsClient.Get(ctx, resourceGroup, profileName, path.Base(endpointType), name)
+	id := endpoints.NewEndpointTypeID(client.Account.SubscriptionId, resourceGroup, profileName, endpoints.EndpointType(path.Base(endpointType)), name)
+	resp, err := client.TrafficManager.EndpointsClient.Get(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return utils.Bool(false), nil
 		}
 		return nil, fmt.Errorf("retrieving Traffic Manager Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -311,7 +338,8 @@ func (r TrafficManagerEndpointResource) Destroy(ctx context.Context, client *cli
 	profileName := state.Attributes["profile_name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	if _, err := client.TrafficManager.EndpointsClient.Delete(ctx, resourceGroup, profileName, path.Base(endpointType), name); err != nil {
+	id := endpoints.NewEndpointTypeID(client.Account.SubscriptionId, resourceGroup, profileName, endpoints.EndpointType(path.Base(endpointType)), name)
+	if _, err := client.TrafficManager.EndpointsClient.Delete(ctx, id); err != nil {
 		return nil, fmt.Errorf("deleting Traffic Manager Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 	return utils.Bool(true), nil
@@ -850,6 +878,46 @@ resource "azurerm_traffic_manager_endpoint" "testExternalNew" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (r TrafficManagerEndpointResource) alwaysServe(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-traffic-%d"
+  location = "%s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctest-TMP-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  traffic_routing_method = "Weighted"
+
+  dns_config {
+    relative_name = "acctest-tmp-%d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "https"
+    port     = 443
+    path     = "/"
+  }
+}
+
+resource "azurerm_traffic_manager_endpoint" "testExternal" {
+  name                 = "acctestend-external%d"
+  type                 = "externalEndpoints"
+  target               = "pluginsdk.io"
+  weight               = 100
+  always_serve_enabled = %t
+  profile_name         = azurerm_traffic_manager_profile.test.name
+  resource_group_name  = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, enabled)
+}
+
 func (r TrafficManagerEndpointResource) nestedEndpointsMinChildOnly(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 )
 
 func RFC3339Time(_, old, new string, _ *schema.ResourceData) bool {
@@ -16,3 +17,9 @@ func RFC3339Time(_, old, new string, _ *schema.ResourceData) bool {
 
 	return nt.Equal(ot)
 }
+
+// AzureTimeZone suppresses the diff between the differing representations of the same Time Zone
+// which are returned by Azure APIs, e.g. `UTC`, `Etc/UTC` and `Coordinated Universal Time`
+func AzureTimeZone(_, old, new string, _ *schema.ResourceData) bool {
+	return validate.CanonicalAzureTimeZone(old) == validate.CanonicalAzureTimeZone(new)
+}
@@ -55,3 +55,57 @@ func TestRFC3339Time(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureTimeZone(t *testing.T) {
+	cases := []struct {
+		Name     string
+		ZoneA    string
+		ZoneB    string
+		Suppress bool
+	}{
+		{
+			Name:     "same zone",
+			ZoneA:    "Europe/London",
+			ZoneB:    "Europe/London",
+			Suppress: true,
+		},
+		{
+			Name:     "different zones",
+			ZoneA:    "Europe/London",
+			ZoneB:    "Europe/Paris",
+			Suppress: false,
+		},
+		{
+			Name:     "utc vs etc/utc",
+			ZoneA:    "UTC",
+			ZoneB:    "Etc/UTC",
+			Suppress: true,
+		},
+		{
+			Name:     "iana vs windows",
+			ZoneA:    "America/Los_Angeles",
+			ZoneB:    "Pacific Standard Time",
+			Suppress: true,
+		},
+		{
+			Name:     "different casing",
+			ZoneA:    "asia/tokyo",
+			ZoneB:    "Asia/Tokyo",
+			Suppress: true,
+		},
+		{
+			Name:     "empty vs utc",
+			ZoneA:    "",
+			ZoneB:    "UTC",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if AzureTimeZone("test", tc.ZoneA, tc.ZoneB, nil) != tc.Suppress {
+				t.Fatalf("Expected AzureTimeZone to return %t for '%q' == '%q'", tc.Suppress, tc.ZoneA, tc.ZoneB)
+			}
+		})
+	}
+}